@@ -53,6 +53,9 @@ const (
 	AddBackupId         = "add"
 	RemoveBackupId      = "remove"
 	RemoveBackupShortId = "rm"
+	VerifyBackupId      = "verify"
+	PruneBackupId       = "prune"
+	RotateKeyBackupId   = "rotate-key"
 )
 
 var branchForceFlagDesc = "Reset {{.LessThan}}branchname{{.GreaterThan}} to {{.LessThan}}startpoint{{.GreaterThan}}, even if {{.LessThan}}branchname{{.GreaterThan}} exists already. Without {{.EmphasisLeft}}-f{{.EmphasisRight}}, {{.EmphasisLeft}}dolt branch{{.EmphasisRight}} refuses to change an existing branch. In combination with {{.EmphasisLeft}}-d{{.EmphasisRight}} (or {{.EmphasisLeft}}--delete{{.EmphasisRight}}), allow deleting the branch irrespective of its merged status. In combination with -m (or {{.EmphasisLeft}}--move{{.EmphasisRight}}), allow renaming the branch even if the new branch name already exists, the same applies for {{.EmphasisLeft}}-c{{.EmphasisRight}} (or {{.EmphasisLeft}}--copy{{.EmphasisRight}})."
@@ -77,14 +80,12 @@ func CreateConflictsResolveArgParser() *argparser.ArgParser {
 	ap := argparser.NewArgParserWithVariableArgs("conflicts resolve")
 	ap.SupportsFlag(OursFlag, "", "For all conflicts, take the version from our branch and resolve the conflict")
 	ap.SupportsFlag(TheirsFlag, "", "For all conflicts, take the version from their branch and resolve the conflict")
+	ap.SupportsString(SchemaParam, "", "create table", "Resolve the schema conflict of the table created by the given CREATE TABLE statement by merging both branches' rows into its schema")
 	return ap
 }
 
 func CreateMergeArgParser() *argparser.ArgParser {
-	ap := argparser.NewArgParserWithMaxArgs("merge", 1)
-	ap.TooManyArgsErrorFunc = func(receivedArgs []string) error {
-		return errors.New("Error: Dolt does not support merging from multiple commits. You probably meant to checkout one and then merge from the other.")
-	}
+	ap := argparser.NewArgParserWithVariableArgs("merge")
 	ap.SupportsFlag(NoFFParam, "", "Create a merge commit even when the merge resolves as a fast-forward.")
 	ap.SupportsFlag(SquashParam, "", "Merge changes to the working set without updating the commit history")
 	ap.SupportsString(MessageArg, "m", "msg", "Use the given {{.LessThan}}msg{{.GreaterThan}} as the commit message.")
@@ -93,6 +94,7 @@ func CreateMergeArgParser() *argparser.ArgParser {
 	ap.SupportsFlag(NoCommitFlag, "", "Perform the merge and stop just before creating a merge commit. Note this will not prevent a fast-forward merge; use the --no-ff arg together with the --no-commit arg to prevent both fast-forwards and merge commits.")
 	ap.SupportsFlag(NoEditFlag, "", "Use an auto-generated commit message when creating a merge commit. The default for interactive CLI sessions is to open an editor.")
 	ap.SupportsString(AuthorParam, "", "author", "Specify an explicit author using the standard A U Thor {{.LessThan}}author@example.com{{.GreaterThan}} format.")
+	ap.SupportsString(StrategyParam, "s", "strategy", "Use the given merge strategy. The only supported strategy is {{.EmphasisLeft}}ours{{.EmphasisRight}}, which records a merge of the named commits without taking any of their changes.")
 
 	return ap
 }
@@ -102,10 +104,12 @@ func CreateRebaseArgParser() *argparser.ArgParser {
 	ap.TooManyArgsErrorFunc = func(receivedArgs []string) error {
 		return errors.New("rebase takes at most one positional argument.")
 	}
-	ap.SupportsString(EmptyParam, "", "empty", "How to handle commits that are not empty to start, but which become empty after rebasing. Valid values are: drop (default) or keep")
+	ap.SupportsString(EmptyParam, "", "empty", "How to handle commits that are not empty to start, but which become empty after rebasing. Valid values are: drop (default), keep, or stop")
 	ap.SupportsFlag(AbortParam, "", "Abort an interactive rebase and return the working set to the pre-rebase state")
 	ap.SupportsFlag(ContinueFlag, "", "Continue an interactive rebase after adjusting the rebase plan")
 	ap.SupportsFlag(InteractiveFlag, "i", "Start an interactive rebase")
+	ap.SupportsString(OntoParam, "", "newbase", "Rebase the commits onto {{.LessThan}}newbase{{.GreaterThan}} instead of onto the upstream branch, transplanting the commits reachable from the current branch, but not from the upstream branch")
+	ap.SupportsFlag(AutosquashFlag, "", "Move commits whose messages begin with {{.EmphasisLeft}}fixup!{{.EmphasisRight}} or {{.EmphasisLeft}}squash!{{.EmphasisRight}} in the rebase plan, so that they are fixed up or squashed into the commit they name")
 	return ap
 }
 
@@ -141,6 +145,7 @@ func CreateCloneArgParser() *argparser.ArgParser {
 	ap.SupportsString(dbfactory.OSSCredsProfile, "", "profile", "OSS profile to use.")
 	ap.SupportsString(UserFlag, "u", "user", "User name to use when authenticating with the remote. Gets password from the environment variable {{.EmphasisLeft}}DOLT_REMOTE_PASSWORD{{.EmphasisRight}}.")
 	ap.SupportsFlag(SingleBranchFlag, "", "Clone only the history leading to the tip of a single branch, either specified by --branch or the remote's HEAD (default).")
+	ap.SupportsString(TablesFlag, "", "tables", "Perform a partial clone which only fetches the data of the given comma separated tables. The data of all other tables remains on the remote, and later fetches and pulls from the remote fetch only these tables.")
 	return ap
 }
 
@@ -168,6 +173,8 @@ func CreateCheckoutArgParser() *argparser.ArgParser {
 	ap.SupportsString(CreateResetBranch, "", "branch", "Similar to '-b'. Forcibly resets the branch to {{.LessThan}}start_point{{.GreaterThan}} if it exists.")
 	ap.SupportsFlag(ForceFlag, "f", "If there is any changes in working set, the force flag will wipe out the current changes and checkout the new branch.")
 	ap.SupportsString(TrackFlag, "t", "", "When creating a new branch, set up 'upstream' configuration.")
+	ap.SupportsString(SparseFlag, "", "table_patterns", "Limit the working set to the tables matching the comma separated {{.LessThan}}table_patterns{{.GreaterThan}}, which may use the wildcards * and ?.")
+	ap.SupportsFlag(NoSparseFlag, "", "Disable the sparse checkout of the working set, so that every table is checked out.")
 	return ap
 }
 
@@ -258,6 +265,8 @@ func CreateBackupArgParser() *argparser.ArgParser {
 	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"profile", "AWS profile to use."})
 	ap.SupportsFlag(VerboseFlag, "v", "When printing the list of backups adds additional details.")
 	ap.SupportsFlag(ForceFlag, "f", "When restoring a backup, overwrite the contents of the existing database with the same name.")
+	ap.SupportsInt(KeepLastFlag, "", "n", "When pruning a backup, the number of most recent syncs to keep.")
+	ap.SupportsString(KeyFileFlag, "", "file", "When rotating the encryption key of a backup, the file holding the new key.")
 	ap.SupportsString(dbfactory.AWSRegionParam, "", "region", "")
 	ap.SupportsValidatedString(dbfactory.AWSCredsTypeParam, "", "creds-type", "", argparser.ValidatorFromStrList(dbfactory.AWSCredsTypeParam, dbfactory.AWSCredTypes))
 	ap.SupportsString(dbfactory.AWSCredsFileParam, "", "file", "AWS credentials file")
@@ -339,7 +348,7 @@ func ProcessBackupArgs(apr *argparser.ArgParseResults, scheme, backupUrl string)
 
 	var err error
 	switch scheme {
-	case dbfactory.AWSScheme:
+	case dbfactory.AWSScheme, dbfactory.S3Scheme:
 		err = AddAWSParams(backupUrl, apr, params)
 	case dbfactory.OSSScheme:
 		err = AddOSSParams(backupUrl, apr, params)
@@ -350,12 +359,12 @@ func ProcessBackupArgs(apr *argparser.ArgParseResults, scheme, backupUrl string)
 }
 
 func AddAWSParams(remoteUrl string, apr *argparser.ArgParseResults, params map[string]string) error {
-	isAWS := strings.HasPrefix(remoteUrl, "aws")
+	isAWS := strings.HasPrefix(remoteUrl, "aws") || strings.HasPrefix(remoteUrl, "s3")
 
 	if !isAWS {
 		for _, p := range awsParams {
 			if _, ok := apr.GetValue(p); ok {
-				return fmt.Errorf("%s param is only valid for aws cloud remotes in the format aws://dynamo-table:s3-bucket/database", p)
+				return fmt.Errorf("%s param is only valid for aws cloud remotes in the format aws://dynamo-table:s3-bucket/database or s3://s3-bucket/database", p)
 			}
 		}
 	}
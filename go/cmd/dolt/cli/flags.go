@@ -22,6 +22,7 @@ const (
 	AllowEmptyFlag       = "allow-empty"
 	AmendFlag            = "amend"
 	AuthorParam          = "author"
+	AutosquashFlag       = "autosquash"
 	BranchParam          = "branch"
 	CachedFlag           = "cached"
 	CheckoutCreateBranch = "b"
@@ -42,6 +43,8 @@ const (
 	HardResetParam       = "hard"
 	HostFlag             = "host"
 	InteractiveFlag      = "interactive"
+	KeepLastFlag         = "keep-last"
+	KeyFileFlag          = "key-file"
 	ListFlag             = "list"
 	MergesFlag           = "merges"
 	MessageArg           = "message"
@@ -51,11 +54,13 @@ const (
 	NoEditFlag           = "no-edit"
 	NoFFParam            = "no-ff"
 	NoPrettyFlag         = "no-pretty"
+	NoSparseFlag         = "no-sparse"
 	NoTLSFlag            = "no-tls"
 	NoJsonMergeFlag      = "dont-merge-json"
 	NotFlag              = "not"
 	NumberFlag           = "number"
 	OneLineFlag          = "oneline"
+	OntoParam            = "onto"
 	OursFlag             = "ours"
 	OutputOnlyFlag       = "output-only"
 	ParentsFlag          = "parents"
@@ -65,6 +70,7 @@ const (
 	PruneFlag            = "prune"
 	QuietFlag            = "quiet"
 	RemoteParam          = "remote"
+	SchemaParam          = "schema"
 	SetUpstreamFlag      = "set-upstream"
 	ShallowFlag          = "shallow"
 	ShowIgnoredFlag      = "ignored"
@@ -74,9 +80,11 @@ const (
 	SingleBranchFlag     = "single-branch"
 	SkipEmptyFlag        = "skip-empty"
 	SoftResetParam       = "soft"
+	SparseFlag           = "sparse"
 	SquashParam          = "squash"
 	StagedFlag           = "staged"
 	StatFlag             = "stat"
+	StrategyParam        = "strategy"
 	SystemFlag           = "system"
 	TablesFlag           = "tables"
 	TheirsFlag           = "theirs"
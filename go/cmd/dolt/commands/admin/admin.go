@@ -23,4 +23,7 @@ var Commands = cli.NewHiddenSubCommandHandler("admin", "Commands for directly wo
 	ShowRootCmd{},
 	ZstdCmd{},
 	StorageCmd{},
+	RestoreCmd{},
+	EncryptCmd{},
+	DecryptCmd{},
 })
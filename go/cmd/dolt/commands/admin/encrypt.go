@@ -0,0 +1,189 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/commands"
+	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	"github.com/dolthub/dolt/go/libraries/doltcore/dbfactory"
+	"github.com/dolthub/dolt/go/libraries/doltcore/dconfig"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+	"github.com/dolthub/dolt/go/store/blobstore"
+	"github.com/dolthub/dolt/go/store/nbs"
+)
+
+var encryptDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Encrypt the storage files of the current database",
+	LongDesc: `Encrypts the table files, archives and chunk journal of the current database with AES-256-GCM, and marks the database as encrypted so that all the storage files written to it afterward are encrypted too.
+
+The key is read from the file given by {{.EmphasisLeft}}--key-file{{.EmphasisRight}}, or from the ` + dconfig.EnvEncryptionKey + ` or ` + dconfig.EnvEncryptionKeyFile + ` environment variables. It must be a base64 encoded 256-bit key, e.g. as created by {{.EmphasisLeft}}openssl rand -base64 32{{.EmphasisRight}}. Once the database is encrypted, ` + dconfig.EnvEncryptionKey + ` or ` + dconfig.EnvEncryptionKeyFile + ` must be set to its key to open it.
+
+The database's statistics store is encrypted along with it. Manifests and the journal index are not encrypted; they only hold the names of storage files, and the addresses and offsets of chunks. The database must not be in use by another process, e.g. a running sql-server.`,
+	Synopsis: []string{
+		"[--key-file {{.LessThan}}file{{.GreaterThan}}]",
+	},
+}
+
+var decryptDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Decrypt the storage files of the current database",
+	LongDesc: `Decrypts the table files, archives and chunk journal of the current database, which was encrypted with {{.EmphasisLeft}}dolt admin encrypt{{.EmphasisRight}}. Storage files written to the database afterward are not encrypted.
+
+The key is read from the file given by {{.EmphasisLeft}}--key-file{{.EmphasisRight}}, or from the ` + dconfig.EnvEncryptionKey + ` or ` + dconfig.EnvEncryptionKeyFile + ` environment variables. The database must not be in use by another process, e.g. a running sql-server.`,
+	Synopsis: []string{
+		"[--key-file {{.LessThan}}file{{.GreaterThan}}]",
+	},
+}
+
+type EncryptCmd struct {
+}
+
+// Name is returns the name of the Dolt cli command. This is what is used on the command line to invoke the command
+func (cmd EncryptCmd) Name() string {
+	return "encrypt"
+}
+
+// Description returns a description of the command
+func (cmd EncryptCmd) Description() string {
+	return encryptDocs.ShortDesc
+}
+
+// RequiresRepo should return false if this interface is implemented, and the command does not have the requirement
+// that it be run from within a data repository directory
+func (cmd EncryptCmd) RequiresRepo() bool {
+	return true
+}
+
+func (cmd EncryptCmd) Docs() *cli.CommandDocumentation {
+	return cli.NewCommandDocumentation(encryptDocs, cmd.ArgParser())
+}
+
+func (cmd EncryptCmd) ArgParser() *argparser.ArgParser {
+	return encryptionArgParser(cmd.Name())
+}
+
+func (cmd EncryptCmd) Hidden() bool {
+	return true
+}
+
+// Exec executes the command
+func (cmd EncryptCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
+	ap := cmd.ArgParser()
+	usage, _ := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, encryptDocs, ap))
+	apr := cli.ParseArgsOrDie(ap, args, usage)
+
+	verr := convertStorage(ctx, dEnv, apr, nbs.EncryptLocalStore)
+	if verr != nil {
+		return commands.HandleVErrAndExitCode(verr, usage)
+	}
+	cli.Printf("Encrypted the database. Set %s or %s to its key to open it.\n", dconfig.EnvEncryptionKey, dconfig.EnvEncryptionKeyFile)
+	return 0
+}
+
+type DecryptCmd struct {
+}
+
+// Name is returns the name of the Dolt cli command. This is what is used on the command line to invoke the command
+func (cmd DecryptCmd) Name() string {
+	return "decrypt"
+}
+
+// Description returns a description of the command
+func (cmd DecryptCmd) Description() string {
+	return decryptDocs.ShortDesc
+}
+
+// RequiresRepo should return false if this interface is implemented, and the command does not have the requirement
+// that it be run from within a data repository directory
+func (cmd DecryptCmd) RequiresRepo() bool {
+	return true
+}
+
+func (cmd DecryptCmd) Docs() *cli.CommandDocumentation {
+	return cli.NewCommandDocumentation(decryptDocs, cmd.ArgParser())
+}
+
+func (cmd DecryptCmd) ArgParser() *argparser.ArgParser {
+	return encryptionArgParser(cmd.Name())
+}
+
+func (cmd DecryptCmd) Hidden() bool {
+	return true
+}
+
+// Exec executes the command
+func (cmd DecryptCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
+	ap := cmd.ArgParser()
+	usage, _ := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, decryptDocs, ap))
+	apr := cli.ParseArgsOrDie(ap, args, usage)
+
+	verr := convertStorage(ctx, dEnv, apr, nbs.DecryptLocalStore)
+	if verr != nil {
+		return commands.HandleVErrAndExitCode(verr, usage)
+	}
+	cli.Println("Decrypted the database.")
+	return 0
+}
+
+func encryptionArgParser(name string) *argparser.ArgParser {
+	ap := argparser.NewArgParserWithMaxArgs(name, 0)
+	ap.SupportsString(cli.KeyFileFlag, "", "file", "the file holding the base64 encoded encryption key")
+	return ap
+}
+
+// convertStorage encrypts or decrypts the storage files of the database in |dEnv| with |convert|, using the key given
+// by the arguments, or the environment.
+func convertStorage(ctx context.Context, dEnv *env.DoltEnv, apr *argparser.ArgParseResults, convert func(context.Context, string, []byte) error) errhand.VerboseError {
+	var key []byte
+	var err error
+	if keyFile, ok := apr.GetValue(cli.KeyFileFlag); ok {
+		key, err = blobstore.ReadEncryptionKeyFile(keyFile)
+	} else {
+		key, err = nbs.ReadEncryptionKey()
+		if err == nil && key == nil {
+			err = fmt.Errorf("an encryption key is required; use --%s, or set %s or %s", cli.KeyFileFlag, dconfig.EnvEncryptionKey, dconfig.EnvEncryptionKeyFile)
+		}
+	}
+	if err != nil {
+		return errhand.VerboseErrorFromError(err)
+	}
+
+	dir, err := dEnv.FS.Abs(dbfactory.DoltDataDir)
+	if err != nil {
+		return errhand.VerboseErrorFromError(err)
+	}
+
+	// the database is closed before its files are rewritten, if it could be opened
+	if ddb := dEnv.DoltDB(ctx); ddb != nil {
+		if dEnv.IsAccessModeReadOnly(ctx) {
+			return errhand.BuildDError("error: the database is in use by another process").Build()
+		}
+		if err = ddb.Close(); err != nil {
+			return errhand.VerboseErrorFromError(err)
+		}
+		if err = dbfactory.DeleteFromSingletonCache(filepath.ToSlash(dir)); err != nil {
+			return errhand.VerboseErrorFromError(err)
+		}
+	}
+	if err = convert(ctx, dir, key); err != nil {
+		return errhand.BuildDError("error: unable to convert the storage files of the database").AddCause(err).Build()
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/commands"
+	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	"github.com/dolthub/dolt/go/libraries/doltcore/dbfactory"
+	"github.com/dolthub/dolt/go/libraries/doltcore/dconfig"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+)
+
+const asOfFlag = "as-of"
+
+var restoreDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Restore the database as of a point in time into a new database",
+	LongDesc: `Recreates every branch, tag, and working set of the current database as it was at {{.LessThan}}time{{.GreaterThan}} in a new database in the directory {{.LessThan}}name{{.GreaterThan}}. The current database is not modified.
+
+The state restored is the last root update written to the chunk journal at or before {{.LessThan}}time{{.GreaterThan}}, which is interpreted as UTC unless it includes a time zone offset, e.g. {{.EmphasisLeft}}2026-10-01 12:00{{.EmphasisRight}} or {{.EmphasisLeft}}2026-10-01T12:00:00-07:00{{.EmphasisRight}}. Only updates still in the chunk journal can be restored; garbage collection starts a new journal.`,
+	Synopsis: []string{
+		"--as-of {{.LessThan}}time{{.GreaterThan}} {{.LessThan}}name{{.GreaterThan}}",
+	},
+}
+
+type RestoreCmd struct {
+}
+
+// Name is returns the name of the Dolt cli command. This is what is used on the command line to invoke the command
+func (cmd RestoreCmd) Name() string {
+	return "restore"
+}
+
+// Description returns a description of the command
+func (cmd RestoreCmd) Description() string {
+	return restoreDocs.ShortDesc
+}
+
+// RequiresRepo should return false if this interface is implemented, and the command does not have the requirement
+// that it be run from within a data repository directory
+func (cmd RestoreCmd) RequiresRepo() bool {
+	return true
+}
+
+func (cmd RestoreCmd) Docs() *cli.CommandDocumentation {
+	return cli.NewCommandDocumentation(restoreDocs, cmd.ArgParser())
+}
+
+func (cmd RestoreCmd) ArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithMaxArgs(cmd.Name(), 1)
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"name", "The directory of the new database to restore into."})
+	ap.SupportsString(asOfFlag, "", "time", "the point in time to restore the database as of")
+	return ap
+}
+
+func (cmd RestoreCmd) Hidden() bool {
+	return true
+}
+
+// Exec executes the command
+func (cmd RestoreCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
+	ap := cmd.ArgParser()
+	usage, _ := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, restoreDocs, ap))
+
+	apr := cli.ParseArgsOrDie(ap, args, usage)
+	asOfStr, ok := apr.GetValue(asOfFlag)
+	if !ok || apr.NArg() != 1 {
+		return commands.HandleVErrAndExitCode(errhand.BuildDError("--as-of and a database name are required").SetPrintUsage().Build(), usage)
+	}
+
+	asOf, err := dconfig.ParseDate(asOfStr)
+	if err != nil {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	restoredAt, verr := restoreAsOf(ctx, dEnv, apr.Arg(0), asOf)
+	if verr != nil {
+		return commands.HandleVErrAndExitCode(verr, usage)
+	}
+
+	cli.Printf("Restored database as of %s into %s\n", restoredAt.UTC().Format(time.RFC3339), apr.Arg(0))
+	return 0
+}
+
+func restoreAsOf(ctx context.Context, dEnv *env.DoltEnv, dbName string, asOf time.Time) (time.Time, errhand.VerboseError) {
+	// For error recovery, record whether EnvForClone created the directory, or just `.dolt/noms` within the directory.
+	userDirExisted, _ := dEnv.FS.Exists(dbName)
+
+	restoredEnv, err := actions.EnvForClone(ctx, dEnv.DoltDB(ctx).Format(), env.NoRemote, dbName, dEnv.FS, dEnv.Version, env.GetCurrentUserHomeDir)
+	if err != nil {
+		return time.Time{}, errhand.VerboseErrorFromError(err)
+	}
+
+	restoredEnv.RepoState, err = env.CreateRepoState(restoredEnv.FS, env.DefaultInitBranch)
+	if err == nil {
+		var restoredAt time.Time
+		restoredAt, err = actions.RestoreAsOf(ctx, dEnv.DoltDB(ctx), restoredEnv, asOf)
+		if err == nil {
+			return restoredAt, nil
+		}
+	}
+
+	// If we're restoring into a directory that already exists do not erase it. Otherwise
+	// make best effort to delete the directory we created.
+	if userDirExisted {
+		_ = restoredEnv.FS.Delete(dbfactory.DoltDir, true)
+	} else {
+		_ = restoredEnv.FS.Delete(".", true)
+	}
+	return time.Time{}, errhand.BuildDError("error: unable to restore database as of %s", asOf.Format(time.RFC3339)).AddCause(err).Build()
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"strings"
+	"time"
 
 	"github.com/dolthub/dolt/go/store/types"
 
@@ -37,7 +38,7 @@ var backupDocs = cli.CommandDocumentationContent{
 
 {{.EmphasisLeft}}add{{.EmphasisRight}}
 Adds a backup named {{.LessThan}}name{{.GreaterThan}} for the database at {{.LessThan}}url{{.GreaterThan}}.
-The {{.LessThan}}url{{.GreaterThan}} parameter supports url schemes of http, https, aws, gs, and file. The url prefix defaults to https. If the {{.LessThan}}url{{.GreaterThan}} parameter is in the format {{.EmphasisLeft}}<organization>/<repository>{{.EmphasisRight}} then dolt will use the {{.EmphasisLeft}}backups.default_host{{.EmphasisRight}} from your configuration file (Which will be dolthub.com unless changed).
+The {{.LessThan}}url{{.GreaterThan}} parameter supports url schemes of http, https, aws, s3, gs, azure, and file. The url prefix defaults to https. If the {{.LessThan}}url{{.GreaterThan}} parameter is in the format {{.EmphasisLeft}}<organization>/<repository>{{.EmphasisRight}} then dolt will use the {{.EmphasisLeft}}backups.default_host{{.EmphasisRight}} from your configuration file (Which will be dolthub.com unless changed).
 The URL address must be unique to existing remotes and backups.
 
 AWS cloud backup urls should be of the form {{.EmphasisLeft}}aws://[dynamo-table:s3-bucket]/database{{.EmphasisRight}}. You may configure your aws cloud backup using the optional parameters {{.EmphasisLeft}}aws-region{{.EmphasisRight}}, {{.EmphasisLeft}}aws-creds-type{{.EmphasisRight}}, {{.EmphasisLeft}}aws-creds-file{{.EmphasisRight}}.
@@ -51,6 +52,10 @@ aws-creds-type specifies the means by which credentials should be retrieved in o
 	
 GCP backup urls should be of the form gs://gcs-bucket/database and will use the credentials setup using the gcloud command line available from Google.
 
+S3 backup urls of the form {{.EmphasisLeft}}s3://[s3-bucket]/database{{.EmphasisRight}} store the manifest in the s3 bucket, and update it with S3 conditional writes instead of a dynamo table, so they can also be used with S3 compatible object stores such as MinIO and Ceph. They take the same optional parameters as aws urls. The endpoint of an S3 compatible object store is set with the AWS_ENDPOINT_URL_S3 environment variable, or the endpoint_url setting of the AWS profile in use.
+
+Azure backup urls should be of the form {{.EmphasisLeft}}azure://[storage-account]/[container]/database{{.EmphasisRight}}. The credentials of the storage account are read from the AZURE_STORAGE_CONNECTION_STRING, AZURE_STORAGE_KEY or AZURE_STORAGE_SAS_TOKEN environment variables, in that order. A connection string also sets the endpoint of the storage account, e.g. to use the Azurite emulator.
+
 The local filesystem can be used as a backup by providing a repository url in the format file://absolute path. See https://en.wikipedia.org/wiki/File_URI_scheme
 
 {{.EmphasisLeft}}remove{{.EmphasisRight}}, {{.EmphasisLeft}}rm{{.EmphasisRight}}
@@ -60,11 +65,22 @@ Remove the backup named {{.LessThan}}name{{.GreaterThan}}. All configuration set
 Restore a Dolt database from a given {{.LessThan}}url{{.GreaterThan}} into a specified directory {{.LessThan}}name{{.GreaterThan}}. This will fail if {{.LessThan}}name{{.GreaterThan}} is already a Dolt database unless '--force' is provided, in which case the existing database will be overwritten with the contents of the restored backup.
 
 {{.EmphasisLeft}}sync{{.EmphasisRight}}
-Snapshot the database and upload to the backup {{.LessThan}}name{{.GreaterThan}}. This includes branches, tags, working sets, and remote tracking refs.
+Snapshot the database and upload to the backup {{.LessThan}}name{{.GreaterThan}}. This includes branches, tags, working sets, and remote tracking refs. Syncs are incremental: only the chunks the backup does not already have are uploaded, and each sync records the table files it added in the backup's chain of syncs.
 
 	
 {{.EmphasisLeft}}sync-url{{.EmphasisRight}}
-Snapshot the database and upload the backup to {{.LessThan}}url{{.GreaterThan}}. Like sync, this includes branches, tags, working sets, and remote tracking refs, but it does not require you to create a named backup`,
+Snapshot the database and upload the backup to {{.LessThan}}url{{.GreaterThan}}. Like sync, this includes branches, tags, working sets, and remote tracking refs, but it does not require you to create a named backup
+
+{{.EmphasisLeft}}verify{{.EmphasisRight}}
+Check that every chunk reachable from the root of each sync in the chain of the backup {{.LessThan}}name{{.GreaterThan}} exists and hashes correctly, and list the syncs that were verified.
+
+{{.EmphasisLeft}}prune{{.EmphasisRight}}
+Remove all but the {{.LessThan}}n{{.GreaterThan}} most recent syncs from the chain of the backup {{.LessThan}}name{{.GreaterThan}}, and garbage collect the data which is only reachable from the removed syncs. Only backups on the local filesystem can be pruned.
+
+{{.EmphasisLeft}}rotate-key{{.EmphasisRight}}
+Rotate the encryption keys of the encrypted backup {{.LessThan}}name{{.GreaterThan}}. A new data key is generated, which encrypts all the data synced to the backup afterward. If {{.EmphasisLeft}}--key-file{{.EmphasisRight}} is given, the backup's data keys are re-encrypted with the key it holds, and the backup is updated to use it.
+
+Backups stored in aws, s3, gs, azure, oci, oss and localbs urls can be encrypted on the client by adding the parameter {{.EmphasisLeft}}encrypt{{.EmphasisRight}} to their url, whose value is the path of a file holding a base64 encoded 256-bit key, e.g. {{.EmphasisLeft}}gs://gcs-bucket/database?encrypt=/path/to/keyfile{{.EmphasisRight}}. A key can be created with {{.EmphasisLeft}}openssl rand -base64 32{{.EmphasisRight}}. The manifest of an encrypted aws backup is kept in the dynamo table unencrypted, as it only holds the addresses of its table files.`,
 
 	Synopsis: []string{
 		"[-v | --verbose]",
@@ -73,6 +89,9 @@ Snapshot the database and upload the backup to {{.LessThan}}url{{.GreaterThan}}.
 		"restore [--force] {{.LessThan}}url{{.GreaterThan}} {{.LessThan}}name{{.GreaterThan}}",
 		"sync {{.LessThan}}name{{.GreaterThan}}",
 		"sync-url [--aws-region {{.LessThan}}region{{.GreaterThan}}] [--aws-creds-type {{.LessThan}}creds-type{{.GreaterThan}}] [--aws-creds-file {{.LessThan}}file{{.GreaterThan}}] [--aws-creds-profile {{.LessThan}}profile{{.GreaterThan}}] {{.LessThan}}url{{.GreaterThan}}",
+		"verify {{.LessThan}}name{{.GreaterThan}}",
+		"prune --keep-last {{.LessThan}}n{{.GreaterThan}} {{.LessThan}}name{{.GreaterThan}}",
+		"rotate-key [--key-file {{.LessThan}}file{{.GreaterThan}}] {{.LessThan}}name{{.GreaterThan}}",
 	},
 }
 
@@ -136,6 +155,12 @@ func (cmd BackupCmd) Exec(ctx context.Context, commandStr string, args []string,
 		verr = syncBackupUrl(ctx, dEnv, apr)
 	case apr.Arg(0) == cli.RestoreBackupId:
 		verr = restoreBackup(ctx, dEnv, apr)
+	case apr.Arg(0) == cli.VerifyBackupId:
+		verr = verifyBackup(ctx, dEnv, apr)
+	case apr.Arg(0) == cli.PruneBackupId:
+		verr = pruneBackup(ctx, dEnv, apr)
+	case apr.Arg(0) == cli.RotateKeyBackupId:
+		verr = rotateBackupKey(ctx, dEnv, apr)
 	default:
 		verr = errhand.BuildDError("").SetPrintUsage().Build()
 	}
@@ -251,19 +276,28 @@ func syncBackup(ctx context.Context, dEnv *env.DoltEnv, apr *argparser.ArgParseR
 		return errhand.BuildDError("").SetPrintUsage().Build()
 	}
 
-	backupName := strings.TrimSpace(apr.Arg(1))
+	b, verr := getNamedBackup(dEnv, apr.Arg(1))
+	if verr != nil {
+		return verr
+	}
+
+	return backup(ctx, dEnv, b)
+}
+
+func getNamedBackup(dEnv *env.DoltEnv, name string) (env.Remote, errhand.VerboseError) {
+	backupName := strings.TrimSpace(name)
 
 	backups, err := dEnv.GetBackups()
 	if err != nil {
-		return errhand.BuildDError("Unable to get backups from the local directory").AddCause(err).Build()
+		return env.Remote{}, errhand.BuildDError("Unable to get backups from the local directory").AddCause(err).Build()
 	}
 
 	b, ok := backups.Get(backupName)
 	if !ok {
-		return errhand.BuildDError("error: unknown backup: '%s' ", backupName).Build()
+		return env.Remote{}, errhand.BuildDError("error: unknown backup: '%s' ", backupName).Build()
 	}
 
-	return backup(ctx, dEnv, b)
+	return b, nil
 }
 
 func backup(ctx context.Context, dEnv *env.DoltEnv, b env.Remote) errhand.VerboseError {
@@ -275,7 +309,7 @@ func backup(ctx context.Context, dEnv *env.DoltEnv, b env.Remote) errhand.Verbos
 	if err != nil {
 		return errhand.BuildDError("error: ").AddCause(err).Build()
 	}
-	err = actions.SyncRoots(ctx, dEnv.DoltDB(ctx), destDb, tmpDir, buildProgStarter(defaultLanguage), stopProgFuncs)
+	_, err = actions.SyncBackup(ctx, dEnv.DoltDB(ctx), destDb, tmpDir, buildProgStarter(defaultLanguage), stopProgFuncs)
 
 	switch err {
 	case nil:
@@ -295,6 +329,80 @@ func backup(ctx context.Context, dEnv *env.DoltEnv, b env.Remote) errhand.Verbos
 	}
 }
 
+func verifyBackup(ctx context.Context, dEnv *env.DoltEnv, apr *argparser.ArgParseResults) errhand.VerboseError {
+	if apr.NArg() != 2 {
+		return errhand.BuildDError("").SetPrintUsage().Build()
+	}
+
+	b, verr := getNamedBackup(dEnv, apr.Arg(1))
+	if verr != nil {
+		return verr
+	}
+	backupDb, err := b.GetRemoteDB(ctx, dEnv.DoltDB(ctx).ValueReadWriter().Format(), dEnv)
+	if err != nil {
+		return errhand.BuildDError("error: unable to open backup.").AddCause(err).Build()
+	}
+
+	chain, err := actions.VerifyBackup(ctx, backupDb)
+	if err != nil {
+		return errhand.BuildDError("error: backup '%s' is not valid", b.Name).AddCause(err).Build()
+	}
+
+	for _, entry := range chain {
+		cli.Printf("%d\t%s\t%s\t%d table files added\n", entry.ID, entry.Time.Format(time.RFC3339), entry.Root, len(entry.TableFiles))
+	}
+	cli.Printf("backup '%s' verified\n", b.Name)
+	return nil
+}
+
+func pruneBackup(ctx context.Context, dEnv *env.DoltEnv, apr *argparser.ArgParseResults) errhand.VerboseError {
+	keepLast, ok := apr.GetInt(cli.KeepLastFlag)
+	if !ok || apr.NArg() != 2 {
+		return errhand.BuildDError("").SetPrintUsage().Build()
+	}
+
+	b, verr := getNamedBackup(dEnv, apr.Arg(1))
+	if verr != nil {
+		return verr
+	}
+	backupDb, err := b.GetRemoteDB(ctx, dEnv.DoltDB(ctx).ValueReadWriter().Format(), dEnv)
+	if err != nil {
+		return errhand.BuildDError("error: unable to open backup.").AddCause(err).Build()
+	}
+
+	pruned, err := actions.PruneBackups(ctx, backupDb, keepLast)
+	if err != nil {
+		return errhand.BuildDError("error: unable to prune backup '%s'", b.Name).AddCause(err).Build()
+	}
+
+	cli.Printf("pruned %d backups from '%s'\n", len(pruned), b.Name)
+	return nil
+}
+
+func rotateBackupKey(ctx context.Context, dEnv *env.DoltEnv, apr *argparser.ArgParseResults) errhand.VerboseError {
+	if apr.NArg() != 2 {
+		return errhand.BuildDError("").SetPrintUsage().Build()
+	}
+
+	b, verr := getNamedBackup(dEnv, apr.Arg(1))
+	if verr != nil {
+		return verr
+	}
+
+	newKeyFile, _ := apr.GetValue(cli.KeyFileFlag)
+	rotated, err := b.RotateEncryptionKey(ctx, dEnv.FS, newKeyFile)
+	if err != nil {
+		return errhand.BuildDError("error: unable to rotate the encryption key of backup '%s'", b.Name).AddCause(err).Build()
+	}
+	b = rotated
+
+	dEnv.RepoState.Backups.Set(b.Name, b)
+	if err = dEnv.RepoState.Save(dEnv.FS); err != nil {
+		return errhand.BuildDError("error: the encryption key of backup '%s' was rotated, but the backup could not be updated to use the new key file", b.Name).AddCause(err).Build()
+	}
+	return nil
+}
+
 func restoreBackup(ctx context.Context, dEnv *env.DoltEnv, apr *argparser.ArgParseResults) errhand.VerboseError {
 	if apr.NArg() < 3 {
 		return errhand.BuildDError("").SetPrintUsage().Build()
@@ -16,10 +16,10 @@ package commands
 
 import (
 	"context"
-	"fmt"
 	"regexp"
 
-	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/gocraft/dbr/v2"
+	"github.com/gocraft/dbr/v2/dialect"
 
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
 	"github.com/dolthub/dolt/go/cmd/dolt/commands/engine"
@@ -32,14 +32,21 @@ import (
 )
 
 const (
-	blameQueryTemplate = "SELECT * FROM dolt_blame_%s AS OF '%s'"
+	blameQueryTemplate        = "SELECT * FROM ? AS OF ?"
+	blameColumnsQueryTemplate = "SELECT * FROM dolt_blame(?, ?)"
+
+	blameColumnsFlag = "columns"
 )
 
 var blameDocs = cli.CommandDocumentationContent{
 	ShortDesc: `Show what revision and author last modified each row of a table`,
-	LongDesc:  `Annotates each row in the given table with information from the revision which last modified the row. Optionally, start annotating from the given revision.`,
+	LongDesc: `Annotates each row in the given table with information from the revision which last modified the row. Optionally, start annotating from the given revision.
+
+With {{.EmphasisLeft}}--columns{{.EmphasisRight}}, annotates each column of each row with the revision which last modified that cell instead.
+
+Rows of tables without a primary key are identified by their row hash.`,
 	Synopsis: []string{
-		`[{{.LessThan}}rev{{.GreaterThan}}] {{.LessThan}}tablename{{.GreaterThan}}`,
+		`[--columns] [{{.LessThan}}rev{{.GreaterThan}}] {{.LessThan}}tablename{{.GreaterThan}}`,
 	},
 }
 
@@ -62,6 +69,7 @@ func (cmd BlameCmd) Docs() *cli.CommandDocumentation {
 
 func (cmd BlameCmd) ArgParser() *argparser.ArgParser {
 	ap := argparser.NewArgParserWithMaxArgs(cmd.Name(), 2)
+	ap.SupportsFlag(blameColumnsFlag, "", "Show the revision which last modified each column of each row.")
 	return ap
 }
 
@@ -111,25 +119,32 @@ func (cmd BlameCmd) Exec(ctx context.Context, commandStr string, args []string,
 		defer closeFunc()
 	}
 
-	var schema sql.Schema
-	var ri sql.RowIter
-	if apr.NArg() == 1 {
-		schema, ri, _, err = queryist.Query(sqlCtx, fmt.Sprintf(blameQueryTemplate, apr.Arg(0), "HEAD"))
-	} else {
-		// validate input
-		ref := apr.Arg(0)
-		if !ref2.IsValidTagName(ref) && !doltdb.IsValidCommitHash(ref) && !isValidHeadRef(ref) {
+	rev, tableName := "HEAD", apr.Arg(0)
+	if apr.NArg() == 2 {
+		rev, tableName = apr.Arg(0), apr.Arg(1)
+		if !ref2.IsValidTagName(rev) && !doltdb.IsValidCommitHash(rev) && !isValidHeadRef(rev) {
 			iohelp.WriteLine(cli.CliOut, "Invalid reference provided")
 			return 1
 		}
+	}
 
-		schema, ri, _, err = queryist.Query(sqlCtx, fmt.Sprintf(blameQueryTemplate, apr.Arg(1), apr.Arg(0)))
+	var query string
+	if apr.Contains(blameColumnsFlag) {
+		query, err = dbr.InterpolateForDialect(blameColumnsQueryTemplate, []interface{}{rev, tableName}, dialect.MySQL)
+	} else {
+		query, err = dbr.InterpolateForDialect(blameQueryTemplate, []interface{}{dbr.I(doltdb.DoltBlameViewPrefix + tableName), rev}, dialect.MySQL)
 	}
 	if err != nil {
 		iohelp.WriteLine(cli.CliOut, err.Error())
 		return 1
 	}
 
+	schema, ri, _, err := queryist.Query(sqlCtx, query)
+	if err != nil {
+		iohelp.WriteLine(cli.CliOut, err.Error())
+		return 1
+	}
+
 	err = engine.PrettyPrintResults(sqlCtx, engine.FormatTabular, schema, ri, false)
 	if err != nil {
 		iohelp.WriteLine(cli.CliOut, err.Error())
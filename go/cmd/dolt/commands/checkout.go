@@ -51,13 +51,18 @@ dolt checkout -b {{.LessThan}}new_branch{{.GreaterThan}} [{{.LessThan}}start_poi
    Specifying -b causes a new branch to be created as if dolt branch were called and then checked out.
 
 dolt checkout {{.LessThan}}table{{.GreaterThan}}...
-  To update table(s) with their values in HEAD `,
+  To update table(s) with their values in HEAD
+
+dolt checkout --sparse {{.LessThan}}table_patterns{{.GreaterThan}} [{{.LessThan}}branch{{.GreaterThan}}]
+   Limits the working set of {{.LessThan}}branch{{.GreaterThan}}, or of the current branch if none is given, to the tables matching the comma separated {{.LessThan}}table_patterns{{.GreaterThan}}. Tables outside of the sparse checkout are hidden from {{.EmphasisLeft}}SHOW TABLES{{.EmphasisRight}}, {{.EmphasisLeft}}dolt status{{.EmphasisRight}} and {{.EmphasisLeft}}dolt diff --summary{{.EmphasisRight}}, and are merged without reading their rows unless both sides of the merge changed them. The patterns can also be edited with the {{.EmphasisLeft}}dolt_sparse{{.EmphasisRight}} system table. {{.EmphasisLeft}}--no-sparse{{.EmphasisRight}} checks out every table again. `,
 	Synopsis: []string{
 		`{{.LessThan}}branch{{.GreaterThan}}`,
 		`{{.LessThan}}commit{{.GreaterThan}} [--] {{.LessThan}}table{{.GreaterThan}}...`,
 		`{{.LessThan}}table{{.GreaterThan}}...`,
 		`-b {{.LessThan}}new-branch{{.GreaterThan}} [{{.LessThan}}start-point{{.GreaterThan}}]`,
 		`--track {{.LessThan}}remote{{.GreaterThan}}/{{.LessThan}}branch{{.GreaterThan}}`,
+		`--sparse {{.LessThan}}table_patterns{{.GreaterThan}} [{{.LessThan}}branch{{.GreaterThan}}]`,
+		`--no-sparse [{{.LessThan}}branch{{.GreaterThan}}]`,
 	},
 }
 
@@ -117,7 +122,8 @@ func (cmd CheckoutCmd) Exec(ctx context.Context, commandStr string, args []strin
 	// Argument validation in the CLI is strictly nice to have. The stored procedure will do the same, but the errors
 	// won't be as nice.
 	branchOrTrack := apr.Contains(cli.CheckoutCreateBranch) || apr.Contains(cli.CreateResetBranch) || apr.Contains(cli.TrackFlag)
-	if (branchOrTrack && apr.NArg() > 1) || (!branchOrTrack && apr.NArg() == 0) {
+	sparse := apr.Contains(cli.SparseFlag) || apr.Contains(cli.NoSparseFlag)
+	if (branchOrTrack && apr.NArg() > 1) || (!branchOrTrack && !sparse && apr.NArg() == 0) {
 		usage()
 		return 1
 	}
@@ -0,0 +1,162 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ci
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fatih/color"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/commands"
+	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions/dolt_ci"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+)
+
+const refFlag = "ref"
+
+var runDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Run a Dolt continuous integration workflow by name",
+	LongDesc: `Run each job of a Dolt continuous integration workflow against the working set, or against {{.EmphasisLeft}}--ref{{.EmphasisRight}} if supplied.
+
+Each saved query step is executed and its results are compared against the step's expected columns and rows. A report of passing and failing steps is printed, and the command exits with a non-zero exit code if any step fails.`,
+	Synopsis: []string{
+		"[--ref {{.LessThan}}ref{{.GreaterThan}}] {{.LessThan}}workflow name{{.GreaterThan}}",
+	},
+}
+
+type RunCmd struct{}
+
+// Name implements cli.Command.
+func (cmd RunCmd) Name() string {
+	return "run"
+}
+
+// Description implements cli.Command.
+func (cmd RunCmd) Description() string {
+	return runDocs.ShortDesc
+}
+
+// RequiresRepo implements cli.Command.
+func (cmd RunCmd) RequiresRepo() bool {
+	return true
+}
+
+// Docs implements cli.Command.
+func (cmd RunCmd) Docs() *cli.CommandDocumentation {
+	ap := cmd.ArgParser()
+	return cli.NewCommandDocumentation(runDocs, ap)
+}
+
+// Hidden should return true if this command should be hidden from the help text
+func (cmd RunCmd) Hidden() bool {
+	return false
+}
+
+// ArgParser implements cli.Command.
+func (cmd RunCmd) ArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithMaxArgs(cmd.Name(), 1)
+	ap.SupportsString(refFlag, "", "ref", "The branch, tag or commit to run the workflow against. Defaults to the working set.")
+	return ap
+}
+
+// Exec implements cli.Command.
+func (cmd RunCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
+	ap := cmd.ArgParser()
+	help, usage := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, runDocs, ap))
+	apr := cli.ParseArgsOrDie(ap, args, help)
+	if !cli.CheckEnvIsValid(dEnv) {
+		return 1
+	}
+
+	if apr.NArg() != 1 {
+		return commands.HandleVErrAndExitCode(errhand.BuildDError("expected 1 argument").SetPrintUsage().Build(), usage)
+	}
+
+	workflowName := apr.Arg(0)
+
+	queryist, sqlCtx, closeFunc, err := cliCtx.QueryEngine(ctx)
+	if err != nil {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+	if closeFunc != nil {
+		defer closeFunc()
+	}
+
+	user, email, err := env.GetNameAndEmail(dEnv.Config)
+	if err != nil {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	hasTables, err := dolt_ci.HasDoltCITables(sqlCtx)
+	if err != nil {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	if !hasTables {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(fmt.Errorf("dolt ci has not been initialized, please initialize with: dolt ci init")), usage)
+	}
+
+	dbName := sqlCtx.GetCurrentDatabase()
+	if ref, ok := apr.GetValue(refFlag); ok {
+		dbName = fmt.Sprintf("%s/%s", dbName, ref)
+		_, err = commands.GetRowsForSql(queryist, sqlCtx, fmt.Sprintf("use `%s`", dbName))
+		if err != nil {
+			return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+		}
+	}
+
+	db, err := newDatabase(sqlCtx, dbName, dEnv, false)
+	if err != nil {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	wm := dolt_ci.NewWorkflowManager(user, email, queryist.Query)
+
+	result, err := wm.RunWorkflow(sqlCtx, db, workflowName)
+	if err != nil {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	printWorkflowRunResult(result)
+
+	if !result.Passed() {
+		return 1
+	}
+	return 0
+}
+
+func printWorkflowRunResult(result *dolt_ci.WorkflowRunResult) {
+	cli.Println(color.CyanString(fmt.Sprintf("Running workflow: %s", result.WorkflowName)))
+	for _, job := range result.Jobs {
+		cli.Println(fmt.Sprintf("Running job: %s", job.JobName))
+		for _, step := range job.Steps {
+			if step.Passed {
+				cli.Println(color.GreenString(fmt.Sprintf("  PASS  %s", step.StepName)))
+			} else {
+				cli.Println(color.RedString(fmt.Sprintf("  FAIL  %s: %s", step.StepName, step.Message)))
+			}
+		}
+	}
+
+	if result.Passed() {
+		cli.Println(color.GreenString(fmt.Sprintf("Workflow '%s' passed", result.WorkflowName)))
+	} else {
+		cli.Println(color.RedString(fmt.Sprintf("Workflow '%s' failed", result.WorkflowName)))
+	}
+}
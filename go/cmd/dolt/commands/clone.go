@@ -43,9 +43,11 @@ var cloneDocs = cli.CommandDocumentationContent{
 After the clone, a plain {{.EmphasisLeft}}dolt fetch{{.EmphasisRight}} without arguments will update all the remote-tracking branches, and a {{.EmphasisLeft}}dolt pull{{.EmphasisRight}} without arguments will in addition merge the remote branch into the current branch.
 
 This default configuration is achieved by creating references to the remote branch heads under {{.LessThan}}refs/remotes/origin{{.GreaterThan}}  and by creating a remote named 'origin'.
+
+When {{.EmphasisLeft}}--tables{{.EmphasisRight}} is given, a partial clone is performed, which only fetches the data of the listed tables (and of system tables such as {{.EmphasisLeft}}dolt_schemas{{.EmphasisRight}}). All other tables are still listed in the cloned database, but their data remains on the remote, and reading them results in an error. Later fetches and pulls from the remote remain partial.
 `,
 	Synopsis: []string{
-		"[-remote {{.LessThan}}remote{{.GreaterThan}}] [-branch {{.LessThan}}branch{{.GreaterThan}}]  [--aws-region {{.LessThan}}region{{.GreaterThan}}] [--aws-creds-type {{.LessThan}}creds-type{{.GreaterThan}}] [--aws-creds-file {{.LessThan}}file{{.GreaterThan}}] [--aws-creds-profile {{.LessThan}}profile{{.GreaterThan}}] {{.LessThan}}remote-url{{.GreaterThan}} {{.LessThan}}new-dir{{.GreaterThan}}",
+		"[-remote {{.LessThan}}remote{{.GreaterThan}}] [-branch {{.LessThan}}branch{{.GreaterThan}}]  [--aws-region {{.LessThan}}region{{.GreaterThan}}] [--aws-creds-type {{.LessThan}}creds-type{{.GreaterThan}}] [--aws-creds-file {{.LessThan}}file{{.GreaterThan}}] [--aws-creds-profile {{.LessThan}}profile{{.GreaterThan}}] [--tables {{.LessThan}}table{{.GreaterThan}}[,{{.LessThan}}table{{.GreaterThan}}...]] {{.LessThan}}remote-url{{.GreaterThan}} {{.LessThan}}new-dir{{.GreaterThan}}",
 	},
 }
 
@@ -135,6 +137,13 @@ func clone(ctx context.Context, apr *argparser.ArgParseResults, dEnv *env.DoltEn
 		return verr
 	}
 
+	if tables, ok := apr.GetValue(cli.TablesFlag); ok {
+		r.Tables, err = actions.ParsePartialCloneTables(tables)
+		if err != nil {
+			return errhand.VerboseErrorFromError(err)
+		}
+	}
+
 	// Create a new Dolt env for the clone
 	clonedEnv, err := actions.EnvForClone(ctx, srcDB.ValueReadWriter().Format(), r, dir, dEnv.FS, dEnv.Version, env.GetCurrentUserHomeDir)
 	if err != nil {
@@ -62,6 +62,26 @@ func AutoResolveTables(queryist cli.Queryist, sqlCtx *sql.Context, strategy Auto
 	return nil
 }
 
+// ResolveSchemaConflict resolves the schema conflict of the table created by |createTable|, a CREATE TABLE statement
+// giving the merged schema of the table.
+func ResolveSchemaConflict(queryist cli.Queryist, sqlCtx *sql.Context, createTable string) error {
+	// Rows that can't be converted to the merged schema are left as data conflicts for the caller to resolve
+	_, err := commands.GetRowsForSql(queryist, sqlCtx, "set @@dolt_allow_commit_conflicts = 1")
+	if err != nil {
+		return fmt.Errorf("error: failed to set @@dolt_allow_commit_conflicts: %w", err)
+	}
+
+	q, err := dbr.InterpolateForDialect("CALL dolt_conflicts_resolve('--schema', ?)", []interface{}{createTable}, dialect.MySQL)
+	if err != nil {
+		return fmt.Errorf("error interpolating resolve schema conflict query: %w", err)
+	}
+	_, err = commands.GetRowsForSql(queryist, sqlCtx, q)
+	if err != nil {
+		return fmt.Errorf("error resolving schema conflict: %w", err)
+	}
+	return nil
+}
+
 func quoteWithPrefix(arr []string, prefix string) []string {
 	out := make([]string, len(arr))
 	for i := range arr {
@@ -34,9 +34,12 @@ var resDocumentation = cli.CommandDocumentationContent{
 	When a merge finds conflicting changes, it documents them in the dolt_conflicts table. A conflict is between two versions: ours (the rows at the destination branch head) and theirs (the rows at the source branch head).
 
 	dolt conflicts resolve will automatically resolve the conflicts by taking either the ours or theirs versions for each row.
+
+	A schema conflict on a table is resolved by giving the merged schema of the table as a CREATE TABLE statement with {{.EmphasisLeft}}--schema{{.EmphasisRight}}. The rows of both branches are then merged into the merged schema, matching columns by tag and then by name. Rows with values that can't be converted to the types of the merged schema are left as data conflicts, to be resolved like any other.
 `,
 	Synopsis: []string{
 		`--ours|--theirs {{.LessThan}}table{{.GreaterThan}}...`,
+		`--schema {{.LessThan}}create table{{.GreaterThan}}`,
 	},
 }
 
@@ -86,6 +89,7 @@ func (cmd ResolveCmd) ArgParser() *argparser.ArgParser {
 	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"table", "List of tables to be resolved. '.' can be used to resolve all tables."})
 	ap.SupportsFlag("ours", "", "For all conflicts, take the version from our branch and resolve the conflict")
 	ap.SupportsFlag("theirs", "", "For all conflicts, take the version from their branch and resolve the conflict")
+	ap.SupportsString(cli.SchemaParam, "", "create table", "Resolve the schema conflict of the table created by the given CREATE TABLE statement by merging both branches' rows into its schema")
 	return ap
 }
 
@@ -104,7 +108,9 @@ func (cmd ResolveCmd) Exec(ctx context.Context, commandStr string, args []string
 	}
 
 	var verr errhand.VerboseError
-	if apr.ContainsAny(autoResolverParams...) {
+	if apr.Contains(cli.SchemaParam) {
+		verr = resolveSchema(queryist, sqlCtx, apr)
+	} else if apr.ContainsAny(autoResolverParams...) {
 		verr = autoResolve(queryist, sqlCtx, apr)
 	} else {
 		verr = errhand.BuildDError("--ours or --theirs must be supplied").SetPrintUsage().Build()
@@ -135,3 +141,18 @@ func autoResolve(queryist cli.Queryist, sqlCtx *sql.Context, apr *argparser.ArgP
 	}
 	return nil
 }
+
+func resolveSchema(queryist cli.Queryist, sqlCtx *sql.Context, apr *argparser.ArgParseResults) errhand.VerboseError {
+	if apr.ContainsAny(autoResolverParams...) {
+		return errhand.BuildDError("--%s can't be used with --ours or --theirs", cli.SchemaParam).SetPrintUsage().Build()
+	} else if apr.NArg() > 0 {
+		return errhand.BuildDError("--%s resolves the table named in its CREATE TABLE statement and takes no tables", cli.SchemaParam).SetPrintUsage().Build()
+	}
+
+	createTable, _ := apr.GetValue(cli.SchemaParam)
+	err := ResolveSchemaConflict(queryist, sqlCtx, createTable)
+	if err != nil {
+		return errhand.BuildDError("error: failed to resolve").AddCause(err).Build()
+	}
+	return nil
+}
@@ -21,6 +21,7 @@ import (
 	"io"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/types"
@@ -31,6 +32,7 @@ import (
 	"github.com/gocraft/dbr/v2/dialect"
 
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/doltversion"
 	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
 	eventsapi "github.com/dolthub/dolt/go/gen/proto/dolt/services/eventsapi/v1alpha1"
 	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
@@ -39,6 +41,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema/typeinfo"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table/typed/json"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/untyped/tabular"
 	"github.com/dolthub/dolt/go/libraries/utils/argparser"
 	"github.com/dolthub/dolt/go/libraries/utils/iohelp"
@@ -57,9 +60,12 @@ const (
 
 	SchemaAndDataDiff = SchemaOnlyDiff | DataOnlyDiff
 
-	TabularDiffOutput diffOutput = 1
-	SQLDiffOutput     diffOutput = 2
-	JsonDiffOutput    diffOutput = 3
+	TabularDiffOutput  diffOutput = 1
+	SQLDiffOutput      diffOutput = 2
+	JsonDiffOutput     diffOutput = 3
+	DebeziumDiffOutput diffOutput = 4
+	CsvDiffOutput      diffOutput = 5
+	ParquetDiffOutput  diffOutput = 6
 
 	DataFlag     = "data"
 	SchemaFlag   = "schema"
@@ -72,6 +78,7 @@ const (
 	MergeBase    = "merge-base"
 	DiffMode     = "diff-mode"
 	ReverseFlag  = "reverse"
+	OutputDir    = "output-dir"
 )
 
 var diffDocs = cli.CommandDocumentationContent{
@@ -98,6 +105,10 @@ The diffs displayed can be limited to show the first N by providing the paramete
 
 To filter which data rows are displayed, use {{.EmphasisLeft}}--where <SQL expression>{{.EmphasisRight}}. Table column names in the filter expression must be prefixed with {{.EmphasisLeft}}from_{{.EmphasisRight}} or {{.EmphasisLeft}}to_{{.EmphasisRight}}, e.g. {{.EmphasisLeft}}to_COLUMN_NAME > 100{{.EmphasisRight}} or {{.EmphasisLeft}}from_COLUMN_NAME + to_COLUMN_NAME = 0{{.EmphasisRight}}.
 
+When the result format is set to {{.EmphasisLeft}}debezium{{.EmphasisRight}}, each changed row and each schema change is written as a Debezium compatible change event, one JSON object per line. Row change events have {{.EmphasisLeft}}before{{.EmphasisRight}}, {{.EmphasisLeft}}after{{.EmphasisRight}}, {{.EmphasisLeft}}op{{.EmphasisRight}} and {{.EmphasisLeft}}source{{.EmphasisRight}} fields, where {{.EmphasisLeft}}source{{.EmphasisRight}} identifies the database, table, commit and branch of the "after" side of the diff.
+
+When the result format is set to {{.EmphasisLeft}}csv{{.EmphasisRight}} or {{.EmphasisLeft}}parquet{{.EmphasisRight}}, each changed row is written as a single row with a {{.EmphasisLeft}}diff_type{{.EmphasisRight}} column followed by {{.EmphasisLeft}}from_{{.EmphasisRight}} and {{.EmphasisLeft}}to_{{.EmphasisRight}} columns for each column of the table, like the columns of {{.EmphasisLeft}}dolt_diff_{{.LessThan}}table{{.GreaterThan}}{{.EmphasisRight}}. Use {{.EmphasisLeft}}--output-dir {{.LessThan}}directory{{.GreaterThan}}{{.EmphasisRight}} to write the diff of each table to its own file in that directory. Characters that can't be used in file names are replaced with underscores, and a numeric suffix is added to the file name of a table if another table's file has the same name. Parquet output requires {{.EmphasisLeft}}--output-dir{{.EmphasisRight}}.
+
 The {{.EmphasisLeft}}--diff-mode{{.EmphasisRight}} argument controls how modified rows are presented when the format output is set to {{.EmphasisLeft}}tabular{{.EmphasisRight}}. When set to {{.EmphasisLeft}}row{{.EmphasisRight}}, modified rows are presented as old and new rows. When set to {{.EmphasisLeft}}line{{.EmphasisRight}}, modified rows are presented as a single row, and changes are presented using "+" and "-" within the column. When set to {{.EmphasisLeft}}in-place{{.EmphasisRight}}, modified rows are presented as a single row, and changes are presented side-by-side with a color distinction (requires a color-enabled terminal). When set to {{.EmphasisLeft}}context{{.EmphasisRight}}, rows that contain at least one column that spans multiple lines uses {{.EmphasisLeft}}line{{.EmphasisRight}}, while all other rows use {{.EmphasisLeft}}row{{.EmphasisRight}}. The default value is {{.EmphasisLeft}}context{{.EmphasisRight}}.
 `,
 	Synopsis: []string{
@@ -113,6 +124,7 @@ type diffDisplaySettings struct {
 	limit      int
 	where      string
 	skinny     bool
+	outputDir  string
 }
 
 type diffDatasets struct {
@@ -169,7 +181,7 @@ func (cmd DiffCmd) ArgParser() *argparser.ArgParser {
 	ap.SupportsFlag(SchemaFlag, "s", "Show only the schema changes, do not show the data changes (Both shown by default).")
 	ap.SupportsFlag(StatFlag, "", "Show stats of data changes")
 	ap.SupportsFlag(SummaryFlag, "", "Show summary of data and schema changes")
-	ap.SupportsString(FormatFlag, "r", "result output format", "How to format diff output. Valid values are tabular, sql, json. Defaults to tabular.")
+	ap.SupportsString(FormatFlag, "r", "result output format", "How to format diff output. Valid values are tabular, sql, json, debezium, csv, parquet. Defaults to tabular.")
 	ap.SupportsString(whereParam, "", "column", "filters columns based on values in the diff.  See {{.EmphasisLeft}}dolt diff --help{{.EmphasisRight}} for details.")
 	ap.SupportsInt(limitParam, "", "record_count", "limits to the first N diffs.")
 	ap.SupportsFlag(cli.StagedFlag, "", "Show only the staged data changes.")
@@ -179,6 +191,7 @@ func (cmd DiffCmd) ArgParser() *argparser.ArgParser {
 	ap.SupportsString(DiffMode, "", "diff mode", "Determines how to display modified rows with tabular output. Valid values are row, line, in-place, context. Defaults to context.")
 	ap.SupportsFlag(ReverseFlag, "R", "Reverses the direction of the diff.")
 	ap.SupportsFlag(NameOnlyFlag, "", "Only shows table names.")
+	ap.SupportsString(OutputDir, "", "directory", "Writes the data diff of each table to its own file in the given directory. Only valid with csv and parquet output.")
 	return ap
 }
 
@@ -232,11 +245,23 @@ func (cmd DiffCmd) validateArgs(apr *argparser.ArgParseResults) errhand.VerboseE
 
 	f, _ := apr.GetValue(FormatFlag)
 	switch strings.ToLower(f) {
-	case "tabular", "sql", "json", "":
+	case "tabular", "sql", "json", "debezium", "csv", "":
+	case "parquet":
+		if !apr.Contains(OutputDir) {
+			return errhand.BuildDError("invalid Arguments: parquet output requires --%s", OutputDir).Build()
+		}
 	default:
 		return errhand.BuildDError("invalid output format: %s", f).Build()
 	}
 
+	if apr.Contains(OutputDir) {
+		switch strings.ToLower(f) {
+		case "csv", "parquet":
+		default:
+			return errhand.BuildDError("invalid Arguments: --%s is only supported for csv and parquet output", OutputDir).Build()
+		}
+	}
+
 	return nil
 }
 
@@ -276,8 +301,16 @@ func parseDiffDisplaySettings(apr *argparser.ArgParseResults) *diffDisplaySettin
 		displaySettings.diffOutput = SQLDiffOutput
 	case "json":
 		displaySettings.diffOutput = JsonDiffOutput
+	case "debezium":
+		displaySettings.diffOutput = DebeziumDiffOutput
+	case "csv":
+		displaySettings.diffOutput = CsvDiffOutput
+	case "parquet":
+		displaySettings.diffOutput = ParquetDiffOutput
 	}
 
+	displaySettings.outputDir = apr.GetValueOrDefault(OutputDir, "")
+
 	displaySettings.limit, _ = apr.GetInt(limitParam)
 	displaySettings.where = apr.GetValueOrDefault(whereParam, "")
 
@@ -756,6 +789,62 @@ func getDiffSummariesBetweenRefs(queryist cli.Queryist, sqlCtx *sql.Context, fro
 	return summaries, nil
 }
 
+// getDebeziumSource returns the source block for the Debezium change events of a diff whose "after" side is |toRef|.
+// The commit of the source is nil if |toRef| is the working or staged root, and its branch is nil if |toRef| is
+// neither a branch nor a ref to the current branch.
+func getDebeziumSource(queryist cli.Queryist, sqlCtx *sql.Context, toRef string) (json.DebeziumSource, error) {
+	source := json.DebeziumSource{
+		Version:   doltversion.Version,
+		Connector: "dolt",
+		Name:      sqlCtx.GetCurrentDatabase(),
+		TsMs:      time.Now().UnixMilli(),
+		Snapshot:  "false",
+		Db:        sqlCtx.GetCurrentDatabase(),
+	}
+
+	isWorkingSet := strings.EqualFold(toRef, doltdb.Working) || strings.EqualFold(toRef, doltdb.Staged)
+	if isWorkingSet || strings.EqualFold(toRef, "HEAD") {
+		branch, err := getActiveBranchName(sqlCtx, queryist)
+		if err != nil {
+			return json.DebeziumSource{}, err
+		}
+		source.Branch = &branch
+	} else {
+		rows, err := InterpolateAndRunQuery(queryist, sqlCtx, "select name from dolt_branches where name = ?", toRef)
+		if err != nil {
+			return json.DebeziumSource{}, err
+		}
+		if len(rows) == 1 {
+			branch := rows[0][0].(string)
+			source.Branch = &branch
+		}
+	}
+
+	if isWorkingSet {
+		return source, nil
+	}
+
+	commitHash, err := getHashOf(queryist, sqlCtx, toRef)
+	if err != nil {
+		return json.DebeziumSource{}, err
+	}
+	source.Commit = &commitHash
+
+	rows, err := InterpolateAndRunQuery(queryist, sqlCtx, "select date from dolt_log(?) limit 1", commitHash)
+	if err != nil {
+		return json.DebeziumSource{}, err
+	}
+	if len(rows) == 1 {
+		ts, err := getTimestampColAsUint64(rows[0][0])
+		if err != nil {
+			return json.DebeziumSource{}, err
+		}
+		source.TsMs = int64(ts)
+	}
+
+	return source, nil
+}
+
 func diffUserTables(queryist cli.Queryist, sqlCtx *sql.Context, dArgs *diffArgs) errhand.VerboseError {
 	var err error
 
@@ -768,7 +857,7 @@ func diffUserTables(queryist cli.Queryist, sqlCtx *sql.Context, dArgs *diffArgs)
 		return printDiffSummary(sqlCtx, deltas, dArgs)
 	}
 
-	dw, err := newDiffWriter(dArgs.diffOutput)
+	dw, err := newDiffWriter(queryist, sqlCtx, dArgs)
 	if err != nil {
 		return errhand.VerboseErrorFromError(err)
 	}
@@ -1183,8 +1272,8 @@ func diffUserTable(
 		}
 	}
 
-	if tableSummary.IsDrop() && dArgs.diffOutput == SQLDiffOutput {
-		return nil // don't output DELETE FROM statements after DROP TABLE
+	if tableSummary.IsDrop() && (dArgs.diffOutput == SQLDiffOutput || dArgs.diffOutput == DebeziumDiffOutput) {
+		return nil // don't output DELETE FROM statements or delete events after DROP TABLE
 	}
 
 	verr := diffRows(queryist, sqlCtx, tableSummary, fromTableInfo, toTableInfo, dArgs, dw)
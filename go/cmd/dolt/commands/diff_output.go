@@ -20,19 +20,27 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
+	"strings"
 
 	textdiff "github.com/andreyvit/diff"
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
 	"github.com/dustin/go-humanize"
 	"github.com/fatih/color"
 
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
 	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dtablefunctions"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlfmt"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/typed/json"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table/typed/parquet"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table/untyped/csv"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/untyped/sqlexport"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/untyped/tabular"
 	"github.com/dolthub/dolt/go/libraries/utils/iohelp"
@@ -60,16 +68,24 @@ type diffWriter interface {
 }
 
 // newDiffWriter returns a diffWriter for the output format given
-func newDiffWriter(diffOutput diffOutput) (diffWriter, error) {
-	switch diffOutput {
+func newDiffWriter(queryist cli.Queryist, sqlCtx *sql.Context, dArgs *diffArgs) (diffWriter, error) {
+	switch dArgs.diffOutput {
 	case TabularDiffOutput:
 		return tabularDiffWriter{}, nil
 	case SQLDiffOutput:
 		return sqlDiffWriter{}, nil
 	case JsonDiffOutput:
 		return newJsonDiffWriter(iohelp.NopWrCloser(cli.CliOut))
+	case CsvDiffOutput, ParquetDiffOutput:
+		return &diffTableFileWriter{diffOutput: dArgs.diffOutput, outputDir: dArgs.outputDir}, nil
+	case DebeziumDiffOutput:
+		source, err := getDebeziumSource(queryist, sqlCtx, dArgs.toRef)
+		if err != nil {
+			return nil, err
+		}
+		return newDebeziumDiffWriter(cli.CliOut, source), nil
 	default:
-		panic(fmt.Sprintf("unexpected diff output: %v", diffOutput))
+		panic(fmt.Sprintf("unexpected diff output: %v", dArgs.diffOutput))
 	}
 }
 
@@ -408,17 +424,7 @@ func (j *jsonDiffWriter) RowWriter(fromTableInfo, toTableInfo *diff.TableInfo, t
 		return nil, err
 	}
 
-	// Translate the union schema to its dolt version
-	cols := schema.NewColCollection()
-	for i, col := range unionSch {
-		doltCol, err := sqlutil.ToDoltCol(uint64(i), col)
-		if err != nil {
-			return nil, err
-		}
-		cols = cols.Append(doltCol)
-	}
-
-	sch, err := schema.SchemaFromCols(cols)
+	sch, err := doltSchemaForUnionSchema(unionSch)
 	if err != nil {
 		return nil, err
 	}
@@ -431,6 +437,19 @@ func (j *jsonDiffWriter) RowWriter(fromTableInfo, toTableInfo *diff.TableInfo, t
 	return jsonRowDiffWriter, nil
 }
 
+// doltSchemaForUnionSchema translates the union schema of a table diff to its dolt version
+func doltSchemaForUnionSchema(unionSch sql.Schema) (schema.Schema, error) {
+	cols := schema.NewColCollection()
+	for i, col := range unionSch {
+		doltCol, err := sqlutil.ToDoltCol(uint64(i), col)
+		if err != nil {
+			return nil, err
+		}
+		cols = cols.Append(doltCol)
+	}
+	return schema.SchemaFromCols(cols)
+}
+
 const jsonDiffEventsHeader = `"events":[`
 
 func (j *jsonDiffWriter) WriteEventDiff(ctx context.Context, eventName, oldDefn, newDefn string) error {
@@ -650,3 +669,327 @@ func (j *jsonDiffWriter) Close(ctx context.Context) error {
 	// Writer has already been closed here during row iteration, no need to close it here
 	return nil
 }
+
+// debeziumDiffWriter writes each row and schema change as a Debezium compatible change event, one per line
+type debeziumDiffWriter struct {
+	wr     *json.DebeziumWriter
+	source json.DebeziumSource
+}
+
+var _ diffWriter = (*debeziumDiffWriter)(nil)
+
+func newDebeziumDiffWriter(wr io.Writer, source json.DebeziumSource) *debeziumDiffWriter {
+	return &debeziumDiffWriter{
+		wr:     json.NewDebeziumWriter(wr),
+		source: source,
+	}
+}
+
+func (d *debeziumDiffWriter) BeginTable(fromTableName, toTableName string, isAdd, isDrop bool) error {
+	d.source.Table = toTableName
+	if isDrop {
+		d.source.Table = fromTableName
+	}
+	return nil
+}
+
+func (d *debeziumDiffWriter) WriteTableSchemaDiff(fromTableInfo, toTableInfo *diff.TableInfo, tds diff.TableDeltaSummary) error {
+	stmts := tds.AlterStmts
+	changeType := json.DebeziumTableAlter
+	if tds.IsAdd() {
+		stmts = []string{toTableInfo.CreateStmt}
+		changeType = json.DebeziumTableCreate
+	} else if tds.IsDrop() {
+		stmts = []string{sqlfmt.DropTableStmt(fromTableInfo.Name)}
+		changeType = json.DebeziumTableDrop
+	}
+	if strings.HasPrefix(d.source.Table, diff.DBPrefix) {
+		changeType = ""
+	}
+
+	for _, stmt := range stmts {
+		if len(stmt) == 0 {
+			continue
+		}
+		err := d.wr.WriteSchemaChange(d.source, stmt, changeType)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *debeziumDiffWriter) WriteEventDiff(ctx context.Context, eventName, oldDefn, newDefn string) error {
+	return d.writeSchemaFragmentDiff("EVENT", eventName, oldDefn, newDefn)
+}
+
+func (d *debeziumDiffWriter) WriteTriggerDiff(ctx context.Context, triggerName, oldDefn, newDefn string) error {
+	return d.writeSchemaFragmentDiff("TRIGGER", triggerName, oldDefn, newDefn)
+}
+
+func (d *debeziumDiffWriter) WriteViewDiff(ctx context.Context, viewName, oldDefn, newDefn string) error {
+	return d.writeSchemaFragmentDiff("VIEW", viewName, oldDefn, newDefn)
+}
+
+// writeSchemaFragmentDiff writes the statements which change the definition of the named event, trigger or view from
+// |oldDefn| to |newDefn| as schema change events
+func (d *debeziumDiffWriter) writeSchemaFragmentDiff(fragmentType, name, oldDefn, newDefn string) error {
+	source := d.source
+	source.Table = ""
+
+	var stmts []string
+	if oldDefn != "" {
+		stmts = append(stmts, fmt.Sprintf("DROP %s %s;", fragmentType, sql.QuoteIdentifier(name)))
+	}
+	if newDefn != "" {
+		stmts = append(stmts, newDefn)
+	}
+
+	for _, stmt := range stmts {
+		err := d.wr.WriteSchemaChange(source, stmt, "")
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *debeziumDiffWriter) WriteTableDiffStats(diffStats []diffStatistics, oldColLen, newColLen int, areTablesKeyless bool) error {
+	return errors.New("diff stats are not supported for debezium output")
+}
+
+func (d *debeziumDiffWriter) RowWriter(fromTableInfo, toTableInfo *diff.TableInfo, tds diff.TableDeltaSummary, unionSch sql.Schema) (diff.SqlRowDiffWriter, error) {
+	sch, err := doltSchemaForUnionSchema(unionSch)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.wr.NewRowDiffWriter(d.source, sch), nil
+}
+
+func (d *debeziumDiffWriter) Close(ctx context.Context) error {
+	return nil
+}
+
+// diffTableFileWriter writes the data diff of each table as rows of a csv or parquet file, with a diff_type column
+// followed by the from_ and to_ columns of the table. The diff of each table is written to its own file if an output
+// directory is given, otherwise csv diffs are written to stdout.
+type diffTableFileWriter struct {
+	diffOutput    diffOutput
+	outputDir     string
+	tablesWritten int
+	// fileNames holds the lower-cased names of the files written so far, so that no two tables are written to the
+	// same file, even on case-insensitive file systems
+	fileNames map[string]struct{}
+}
+
+var _ diffWriter = (*diffTableFileWriter)(nil)
+
+func (f *diffTableFileWriter) BeginTable(fromTableName, toTableName string, isAdd, isDrop bool) error {
+	return nil
+}
+
+func (f *diffTableFileWriter) WriteTableSchemaDiff(fromTableInfo, toTableInfo *diff.TableInfo, tds diff.TableDeltaSummary) error {
+	// schema changes are only reflected in the columns of the data diff
+	return nil
+}
+
+func (f *diffTableFileWriter) WriteEventDiff(ctx context.Context, eventName, oldDefn, newDefn string) error {
+	return nil
+}
+
+func (f *diffTableFileWriter) WriteTriggerDiff(ctx context.Context, triggerName, oldDefn, newDefn string) error {
+	return nil
+}
+
+func (f *diffTableFileWriter) WriteViewDiff(ctx context.Context, viewName, oldDefn, newDefn string) error {
+	return nil
+}
+
+func (f *diffTableFileWriter) WriteTableDiffStats(diffStats []diffStatistics, oldColLen, newColLen int, areTablesKeyless bool) error {
+	return fmt.Errorf("diff stats are not supported for %s output", f.formatName())
+}
+
+func (f *diffTableFileWriter) RowWriter(fromTableInfo, toTableInfo *diff.TableInfo, tds diff.TableDeltaSummary, unionSch sql.Schema) (diff.SqlRowDiffWriter, error) {
+	tableName := tds.ToTableName
+	if len(tableName.Name) == 0 {
+		tableName = tds.FromTableName
+	}
+
+	outSch := sql.Schema{&sql.Column{Name: "diff_type", Type: types.Text}}
+	for _, prefix := range []string{"from_", "to_"} {
+		for _, col := range unionSch {
+			outSch = append(outSch, &sql.Column{
+				Name:     prefix + col.Name,
+				Type:     col.Type,
+				Nullable: true,
+			})
+		}
+	}
+
+	return &diffTableRowWriter{
+		unionSch: unionSch,
+		outSch:   outSch,
+		open: func() (table.SqlRowWriter, error) {
+			return f.openTableWriter(tableName, outSch)
+		},
+	}, nil
+}
+
+// openTableWriter returns the writer for the data diff of |tableName|, which is called when the first row of the diff
+// is written, so that no file is written for tables whose data did not change
+func (f *diffTableFileWriter) openTableWriter(tableName doltdb.TableName, outSch sql.Schema) (table.SqlRowWriter, error) {
+	defer func() { f.tablesWritten++ }()
+
+	if f.outputDir == "" {
+		if f.tablesWritten > 0 {
+			// separate the diffs of each table with an empty line
+			cli.Println()
+		}
+		return csv.NewCSVSqlWriter(iohelp.NopWrCloser(cli.CliOut), outSch, csv.NewCSVInfo())
+	}
+
+	err := os.MkdirAll(f.outputDir, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Create(filepath.Join(f.outputDir, f.uniqueFileName(tableName)))
+	if err != nil {
+		return nil, err
+	}
+
+	var wr table.SqlRowWriter
+	if f.diffOutput == ParquetDiffOutput {
+		wr, err = parquet.NewParquetRowWriter(outSch, file)
+	} else {
+		wr, err = csv.NewCSVSqlWriter(file, outSch, csv.NewCSVInfo())
+	}
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return wr, nil
+}
+
+// diffFileName returns the name of the file, without its extension, that the data diff of |tableName| is written to.
+// Tables in a schema other than the default are prefixed with the schema name. Characters that can't be used in file
+// names, such as path separators, are replaced with underscores, so that every file is written to the output directory.
+// Different tables can have the same file name, see diffTableFileWriter.uniqueFileName.
+func diffFileName(tableName doltdb.TableName) string {
+	name := tableName.Name
+	if tableName.Schema != "" {
+		name = tableName.Schema + "." + name
+	}
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r < ' ', r == '/', r == '\\', r == ':', r == '*', r == '?', r == '"', r == '<', r == '>', r == '|':
+			return '_'
+		default:
+			return r
+		}
+	}, name)
+}
+
+// uniqueFileName returns the name of the file that the data diff of |tableName| is written to. If the file name of
+// an earlier table is the same, such as for the tables "a/b" and "a_b", a numeric suffix is added to the name.
+func (f *diffTableFileWriter) uniqueFileName(tableName doltdb.TableName) string {
+	if f.fileNames == nil {
+		f.fileNames = make(map[string]struct{})
+	}
+	base := diffFileName(tableName)
+	name := base + "." + f.formatName()
+	for i := 2; ; i++ {
+		if _, ok := f.fileNames[strings.ToLower(name)]; !ok {
+			break
+		}
+		name = fmt.Sprintf("%s_%d.%s", base, i, f.formatName())
+	}
+	f.fileNames[strings.ToLower(name)] = struct{}{}
+	return name
+}
+
+func (f *diffTableFileWriter) formatName() string {
+	if f.diffOutput == ParquetDiffOutput {
+		return "parquet"
+	}
+	return "csv"
+}
+
+func (f *diffTableFileWriter) Close(ctx context.Context) error {
+	return nil
+}
+
+// diffTableRowWriter combines the old and new rows of each row diff into a single row of the form
+// (diff_type, from_cols..., to_cols...) and writes it to a table.SqlRowWriter
+type diffTableRowWriter struct {
+	unionSch sql.Schema
+	outSch   sql.Schema
+	open     func() (table.SqlRowWriter, error)
+	wr       table.SqlRowWriter
+	oldRow   sql.Row
+}
+
+var _ diff.SqlRowDiffWriter = (*diffTableRowWriter)(nil)
+
+func (d *diffTableRowWriter) WriteRow(ctx context.Context, row sql.Row, rowDiffType diff.ChangeType, colDiffTypes []diff.ChangeType) error {
+	switch rowDiffType {
+	case diff.ModifiedOld:
+		d.oldRow = row
+		return nil
+	case diff.ModifiedNew:
+		oldRow := d.oldRow
+		d.oldRow = nil
+		return d.write(ctx, "modified", oldRow, row)
+	case diff.Added:
+		return d.write(ctx, "added", nil, row)
+	case diff.Removed:
+		return d.write(ctx, "removed", row, nil)
+	default:
+		return fmt.Errorf("unexpected row diff type: %v", rowDiffType)
+	}
+}
+
+func (d *diffTableRowWriter) write(ctx context.Context, diffType string, oldRow, newRow sql.Row) error {
+	if d.wr == nil {
+		wr, err := d.open()
+		if err != nil {
+			return err
+		}
+		d.wr = wr
+	}
+
+	outRow := make(sql.Row, 1, len(d.outSch))
+	outRow[0] = diffType
+	for _, row := range []sql.Row{oldRow, newRow} {
+		for i, col := range d.unionSch {
+			if row == nil || row[i] == nil {
+				outRow = append(outRow, nil)
+				continue
+			}
+			// rows from a remote server are not of the column's type, so convert them before writing
+			val, _, err := col.Type.Convert(row[i])
+			if err != nil {
+				return err
+			}
+			outRow = append(outRow, val)
+		}
+	}
+
+	return d.wr.WriteSqlRow(ctx, outRow)
+}
+
+func (d *diffTableRowWriter) WriteCombinedRow(ctx context.Context, oldRow, newRow sql.Row, mode diff.Mode) error {
+	return fmt.Errorf("file output is unable to output diffs for combined rows")
+}
+
+func (d *diffTableRowWriter) Close(ctx context.Context) error {
+	if d.wr == nil {
+		return nil
+	}
+	wr := d.wr
+	d.wr = nil
+	return wr.Close(ctx)
+}
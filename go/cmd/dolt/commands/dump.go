@@ -57,6 +57,10 @@ const (
 	csvFileExt     = "csv"
 	jsonFileExt    = "json"
 	parquetFileExt = "parquet"
+	arrowFileExt   = "arrow"
+	featherFileExt = "feather"
+	jsonlFileExt   = "jsonl"
+	ndjsonFileExt  = "ndjson"
 	emptyFileExt   = ""
 	emptyStr       = ""
 )
@@ -67,7 +71,10 @@ var dumpDocs = cli.CommandDocumentationContent{
 If a dump file already exists then the operation will fail, unless the {{.EmphasisLeft}}--force | -f{{.EmphasisRight}} flag 
 is provided. The force flag forces the existing dump file to be overwritten. The {{.EmphasisLeft}}-r{{.EmphasisRight}} flag 
 is used to support different file formats of the dump. In the case of non .sql files each table is written to a separate
-csv,json or parquet file. 
+csv, json, newline delimited json, parquet or arrow file. Arrow files are written in the Arrow IPC file format, and are
+named with a {{.EmphasisLeft}}.feather{{.EmphasisRight}} extension when the result format is {{.EmphasisLeft}}feather{{.EmphasisRight}}. 
+Newline delimited json files are written with one row per line, and are named with the extension given by the result
+format, {{.EmphasisLeft}}jsonl{{.EmphasisRight}} or {{.EmphasisLeft}}ndjson{{.EmphasisRight}}.
 `,
 
 	Synopsis: []string{
@@ -95,7 +102,7 @@ func (cmd DumpCmd) Docs() *cli.CommandDocumentation {
 
 func (cmd DumpCmd) ArgParser() *argparser.ArgParser {
 	ap := argparser.NewArgParserWithMaxArgs(cmd.Name(), 0)
-	ap.SupportsString(FormatFlag, "r", "result_file_type", "Define the type of the output file. Defaults to sql. Valid values are sql, csv, json and parquet.")
+	ap.SupportsString(FormatFlag, "r", "result_file_type", "Define the type of the output file. Defaults to sql. Valid values are sql, csv, json, jsonl, ndjson, parquet, arrow and feather.")
 	ap.SupportsString(filenameFlag, "fn", "file_name", "Define file name for dump file. Defaults to `doltdump.sql`.")
 	ap.SupportsString(directoryFlag, "d", "directory_name", "Define directory name to dump the files in. Defaults to `doltdump/`.")
 	ap.SupportsFlag(forceParam, "f", "If data already exists in the destination, the force flag will allow the target to be overwritten.")
@@ -193,7 +200,7 @@ func (cmd DumpCmd) Exec(ctx context.Context, commandStr string, args []string, d
 		if err != nil {
 			return HandleVErrAndExitCode(err, usage)
 		}
-	case csvFileExt, jsonFileExt, parquetFileExt:
+	case csvFileExt, jsonFileExt, jsonlFileExt, ndjsonFileExt, parquetFileExt, arrowFileExt, featherFileExt:
 		err = dumpNonSqlTables(ctx, root, dEnv, force, tblNames, resFormat, outputFileOrDirName, false)
 		if err != nil {
 			return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
@@ -697,7 +704,7 @@ func validateDumpArgs(apr *argparser.ArgParseResults) (string, errhand.VerboseEr
 			return emptyStr, errhand.BuildDError("%s is not supported for %s exports", directoryFlag, sqlFileExt).SetPrintUsage().Build()
 		}
 		return fn, nil
-	case csvFileExt, jsonFileExt, parquetFileExt:
+	case csvFileExt, jsonFileExt, jsonlFileExt, ndjsonFileExt, parquetFileExt, arrowFileExt, featherFileExt:
 		if fnOk {
 			return emptyStr, errhand.BuildDError("%s is not supported for %s exports", filenameFlag, rf).SetPrintUsage().Build()
 		}
@@ -737,7 +744,7 @@ func newTableArgs(tblName string, destination mvdata.DataLocation, batched, auto
 }
 
 // dumpNonSqlTables returns nil if all tables is dumped successfully, and it returns err if there is one.
-// It handles only csv and json file types(rf).
+// It handles csv, json, ndjson, parquet and arrow file types(rf).
 func dumpNonSqlTables(ctx context.Context, root doltdb.RootValue, dEnv *env.DoltEnv, force bool, tblNames []string, rf string, dirName string, batched bool) errhand.VerboseError {
 	var fName string
 	if dirName == emptyStr {
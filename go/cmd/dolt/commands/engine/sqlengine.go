@@ -36,6 +36,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/dconfig"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/gcctx"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions/dolt_ci"
 	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle"
 	dsqle "github.com/dolthub/dolt/go/libraries/doltcore/sqle"
@@ -82,6 +83,8 @@ type SqlEngineConfig struct {
 	SystemVariables            SystemVariables
 	ClusterController          *cluster.Controller
 	AutoGCController           *dsqle.AutoGCController
+	WorkflowHookController     *dolt_ci.WorkflowHookController
+	WebhookController          *dsqle.WebhookController
 	BinlogReplicaController    binlogreplication.BinlogReplicaController
 	EventSchedulerStatus       eventscheduler.SchedulerStatus
 }
@@ -160,6 +163,9 @@ func NewSqlEngine(
 
 	sqlEngine := &SqlEngine{}
 
+	// Stored procedures can't run queries on their own, so give them a way to, e.g. for the exec actions of dolt_rebase
+	pro.SetQueryRunner(sqlEngine.Query)
+
 	// Create the engine
 	engine := gms.New(analyzer.NewBuilder(pro).Build(), &gms.Config{
 		IsReadOnly:     config.IsReadOnly,
@@ -217,6 +223,24 @@ func NewSqlEngine(
 		dprocedures.UseSessionAwareSafepointController = true
 	}
 
+	if config.WorkflowHookController != nil {
+		err = config.WorkflowHookController.RunBackgroundThread(bThreads, sqlEngine.NewDefaultContext, sqlEngine.Query, engine.Analyzer.Catalog.MySQLDb)
+		if err != nil {
+			return nil, err
+		}
+		config.WorkflowHookController.ApplyCommitHooks(ctx, mrEnv, dbs...)
+		pro.InitDatabaseHooks = append(pro.InitDatabaseHooks, config.WorkflowHookController.InitDatabaseHook())
+	}
+
+	if config.WebhookController != nil {
+		err = config.WebhookController.RunBackgroundThread(bThreads, sqlEngine.NewDefaultContext)
+		if err != nil {
+			return nil, err
+		}
+		config.WebhookController.ApplyCommitHooks(ctx, mrEnv, dbs...)
+		pro.InitDatabaseHooks = append(pro.InitDatabaseHooks, config.WebhookController.InitDatabaseHook())
+	}
+
 	var statsPro sql.StatsProvider
 	_, enabled, _ := sql.SystemVariables.GetGlobal(dsess.DoltStatsEnabled)
 	if enabled.(int8) == 1 {
@@ -49,11 +49,16 @@ var mergeDocs = cli.CommandDocumentationContent{
 The second syntax ({{.LessThan}}dolt merge --abort{{.GreaterThan}}) can only be run after the merge has resulted in conflicts. dolt merge {{.EmphasisLeft}}--abort{{.EmphasisRight}} will abort the merge process and try to reconstruct the pre-merge state. However, if there were uncommitted changes when the merge started (and especially if those changes were further modified after the merge was started), dolt merge {{.EmphasisLeft}}--abort{{.EmphasisRight}} will in some cases be unable to reconstruct the original (pre-merge) changes. Therefore: 
 
 {{.LessThan}}Warning{{.GreaterThan}}: Running dolt merge with non-trivial uncommitted changes is discouraged: while possible, it may leave you in a state that is hard to back out of in the case of a conflict.
+
+When more than one branch is given, all of them are merged into the current branch at once, creating a single merge commit with each of the merged branches as a parent. This requires a clean working set, and the merge fails without changing anything if any of the branches can't be merged without conflicts or constraint violations. Merge the branches one at a time to resolve their conflicts.
+
+With {{.EmphasisLeft}}-s ours{{.EmphasisRight}}, a merge commit is created for the named branches, but none of their changes are taken, and the contents of the current branch are left unchanged. This is useful for recording that a branch has been superseded.
 `,
 
 	Synopsis: []string{
 		"[--squash] {{.LessThan}}branch{{.GreaterThan}}",
 		"--no-ff [-m message] {{.LessThan}}branch{{.GreaterThan}}",
+		"[-m message] [-s ours] {{.LessThan}}branch{{.GreaterThan}}...",
 		"--abort",
 	},
 }
@@ -219,7 +224,7 @@ func validateDoltMergeArgs(apr *argparser.ArgParseResults, usage cli.UsagePrinte
 			return 1
 		}
 	} else if apr.Contains(cli.NoFFParam) {
-		if apr.NArg() == 0 || apr.NArg() > 2 {
+		if apr.NArg() == 0 {
 			usage()
 			return 1
 		}
@@ -317,10 +322,17 @@ func constructInterpolatedDoltMergeQuery(apr *argparser.ArgParseResults, cliCtx
 		}
 		params = append(params, msg)
 	}
+	if strategy, ok := apr.GetValue(cli.StrategyParam); ok {
+		writeToBuffer("--strategy", false)
+		writeToBuffer("?", true)
+		params = append(params, strategy)
+	}
 
 	if !apr.Contains(cli.AbortParam) && !apr.Contains(cli.SquashParam) {
-		writeToBuffer("?", true)
-		params = append(params, apr.Arg(0))
+		for _, arg := range apr.Args {
+			writeToBuffer("?", true)
+			params = append(params, arg)
+		}
 	}
 
 	buffer.WriteString(")")
@@ -380,7 +392,8 @@ func printMergeStats(fastForward bool,
 			cli.Println(err.Error())
 			return 1
 		}
-		if upToDate {
+		// A merge with the ours strategy doesn't change any tables, but it's still a merge
+		if upToDate && !apr.Contains(cli.StrategyParam) {
 			cli.Println(doltdb.ErrUpToDate.Error())
 			return 0
 		}
@@ -47,9 +47,21 @@ the same as Git and Dolt's "two dot log" syntax, or |upstreamBranch|..|currentBr
 Rebasing is useful to clean and organize your commit history, especially before merging a feature branch back to a shared 
 branch. For example, you can drop commits that contain debugging or test changes, or squash or fixup small commits into a 
 single commit, or reorder commits so that related changes are adjacent in the new commit history.
+
+The rebase plan can also stop the rebase, so that you can take additional action before continuing it with 
+{{.EmphasisLeft}}dolt rebase --continue{{.EmphasisRight}}. An edit action applies a commit and then stops, so that the commit 
+can be amended with any staged changes. A break action stops at that point in the plan, and an exec action runs a SQL 
+query and stops if the query fails. Where the rebase stops is recorded in the working set, so a stopped rebase can be 
+continued after a server restart.
+
+With {{.EmphasisLeft}}--onto{{.EmphasisRight}}, the commits in |upstreamBranch|..|currentBranch| are replayed on top of the 
+given new base instead of the upstream branch, which transplants a range of commits to another branch. With 
+{{.EmphasisLeft}}--autosquash{{.EmphasisRight}}, commits whose message starts with "fixup! " or "squash! " followed by the 
+subject line (or a prefix of the commit hash) of an earlier commit in the plan are moved right after that commit, and 
+their action is set to fixup or squash.
 `,
 	Synopsis: []string{
-		`(-i | --interactive) [--empty=drop|keep] {{.LessThan}}upstream{{.GreaterThan}}`,
+		`(-i | --interactive) [--empty=drop|keep|stop] [--onto {{.LessThan}}newbase{{.GreaterThan}}] [--autosquash] {{.LessThan}}upstream{{.GreaterThan}}`,
 		`(--continue | --abort)`,
 	},
 }
@@ -125,11 +137,12 @@ func (cmd RebaseCmd) Exec(ctx context.Context, commandStr string, args []string,
 		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(errors.New("error: "+rows[0][1].(string))), usage)
 	}
 
-	// If the rebase was successful, or if it was aborted, print out the message and
-	// ensure the branch being rebased is checked out in the CLI
+	// If the rebase was successful, if it was aborted, or if it stopped at a step of the rebase plan, print out
+	// the message and ensure the branch being rebased (or the rebase working branch) is checked out in the CLI
 	message := rows[0][1].(string)
 	if strings.Contains(message, dprocedures.SuccessfulRebaseMessage) ||
-		strings.Contains(message, dprocedures.RebaseAbortedMessage) {
+		strings.Contains(message, dprocedures.RebaseAbortedMessage) ||
+		strings.HasPrefix(message, dprocedures.RebaseStoppedMessage) {
 		cli.Println(message)
 		if err = syncCliBranchToSqlSessionBranch(sqlCtx, dEnv); err != nil {
 			return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
@@ -137,7 +150,11 @@ func (cmd RebaseCmd) Exec(ctx context.Context, commandStr string, args []string,
 		return 0
 	}
 
-	rebasePlan, err := getRebasePlan(cliCtx, sqlCtx, queryist, apr.Arg(0), branchName)
+	ontoBranch := apr.Arg(0)
+	if onto, ok := apr.GetValue(cli.OntoParam); ok {
+		ontoBranch = onto
+	}
+	rebasePlan, err := getRebasePlan(cliCtx, sqlCtx, queryist, apr.Arg(0), ontoBranch, branchName)
 	if err != nil {
 		// attempt to abort the rebase
 		_, _, _, _ = queryist.Query(sqlCtx, "CALL DOLT_REBASE('--abort');")
@@ -171,8 +188,10 @@ func (cmd RebaseCmd) Exec(ctx context.Context, commandStr string, args []string,
 
 	rows, err = GetRowsForSql(queryist, sqlCtx, "CALL DOLT_REBASE('--continue');")
 	if err != nil {
-		// If the error is a data conflict, don't abort the rebase, but let the caller resolve the conflicts
-		if dprocedures.ErrRebaseDataConflict.Is(err) || strings.Contains(err.Error(), dprocedures.ErrRebaseDataConflict.Message[:40]) {
+		// If the error is a data conflict or a failed exec action, don't abort the rebase, but let the caller
+		// resolve the problem and continue the rebase
+		if dprocedures.ErrRebaseDataConflict.Is(err) || strings.Contains(err.Error(), dprocedures.ErrRebaseDataConflict.Message[:40]) ||
+			dprocedures.ErrRebaseExecFailed.Is(err) || strings.Contains(err.Error(), dprocedures.ErrRebaseExecFailed.Message[:30]) {
 			if checkoutErr := syncCliBranchToSqlSessionBranch(sqlCtx, dEnv); checkoutErr != nil {
 				return HandleVErrAndExitCode(errhand.VerboseErrorFromError(checkoutErr), usage)
 			}
@@ -201,12 +220,20 @@ func (cmd RebaseCmd) Exec(ctx context.Context, commandStr string, args []string,
 		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(errors.New("error: "+rows[0][1].(string))), usage)
 	}
 
-	cli.Println(rows[0][1].(string))
+	// If the rebase stopped at a step of the rebase plan, the rebase working branch must be checked out in the CLI
+	message = rows[0][1].(string)
+	if strings.HasPrefix(message, dprocedures.RebaseStoppedMessage) {
+		if err = syncCliBranchToSqlSessionBranch(sqlCtx, dEnv); err != nil {
+			return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+		}
+	}
+
+	cli.Println(message)
 	return 0
 }
 
 // getRebasePlan opens an editor for users to edit the rebase plan and returns the parsed rebase plan from the editor.
-func getRebasePlan(cliCtx cli.CliContext, sqlCtx *sql.Context, queryist cli.Queryist, rebaseBranch, currentBranch string) (*rebase.RebasePlan, error) {
+func getRebasePlan(cliCtx cli.CliContext, sqlCtx *sql.Context, queryist cli.Queryist, rebaseBranch, ontoBranch, currentBranch string) (*rebase.RebasePlan, error) {
 	if cli.ExecuteWithStdioRestored == nil {
 		return nil, nil
 	}
@@ -215,7 +242,7 @@ func getRebasePlan(cliCtx cli.CliContext, sqlCtx *sql.Context, queryist cli.Quer
 		return nil, nil
 	}
 
-	initialRebaseMsg, err := buildInitialRebaseMsg(sqlCtx, queryist, rebaseBranch, currentBranch)
+	initialRebaseMsg, err := buildInitialRebaseMsg(sqlCtx, queryist, rebaseBranch, ontoBranch, currentBranch)
 	if err != nil {
 		return nil, err
 	}
@@ -241,7 +268,7 @@ func getRebasePlan(cliCtx cli.CliContext, sqlCtx *sql.Context, queryist cli.Quer
 
 // buildInitialRebaseMsg builds the initial message to display to the user when they open the rebase plan editor,
 // including the formatted rebase plan.
-func buildInitialRebaseMsg(sqlCtx *sql.Context, queryist cli.Queryist, rebaseBranch, currentBranch string) (string, error) {
+func buildInitialRebaseMsg(sqlCtx *sql.Context, queryist cli.Queryist, rebaseBranch, ontoBranch, currentBranch string) (string, error) {
 	var buffer bytes.Buffer
 
 	rows, err := GetRowsForSql(queryist, sqlCtx, "SELECT action, commit_hash, commit_message FROM dolt_rebase ORDER BY rebase_order")
@@ -257,7 +284,14 @@ func buildInitialRebaseMsg(sqlCtx *sql.Context, queryist cli.Queryist, rebaseBra
 		}
 		commitHash := row[1].(string)
 		commitMessage := row[2].(string)
-		buffer.WriteString(fmt.Sprintf("%s %s %s\n", action, commitHash, commitMessage))
+		switch action {
+		case rebase.RebaseActionBreak:
+			buffer.WriteString(fmt.Sprintf("%s\n", action))
+		case rebase.RebaseActionExec:
+			buffer.WriteString(fmt.Sprintf("%s %s\n", action, commitMessage))
+		default:
+			buffer.WriteString(fmt.Sprintf("%s %s %s\n", action, commitHash, commitMessage))
+		}
 	}
 	buffer.WriteString("\n")
 
@@ -270,8 +304,12 @@ func buildInitialRebaseMsg(sqlCtx *sql.Context, queryist cli.Queryist, rebaseBra
 	if err != nil {
 		return "", err
 	}
+	ontoBranchHash, err := getHashOf(queryist, sqlCtx, ontoBranch)
+	if err != nil {
+		return "", err
+	}
 	numSteps := len(rows)
-	buffer.WriteString(fmt.Sprintf("# Rebase %s..%s onto %s (%d commands)\n#\n", rebaseBranchHash, currentBranchHash, rebaseBranchHash, numSteps))
+	buffer.WriteString(fmt.Sprintf("# Rebase %s..%s onto %s (%d commands)\n#\n", rebaseBranchHash, currentBranchHash, ontoBranchHash, numSteps))
 
 	buffer.WriteString("# Commands:\n")
 	buffer.WriteString("# p, pick <commit> = use commit\n")
@@ -279,6 +317,9 @@ func buildInitialRebaseMsg(sqlCtx *sql.Context, queryist cli.Queryist, rebaseBra
 	buffer.WriteString("# r, reword <commit> = use commit, but edit the commit message\n")
 	buffer.WriteString("# s, squash <commit> = use commit, but meld into previous commit\n")
 	buffer.WriteString("# f, fixup <commit> = like \"squash\", but discard this commit's message\n")
+	buffer.WriteString("# e, edit <commit> = use commit, but stop for amending\n")
+	buffer.WriteString("# b, break = stop here (continue rebase later with 'dolt rebase --continue')\n")
+	buffer.WriteString("# x, exec <query> = run SQL query, and stop if it fails\n")
 	buffer.WriteString("# These lines can be re-ordered; they are executed from top to bottom.\n")
 	buffer.WriteString("#\n")
 	buffer.WriteString("# If you remove a line here THAT COMMIT WILL BE LOST.\n")
@@ -307,6 +348,18 @@ func parseRebaseMessage(rebaseMsg string) (*rebase.RebasePlan, error) {
 	splitMsg := strings.Split(rebaseMsg, "\n")
 	for i, line := range splitMsg {
 		if !strings.HasPrefix(line, "#") && strings.TrimSpace(line) != "" {
+			// break and exec steps don't refer to a commit; the query of an exec step is the rest of the line
+			if action := strings.TrimSpace(line); action == rebase.RebaseActionBreak {
+				plan.Steps = append(plan.Steps, rebase.RebasePlanStep{Action: action})
+				continue
+			} else if strings.HasPrefix(line, rebase.RebaseActionExec+" ") {
+				plan.Steps = append(plan.Steps, rebase.RebasePlanStep{
+					Action:    rebase.RebaseActionExec,
+					CommitMsg: strings.TrimSpace(strings.TrimPrefix(line, rebase.RebaseActionExec+" ")),
+				})
+				continue
+			}
+
 			rebaseStepParts := strings.SplitN(line, " ", 3)
 			if len(rebaseStepParts) != 3 {
 				return nil, fmt.Errorf("invalid line %d: %s", i, line)
@@ -331,7 +384,8 @@ func insertRebasePlanIntoDoltRebaseTable(plan *rebase.RebasePlan, sqlCtx *sql.Co
 	}
 
 	for i, step := range plan.Steps {
-		_, err := GetRowsForSql(queryist, sqlCtx, fmt.Sprintf("INSERT INTO dolt_rebase VALUES (%d, '%s', '%s', '%s')", i+1, step.Action, step.CommitHash, step.CommitMsg))
+		_, err := GetRowsForSql(queryist, sqlCtx, fmt.Sprintf("INSERT INTO dolt_rebase VALUES (%d, '%s', '%s', '%s')",
+			i+1, step.Action, step.CommitHash, strings.ReplaceAll(step.CommitMsg, "'", "''")))
 		if err != nil {
 			return err
 		}
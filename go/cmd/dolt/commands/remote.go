@@ -43,7 +43,7 @@ var remoteDocs = cli.CommandDocumentationContent{
 {{.EmphasisLeft}}add{{.EmphasisRight}}
 Adds a remote named {{.LessThan}}name{{.GreaterThan}} for the repository at {{.LessThan}}url{{.GreaterThan}}. The command dolt fetch {{.LessThan}}name{{.GreaterThan}} can then be used to create and update remote-tracking branches {{.EmphasisLeft}}<name>/<branch>{{.EmphasisRight}}.
 
-The {{.LessThan}}url{{.GreaterThan}} parameter supports url schemes of http, https, aws, gs, and file. The url prefix defaults to https. If the {{.LessThan}}url{{.GreaterThan}} parameter is in the format {{.EmphasisLeft}}<organization>/<repository>{{.EmphasisRight}} then dolt will use the {{.EmphasisLeft}}remotes.default_host{{.EmphasisRight}} from your configuration file (Which will be dolthub.com unless changed).
+The {{.LessThan}}url{{.GreaterThan}} parameter supports url schemes of http, https, aws, s3, gs, azure, and file. The url prefix defaults to https. If the {{.LessThan}}url{{.GreaterThan}} parameter is in the format {{.EmphasisLeft}}<organization>/<repository>{{.EmphasisRight}} then dolt will use the {{.EmphasisLeft}}remotes.default_host{{.EmphasisRight}} from your configuration file (Which will be dolthub.com unless changed).
 
 AWS cloud remote urls should be of the form {{.EmphasisLeft}}aws://[dynamo-table:s3-bucket]/database{{.EmphasisRight}}.  You may configure your aws cloud remote using the optional parameters {{.EmphasisLeft}}aws-region{{.EmphasisRight}}, {{.EmphasisLeft}}aws-creds-type{{.EmphasisRight}}, {{.EmphasisLeft}}aws-creds-file{{.EmphasisRight}}.
 
@@ -55,15 +55,25 @@ aws-creds-type specifies the means by which credentials should be retrieved in o
 	
 GCP remote urls should be of the form gs://gcs-bucket/database and will use the credentials setup using the gcloud command line available from Google.
 
+S3 remote urls of the form {{.EmphasisLeft}}s3://[s3-bucket]/database{{.EmphasisRight}} store the manifest in the s3 bucket, and update it with S3 conditional writes instead of a dynamo table, so they can also be used with S3 compatible object stores such as MinIO and Ceph. They take the same optional parameters as aws urls. The endpoint of an S3 compatible object store is set with the AWS_ENDPOINT_URL_S3 environment variable, or the endpoint_url setting of the AWS profile in use.
+
+Azure remote urls should be of the form {{.EmphasisLeft}}azure://[storage-account]/[container]/database{{.EmphasisRight}}. The credentials of the storage account are read from the AZURE_STORAGE_CONNECTION_STRING, AZURE_STORAGE_KEY or AZURE_STORAGE_SAS_TOKEN environment variables, in that order. A connection string also sets the endpoint of the storage account, e.g. to use the Azurite emulator.
+
 The local filesystem can be used as a remote by providing a repository url in the format file://absolute path. See https://en.wikipedia.org/wiki/File_URI_scheme
 
+Remotes stored in aws, s3, gs, azure, oci, oss and localbs urls can be encrypted on the client by adding the parameter {{.EmphasisLeft}}encrypt{{.EmphasisRight}} to their url, whose value is the path of a file holding a base64 encoded 256-bit key, e.g. {{.EmphasisLeft}}gs://gcs-bucket/database?encrypt=/path/to/keyfile{{.EmphasisRight}}. A key can be created with {{.EmphasisLeft}}openssl rand -base64 32{{.EmphasisRight}}. Table files and manifests are encrypted before they are pushed and decrypted after they are fetched, so every clone of an encrypted remote needs the key. The manifest of an encrypted aws remote is kept in the dynamo table unencrypted, as it only holds the addresses of its table files.
+
 {{.EmphasisLeft}}remove{{.EmphasisRight}}, {{.EmphasisLeft}}rm{{.EmphasisRight}}
-Remove the remote named {{.LessThan}}name{{.GreaterThan}}. All remote-tracking branches and configuration settings for the remote are removed.`,
+Remove the remote named {{.LessThan}}name{{.GreaterThan}}. All remote-tracking branches and configuration settings for the remote are removed.
+
+{{.EmphasisLeft}}rotate-key{{.EmphasisRight}}
+Rotate the encryption keys of the encrypted remote named {{.LessThan}}name{{.GreaterThan}}. A new data key is generated, which encrypts all the data pushed to the remote afterward. If {{.EmphasisLeft}}--key-file{{.EmphasisRight}} is given, the remote's data keys are re-encrypted with the key it holds, and the remote is updated to use it.`,
 
 	Synopsis: []string{
 		"[-v | --verbose]",
 		"add [--aws-region {{.LessThan}}region{{.GreaterThan}}] [--aws-creds-type {{.LessThan}}creds-type{{.GreaterThan}}] [--aws-creds-file {{.LessThan}}file{{.GreaterThan}}] [--aws-creds-profile {{.LessThan}}profile{{.GreaterThan}}] {{.LessThan}}name{{.GreaterThan}} {{.LessThan}}url{{.GreaterThan}}",
 		"remove {{.LessThan}}name{{.GreaterThan}}",
+		"rotate-key [--key-file {{.LessThan}}file{{.GreaterThan}}] {{.LessThan}}name{{.GreaterThan}}",
 	},
 }
 
@@ -71,6 +81,7 @@ const (
 	addRemoteId         = "add"
 	removeRemoteId      = "remove"
 	removeRemoteShortId = "rm"
+	rotateKeyRemoteId   = "rotate-key"
 )
 
 type RemoteCmd struct{}
@@ -101,6 +112,8 @@ func (cmd RemoteCmd) ArgParser() *argparser.ArgParser {
 
 	ap.SupportsString(dbfactory.OSSCredsFileParam, "", "file", "OSS credentials file")
 	ap.SupportsString(dbfactory.OSSCredsProfile, "", "profile", "OSS profile to use")
+
+	ap.SupportsString(cli.KeyFileFlag, "", "file", "When rotating the encryption key of a remote, the file holding the new key.")
 	return ap
 }
 
@@ -131,6 +144,8 @@ func (cmd RemoteCmd) Exec(ctx context.Context, commandStr string, args []string,
 		verr = addRemote(sqlCtx, queryist, dEnv, apr)
 	case apr.Arg(0) == removeRemoteId, apr.Arg(0) == removeRemoteShortId:
 		verr = removeRemote(sqlCtx, queryist, apr)
+	case apr.Arg(0) == rotateKeyRemoteId:
+		verr = rotateRemoteKey(ctx, queryist, dEnv, apr)
 	default:
 		verr = errhand.BuildDError("").SetPrintUsage().Build()
 	}
@@ -151,6 +166,38 @@ func removeRemote(sqlCtx *sql.Context, qureyist cli.Queryist, apr *argparser.Arg
 	return nil
 }
 
+func rotateRemoteKey(ctx context.Context, queryist cli.Queryist, dEnv *env.DoltEnv, apr *argparser.ArgParseResults) errhand.VerboseError {
+	if apr.NArg() != 2 {
+		return errhand.BuildDError("").SetPrintUsage().Build()
+	}
+	// the key file of a remote is only stored in the local configuration
+	if _, ok := queryist.(*engine.SqlEngine); !ok {
+		return errhand.BuildDError("error: remote rotate-key failed. sql-server running while attempting to rotate the encryption key of a remote. Stop server and re-run").Build()
+	}
+
+	remoteName := strings.TrimSpace(apr.Arg(1))
+	remotes, err := dEnv.GetRemotes()
+	if err != nil {
+		return errhand.BuildDError("error: Unable to get remotes from the local directory").AddCause(err).Build()
+	}
+	r, ok := remotes.Get(remoteName)
+	if !ok {
+		return errhand.BuildDError("error: unknown remote: '%s' ", remoteName).Build()
+	}
+
+	newKeyFile, _ := apr.GetValue(cli.KeyFileFlag)
+	r, err = r.RotateEncryptionKey(ctx, dEnv.FS, newKeyFile)
+	if err != nil {
+		return errhand.BuildDError("error: unable to rotate the encryption key of remote '%s'", remoteName).AddCause(err).Build()
+	}
+
+	dEnv.RepoState.Remotes.Set(r.Name, r)
+	if err = dEnv.RepoState.Save(dEnv.FS); err != nil {
+		return errhand.BuildDError("error: the encryption key of remote '%s' was rotated, but the remote could not be updated to use the new key file", remoteName).AddCause(err).Build()
+	}
+	return nil
+}
+
 func addRemote(sqlCtx *sql.Context, queryist cli.Queryist, dEnv *env.DoltEnv, apr *argparser.ArgParseResults) errhand.VerboseError {
 	if apr.NArg() != 3 {
 		return errhand.BuildDError("").SetPrintUsage().Build()
@@ -211,7 +258,7 @@ func parseRemoteArgs(apr *argparser.ArgParseResults, scheme, remoteUrl string) (
 
 	var err error
 	switch scheme {
-	case dbfactory.AWSScheme:
+	case dbfactory.AWSScheme, dbfactory.S3Scheme:
 		err = cli.AddAWSParams(remoteUrl, apr, params)
 	case dbfactory.OSSScheme:
 		err = cli.AddOSSParams(remoteUrl, apr, params)
@@ -111,6 +111,20 @@ func TestGetAbsRemoteUrl(t *testing.T) {
 			"file",
 			false,
 		},
+		{
+			"localbs://./test-repo?encrypt=keyfile",
+			config.NewMapConfig(map[string]string{}),
+			fmt.Sprintf("localbs://%s/test-repo?encrypt=%s/keyfile", cwd, cwd),
+			"localbs",
+			false,
+		},
+		{
+			"gs://bucket/db?encrypt=keyfile",
+			config.NewMapConfig(map[string]string{}),
+			fmt.Sprintf("gs://bucket/db?encrypt=%s/keyfile", cwd),
+			"gs",
+			false,
+		},
 		{
 			":/:/:/", // intended to fail earl.Parse
 			config.NewMapConfig(map[string]string{}),
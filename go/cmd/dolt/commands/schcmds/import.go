@@ -32,13 +32,17 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions"
+	"github.com/dolthub/dolt/go/libraries/doltcore/mvdata"
 	"github.com/dolthub/dolt/go/libraries/doltcore/rowconv"
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/editor"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table/typed/parquet"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/untyped/csv"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table/untyped/xlsx"
 	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+	"github.com/dolthub/dolt/go/libraries/utils/filesys"
 	"github.com/dolthub/dolt/go/libraries/utils/funcitr"
 	"github.com/dolthub/dolt/go/libraries/utils/set"
 	"github.com/dolthub/dolt/go/store/types"
@@ -69,7 +73,7 @@ where source_field_name is the name of a field in the file being imported and de
 
 var schImportDocs = cli.CommandDocumentationContent{
 	ShortDesc: "Creates or updates a table by inferring a schema from a file containing sample data.",
-	LongDesc: `If {{.EmphasisLeft}}--create | -c{{.EmphasisRight}} is given the operation will create {{.LessThan}}table{{.GreaterThan}} with a schema that it infers from the supplied file. One or more primary key columns must be specified using the {{.EmphasisLeft}}--pks{{.EmphasisRight}} parameter.
+	LongDesc: `If {{.EmphasisLeft}}--create | -c{{.EmphasisRight}} is given the operation will create {{.LessThan}}table{{.GreaterThan}} with a schema that it infers from the supplied file. One or more primary key columns must be specified using the {{.EmphasisLeft}}--pks{{.EmphasisRight}} parameter, except for xlsx and parquet files, where a column with a unique, non-null value in every row is used as the primary key when {{.EmphasisLeft}}--pks{{.EmphasisRight}} is not given.
 
 If {{.EmphasisLeft}}--update | -u{{.EmphasisRight}} is given the operation will update {{.LessThan}}table{{.GreaterThan}} any additional columns, or change the types of columns based on the file supplied.  If the {{.EmphasisLeft}}--keep-types{{.EmphasisRight}} parameter is supplied then the types for existing columns will not be modified, even if they differ from what is in the supplied file.
 
@@ -79,7 +83,7 @@ A mapping file can be used to map fields between the file being imported and the
 
 ` + MappingFileHelp + `
 
-In create, update, and replace scenarios the file's extension is used to infer the type of the file.  If a file does not have the expected extension then the {{.EmphasisLeft}}--file-type{{.EmphasisRight}} parameter should be used to explicitly define the format of the file in one of the supported formats (Currently only csv is supported).  For files separated by a delimiter other than a ',', the --delim parameter can be used to specify a delimiter.
+In create, update, and replace scenarios the file's extension is used to infer the type of the file.  If a file does not have the expected extension then the {{.EmphasisLeft}}--file-type{{.EmphasisRight}} parameter should be used to explicitly define the format of the file in one of the supported formats (csv, psv, xlsx and parquet).  For files separated by a delimiter other than a ',', the --delim parameter can be used to specify a delimiter. The name of the table must match the name of the sheet being read from an xlsx file. The column types of a parquet file are read from its metadata rather than inferred from its values.
 
 If the parameter {{.EmphasisLeft}}--dry-run{{.EmphasisRight}} is supplied a sql statement will be generated showing what would be executed if this were run without the --dry-run flag
 
@@ -246,10 +250,11 @@ func getSchemaImportArgs(ctx context.Context, apr *argparser.ArgParseResults, dE
 	pks := funcitr.MapStrings(strings.Split(val, ","), strings.TrimSpace)
 	pks = funcitr.FilterStrings(pks, func(s string) bool { return s != "" })
 
-	if !pksOK {
+	fileType := apr.GetValueOrDefault(fileTypeParam, filepath.Ext(fileName))
+	if !pksOK && !fileTypeSuggestsPrimaryKey(fileType) {
 		return nil, errhand.BuildDError("error: missing required parameter pks").SetPrintUsage().Build()
 	}
-	if len(pks) == 0 {
+	if pksOK && len(pks) == 0 {
 		return nil, errhand.BuildDError("error: no valid columns provided in --pks argument").Build()
 	}
 
@@ -270,7 +275,7 @@ func getSchemaImportArgs(ctx context.Context, apr *argparser.ArgParseResults, dE
 	return &importOptions{
 		op:             op,
 		fileName:       fileName,
-		fileType:       apr.GetValueOrDefault(fileTypeParam, filepath.Ext(fileName)),
+		fileType:       fileType,
 		delim:          apr.GetValueOrDefault(delimParam, ","),
 		tableName:      tblName,
 		existingSch:    existingSch,
@@ -361,12 +366,24 @@ func putEmptyTableWithSchema(ctx context.Context, tblName string, root doltdb.Ro
 	return root, nil
 }
 
+// fileTypeSuggestsPrimaryKey returns whether the reader for |fileType| can suggest a primary key when none is given.
+func fileTypeSuggestsPrimaryKey(fileType string) bool {
+	switch strings.TrimPrefix(fileType, ".") {
+	case "xlsx", "parquet":
+		return true
+	default:
+		return false
+	}
+}
+
 func inferSchemaFromFile(ctx context.Context, nbf *types.NomsBinFormat, impOpts *importOptions, root doltdb.RootValue) (schema.Schema, errhand.VerboseError) {
 	if impOpts.fileType[0] == '.' {
 		impOpts.fileType = impOpts.fileType[1:]
 	}
 
 	var rd table.ReadCloser
+	var infCols *schema.ColCollection
+	var err error
 	csvInfo := csv.NewCSVInfo().SetDelim(",")
 
 	switch impOpts.fileType {
@@ -376,35 +393,82 @@ func inferSchemaFromFile(ctx context.Context, nbf *types.NomsBinFormat, impOpts
 		}
 	case "psv":
 		csvInfo.SetDelim("|")
+	case "xlsx":
+		// table name must match sheet name currently
+		rd, err = xlsx.OpenXLSXReader(ctx, root.VRW(), impOpts.fileName, filesys.LocalFS, xlsx.NewXLSXInfo(impOpts.tableName))
+		if err != nil {
+			return nil, errhand.BuildDError("error: failed to create an XLSXReader.").AddCause(err).Build()
+		}
+	case "parquet":
+		// parquet files are typed, so the column types come from the file's metadata rather than from inference
+		rd, err = parquet.OpenParquetReader(root.VRW(), impOpts.fileName, nil)
+		if err != nil {
+			return nil, errhand.BuildDError("error: failed to create a ParquetReader.").AddCause(err).Build()
+		}
+		infCols = schema.MapColCollection(rd.GetSchema().GetAllCols(), func(col schema.Column) schema.Column {
+			col.Name = impOpts.colMapper.Map(col.Name)
+			return col
+		})
 	default:
 		return nil, errhand.BuildDError("error: unsupported file type '%s'", impOpts.fileType).Build()
 	}
 
-	f, err := os.Open(impOpts.fileName)
+	if rd == nil {
+		f, err := os.Open(impOpts.fileName)
 
-	if err != nil {
-		return nil, errhand.BuildDError("error: failed to open '%s'", impOpts.fileName).Build()
-	}
+		if err != nil {
+			return nil, errhand.BuildDError("error: failed to open '%s'", impOpts.fileName).Build()
+		}
 
-	defer f.Close()
+		defer f.Close()
 
-	rd, err = csv.NewCSVReader(nbf, f, csvInfo)
+		rd, err = csv.NewCSVReader(nbf, f, csvInfo)
 
-	if err != nil {
-		return nil, errhand.BuildDError("error: failed to create a CSVReader.").AddCause(err).Build()
+		if err != nil {
+			return nil, errhand.BuildDError("error: failed to create a CSVReader.").AddCause(err).Build()
+		}
 	}
 
 	defer rd.Close(ctx)
 
-	infCols, err := actions.InferColumnTypesFromTableReader(ctx, rd, impOpts)
+	if len(impOpts.PkCols) == 0 {
+		verr := usePrimaryKeySuggestion(rd, impOpts)
+		if verr != nil {
+			return nil, verr
+		}
+	}
+
+	if infCols == nil {
+		infCols, err = actions.InferColumnTypesFromTableReader(ctx, rd, impOpts)
 
-	if err != nil {
-		return nil, errhand.BuildDError("error: failed to infer schema").AddCause(err).Build()
+		if err != nil {
+			return nil, errhand.BuildDError("error: failed to infer schema").AddCause(err).Build()
+		}
+	} else if impOpts.op == CreateOp {
+		infCols = mvdata.KeyableColumns(infCols, impOpts.PkCols)
 	}
 
 	return CombineColCollections(ctx, root, infCols, impOpts)
 }
 
+// usePrimaryKeySuggestion sets the primary key of |impOpts| to the columns suggested by |rd|, for files imported
+// without the --pks parameter.
+func usePrimaryKeySuggestion(rd table.ReadCloser, impOpts *importOptions) errhand.VerboseError {
+	var pks []string
+	if suggester, ok := rd.(table.PrimaryKeySuggester); ok {
+		pks = suggester.SuggestPrimaryKey()
+	}
+	if len(pks) == 0 {
+		return errhand.BuildDError("error: missing required parameter pks").
+			AddDetails("No column of '%s' has a unique, non-null value in every row to suggest as the primary key.", impOpts.fileName).
+			Build()
+	}
+
+	impOpts.PkCols = funcitr.MapStrings(pks, impOpts.colMapper.Map)
+	cli.PrintErrln(color.YellowString("No --%s were given, using suggested primary key %s", pksParam, strings.Join(impOpts.PkCols, ", ")))
+	return nil
+}
+
 func CombineColCollections(ctx context.Context, root doltdb.RootValue, inferredCols *schema.ColCollection, impOpts *importOptions) (schema.Schema, errhand.VerboseError) {
 	existingCols := impOpts.existingSch.GetAllCols()
 
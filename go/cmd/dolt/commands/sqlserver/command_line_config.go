@@ -549,6 +549,14 @@ func (cfg *commandLineServerConfig) AutoGCBehavior() servercfg.AutoGCBehavior {
 	return stubAutoGCBehavior{}
 }
 
+func (cfg *commandLineServerConfig) Webhooks() []servercfg.WebhookConfig {
+	return nil
+}
+
+func (cfg *commandLineServerConfig) RunCIWorkflows() bool {
+	return servercfg.DefaultRunCIWorkflows
+}
+
 // DoltServerConfigReader is the default implementation of ServerConfigReader suitable for parsing Dolt config files
 // and command line options.
 type DoltServerConfigReader struct{}
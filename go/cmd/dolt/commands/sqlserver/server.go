@@ -48,10 +48,12 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/dconfig"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions/dolt_ci"
 	"github.com/dolthub/dolt/go/libraries/doltcore/remotesrv"
 	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/binlogreplication"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/cdc"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/cluster"
 	_ "github.com/dolthub/dolt/go/libraries/doltcore/sqle/dfunctions"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
@@ -278,6 +280,26 @@ func ConfigureServices(
 	}
 	controller.Register(InitAutoGCController)
 
+	InitWorkflowHookController := &svcs.AnonService{
+		InitF: func(context.Context) error {
+			if cfg.ServerConfig.RunCIWorkflows() {
+				config.WorkflowHookController = dolt_ci.NewWorkflowHookController(lgr)
+			}
+			return nil
+		},
+	}
+	controller.Register(InitWorkflowHookController)
+
+	InitWebhookController := &svcs.AnonService{
+		InitF: func(context.Context) error {
+			if len(cfg.ServerConfig.Webhooks()) > 0 {
+				config.WebhookController = sqle.NewWebhookController(lgr, cfg.ServerConfig.Webhooks())
+			}
+			return nil
+		},
+	}
+	controller.Register(InitWebhookController)
+
 	// mySQLServer is going to be populated down below once further services
 	// are initialized. However, we want to block Controller shutdown on all
 	// connections being fully drained from the Server. Stopping the
@@ -626,6 +648,7 @@ func ConfigureServices(
 				lgr.Errorf("error creating remotesapi server on port %d: %v", port, err)
 				return err
 			}
+			cdc.NewServer(sqle.GetInterceptorSqlContext, cdc.DefaultPollInterval).RegisterGrpcService(remoteSrv.srv.GrpcServer())
 			remoteSrv.lis, err = remoteSrv.srv.Listeners()
 			if err != nil {
 				lgr.Errorf("error starting remotesapi server listeners on port %d: %v", port, err)
@@ -590,6 +590,7 @@ func TestGenerateYamlConfig(t *testing.T) {
   # event_scheduler: "OFF"
   # auto_gc_behavior:
     # enable: false
+  # run_ci_workflows: false
 
 listener:
   # host: localhost
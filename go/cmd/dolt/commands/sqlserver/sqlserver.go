@@ -103,6 +103,8 @@ SUPPORTED CONFIG FILE FIELDS:
 
 {{.EmphasisLeft}}behavior.auto_gc_behavior.enabled{{.EmphasisRight}}: If true, garbage collection will run automatically in the background. 
 
+{{.EmphasisLeft}}behavior.run_ci_workflows{{.EmphasisRight}}: If true, the dolt_ci workflows of a database are run whenever one of its branch heads is updated. Workflows run as a locked account which may only read the database. Defaults to false.
+
 {{.EmphasisLeft}}listener.host{{.EmphasisRight}}: The host address that the server will run on.  This may be {{.EmphasisLeft}}localhost{{.EmphasisRight}} or an IPv4 or IPv6 address
 
 {{.EmphasisLeft}}listener.port{{.EmphasisRight}}: The port that the server should listen on
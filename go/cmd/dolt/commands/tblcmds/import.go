@@ -85,13 +85,17 @@ var jsonInputFileHelp = "The expected JSON input file format is:" + `
 	}
 
 where column_name is the name of a column of the table being imported and value is the data for that column in the table.
+
+Newline delimited JSON files, with a {{.EmphasisLeft}}.jsonl{{.EmphasisRight}} or {{.EmphasisLeft}}.ndjson{{.EmphasisRight}} extension, contain one such row object per line and are read one row at a time. Unlike .json files, a schema file is not required to create a table from them, as the columns are read from the file and their types are inferred.
 `
 
 var importDocs = cli.CommandDocumentationContent{
 	ShortDesc: `Imports data into a dolt table`,
 	LongDesc: `If {{.EmphasisLeft}}--create-table | -c{{.EmphasisRight}} is given the operation will create {{.LessThan}}table{{.GreaterThan}} and import the contents of file into it.  If a table already exists at this location then the operation will fail, unless the {{.EmphasisLeft}}--force | -f{{.EmphasisRight}} flag is provided. The force flag forces the existing table to be overwritten.
 
-The schema for the new table can be specified explicitly by providing a SQL schema definition file, or will be inferred from the imported file.  All schemas, inferred or explicitly defined must define a primary key.  If the file format being imported does not support defining a primary key, then the {{.EmphasisLeft}}--pk{{.EmphasisRight}} parameter must supply the name of the field that should be used as the primary key. If no primary key is explicitly defined, the first column in the import file will be used as the primary key.
+The schema for the new table can be specified explicitly by providing a SQL schema definition file, or will be inferred from the imported file.  If the file format being imported does not support defining a primary key, then the {{.EmphasisLeft}}--pk{{.EmphasisRight}} parameter can supply the names of the fields that should be used as the primary key. If no primary key is defined, the table is created without one, unless {{.EmphasisLeft}}--all-text{{.EmphasisRight}} is given, in which case the first column in the import file is used as the primary key.
+
+The column types of a new table created from a parquet file are read from the file's metadata, so no schema file is needed. The column types of an xlsx sheet are inferred from its cells, with cells formatted as dates and times read as such. If no {{.EmphasisLeft}}--pk{{.EmphasisRight}} is given when creating a table from a parquet, arrow or xlsx file, the table is created without a primary key, and a column with a unique, non-null value in every row is suggested as one.
 
 If {{.EmphasisLeft}}--update-table | -u{{.EmphasisRight}} is given the operation will update {{.LessThan}}table{{.GreaterThan}} with the contents of file. The table's existing schema will be used, and field names will be used to match file fields with table fields unless a mapping file is specified.
 
@@ -109,7 +113,7 @@ During import, if there is an error importing any row, the import will be aborte
 		`
 ` + jsonInputFileHelp +
 		`
-In create, update, and replace scenarios the file's extension is used to infer the type of the file.  If a file does not have the expected extension then the {{.EmphasisLeft}}--file-type{{.EmphasisRight}} parameter should be used to explicitly define the format of the file in one of the supported formats (csv, psv, json, xlsx).  For files separated by a delimiter other than a ',' (type csv) or a '|' (type psv), the --delim parameter can be used to specify a delimiter`,
+In create, update, and replace scenarios the file's extension is used to infer the type of the file.  If a file does not have the expected extension then the {{.EmphasisLeft}}--file-type{{.EmphasisRight}} parameter should be used to explicitly define the format of the file in one of the supported formats (csv, psv, json, jsonl, xlsx, parquet, arrow).  For files separated by a delimiter other than a ',' (type csv) or a '|' (type psv), the --delim parameter can be used to specify a delimiter`,
 
 	Synopsis: []string{
 		"-c [-f] [--pk {{.LessThan}}field{{.GreaterThan}}] [--all-text] [--schema {{.LessThan}}file{{.GreaterThan}}] [--map {{.LessThan}}file{{.GreaterThan}}] [--continue]  [--quiet] [--disable-fk-checks] [--file-type {{.LessThan}}type{{.GreaterThan}}] {{.LessThan}}table{{.GreaterThan}} {{.LessThan}}file{{.GreaterThan}}",
@@ -171,8 +175,18 @@ func (m importOptions) checkOverwrite(ctx context.Context, root doltdb.RootValue
 }
 
 func (m importOptions) srcIsJson() bool {
-	_, isJson := m.srcOptions.(mvdata.JSONOptions)
-	return isJson
+	f, isFile := m.src.(mvdata.FileDataLocation)
+	return isFile && f.Format == mvdata.JsonFile
+}
+
+func (m importOptions) srcIsArrow() bool {
+	f, isFile := m.src.(mvdata.FileDataLocation)
+	return isFile && f.Format == mvdata.ArrowFile
+}
+
+func (m importOptions) srcIsParquet() bool {
+	f, isFile := m.src.(mvdata.FileDataLocation)
+	return isFile && f.Format == mvdata.ParquetFile
 }
 
 func (m importOptions) srcIsStream() bool {
@@ -224,7 +238,7 @@ func getImportMoveOptions(ctx context.Context, apr *argparser.ArgParseResults, d
 		if val.Format == mvdata.XlsxFile {
 			// table name must match sheet name currently
 			srcOpts = mvdata.XlsxOptions{SheetName: tableName}
-		} else if val.Format == mvdata.JsonFile {
+		} else if val.Format == mvdata.JsonFile || val.Format == mvdata.NdjsonFile {
 			srcOpts = mvdata.JSONOptions{TableName: tableName, SchFile: schemaFile}
 		} else if val.Format == mvdata.ParquetFile {
 			srcOpts = mvdata.ParquetOptions{TableName: tableName, SchFile: schemaFile}
@@ -238,6 +252,8 @@ func getImportMoveOptions(ctx context.Context, apr *argparser.ArgParseResults, d
 
 		if hasDelim {
 			srcOpts = mvdata.CsvOptions{Delim: delim}
+		} else if val.Format == mvdata.NdjsonFile {
+			srcOpts = mvdata.JSONOptions{TableName: tableName, SchFile: schemaFile}
 		}
 	}
 
@@ -253,6 +269,12 @@ func getImportMoveOptions(ctx context.Context, apr *argparser.ArgParseResults, d
 		moveOp = mvdata.UpdateOp
 	}
 
+	if parquetOpts, ok := srcOpts.(mvdata.ParquetOptions); ok && moveOp == mvdata.CreateOp && schemaFile == "" {
+		// new tables are created with the column types described by the parquet file
+		parquetOpts.FileSchema = true
+		srcOpts = parquetOpts
+	}
+
 	if moveOp != mvdata.CreateOp {
 		root, err := dEnv.WorkingRoot(ctx)
 		if err != nil {
@@ -346,8 +368,6 @@ func validateImportArgs(apr *argparser.ArgParseResults) errhand.VerboseError {
 		_, hasSchema := apr.GetValue(schemaParam)
 		if srcFileLoc.Format == mvdata.JsonFile && apr.Contains(createParam) && !hasSchema {
 			return errhand.BuildDError("Please specify schema file for .json tables.").Build()
-		} else if srcFileLoc.Format == mvdata.ParquetFile && apr.Contains(createParam) && !hasSchema {
-			return errhand.BuildDError("Please specify schema file for .parquet tables.").Build()
 		}
 	}
 
@@ -374,7 +394,7 @@ func (cmd ImportCmd) Docs() *cli.CommandDocumentation {
 func (cmd ImportCmd) ArgParser() *argparser.ArgParser {
 	ap := argparser.NewArgParserWithMaxArgs(cmd.Name(), 2)
 	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{tableParam, "The new or existing table being imported to."})
-	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{fileParam, "The file being imported. Supported file types are csv, psv, and nbf."})
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{fileParam, "The file being imported. Supported file types are csv, psv, json, jsonl, xlsx, parquet and arrow."})
 	ap.SupportsFlag(createParam, "c", "Create a new table, or overwrite an existing table (with the -f flag) from the imported data.")
 	ap.SupportsFlag(updateParam, "u", "Update an existing table with the imported data.")
 	ap.SupportsFlag(appendParam, "a", "Require that the operation will not modify any rows in the table.")
@@ -733,6 +753,24 @@ func getImportSchema(ctx context.Context, dEnv *env.DoltEnv, impOpts *importOpti
 			return nil, &mvdata.DataMoverCreationError{ErrType: mvdata.SchemaErr, Cause: err}
 		}
 
+		if len(impOpts.primaryKeys) == 0 {
+			printPrimaryKeySuggestion(rd, impOpts)
+		}
+
+		if impOpts.srcIsArrow() || impOpts.srcIsParquet() {
+			// arrow and parquet files are typed, so the column types come from the file rather than from inference
+			cols := schema.MapColCollection(rd.GetSchema().GetAllCols(), func(col schema.Column) schema.Column {
+				col.Name = impOpts.nameMapper.Map(col.Name)
+				return col
+			})
+			cols = mvdata.KeyableColumns(cols, impOpts.primaryKeys)
+			outSch, err := mvdata.SchemaWithPrimaryKeys(ctx, root, cols, impOpts.destTableName, impOpts.primaryKeys)
+			if err != nil {
+				return nil, &mvdata.DataMoverCreationError{ErrType: mvdata.SchemaErr, Cause: err}
+			}
+			return outSch, nil
+		}
+
 		outSch, err := mvdata.InferSchema(ctx, root, rd, impOpts.destTableName, impOpts.primaryKeys, impOpts)
 		if err != nil {
 			return nil, &mvdata.DataMoverCreationError{ErrType: mvdata.SchemaErr, Cause: err}
@@ -751,14 +789,32 @@ func getImportSchema(ctx context.Context, dEnv *env.DoltEnv, impOpts *importOpti
 	return tblRd.GetSchema(), nil
 }
 
+// printPrimaryKeySuggestion prints a hint naming a column that could be used as the primary key of the table being
+// created, if |rd| can suggest one.
+func printPrimaryKeySuggestion(rd table.ReadCloser, impOpts *importOptions) {
+	suggester, ok := rd.(table.PrimaryKeySuggester)
+	if !ok {
+		return
+	}
+	pks := suggester.SuggestPrimaryKey()
+	if len(pks) == 0 {
+		return
+	}
+	pks = funcitr.MapStrings(pks, impOpts.nameMapper.Map)
+	cli.PrintErrln(color.YellowString("No primary key was given, so %s will be created without one. Column %s has a unique value in every row; use --%s %s to make it the primary key.",
+		impOpts.destTableName, strings.Join(pks, ", "), primaryKeyParam, strings.Join(pks, ",")))
+}
+
 // generateAllTextSchema returns a schema where each column has a text type. Primary key columns will have type
-// varchar(16383) because text type is not supported for priamry keys.
+// varchar(16383) because text type is not supported for priamry keys. Unlike other imports, which create a keyless
+// table when no primary key is given, the first column is used as the primary key when |impOpts| names none.
 func generateAllTextSchema(rd table.ReadCloser, impOpts *importOptions) (schema.Schema, error) {
 	var cols []schema.Column
 	err := rd.GetSchema().GetAllCols().Iter(func(tag uint64, col schema.Column) (stop bool, err error) {
 		var colType typeinfo.TypeInfo
 		if slices.Contains(impOpts.primaryKeys, col.Name) || (len(impOpts.primaryKeys) == 0 && len(cols) == 0) {
-			// text type is not supported for primary keys, pk is either explicitly set or is the first column
+			// text type is not supported for primary keys, pk is either explicitly set or is the first column when
+			// no --pk is given
 			colType = typeinfo.StringDefaultType
 		} else {
 			colType = typeinfo.TextType
@@ -841,6 +897,11 @@ func NameAndTypeTransform(row sql.Row, rowOperationSchema sql.PrimaryKeySchema,
 		// Bit types need additional verification due to the differing values they can take on. "4", "0x04", b'100' should
 		// be interpreted in the correct manner.
 		if _, ok := col.Type.(gmstypes.BitType); ok {
+			// typed sources, such as arrow files, provide bit values as integers or nulls
+			if _, ok := row[i].(uint64); ok || row[i] == nil {
+				continue
+			}
+
 			colAsString, ok := row[i].(string)
 			if !ok {
 				return nil, fmt.Errorf("error: column value should be of type string")
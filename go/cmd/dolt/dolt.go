@@ -63,6 +63,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/utils/argparser"
 	"github.com/dolthub/dolt/go/libraries/utils/config"
 	"github.com/dolthub/dolt/go/libraries/utils/filesys"
+	"github.com/dolthub/dolt/go/store/blobstore"
 	"github.com/dolthub/dolt/go/store/nbs"
 	"github.com/dolthub/dolt/go/store/util/tempfiles"
 )
@@ -661,11 +662,15 @@ If you're interested in running this command against a remote host, hit us up on
 	if noValidRepository && isValidRepositoryRequired {
 		return func(ctx context.Context) (cli.Queryist, *sql.Context, func(), error) {
 			err := errors.New("The current directory is not a valid dolt repository.")
+			// the root env is loaded without its database, which is loaded here to report why it couldn't be opened
+			rootEnv.DoltDB(ctx)
 			if errors.Is(rootEnv.DBLoadError, nbs.ErrUnsupportedTableFileFormat) {
 				// This is fairly targeted and specific to allow for better error messaging. We should consider
 				// breaking this out into its own function if we add more conditions.
 
 				err = errors.New("The data in this database is in an unsupported format. Please upgrade to the latest version of Dolt.")
+			} else if errors.Is(rootEnv.DBLoadError, nbs.ErrMissingEncryptionKey) || errors.Is(rootEnv.DBLoadError, blobstore.ErrWrongEncryptionKey) {
+				err = rootEnv.DBLoadError
 			}
 
 			return nil, nil, nil, err
@@ -84,7 +84,55 @@ func (rcv *BranchControl) TryNamespaceTbl(obj *BranchControlNamespace) (*BranchC
 	return nil, nil
 }
 
-const BranchControlNumFields = 2
+func (rcv *BranchControl) TryTableControlTbl(obj *BranchControlTableControl) (*BranchControlTableControl, error) {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		x := rcv._tab.Indirect(o + rcv._tab.Pos)
+		if obj == nil {
+			obj = new(BranchControlTableControl)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		if BranchControlTableControlNumFields < obj.Table().NumFields() {
+			return nil, flatbuffers.ErrTableHasUnknownFields
+		}
+		return obj, nil
+	}
+	return nil, nil
+}
+
+func (rcv *BranchControl) TryProtectionTbl(obj *BranchControlProtection) (*BranchControlProtection, error) {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		x := rcv._tab.Indirect(o + rcv._tab.Pos)
+		if obj == nil {
+			obj = new(BranchControlProtection)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		if BranchControlProtectionNumFields < obj.Table().NumFields() {
+			return nil, flatbuffers.ErrTableHasUnknownFields
+		}
+		return obj, nil
+	}
+	return nil, nil
+}
+
+func (rcv *BranchControl) TryApprovalsTbl(obj *BranchControlMergeApprovals) (*BranchControlMergeApprovals, error) {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(12))
+	if o != 0 {
+		x := rcv._tab.Indirect(o + rcv._tab.Pos)
+		if obj == nil {
+			obj = new(BranchControlMergeApprovals)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		if BranchControlMergeApprovalsNumFields < obj.Table().NumFields() {
+			return nil, flatbuffers.ErrTableHasUnknownFields
+		}
+		return obj, nil
+	}
+	return nil, nil
+}
+
+const BranchControlNumFields = 5
 
 func BranchControlStart(builder *flatbuffers.Builder) {
 	builder.StartObject(BranchControlNumFields)
@@ -95,6 +143,15 @@ func BranchControlAddAccessTbl(builder *flatbuffers.Builder, accessTbl flatbuffe
 func BranchControlAddNamespaceTbl(builder *flatbuffers.Builder, namespaceTbl flatbuffers.UOffsetT) {
 	builder.PrependUOffsetTSlot(1, flatbuffers.UOffsetT(namespaceTbl), 0)
 }
+func BranchControlAddTableControlTbl(builder *flatbuffers.Builder, tableControlTbl flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(2, flatbuffers.UOffsetT(tableControlTbl), 0)
+}
+func BranchControlAddProtectionTbl(builder *flatbuffers.Builder, protectionTbl flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(3, flatbuffers.UOffsetT(protectionTbl), 0)
+}
+func BranchControlAddApprovalsTbl(builder *flatbuffers.Builder, approvalsTbl flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(4, flatbuffers.UOffsetT(approvalsTbl), 0)
+}
 func BranchControlEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
@@ -694,6 +751,542 @@ func BranchControlNamespaceValueEnd(builder *flatbuffers.Builder) flatbuffers.UO
 	return builder.EndObject()
 }
 
+type BranchControlTableControl struct {
+	_tab flatbuffers.Table
+}
+
+func InitBranchControlTableControlRoot(o *BranchControlTableControl, buf []byte, offset flatbuffers.UOffsetT) error {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	return o.Init(buf, n+offset)
+}
+
+func TryGetRootAsBranchControlTableControl(buf []byte, offset flatbuffers.UOffsetT) (*BranchControlTableControl, error) {
+	x := &BranchControlTableControl{}
+	return x, InitBranchControlTableControlRoot(x, buf, offset)
+}
+
+func TryGetSizePrefixedRootAsBranchControlTableControl(buf []byte, offset flatbuffers.UOffsetT) (*BranchControlTableControl, error) {
+	x := &BranchControlTableControl{}
+	return x, InitBranchControlTableControlRoot(x, buf, offset+flatbuffers.SizeUint32)
+}
+
+func (rcv *BranchControlTableControl) Init(buf []byte, i flatbuffers.UOffsetT) error {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+	if BranchControlTableControlNumFields < rcv.Table().NumFields() {
+		return flatbuffers.ErrTableHasUnknownFields
+	}
+	return nil
+}
+
+func (rcv *BranchControlTableControl) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *BranchControlTableControl) TryValues(obj *BranchControlTableControlValue, j int) (bool, error) {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		x := rcv._tab.Vector(o)
+		x += flatbuffers.UOffsetT(j) * 4
+		x = rcv._tab.Indirect(x)
+		obj.Init(rcv._tab.Bytes, x)
+		if BranchControlTableControlValueNumFields < obj.Table().NumFields() {
+			return false, flatbuffers.ErrTableHasUnknownFields
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+func (rcv *BranchControlTableControl) ValuesLength() int {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.VectorLen(o)
+	}
+	return 0
+}
+
+const BranchControlTableControlNumFields = 1
+
+func BranchControlTableControlStart(builder *flatbuffers.Builder) {
+	builder.StartObject(BranchControlTableControlNumFields)
+}
+func BranchControlTableControlAddValues(builder *flatbuffers.Builder, values flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(0, flatbuffers.UOffsetT(values), 0)
+}
+func BranchControlTableControlStartValuesVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
+	return builder.StartVector(4, numElems, 4)
+}
+func BranchControlTableControlEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
+
+type BranchControlTableControlValue struct {
+	_tab flatbuffers.Table
+}
+
+func InitBranchControlTableControlValueRoot(o *BranchControlTableControlValue, buf []byte, offset flatbuffers.UOffsetT) error {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	return o.Init(buf, n+offset)
+}
+
+func TryGetRootAsBranchControlTableControlValue(buf []byte, offset flatbuffers.UOffsetT) (*BranchControlTableControlValue, error) {
+	x := &BranchControlTableControlValue{}
+	return x, InitBranchControlTableControlValueRoot(x, buf, offset)
+}
+
+func TryGetSizePrefixedRootAsBranchControlTableControlValue(buf []byte, offset flatbuffers.UOffsetT) (*BranchControlTableControlValue, error) {
+	x := &BranchControlTableControlValue{}
+	return x, InitBranchControlTableControlValueRoot(x, buf, offset+flatbuffers.SizeUint32)
+}
+
+func (rcv *BranchControlTableControlValue) Init(buf []byte, i flatbuffers.UOffsetT) error {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+	if BranchControlTableControlValueNumFields < rcv.Table().NumFields() {
+		return flatbuffers.ErrTableHasUnknownFields
+	}
+	return nil
+}
+
+func (rcv *BranchControlTableControlValue) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *BranchControlTableControlValue) Database() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *BranchControlTableControlValue) Branch() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *BranchControlTableControlValue) User() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *BranchControlTableControlValue) Host() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *BranchControlTableControlValue) TableName() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(12))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *BranchControlTableControlValue) Predicate() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(14))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *BranchControlTableControlValue) Permissions() uint64 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(16))
+	if o != 0 {
+		return rcv._tab.GetUint64(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *BranchControlTableControlValue) MutatePermissions(n uint64) bool {
+	return rcv._tab.MutateUint64Slot(16, n)
+}
+
+const BranchControlTableControlValueNumFields = 7
+
+func BranchControlTableControlValueStart(builder *flatbuffers.Builder) {
+	builder.StartObject(BranchControlTableControlValueNumFields)
+}
+func BranchControlTableControlValueAddDatabase(builder *flatbuffers.Builder, database flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(0, flatbuffers.UOffsetT(database), 0)
+}
+func BranchControlTableControlValueAddBranch(builder *flatbuffers.Builder, branch flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(1, flatbuffers.UOffsetT(branch), 0)
+}
+func BranchControlTableControlValueAddUser(builder *flatbuffers.Builder, user flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(2, flatbuffers.UOffsetT(user), 0)
+}
+func BranchControlTableControlValueAddHost(builder *flatbuffers.Builder, host flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(3, flatbuffers.UOffsetT(host), 0)
+}
+func BranchControlTableControlValueAddTableName(builder *flatbuffers.Builder, tableName flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(4, flatbuffers.UOffsetT(tableName), 0)
+}
+func BranchControlTableControlValueAddPredicate(builder *flatbuffers.Builder, predicate flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(5, flatbuffers.UOffsetT(predicate), 0)
+}
+func BranchControlTableControlValueAddPermissions(builder *flatbuffers.Builder, permissions uint64) {
+	builder.PrependUint64Slot(6, permissions, 0)
+}
+func BranchControlTableControlValueEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
+
+type BranchControlProtection struct {
+	_tab flatbuffers.Table
+}
+
+func InitBranchControlProtectionRoot(o *BranchControlProtection, buf []byte, offset flatbuffers.UOffsetT) error {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	return o.Init(buf, n+offset)
+}
+
+func TryGetRootAsBranchControlProtection(buf []byte, offset flatbuffers.UOffsetT) (*BranchControlProtection, error) {
+	x := &BranchControlProtection{}
+	return x, InitBranchControlProtectionRoot(x, buf, offset)
+}
+
+func TryGetSizePrefixedRootAsBranchControlProtection(buf []byte, offset flatbuffers.UOffsetT) (*BranchControlProtection, error) {
+	x := &BranchControlProtection{}
+	return x, InitBranchControlProtectionRoot(x, buf, offset+flatbuffers.SizeUint32)
+}
+
+func (rcv *BranchControlProtection) Init(buf []byte, i flatbuffers.UOffsetT) error {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+	if BranchControlProtectionNumFields < rcv.Table().NumFields() {
+		return flatbuffers.ErrTableHasUnknownFields
+	}
+	return nil
+}
+
+func (rcv *BranchControlProtection) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *BranchControlProtection) TryValues(obj *BranchControlProtectionValue, j int) (bool, error) {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		x := rcv._tab.Vector(o)
+		x += flatbuffers.UOffsetT(j) * 4
+		x = rcv._tab.Indirect(x)
+		obj.Init(rcv._tab.Bytes, x)
+		if BranchControlProtectionValueNumFields < obj.Table().NumFields() {
+			return false, flatbuffers.ErrTableHasUnknownFields
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+func (rcv *BranchControlProtection) ValuesLength() int {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.VectorLen(o)
+	}
+	return 0
+}
+
+const BranchControlProtectionNumFields = 1
+
+func BranchControlProtectionStart(builder *flatbuffers.Builder) {
+	builder.StartObject(BranchControlProtectionNumFields)
+}
+func BranchControlProtectionAddValues(builder *flatbuffers.Builder, values flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(0, flatbuffers.UOffsetT(values), 0)
+}
+func BranchControlProtectionStartValuesVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
+	return builder.StartVector(4, numElems, 4)
+}
+func BranchControlProtectionEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
+
+type BranchControlProtectionValue struct {
+	_tab flatbuffers.Table
+}
+
+func InitBranchControlProtectionValueRoot(o *BranchControlProtectionValue, buf []byte, offset flatbuffers.UOffsetT) error {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	return o.Init(buf, n+offset)
+}
+
+func TryGetRootAsBranchControlProtectionValue(buf []byte, offset flatbuffers.UOffsetT) (*BranchControlProtectionValue, error) {
+	x := &BranchControlProtectionValue{}
+	return x, InitBranchControlProtectionValueRoot(x, buf, offset)
+}
+
+func TryGetSizePrefixedRootAsBranchControlProtectionValue(buf []byte, offset flatbuffers.UOffsetT) (*BranchControlProtectionValue, error) {
+	x := &BranchControlProtectionValue{}
+	return x, InitBranchControlProtectionValueRoot(x, buf, offset+flatbuffers.SizeUint32)
+}
+
+func (rcv *BranchControlProtectionValue) Init(buf []byte, i flatbuffers.UOffsetT) error {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+	if BranchControlProtectionValueNumFields < rcv.Table().NumFields() {
+		return flatbuffers.ErrTableHasUnknownFields
+	}
+	return nil
+}
+
+func (rcv *BranchControlProtectionValue) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *BranchControlProtectionValue) Database() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *BranchControlProtectionValue) Branch() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *BranchControlProtectionValue) FastForwardOnly() bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		return rcv._tab.GetBool(o + rcv._tab.Pos)
+	}
+	return false
+}
+
+func (rcv *BranchControlProtectionValue) MutateFastForwardOnly(n bool) bool {
+	return rcv._tab.MutateBoolSlot(8, n)
+}
+
+func (rcv *BranchControlProtectionValue) DenyForcePush() bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		return rcv._tab.GetBool(o + rcv._tab.Pos)
+	}
+	return false
+}
+
+func (rcv *BranchControlProtectionValue) MutateDenyForcePush(n bool) bool {
+	return rcv._tab.MutateBoolSlot(10, n)
+}
+
+func (rcv *BranchControlProtectionValue) RequiredApprovals() uint32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(12))
+	if o != 0 {
+		return rcv._tab.GetUint32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *BranchControlProtectionValue) MutateRequiredApprovals(n uint32) bool {
+	return rcv._tab.MutateUint32Slot(12, n)
+}
+
+func (rcv *BranchControlProtectionValue) RequiredWorkflow() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(14))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+const BranchControlProtectionValueNumFields = 6
+
+func BranchControlProtectionValueStart(builder *flatbuffers.Builder) {
+	builder.StartObject(BranchControlProtectionValueNumFields)
+}
+func BranchControlProtectionValueAddDatabase(builder *flatbuffers.Builder, database flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(0, flatbuffers.UOffsetT(database), 0)
+}
+func BranchControlProtectionValueAddBranch(builder *flatbuffers.Builder, branch flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(1, flatbuffers.UOffsetT(branch), 0)
+}
+func BranchControlProtectionValueAddFastForwardOnly(builder *flatbuffers.Builder, fastForwardOnly bool) {
+	builder.PrependBoolSlot(2, fastForwardOnly, false)
+}
+func BranchControlProtectionValueAddDenyForcePush(builder *flatbuffers.Builder, denyForcePush bool) {
+	builder.PrependBoolSlot(3, denyForcePush, false)
+}
+func BranchControlProtectionValueAddRequiredApprovals(builder *flatbuffers.Builder, requiredApprovals uint32) {
+	builder.PrependUint32Slot(4, requiredApprovals, 0)
+}
+func BranchControlProtectionValueAddRequiredWorkflow(builder *flatbuffers.Builder, requiredWorkflow flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(5, flatbuffers.UOffsetT(requiredWorkflow), 0)
+}
+func BranchControlProtectionValueEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
+
+type BranchControlMergeApprovals struct {
+	_tab flatbuffers.Table
+}
+
+func InitBranchControlMergeApprovalsRoot(o *BranchControlMergeApprovals, buf []byte, offset flatbuffers.UOffsetT) error {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	return o.Init(buf, n+offset)
+}
+
+func TryGetRootAsBranchControlMergeApprovals(buf []byte, offset flatbuffers.UOffsetT) (*BranchControlMergeApprovals, error) {
+	x := &BranchControlMergeApprovals{}
+	return x, InitBranchControlMergeApprovalsRoot(x, buf, offset)
+}
+
+func TryGetSizePrefixedRootAsBranchControlMergeApprovals(buf []byte, offset flatbuffers.UOffsetT) (*BranchControlMergeApprovals, error) {
+	x := &BranchControlMergeApprovals{}
+	return x, InitBranchControlMergeApprovalsRoot(x, buf, offset+flatbuffers.SizeUint32)
+}
+
+func (rcv *BranchControlMergeApprovals) Init(buf []byte, i flatbuffers.UOffsetT) error {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+	if BranchControlMergeApprovalsNumFields < rcv.Table().NumFields() {
+		return flatbuffers.ErrTableHasUnknownFields
+	}
+	return nil
+}
+
+func (rcv *BranchControlMergeApprovals) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *BranchControlMergeApprovals) TryValues(obj *BranchControlMergeApproval, j int) (bool, error) {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		x := rcv._tab.Vector(o)
+		x += flatbuffers.UOffsetT(j) * 4
+		x = rcv._tab.Indirect(x)
+		obj.Init(rcv._tab.Bytes, x)
+		if BranchControlMergeApprovalNumFields < obj.Table().NumFields() {
+			return false, flatbuffers.ErrTableHasUnknownFields
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+func (rcv *BranchControlMergeApprovals) ValuesLength() int {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.VectorLen(o)
+	}
+	return 0
+}
+
+const BranchControlMergeApprovalsNumFields = 1
+
+func BranchControlMergeApprovalsStart(builder *flatbuffers.Builder) {
+	builder.StartObject(BranchControlMergeApprovalsNumFields)
+}
+func BranchControlMergeApprovalsAddValues(builder *flatbuffers.Builder, values flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(0, flatbuffers.UOffsetT(values), 0)
+}
+func BranchControlMergeApprovalsStartValuesVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
+	return builder.StartVector(4, numElems, 4)
+}
+func BranchControlMergeApprovalsEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
+
+type BranchControlMergeApproval struct {
+	_tab flatbuffers.Table
+}
+
+func InitBranchControlMergeApprovalRoot(o *BranchControlMergeApproval, buf []byte, offset flatbuffers.UOffsetT) error {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	return o.Init(buf, n+offset)
+}
+
+func TryGetRootAsBranchControlMergeApproval(buf []byte, offset flatbuffers.UOffsetT) (*BranchControlMergeApproval, error) {
+	x := &BranchControlMergeApproval{}
+	return x, InitBranchControlMergeApprovalRoot(x, buf, offset)
+}
+
+func TryGetSizePrefixedRootAsBranchControlMergeApproval(buf []byte, offset flatbuffers.UOffsetT) (*BranchControlMergeApproval, error) {
+	x := &BranchControlMergeApproval{}
+	return x, InitBranchControlMergeApprovalRoot(x, buf, offset+flatbuffers.SizeUint32)
+}
+
+func (rcv *BranchControlMergeApproval) Init(buf []byte, i flatbuffers.UOffsetT) error {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+	if BranchControlMergeApprovalNumFields < rcv.Table().NumFields() {
+		return flatbuffers.ErrTableHasUnknownFields
+	}
+	return nil
+}
+
+func (rcv *BranchControlMergeApproval) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *BranchControlMergeApproval) Database() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *BranchControlMergeApproval) Branch() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *BranchControlMergeApproval) CommitHash() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *BranchControlMergeApproval) User() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+const BranchControlMergeApprovalNumFields = 4
+
+func BranchControlMergeApprovalStart(builder *flatbuffers.Builder) {
+	builder.StartObject(BranchControlMergeApprovalNumFields)
+}
+func BranchControlMergeApprovalAddDatabase(builder *flatbuffers.Builder, database flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(0, flatbuffers.UOffsetT(database), 0)
+}
+func BranchControlMergeApprovalAddBranch(builder *flatbuffers.Builder, branch flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(1, flatbuffers.UOffsetT(branch), 0)
+}
+func BranchControlMergeApprovalAddCommitHash(builder *flatbuffers.Builder, commitHash flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(2, flatbuffers.UOffsetT(commitHash), 0)
+}
+func BranchControlMergeApprovalAddUser(builder *flatbuffers.Builder, user flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(3, flatbuffers.UOffsetT(user), 0)
+}
+func BranchControlMergeApprovalEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
+
 type BranchControlBinlog struct {
 	_tab flatbuffers.Table
 }
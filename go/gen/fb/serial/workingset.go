@@ -188,7 +188,24 @@ func (rcv *WorkingSet) TryRebaseState(obj *RebaseState) (*RebaseState, error) {
 	return nil, nil
 }
 
-const WorkingSetNumFields = 8
+func (rcv *WorkingSet) SparseTables(j int) []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(20))
+	if o != 0 {
+		a := rcv._tab.Vector(o)
+		return rcv._tab.ByteVector(a + flatbuffers.UOffsetT(j*4))
+	}
+	return nil
+}
+
+func (rcv *WorkingSet) SparseTablesLength() int {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(20))
+	if o != 0 {
+		return rcv._tab.VectorLen(o)
+	}
+	return 0
+}
+
+const WorkingSetNumFields = 9
 
 func WorkingSetStart(builder *flatbuffers.Builder) {
 	builder.StartObject(WorkingSetNumFields)
@@ -223,6 +240,12 @@ func WorkingSetAddMergeState(builder *flatbuffers.Builder, mergeState flatbuffer
 func WorkingSetAddRebaseState(builder *flatbuffers.Builder, rebaseState flatbuffers.UOffsetT) {
 	builder.PrependUOffsetTSlot(7, flatbuffers.UOffsetT(rebaseState), 0)
 }
+func WorkingSetAddSparseTables(builder *flatbuffers.Builder, sparseTables flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(8, flatbuffers.UOffsetT(sparseTables), 0)
+}
+func WorkingSetStartSparseTablesVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
+	return builder.StartVector(4, numElems, 4)
+}
 func WorkingSetEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
@@ -579,7 +602,19 @@ func (rcv *RebaseState) MutateRebasingStarted(n bool) bool {
 	return rcv._tab.MutateBoolSlot(16, n)
 }
 
-const RebaseStateNumFields = 7
+func (rcv *RebaseState) StopReason() byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(18))
+	if o != 0 {
+		return rcv._tab.GetByte(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *RebaseState) MutateStopReason(n byte) bool {
+	return rcv._tab.MutateByteSlot(18, n)
+}
+
+const RebaseStateNumFields = 8
 
 func RebaseStateStart(builder *flatbuffers.Builder) {
 	builder.StartObject(RebaseStateNumFields)
@@ -614,6 +649,9 @@ func RebaseStateAddLastAttemptedStep(builder *flatbuffers.Builder, lastAttempted
 func RebaseStateAddRebasingStarted(builder *flatbuffers.Builder, rebasingStarted bool) {
 	builder.PrependBoolSlot(6, rebasingStarted, false)
 }
+func RebaseStateAddStopReason(builder *flatbuffers.Builder, stopReason byte) {
+	builder.PrependByteSlot(7, stopReason, 0)
+}
 func RebaseStateEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
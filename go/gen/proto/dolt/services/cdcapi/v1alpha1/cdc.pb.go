@@ -0,0 +1,484 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v5.28.3
+// source: dolt/services/cdcapi/v1alpha1/cdc.proto
+
+package cdcapi
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ChangeOp int32
+
+const (
+	ChangeOp_CHANGE_OP_UNSPECIFIED ChangeOp = 0
+	ChangeOp_CHANGE_OP_INSERT      ChangeOp = 1
+	ChangeOp_CHANGE_OP_UPDATE      ChangeOp = 2
+	ChangeOp_CHANGE_OP_DELETE      ChangeOp = 3
+)
+
+// Enum value maps for ChangeOp.
+var (
+	ChangeOp_name = map[int32]string{
+		0: "CHANGE_OP_UNSPECIFIED",
+		1: "CHANGE_OP_INSERT",
+		2: "CHANGE_OP_UPDATE",
+		3: "CHANGE_OP_DELETE",
+	}
+	ChangeOp_value = map[string]int32{
+		"CHANGE_OP_UNSPECIFIED": 0,
+		"CHANGE_OP_INSERT":      1,
+		"CHANGE_OP_UPDATE":      2,
+		"CHANGE_OP_DELETE":      3,
+	}
+)
+
+func (x ChangeOp) Enum() *ChangeOp {
+	p := new(ChangeOp)
+	*p = x
+	return p
+}
+
+func (x ChangeOp) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChangeOp) Descriptor() protoreflect.EnumDescriptor {
+	return file_dolt_services_cdcapi_v1alpha1_cdc_proto_enumTypes[0].Descriptor()
+}
+
+func (ChangeOp) Type() protoreflect.EnumType {
+	return &file_dolt_services_cdcapi_v1alpha1_cdc_proto_enumTypes[0]
+}
+
+func (x ChangeOp) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChangeOp.Descriptor instead.
+func (ChangeOp) EnumDescriptor() ([]byte, []int) {
+	return file_dolt_services_cdcapi_v1alpha1_cdc_proto_rawDescGZIP(), []int{0}
+}
+
+type StreamChangesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The database to stream changes from.
+	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	// The branch whose history is streamed.
+	Branch string `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	// The changes made by commits after |from_commit| are streamed. It must be
+	// on the first-parent history of |branch|. If it is empty, the changes of
+	// the entire history of the branch are streamed. Ignored if |cursor| is set.
+	FromCommit string `protobuf:"bytes,3,opt,name=from_commit,json=fromCommit,proto3" json:"from_commit,omitempty"`
+	// A cursor returned in a previous RowChange. If set, the stream resumes
+	// with the change following the one which returned the cursor.
+	Cursor string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// If not empty, only changes to these tables are streamed.
+	Tables []string `protobuf:"bytes,5,rep,name=tables,proto3" json:"tables,omitempty"`
+	// If true, the stream does not end once it reaches the head of the branch.
+	// Instead it waits for new commits and streams their changes as they land.
+	Follow bool `protobuf:"varint,6,opt,name=follow,proto3" json:"follow,omitempty"`
+}
+
+func (x *StreamChangesRequest) Reset() {
+	*x = StreamChangesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolt_services_cdcapi_v1alpha1_cdc_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamChangesRequest) ProtoMessage() {}
+
+func (x *StreamChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dolt_services_cdcapi_v1alpha1_cdc_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamChangesRequest.ProtoReflect.Descriptor instead.
+func (*StreamChangesRequest) Descriptor() ([]byte, []int) {
+	return file_dolt_services_cdcapi_v1alpha1_cdc_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StreamChangesRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *StreamChangesRequest) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *StreamChangesRequest) GetFromCommit() string {
+	if x != nil {
+		return x.FromCommit
+	}
+	return ""
+}
+
+func (x *StreamChangesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *StreamChangesRequest) GetTables() []string {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+func (x *StreamChangesRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+type StreamChangesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes []*RowChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *StreamChangesResponse) Reset() {
+	*x = StreamChangesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolt_services_cdcapi_v1alpha1_cdc_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamChangesResponse) ProtoMessage() {}
+
+func (x *StreamChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dolt_services_cdcapi_v1alpha1_cdc_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamChangesResponse.ProtoReflect.Descriptor instead.
+func (*StreamChangesResponse) Descriptor() ([]byte, []int) {
+	return file_dolt_services_cdcapi_v1alpha1_cdc_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StreamChangesResponse) GetChanges() []*RowChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type RowChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The commit which made the change.
+	CommitHash string   `protobuf:"bytes,1,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	TableName  string   `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	Op         ChangeOp `protobuf:"varint,3,opt,name=op,proto3,enum=dolt.services.cdcapi.v1alpha1.ChangeOp" json:"op,omitempty"`
+	// The row before the change, as a JSON object keyed by column name. Empty
+	// for inserts.
+	OldRowJson string `protobuf:"bytes,4,opt,name=old_row_json,json=oldRowJson,proto3" json:"old_row_json,omitempty"`
+	// The row after the change, as a JSON object keyed by column name. Empty
+	// for deletes.
+	NewRowJson string `protobuf:"bytes,5,opt,name=new_row_json,json=newRowJson,proto3" json:"new_row_json,omitempty"`
+	// An opaque cursor which can be passed in a StreamChangesRequest to resume
+	// the stream after this change.
+	Cursor string `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *RowChange) Reset() {
+	*x = RowChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolt_services_cdcapi_v1alpha1_cdc_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RowChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RowChange) ProtoMessage() {}
+
+func (x *RowChange) ProtoReflect() protoreflect.Message {
+	mi := &file_dolt_services_cdcapi_v1alpha1_cdc_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RowChange.ProtoReflect.Descriptor instead.
+func (*RowChange) Descriptor() ([]byte, []int) {
+	return file_dolt_services_cdcapi_v1alpha1_cdc_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RowChange) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *RowChange) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *RowChange) GetOp() ChangeOp {
+	if x != nil {
+		return x.Op
+	}
+	return ChangeOp_CHANGE_OP_UNSPECIFIED
+}
+
+func (x *RowChange) GetOldRowJson() string {
+	if x != nil {
+		return x.OldRowJson
+	}
+	return ""
+}
+
+func (x *RowChange) GetNewRowJson() string {
+	if x != nil {
+		return x.NewRowJson
+	}
+	return ""
+}
+
+func (x *RowChange) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+var File_dolt_services_cdcapi_v1alpha1_cdc_proto protoreflect.FileDescriptor
+
+var file_dolt_services_cdcapi_v1alpha1_cdc_proto_rawDesc = []byte{
+	0x0a, 0x27, 0x64, 0x6f, 0x6c, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f,
+	0x63, 0x64, 0x63, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x63, 0x64, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1d, 0x64, 0x6f, 0x6c, 0x74, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x63, 0x64, 0x63, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x22, 0xb3, 0x01, 0x0a, 0x14, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x22, 0x5b,
+	0x0a, 0x15, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x64, 0x6f, 0x6c, 0x74, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x63, 0x64, 0x63, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x6f, 0x77, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0xe0, 0x01, 0x0a, 0x09,
+	0x52, 0x6f, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x02, 0x6f, 0x70, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x64, 0x6f, 0x6c, 0x74, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x63, 0x64, 0x63, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4f, 0x70, 0x52, 0x02,
+	0x6f, 0x70, 0x12, 0x20, 0x0a, 0x0c, 0x6f, 0x6c, 0x64, 0x5f, 0x72, 0x6f, 0x77, 0x5f, 0x6a, 0x73,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x6c, 0x64, 0x52, 0x6f, 0x77,
+	0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x72, 0x6f, 0x77, 0x5f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x52,
+	0x6f, 0x77, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x2a, 0x67,
+	0x0a, 0x08, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4f, 0x70, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x48,
+	0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4f, 0x50, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f,
+	0x4f, 0x50, 0x5f, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x43,
+	0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4f, 0x50, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10,
+	0x02, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4f, 0x50, 0x5f, 0x44,
+	0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x32, 0x8a, 0x01, 0x0a, 0x0a, 0x43, 0x44, 0x43, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7c, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x33, 0x2e, 0x64, 0x6f, 0x6c, 0x74, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x63, 0x64, 0x63, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x64,
+	0x6f, 0x6c, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x63, 0x64, 0x63,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x30, 0x01, 0x42, 0x4b, 0x5a, 0x49, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x64, 0x6f, 0x6c, 0x74, 0x68, 0x75, 0x62, 0x2f, 0x64, 0x6f, 0x6c, 0x74, 0x2f,
+	0x67, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x64, 0x6f, 0x6c,
+	0x74, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x63, 0x64, 0x63, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b, 0x63, 0x64, 0x63, 0x61, 0x70,
+	0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_dolt_services_cdcapi_v1alpha1_cdc_proto_rawDescOnce sync.Once
+	file_dolt_services_cdcapi_v1alpha1_cdc_proto_rawDescData = file_dolt_services_cdcapi_v1alpha1_cdc_proto_rawDesc
+)
+
+func file_dolt_services_cdcapi_v1alpha1_cdc_proto_rawDescGZIP() []byte {
+	file_dolt_services_cdcapi_v1alpha1_cdc_proto_rawDescOnce.Do(func() {
+		file_dolt_services_cdcapi_v1alpha1_cdc_proto_rawDescData = protoimpl.X.CompressGZIP(file_dolt_services_cdcapi_v1alpha1_cdc_proto_rawDescData)
+	})
+	return file_dolt_services_cdcapi_v1alpha1_cdc_proto_rawDescData
+}
+
+var file_dolt_services_cdcapi_v1alpha1_cdc_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_dolt_services_cdcapi_v1alpha1_cdc_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_dolt_services_cdcapi_v1alpha1_cdc_proto_goTypes = []interface{}{
+	(ChangeOp)(0),                 // 0: dolt.services.cdcapi.v1alpha1.ChangeOp
+	(*StreamChangesRequest)(nil),  // 1: dolt.services.cdcapi.v1alpha1.StreamChangesRequest
+	(*StreamChangesResponse)(nil), // 2: dolt.services.cdcapi.v1alpha1.StreamChangesResponse
+	(*RowChange)(nil),             // 3: dolt.services.cdcapi.v1alpha1.RowChange
+}
+var file_dolt_services_cdcapi_v1alpha1_cdc_proto_depIdxs = []int32{
+	3, // 0: dolt.services.cdcapi.v1alpha1.StreamChangesResponse.changes:type_name -> dolt.services.cdcapi.v1alpha1.RowChange
+	0, // 1: dolt.services.cdcapi.v1alpha1.RowChange.op:type_name -> dolt.services.cdcapi.v1alpha1.ChangeOp
+	1, // 2: dolt.services.cdcapi.v1alpha1.CDCService.StreamChanges:input_type -> dolt.services.cdcapi.v1alpha1.StreamChangesRequest
+	2, // 3: dolt.services.cdcapi.v1alpha1.CDCService.StreamChanges:output_type -> dolt.services.cdcapi.v1alpha1.StreamChangesResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_dolt_services_cdcapi_v1alpha1_cdc_proto_init() }
+func file_dolt_services_cdcapi_v1alpha1_cdc_proto_init() {
+	if File_dolt_services_cdcapi_v1alpha1_cdc_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_dolt_services_cdcapi_v1alpha1_cdc_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamChangesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dolt_services_cdcapi_v1alpha1_cdc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamChangesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dolt_services_cdcapi_v1alpha1_cdc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RowChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_dolt_services_cdcapi_v1alpha1_cdc_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_dolt_services_cdcapi_v1alpha1_cdc_proto_goTypes,
+		DependencyIndexes: file_dolt_services_cdcapi_v1alpha1_cdc_proto_depIdxs,
+		EnumInfos:         file_dolt_services_cdcapi_v1alpha1_cdc_proto_enumTypes,
+		MessageInfos:      file_dolt_services_cdcapi_v1alpha1_cdc_proto_msgTypes,
+	}.Build()
+	File_dolt_services_cdcapi_v1alpha1_cdc_proto = out.File
+	file_dolt_services_cdcapi_v1alpha1_cdc_proto_rawDesc = nil
+	file_dolt_services_cdcapi_v1alpha1_cdc_proto_goTypes = nil
+	file_dolt_services_cdcapi_v1alpha1_cdc_proto_depIdxs = nil
+}
@@ -41,6 +41,21 @@ var (
 	ErrUpdatingToRow         = errors.NewKind("`%s`@`%s` cannot update the row [%q, %q, %q, %q] to the new branch expression [%q, %q]")
 	ErrDeletingRow           = errors.NewKind("`%s`@`%s` cannot delete the row [%q, %q, %q, %q]")
 	ErrMissingController     = errors.NewKind("a context has a non-nil session but is missing its branch controller")
+
+	ErrInsertingTableControlRow = errors.NewKind("`%s`@`%s` cannot add the row [%q, %q, %q, %q, %q]")
+	ErrTableAccess              = errors.NewKind("`%s`@`%s` does not have permission to modify the table `%s` on branch `%s`")
+	ErrTableRowAccess           = errors.NewKind("`%s`@`%s` does not have permission to modify the row %s in the table `%s` on branch `%s`")
+	ErrTableReplaceAccess       = errors.NewKind("`%s`@`%s` may only modify some rows in the table `%s` on branch `%s`, which does not allow REPLACE or TRUNCATE")
+	ErrTableRestrictedAccess    = errors.NewKind("`%s`@`%s` may only modify some rows in the table `%s` on branch `%s`, so it may not change the table as a whole")
+
+	ErrModifyingProtectionRow  = errors.NewKind("`%s`@`%s` cannot modify the protection rules for [%q, %q]")
+	ErrModifyingApprovalRow    = errors.NewKind("`%s`@`%s` cannot modify the approval [%q, %q, %q, %q]")
+	ErrProtectedFastForward    = errors.NewKind("branch `%s` is protected and only allows fast-forward merges")
+	ErrProtectedForceUpdate    = errors.NewKind("branch `%s` is protected and does not allow its history to be overwritten")
+	ErrProtectedApprovals      = errors.NewKind("branch `%s` is protected and requires %d approval(s) of commit `%s` from users other than `%s`, but has %d")
+	ErrProtectedWorkflowFailed = errors.NewKind("branch `%s` is protected and requires the workflow `%s` to pass on commit `%s`")
+	ErrProtectedDelete         = errors.NewKind("branch `%s` is protected and cannot be deleted or renamed")
+	ErrProtectedDirectCommit   = errors.NewKind("branch `%s` is protected and only accepts changes through merges")
 )
 
 // Context represents the interface that must be inherited from the context.
@@ -56,8 +71,11 @@ type Context interface {
 
 // Controller is the central hub for branch control functions. This is passed within a context.
 type Controller struct {
-	Access    *Access
-	Namespace *Namespace
+	Access       *Access
+	Namespace    *Namespace
+	TableControl *TableControl
+	Protection   *Protection
+	Approvals    *MergeApprovals
 
 	Serialized atomic.Pointer[[]byte]
 
@@ -87,6 +105,9 @@ func LoadData(ctx context.Context, branchControlFilePath string, doltConfigDirPa
 	controller := &Controller{
 		Access:                accessTbl,
 		Namespace:             newNamespace(accessTbl),
+		TableControl:          newTableControl(accessTbl),
+		Protection:            newProtection(accessTbl),
+		Approvals:             newMergeApprovals(accessTbl),
 		branchControlFilePath: branchControlFilePath,
 		doltConfigDirPath:     doltConfigDirPath,
 	}
@@ -118,6 +139,9 @@ func (controller *Controller) LoadData(ctx context.Context, data []byte, isFirst
 	if len(data) == 0 {
 		// As there is nothing to load, we should populate the controller with the default row to ensure normal (expected) operation
 		controller.Access.insertDefaultRow()
+		controller.TableControl.reinit()
+		controller.Protection.reinit()
+		controller.Approvals.reinit()
 		controller.Serialized.Store(&data)
 		if controller.SavedCallback != nil {
 			controller.SavedCallback(ctx)
@@ -140,6 +164,18 @@ func (controller *Controller) LoadData(ctx context.Context, data []byte, isFirst
 	if err != nil {
 		return err
 	}
+	tableControl, err := bc.TryTableControlTbl(nil)
+	if err != nil {
+		return err
+	}
+	protection, err := bc.TryProtectionTbl(nil)
+	if err != nil {
+		return err
+	}
+	approvals, err := bc.TryApprovalsTbl(nil)
+	if err != nil {
+		return err
+	}
 
 	rollback := controller.Serialized.Load()
 
@@ -157,6 +193,21 @@ func (controller *Controller) LoadData(ctx context.Context, data []byte, isFirst
 		controller.LoadData(ctx, *rollback, isFirstLoad)
 		return err
 	}
+	if err = controller.TableControl.Deserialize(tableControl); err != nil {
+		// TODO: More principaled rollback. Hopefully this does not fail.
+		controller.LoadData(ctx, *rollback, isFirstLoad)
+		return err
+	}
+	if err = controller.Protection.Deserialize(protection); err != nil {
+		// TODO: More principaled rollback. Hopefully this does not fail.
+		controller.LoadData(ctx, *rollback, isFirstLoad)
+		return err
+	}
+	if err = controller.Approvals.Deserialize(approvals); err != nil {
+		// TODO: More principaled rollback. Hopefully this does not fail.
+		controller.LoadData(ctx, *rollback, isFirstLoad)
+		return err
+	}
 
 	controller.Serialized.Store(&data)
 	if controller.SavedCallback != nil {
@@ -202,9 +253,15 @@ func (controller *Controller) SaveData(ctx context.Context, fs filesys.Filesys)
 	// The Serialize functions acquire read locks, so we don't acquire them here
 	accessOffset := controller.Access.Serialize(b)
 	namespaceOffset := controller.Namespace.Serialize(b)
+	tableControlOffset := controller.TableControl.Serialize(b)
+	protectionOffset := controller.Protection.Serialize(b)
+	approvalsOffset := controller.Approvals.Serialize(b)
 	serial.BranchControlStart(b)
 	serial.BranchControlAddAccessTbl(b, accessOffset)
 	serial.BranchControlAddNamespaceTbl(b, namespaceOffset)
+	serial.BranchControlAddTableControlTbl(b, tableControlOffset)
+	serial.BranchControlAddProtectionTbl(b, protectionOffset)
+	serial.BranchControlAddApprovalsTbl(b, approvalsOffset)
 	root := serial.BranchControlEnd(b)
 	// serial.FinishMessage() limits files to 2^24 bytes, so this works around it while maintaining read compatibility
 	b.Prep(1, flatbuffers.SizeInt32+4+serial.MessagePrefixSz)
@@ -258,6 +315,48 @@ func CheckAccess(ctx context.Context, flags Permissions) error {
 	return ErrIncorrectPermissions.New(user, host, branch)
 }
 
+// CheckUnrestrictedTableAccess checks whether the current user may write every row of each of the given tables on the
+// current branch, according to the "dolt_table_control" table. Users with admin permissions on the branch are never
+// restricted. Procedures which change tables as a whole, such as merges, resets, and checkouts of tables, require this.
+func CheckUnrestrictedTableAccess(ctx context.Context, tableNames ...string) error {
+	branchAwareSession := GetBranchAwareSession(ctx)
+	// A nil session means we're not in the SQL context, so we allow all operations
+	if branchAwareSession == nil {
+		return nil
+	}
+	controller := branchAwareSession.GetController()
+	// Any context that has a non-nil session should always have a non-nil controller, so this is an error
+	if controller == nil {
+		return ErrMissingController.New()
+	}
+	controller.Access.RWMutex.RLock()
+	defer controller.Access.RWMutex.RUnlock()
+
+	user := branchAwareSession.GetUser()
+	host := branchAwareSession.GetHost()
+	database := getDatabaseNameOnly(branchAwareSession.GetCurrentDatabase())
+	branch, err := branchAwareSession.GetBranch()
+	if err != nil {
+		return err
+	}
+	if _, perms := controller.Access.Match(database, branch, user, host); perms&Permissions_Admin == Permissions_Admin {
+		return nil
+	}
+	for _, tableName := range tableNames {
+		restricted, perms, predicates := controller.TableControl.Match(database, branch, user, host, tableName)
+		if !restricted {
+			continue
+		}
+		if perms&Permissions_Write != Permissions_Write {
+			return ErrTableAccess.New(user, host, tableName, branch)
+		}
+		if len(predicates) > 0 {
+			return ErrTableRestrictedAccess.New(user, host, tableName, branch)
+		}
+	}
+	return nil
+}
+
 // CanCreateBranch returns whether the given context can create a branch with the given name. In general, SQL statements
 // will almost always return a *sql.Context, so any checks from the SQL path will be able to validate a branch's name.
 // However, not all CLI commands use *sql.Context, and therefore will not have any user associated with the context. In
@@ -315,6 +414,94 @@ func CanDeleteBranch(ctx context.Context, branchName string) error {
 	return ErrCannotDeleteBranch.New(user, host, branchName)
 }
 
+// GetBranchProtection returns the protection rules that apply to the given branch of the context's current database,
+// along with whether the branch is protected at all. Contexts without a session, such as some CLI commands, never see a
+// protected branch.
+func GetBranchProtection(ctx context.Context, branchName string) (ProtectionValue, bool, error) {
+	branchAwareSession := GetBranchAwareSession(ctx)
+	// A nil session means we're not in the SQL context, so there are no rules to enforce
+	if branchAwareSession == nil {
+		return ProtectionValue{}, false, nil
+	}
+	controller := branchAwareSession.GetController()
+	// Any context that has a non-nil session should always have a non-nil controller, so this is an error
+	if controller == nil {
+		return ProtectionValue{}, false, ErrMissingController.New()
+	}
+	controller.Protection.RWMutex.RLock()
+	defer controller.Protection.RWMutex.RUnlock()
+
+	database := getDatabaseNameOnly(branchAwareSession.GetCurrentDatabase())
+	rules, ok := controller.Protection.Match(database, branchName)
+	return rules, ok, nil
+}
+
+// CanForceUpdateBranch returns whether the given context may overwrite the history of the given branch, such as by
+// force pushing, hard resetting, or force creating a branch over it.
+func CanForceUpdateBranch(ctx context.Context, branchName string) error {
+	rules, ok, err := GetBranchProtection(ctx, branchName)
+	if err != nil {
+		return err
+	}
+	if ok && rules.DenyForcePush {
+		return ErrProtectedForceUpdate.New(branchName)
+	}
+	return nil
+}
+
+// CanDeleteProtectedBranch returns whether the given context may delete or rename the given branch. Protected branches
+// may not be removed, as their rules would no longer apply to a branch created with the same name, so their protection
+// must be removed first.
+func CanDeleteProtectedBranch(ctx context.Context, branchName string) error {
+	_, ok, err := GetBranchProtection(ctx, branchName)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return ErrProtectedDelete.New(branchName)
+	}
+	return nil
+}
+
+// CanCommitToBranch returns whether the given context may commit directly to the given branch. Branches that require
+// approvals or a passing workflow only accept changes through merges, as that is where those rules are checked.
+func CanCommitToBranch(ctx context.Context, branchName string) error {
+	rules, ok, err := GetBranchProtection(ctx, branchName)
+	if err != nil {
+		return err
+	}
+	if ok && (rules.RequiredApprovals > 0 || len(rules.RequiredWorkflow) > 0) {
+		return ErrProtectedDirectCommit.New(branchName)
+	}
+	return nil
+}
+
+// CanMergeIntoBranch returns whether the given context may merge the given commit into the given branch, checking the
+// fast-forward and approval rules of the branch. Approvals given by the merging user are not counted. The workflow rule
+// depends on the CI runs of the database, and is therefore returned so that the caller may check it.
+func CanMergeIntoBranch(ctx context.Context, branchName string, commitHash string, fastForward bool) (requiredWorkflow string, err error) {
+	rules, ok, err := GetBranchProtection(ctx, branchName)
+	if err != nil || !ok {
+		return "", err
+	}
+	if rules.FastForwardOnly && !fastForward {
+		return "", ErrProtectedFastForward.New(branchName)
+	}
+	if rules.RequiredApprovals > 0 {
+		branchAwareSession := GetBranchAwareSession(ctx)
+		controller := branchAwareSession.GetController()
+		controller.Approvals.RWMutex.RLock()
+		defer controller.Approvals.RWMutex.RUnlock()
+
+		user := branchAwareSession.GetUser()
+		count := controller.Approvals.Count(strings.ToLower(rules.Database), strings.ToLower(branchName), commitHash, user)
+		if count < int(rules.RequiredApprovals) {
+			return "", ErrProtectedApprovals.New(branchName, rules.RequiredApprovals, commitHash, user, count)
+		}
+	}
+	return rules.RequiredWorkflow, nil
+}
+
 // AddAdminForContext adds an entry in the access table for the user represented by the given context. If the
 // context is missing some functionality that is needed to perform the addition, such as a user or the Controller, then
 // this simply returns.
@@ -0,0 +1,154 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package branch_control
+
+import (
+	"fmt"
+	"sync"
+
+	flatbuffers "github.com/dolthub/flatbuffers/v23/go"
+
+	"github.com/dolthub/dolt/go/gen/fb/serial"
+)
+
+// MergeApprovals contains the rows of the "dolt_merge_approvals" table, which records which users have approved
+// merging a commit into a protected branch. Unlike the other tables, the values are literals rather than expressions.
+type MergeApprovals struct {
+	access *Access
+
+	Values  []MergeApprovalValue
+	RWMutex *sync.RWMutex
+}
+
+// MergeApprovalValue contains the user-facing values of a particular row.
+type MergeApprovalValue struct {
+	Database string
+	Branch   string
+	Commit   string
+	User     string
+}
+
+// newMergeApprovals returns a new MergeApprovals.
+func newMergeApprovals(accessTbl *Access) *MergeApprovals {
+	return &MergeApprovals{
+		access:  accessTbl,
+		Values:  nil,
+		RWMutex: accessTbl.RWMutex,
+	}
+}
+
+// Count returns the number of distinct users that have approved merging the given commit into the given database and
+// branch, ignoring any approval given by |excludeUser|. Requires external synchronization handling, therefore manually
+// manage the RWMutex.
+func (tbl *MergeApprovals) Count(database string, branch string, commit string, excludeUser string) int {
+	count := 0
+	for _, value := range tbl.Values {
+		if value.Database == database && value.Branch == branch && value.Commit == commit && value.User != excludeUser {
+			count++
+		}
+	}
+	return count
+}
+
+// GetIndex returns the index of the given approval. If the approval cannot be found, returns -1.
+func (tbl *MergeApprovals) GetIndex(database string, branch string, commit string, user string) int {
+	for i, value := range tbl.Values {
+		if value.Database == database && value.Branch == branch && value.Commit == commit && value.User == user {
+			return i
+		}
+	}
+	return -1
+}
+
+// Insert adds the given value to the table. Assumes that an identical entry does not already exist. Requires external
+// synchronization handling, therefore manually manage the RWMutex.
+func (tbl *MergeApprovals) Insert(value MergeApprovalValue) {
+	tbl.Values = append(tbl.Values, value)
+}
+
+// Delete removes the entry at the given index. Requires external synchronization handling, therefore manually manage
+// the RWMutex.
+func (tbl *MergeApprovals) Delete(tblIndex int) {
+	endIndex := len(tbl.Values) - 1
+	tbl.Values[tblIndex] = tbl.Values[endIndex]
+	tbl.Values = tbl.Values[:endIndex]
+}
+
+// Access returns the Access table.
+func (tbl *MergeApprovals) Access() *Access {
+	return tbl.access
+}
+
+// Serialize returns the offset for the MergeApprovals table written to the given builder.
+func (tbl *MergeApprovals) Serialize(b *flatbuffers.Builder) flatbuffers.UOffsetT {
+	valueOffsets := make([]flatbuffers.UOffsetT, len(tbl.Values))
+	for i, val := range tbl.Values {
+		valueOffsets[i] = val.Serialize(b)
+	}
+	serial.BranchControlMergeApprovalsStartValuesVector(b, len(valueOffsets))
+	for i := len(valueOffsets) - 1; i >= 0; i-- {
+		b.PrependUOffsetT(valueOffsets[i])
+	}
+	values := b.EndVector(len(valueOffsets))
+
+	serial.BranchControlMergeApprovalsStart(b)
+	serial.BranchControlMergeApprovalsAddValues(b, values)
+	return serial.BranchControlMergeApprovalsEnd(b)
+}
+
+func (tbl *MergeApprovals) reinit() {
+	tbl.Values = nil
+}
+
+// Deserialize populates the table with the data from the flatbuffers representation. A nil representation, which is
+// the case for files written before the table existed, results in an empty table.
+func (tbl *MergeApprovals) Deserialize(fb *serial.BranchControlMergeApprovals) error {
+	tbl.reinit()
+	if fb == nil {
+		return nil
+	}
+	for i := 0; i < fb.ValuesLength(); i++ {
+		serialValue := &serial.BranchControlMergeApproval{}
+		if _, err := fb.TryValues(serialValue, i); err != nil {
+			return err
+		}
+		value := MergeApprovalValue{
+			Database: string(serialValue.Database()),
+			Branch:   string(serialValue.Branch()),
+			Commit:   string(serialValue.CommitHash()),
+			User:     string(serialValue.User()),
+		}
+		if tbl.GetIndex(value.Database, value.Branch, value.Commit, value.User) != -1 {
+			return fmt.Errorf("cannot deserialize a merge approvals table with duplicate entries")
+		}
+		tbl.Insert(value)
+	}
+	return nil
+}
+
+// Serialize returns the offset for the MergeApprovalValue written to the given builder.
+func (val *MergeApprovalValue) Serialize(b *flatbuffers.Builder) flatbuffers.UOffsetT {
+	database := b.CreateSharedString(val.Database)
+	branch := b.CreateSharedString(val.Branch)
+	commit := b.CreateSharedString(val.Commit)
+	user := b.CreateSharedString(val.User)
+
+	serial.BranchControlMergeApprovalStart(b)
+	serial.BranchControlMergeApprovalAddDatabase(b, database)
+	serial.BranchControlMergeApprovalAddBranch(b, branch)
+	serial.BranchControlMergeApprovalAddCommitHash(b, commit)
+	serial.BranchControlMergeApprovalAddUser(b, user)
+	return serial.BranchControlMergeApprovalEnd(b)
+}
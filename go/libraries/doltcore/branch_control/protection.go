@@ -0,0 +1,225 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package branch_control
+
+import (
+	"fmt"
+	"sync"
+
+	flatbuffers "github.com/dolthub/flatbuffers/v23/go"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/gen/fb/serial"
+)
+
+// Protection contains all of the expressions that comprise the "dolt_branch_protection" table, which declares the
+// rules that apply to protected branches. Modification of this table is handled by the Access table.
+type Protection struct {
+	access *Access
+
+	Databases []MatchExpression
+	Branches  []MatchExpression
+	Values    []ProtectionValue
+	RWMutex   *sync.RWMutex
+}
+
+// ProtectionValue contains the user-facing values of a particular row.
+type ProtectionValue struct {
+	Database          string
+	Branch            string
+	FastForwardOnly   bool
+	DenyForcePush     bool
+	RequiredApprovals uint32
+	RequiredWorkflow  string
+}
+
+// newProtection returns a new Protection.
+func newProtection(accessTbl *Access) *Protection {
+	return &Protection{
+		access:    accessTbl,
+		Databases: nil,
+		Branches:  nil,
+		Values:    nil,
+		RWMutex:   accessTbl.RWMutex,
+	}
+}
+
+// Match returns the rules that apply to the given database and branch, along with whether the branch is protected at
+// all. When multiple entries match, the longest branch match is used, with ties combined such that the strictest rules
+// apply. Requires external synchronization handling, therefore manually manage the RWMutex.
+func (tbl *Protection) Match(database string, branch string) (ProtectionValue, bool) {
+	filteredIndexes := Match(tbl.Databases, database, sql.Collation_utf8mb4_0900_ai_ci)
+	if len(filteredIndexes) == 0 {
+		indexPool.Put(filteredIndexes)
+		return ProtectionValue{}, false
+	}
+
+	filteredBranches := tbl.filterBranches(filteredIndexes)
+	indexPool.Put(filteredIndexes)
+	matchedSet := Match(filteredBranches, branch, sql.Collation_utf8mb4_0900_ai_ci)
+	matchExprPool.Put(filteredBranches)
+	defer indexPool.Put(matchedSet)
+	if len(matchedSet) == 0 {
+		return ProtectionValue{}, false
+	}
+
+	// We take either the longest match, or the set of longest matches if multiple matches have the same length
+	longest := -1
+	rules := ProtectionValue{Database: database, Branch: branch}
+	for _, matched := range matchedSet {
+		matchedValue := tbl.Values[matched]
+		if len(matchedValue.Branch) > longest {
+			longest = len(matchedValue.Branch)
+			rules = ProtectionValue{Database: database, Branch: branch}
+		}
+		if len(matchedValue.Branch) < longest {
+			continue
+		}
+		rules.FastForwardOnly = rules.FastForwardOnly || matchedValue.FastForwardOnly
+		rules.DenyForcePush = rules.DenyForcePush || matchedValue.DenyForcePush
+		if matchedValue.RequiredApprovals > rules.RequiredApprovals {
+			rules.RequiredApprovals = matchedValue.RequiredApprovals
+		}
+		if len(matchedValue.RequiredWorkflow) > 0 {
+			rules.RequiredWorkflow = matchedValue.RequiredWorkflow
+		}
+	}
+	return rules, true
+}
+
+// GetIndex returns the index of the given database and branch expressions. If the expressions cannot be found, returns
+// -1. Assumes that the given expressions have already been folded.
+func (tbl *Protection) GetIndex(databaseExpr string, branchExpr string) int {
+	for i, value := range tbl.Values {
+		if value.Database == databaseExpr && value.Branch == branchExpr {
+			return i
+		}
+	}
+	return -1
+}
+
+// Insert adds the given value to the table. Assumes that the expressions have already been folded, and that an entry
+// with the same expressions does not already exist. Requires external synchronization handling, therefore manually
+// manage the RWMutex.
+func (tbl *Protection) Insert(value ProtectionValue) {
+	nextIdx := uint32(len(tbl.Values))
+	tbl.Databases = append(tbl.Databases, MatchExpression{CollectionIndex: nextIdx, SortOrders: ParseExpression(value.Database, sql.Collation_utf8mb4_0900_ai_ci)})
+	tbl.Branches = append(tbl.Branches, MatchExpression{CollectionIndex: nextIdx, SortOrders: ParseExpression(value.Branch, sql.Collation_utf8mb4_0900_ai_ci)})
+	tbl.Values = append(tbl.Values, value)
+}
+
+// Delete removes the entry at the given index. Requires external synchronization handling, therefore manually manage
+// the RWMutex.
+func (tbl *Protection) Delete(tblIndex int) {
+	endIndex := len(tbl.Values) - 1
+	// Remove the matching row from all slices by first swapping with the last element
+	tbl.Databases[tblIndex], tbl.Databases[endIndex] = tbl.Databases[endIndex], tbl.Databases[tblIndex]
+	tbl.Branches[tblIndex], tbl.Branches[endIndex] = tbl.Branches[endIndex], tbl.Branches[tblIndex]
+	tbl.Values[tblIndex], tbl.Values[endIndex] = tbl.Values[endIndex], tbl.Values[tblIndex]
+	// Then we remove the last element
+	tbl.Databases = tbl.Databases[:endIndex]
+	tbl.Branches = tbl.Branches[:endIndex]
+	tbl.Values = tbl.Values[:endIndex]
+	// Then we update the index for the match expressions
+	if tblIndex != endIndex {
+		tbl.Databases[tblIndex].CollectionIndex = uint32(tblIndex)
+		tbl.Branches[tblIndex].CollectionIndex = uint32(tblIndex)
+	}
+}
+
+// Access returns the Access table.
+func (tbl *Protection) Access() *Access {
+	return tbl.access
+}
+
+// Serialize returns the offset for the Protection table written to the given builder. Only the values are written, as
+// the match expressions are rebuilt from them when deserializing.
+func (tbl *Protection) Serialize(b *flatbuffers.Builder) flatbuffers.UOffsetT {
+	valueOffsets := make([]flatbuffers.UOffsetT, len(tbl.Values))
+	for i, val := range tbl.Values {
+		valueOffsets[i] = val.Serialize(b)
+	}
+	serial.BranchControlProtectionStartValuesVector(b, len(valueOffsets))
+	for i := len(valueOffsets) - 1; i >= 0; i-- {
+		b.PrependUOffsetT(valueOffsets[i])
+	}
+	values := b.EndVector(len(valueOffsets))
+
+	serial.BranchControlProtectionStart(b)
+	serial.BranchControlProtectionAddValues(b, values)
+	return serial.BranchControlProtectionEnd(b)
+}
+
+func (tbl *Protection) reinit() {
+	tbl.Databases = nil
+	tbl.Branches = nil
+	tbl.Values = nil
+}
+
+// Deserialize populates the table with the data from the flatbuffers representation. A nil representation, which is
+// the case for files written before the table existed, results in an empty table.
+func (tbl *Protection) Deserialize(fb *serial.BranchControlProtection) error {
+	tbl.reinit()
+	if fb == nil {
+		return nil
+	}
+	for i := 0; i < fb.ValuesLength(); i++ {
+		serialValue := &serial.BranchControlProtectionValue{}
+		if _, err := fb.TryValues(serialValue, i); err != nil {
+			return err
+		}
+		value := ProtectionValue{
+			Database:          string(serialValue.Database()),
+			Branch:            string(serialValue.Branch()),
+			FastForwardOnly:   serialValue.FastForwardOnly(),
+			DenyForcePush:     serialValue.DenyForcePush(),
+			RequiredApprovals: serialValue.RequiredApprovals(),
+			RequiredWorkflow:  string(serialValue.RequiredWorkflow()),
+		}
+		if tbl.GetIndex(value.Database, value.Branch) != -1 {
+			return fmt.Errorf("cannot deserialize a branch protection table with duplicate entries")
+		}
+		tbl.Insert(value)
+	}
+	return nil
+}
+
+// filterBranches returns all branches that match the given collection indexes.
+func (tbl *Protection) filterBranches(filters []uint32) []MatchExpression {
+	if len(filters) == 0 {
+		return nil
+	}
+	matchExprs := matchExprPool.Get().([]MatchExpression)[:0]
+	for _, filter := range filters {
+		matchExprs = append(matchExprs, tbl.Branches[filter])
+	}
+	return matchExprs
+}
+
+// Serialize returns the offset for the ProtectionValue written to the given builder.
+func (val *ProtectionValue) Serialize(b *flatbuffers.Builder) flatbuffers.UOffsetT {
+	database := b.CreateSharedString(val.Database)
+	branch := b.CreateSharedString(val.Branch)
+	workflow := b.CreateSharedString(val.RequiredWorkflow)
+
+	serial.BranchControlProtectionValueStart(b)
+	serial.BranchControlProtectionValueAddDatabase(b, database)
+	serial.BranchControlProtectionValueAddBranch(b, branch)
+	serial.BranchControlProtectionValueAddFastForwardOnly(b, val.FastForwardOnly)
+	serial.BranchControlProtectionValueAddDenyForcePush(b, val.DenyForcePush)
+	serial.BranchControlProtectionValueAddRequiredApprovals(b, val.RequiredApprovals)
+	serial.BranchControlProtectionValueAddRequiredWorkflow(b, workflow)
+	return serial.BranchControlProtectionValueEnd(b)
+}
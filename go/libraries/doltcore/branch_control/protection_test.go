@@ -0,0 +1,95 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package branch_control
+
+import (
+	"testing"
+
+	fb "github.com/dolthub/flatbuffers/v23/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/gen/fb/serial"
+)
+
+func TestProtectionMatch(t *testing.T) {
+	tbl := newProtection(newAccess())
+	tbl.Insert(ProtectionValue{Database: "%", Branch: "release%", DenyForcePush: true})
+	tbl.Insert(ProtectionValue{Database: "%", Branch: "main", FastForwardOnly: true, RequiredApprovals: 1})
+	tbl.Insert(ProtectionValue{Database: "mydb", Branch: "main", RequiredApprovals: 2, RequiredWorkflow: "tests"})
+
+	_, ok := tbl.Match("mydb", "feature")
+	assert.False(t, ok)
+
+	rules, ok := tbl.Match("mydb", "release-1")
+	assert.True(t, ok)
+	assert.True(t, rules.DenyForcePush)
+	assert.False(t, rules.FastForwardOnly)
+
+	// Entries with the same branch length are combined, with the strictest rules taking precedence
+	rules, ok = tbl.Match("mydb", "main")
+	assert.True(t, ok)
+	assert.True(t, rules.FastForwardOnly)
+	assert.False(t, rules.DenyForcePush)
+	assert.Equal(t, uint32(2), rules.RequiredApprovals)
+	assert.Equal(t, "tests", rules.RequiredWorkflow)
+
+	rules, ok = tbl.Match("otherdb", "main")
+	assert.True(t, ok)
+	assert.Equal(t, uint32(1), rules.RequiredApprovals)
+	assert.Empty(t, rules.RequiredWorkflow)
+
+	tbl.Delete(tbl.GetIndex("%", "main"))
+	_, ok = tbl.Match("otherdb", "main")
+	assert.False(t, ok)
+	rules, ok = tbl.Match("mydb", "main")
+	assert.True(t, ok)
+	assert.False(t, rules.FastForwardOnly)
+}
+
+func TestProtectionSerialization(t *testing.T) {
+	protection := newProtection(newAccess())
+	protection.Insert(ProtectionValue{Database: "%", Branch: "main", FastForwardOnly: true, DenyForcePush: true, RequiredApprovals: 2, RequiredWorkflow: "tests"})
+	approvals := newMergeApprovals(newAccess())
+	approvals.Insert(MergeApprovalValue{Database: "db", Branch: "main", Commit: "abc", User: "a"})
+	approvals.Insert(MergeApprovalValue{Database: "db", Branch: "main", Commit: "abc", User: "b"})
+
+	b := fb.NewBuilder(0)
+	b.Finish(protection.Serialize(b))
+	serialProtection, err := serial.TryGetRootAsBranchControlProtection(b.FinishedBytes(), 0)
+	require.NoError(t, err)
+	loadedProtection := newProtection(newAccess())
+	require.NoError(t, loadedProtection.Deserialize(serialProtection))
+	assert.Equal(t, protection.Values, loadedProtection.Values)
+	_, ok := loadedProtection.Match("db", "main")
+	assert.True(t, ok)
+
+	b = fb.NewBuilder(0)
+	b.Finish(approvals.Serialize(b))
+	serialApprovals, err := serial.TryGetRootAsBranchControlMergeApprovals(b.FinishedBytes(), 0)
+	require.NoError(t, err)
+	loadedApprovals := newMergeApprovals(newAccess())
+	require.NoError(t, loadedApprovals.Deserialize(serialApprovals))
+	assert.Equal(t, approvals.Values, loadedApprovals.Values)
+	assert.Equal(t, 2, loadedApprovals.Count("db", "main", "abc", ""))
+	assert.Equal(t, 1, loadedApprovals.Count("db", "main", "abc", "a"))
+	assert.Equal(t, 0, loadedApprovals.Count("db", "main", "def", ""))
+
+	// Files written before the tables existed do not contain them
+	require.NoError(t, loadedProtection.Deserialize(nil))
+	assert.Empty(t, loadedProtection.Values)
+	require.NoError(t, loadedApprovals.Deserialize(nil))
+	assert.Empty(t, loadedApprovals.Values)
+}
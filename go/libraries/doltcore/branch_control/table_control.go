@@ -0,0 +1,283 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package branch_control
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	flatbuffers "github.com/dolthub/flatbuffers/v23/go"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/gen/fb/serial"
+)
+
+// TableControl contains all of the expressions that comprise the "dolt_table_control" table, which controls which
+// tables (and optionally which rows of those tables) users may modify on a branch. Modification of this table is
+// handled by the Access table.
+//
+// A user that does not match any entry is unrestricted. Once a user matches an entry for a database and branch, they
+// may only modify the tables matched by their entries, and only the rows that satisfy the predicates of those entries.
+type TableControl struct {
+	access *Access
+
+	Databases []MatchExpression
+	Branches  []MatchExpression
+	Users     []MatchExpression
+	Hosts     []MatchExpression
+	Tables    []MatchExpression
+	Values    []TableControlValue
+	RWMutex   *sync.RWMutex
+}
+
+// TableControlValue contains the user-facing values of a particular row.
+type TableControlValue struct {
+	Database    string
+	Branch      string
+	User        string
+	Host        string
+	Table       string
+	Predicate   string
+	Permissions Permissions
+}
+
+// newTableControl returns a new TableControl.
+func newTableControl(accessTbl *Access) *TableControl {
+	return &TableControl{
+		access:    accessTbl,
+		Databases: nil,
+		Branches:  nil,
+		Users:     nil,
+		Hosts:     nil,
+		Tables:    nil,
+		Values:    nil,
+		RWMutex:   accessTbl.RWMutex,
+	}
+}
+
+// Match returns whether the given user and host are restricted by any entries for the given database and branch. When
+// restricted, this also returns the consolidated permissions of the longest matching table expressions, along with the
+// predicates that every written row must satisfy (any one of them). A nil set of predicates means that all rows may be
+// written. Requires external synchronization handling, therefore manually manage the RWMutex.
+func (tbl *TableControl) Match(database string, branch string, user string, host string, table string) (restricted bool, perms Permissions, predicates []string) {
+	filteredIndexes := Match(tbl.Databases, database, sql.Collation_utf8mb4_0900_ai_ci)
+	for _, stage := range []struct {
+		exprs     []MatchExpression
+		str       string
+		collation sql.CollationID
+	}{
+		{tbl.Branches, branch, sql.Collation_utf8mb4_0900_ai_ci},
+		{tbl.Users, user, sql.Collation_utf8mb4_0900_bin},
+		{tbl.Hosts, host, sql.Collation_utf8mb4_0900_ai_ci},
+	} {
+		if len(filteredIndexes) == 0 {
+			break
+		}
+		filteredExprs := tbl.filter(stage.exprs, filteredIndexes)
+		indexPool.Put(filteredIndexes)
+		filteredIndexes = Match(filteredExprs, stage.str, stage.collation)
+		matchExprPool.Put(filteredExprs)
+	}
+	// If there are no entries for this user on this branch, then they are unrestricted
+	if len(filteredIndexes) == 0 {
+		indexPool.Put(filteredIndexes)
+		return false, Permissions_None, nil
+	}
+
+	filteredTables := tbl.filter(tbl.Tables, filteredIndexes)
+	indexPool.Put(filteredIndexes)
+	matchedSet := Match(filteredTables, table, sql.Collation_utf8mb4_0900_ai_ci)
+	matchExprPool.Put(filteredTables)
+	defer indexPool.Put(matchedSet)
+
+	// We take either the longest match, or the set of longest matches if multiple matches have the same length
+	longest := -1
+	allRows := false
+	for _, matched := range matchedSet {
+		matchedValue := tbl.Values[matched]
+		if len(matchedValue.Table) > longest {
+			longest = len(matchedValue.Table)
+			perms = Permissions_None
+			predicates = nil
+			allRows = false
+		}
+		if len(matchedValue.Table) < longest {
+			continue
+		}
+		perms |= matchedValue.Permissions
+		if matchedValue.Permissions&Permissions_Write == Permissions_Write {
+			if len(matchedValue.Predicate) == 0 {
+				allRows = true
+			} else {
+				predicates = append(predicates, matchedValue.Predicate)
+			}
+		}
+	}
+	if allRows {
+		predicates = nil
+	}
+	return true, perms, predicates
+}
+
+// GetIndex returns the index of the given database, branch, user, host, and table expressions. If the expressions
+// cannot be found, returns -1. Assumes that the given expressions have already been folded.
+func (tbl *TableControl) GetIndex(databaseExpr string, branchExpr string, userExpr string, hostExpr string, tableExpr string) int {
+	for i, value := range tbl.Values {
+		if value.Database == databaseExpr && value.Branch == branchExpr && value.User == userExpr && value.Host == hostExpr && value.Table == tableExpr {
+			return i
+		}
+	}
+	return -1
+}
+
+// Insert adds the given value to the table. Assumes that the expressions have already been folded, and that an entry
+// with the same expressions does not already exist. Requires external synchronization handling, therefore manually
+// manage the RWMutex.
+func (tbl *TableControl) Insert(value TableControlValue) {
+	nextIdx := uint32(len(tbl.Values))
+	tbl.Databases = append(tbl.Databases, MatchExpression{CollectionIndex: nextIdx, SortOrders: ParseExpression(value.Database, sql.Collation_utf8mb4_0900_ai_ci)})
+	tbl.Branches = append(tbl.Branches, MatchExpression{CollectionIndex: nextIdx, SortOrders: ParseExpression(value.Branch, sql.Collation_utf8mb4_0900_ai_ci)})
+	tbl.Users = append(tbl.Users, MatchExpression{CollectionIndex: nextIdx, SortOrders: ParseExpression(value.User, sql.Collation_utf8mb4_0900_bin)})
+	tbl.Hosts = append(tbl.Hosts, MatchExpression{CollectionIndex: nextIdx, SortOrders: ParseExpression(value.Host, sql.Collation_utf8mb4_0900_ai_ci)})
+	tbl.Tables = append(tbl.Tables, MatchExpression{CollectionIndex: nextIdx, SortOrders: ParseExpression(value.Table, sql.Collation_utf8mb4_0900_ai_ci)})
+	tbl.Values = append(tbl.Values, value)
+}
+
+// Delete removes the entry at the given index. Requires external synchronization handling, therefore manually manage
+// the RWMutex.
+func (tbl *TableControl) Delete(tblIndex int) {
+	endIndex := len(tbl.Values) - 1
+	// Remove the matching row from all slices by first swapping with the last element
+	tbl.Databases[tblIndex], tbl.Databases[endIndex] = tbl.Databases[endIndex], tbl.Databases[tblIndex]
+	tbl.Branches[tblIndex], tbl.Branches[endIndex] = tbl.Branches[endIndex], tbl.Branches[tblIndex]
+	tbl.Users[tblIndex], tbl.Users[endIndex] = tbl.Users[endIndex], tbl.Users[tblIndex]
+	tbl.Hosts[tblIndex], tbl.Hosts[endIndex] = tbl.Hosts[endIndex], tbl.Hosts[tblIndex]
+	tbl.Tables[tblIndex], tbl.Tables[endIndex] = tbl.Tables[endIndex], tbl.Tables[tblIndex]
+	tbl.Values[tblIndex], tbl.Values[endIndex] = tbl.Values[endIndex], tbl.Values[tblIndex]
+	// Then we remove the last element
+	tbl.Databases = tbl.Databases[:endIndex]
+	tbl.Branches = tbl.Branches[:endIndex]
+	tbl.Users = tbl.Users[:endIndex]
+	tbl.Hosts = tbl.Hosts[:endIndex]
+	tbl.Tables = tbl.Tables[:endIndex]
+	tbl.Values = tbl.Values[:endIndex]
+	// Then we update the index for the match expressions
+	if tblIndex != endIndex {
+		tbl.Databases[tblIndex].CollectionIndex = uint32(tblIndex)
+		tbl.Branches[tblIndex].CollectionIndex = uint32(tblIndex)
+		tbl.Users[tblIndex].CollectionIndex = uint32(tblIndex)
+		tbl.Hosts[tblIndex].CollectionIndex = uint32(tblIndex)
+		tbl.Tables[tblIndex].CollectionIndex = uint32(tblIndex)
+	}
+}
+
+// Access returns the Access table.
+func (tbl *TableControl) Access() *Access {
+	return tbl.access
+}
+
+// Serialize returns the offset for the TableControl table written to the given builder. Only the values are written,
+// as the match expressions are rebuilt from them when deserializing.
+func (tbl *TableControl) Serialize(b *flatbuffers.Builder) flatbuffers.UOffsetT {
+	valueOffsets := make([]flatbuffers.UOffsetT, len(tbl.Values))
+	for i, val := range tbl.Values {
+		valueOffsets[i] = val.Serialize(b)
+	}
+	serial.BranchControlTableControlStartValuesVector(b, len(valueOffsets))
+	for i := len(valueOffsets) - 1; i >= 0; i-- {
+		b.PrependUOffsetT(valueOffsets[i])
+	}
+	values := b.EndVector(len(valueOffsets))
+
+	serial.BranchControlTableControlStart(b)
+	serial.BranchControlTableControlAddValues(b, values)
+	return serial.BranchControlTableControlEnd(b)
+}
+
+func (tbl *TableControl) reinit() {
+	tbl.Databases = nil
+	tbl.Branches = nil
+	tbl.Users = nil
+	tbl.Hosts = nil
+	tbl.Tables = nil
+	tbl.Values = nil
+}
+
+// Deserialize populates the table with the data from the flatbuffers representation. A nil representation, which is
+// the case for files written before the table existed, results in an empty table.
+func (tbl *TableControl) Deserialize(fb *serial.BranchControlTableControl) error {
+	tbl.reinit()
+	if fb == nil {
+		return nil
+	}
+	for i := 0; i < fb.ValuesLength(); i++ {
+		serialValue := &serial.BranchControlTableControlValue{}
+		if _, err := fb.TryValues(serialValue, i); err != nil {
+			return err
+		}
+		value := TableControlValue{
+			Database:    string(serialValue.Database()),
+			Branch:      string(serialValue.Branch()),
+			User:        string(serialValue.User()),
+			Host:        string(serialValue.Host()),
+			Table:       string(serialValue.TableName()),
+			Predicate:   string(serialValue.Predicate()),
+			Permissions: Permissions(serialValue.Permissions()),
+		}
+		if tbl.GetIndex(value.Database, value.Branch, value.User, value.Host, value.Table) != -1 {
+			return fmt.Errorf("cannot deserialize a table control table with duplicate entries")
+		}
+		tbl.Insert(value)
+	}
+	return nil
+}
+
+// filter returns all match expressions from the given collection that match the given collection indexes.
+func (tbl *TableControl) filter(collection []MatchExpression, filters []uint32) []MatchExpression {
+	if len(filters) == 0 {
+		return nil
+	}
+	matchExprs := matchExprPool.Get().([]MatchExpression)[:0]
+	for _, filter := range filters {
+		matchExprs = append(matchExprs, collection[filter])
+	}
+	return matchExprs
+}
+
+// Serialize returns the offset for the TableControlValue written to the given builder.
+func (val *TableControlValue) Serialize(b *flatbuffers.Builder) flatbuffers.UOffsetT {
+	database := b.CreateSharedString(val.Database)
+	branch := b.CreateSharedString(val.Branch)
+	user := b.CreateSharedString(val.User)
+	host := b.CreateSharedString(val.Host)
+	table := b.CreateSharedString(val.Table)
+	predicate := b.CreateString(val.Predicate)
+
+	serial.BranchControlTableControlValueStart(b)
+	serial.BranchControlTableControlValueAddDatabase(b, database)
+	serial.BranchControlTableControlValueAddBranch(b, branch)
+	serial.BranchControlTableControlValueAddUser(b, user)
+	serial.BranchControlTableControlValueAddHost(b, host)
+	serial.BranchControlTableControlValueAddTableName(b, table)
+	serial.BranchControlTableControlValueAddPredicate(b, predicate)
+	serial.BranchControlTableControlValueAddPermissions(b, uint64(val.Permissions))
+	return serial.BranchControlTableControlValueEnd(b)
+}
+
+// FoldTableExpression folds the given table expression. Table names are case-insensitive.
+func FoldTableExpression(table string) string {
+	return strings.ToLower(FoldExpression(table))
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package branch_control
+
+import (
+	"testing"
+
+	fb "github.com/dolthub/flatbuffers/v23/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/gen/fb/serial"
+)
+
+func TestTableControlMatch(t *testing.T) {
+	tbl := newTableControl(newAccess())
+	tbl.Insert(TableControlValue{Database: "%", Branch: "main", User: "a", Host: "%", Table: "%", Permissions: Permissions_Write})
+	tbl.Insert(TableControlValue{Database: "%", Branch: "main", User: "a", Host: "%", Table: "owned%", Predicate: "owner = 'a'", Permissions: Permissions_Write})
+	tbl.Insert(TableControlValue{Database: "%", Branch: "main", User: "a", Host: "%", Table: "owned%", Predicate: "owner = 'b'", Permissions: Permissions_Write})
+	tbl.Insert(TableControlValue{Database: "%", Branch: "main", User: "a", Host: "%", Table: "secret", Permissions: Permissions_Read})
+
+	restricted, _, _ := tbl.Match("mydb", "other", "a", "localhost", "owned")
+	assert.False(t, restricted)
+	restricted, _, _ = tbl.Match("mydb", "main", "b", "localhost", "owned")
+	assert.False(t, restricted)
+
+	restricted, perms, predicates := tbl.Match("mydb", "main", "a", "localhost", "any")
+	assert.True(t, restricted)
+	assert.Equal(t, Permissions_Write, perms)
+	assert.Nil(t, predicates)
+
+	restricted, perms, predicates = tbl.Match("mydb", "main", "a", "localhost", "owned_rows")
+	assert.True(t, restricted)
+	assert.Equal(t, Permissions_Write, perms)
+	assert.ElementsMatch(t, []string{"owner = 'a'", "owner = 'b'"}, predicates)
+
+	restricted, perms, _ = tbl.Match("mydb", "main", "a", "localhost", "secret")
+	assert.True(t, restricted)
+	assert.Equal(t, Permissions_Read, perms)
+
+	tbl.Delete(0)
+	restricted, perms, _ = tbl.Match("mydb", "main", "a", "localhost", "any")
+	assert.True(t, restricted)
+	assert.Equal(t, Permissions_None, perms)
+}
+
+func TestTableControlSerialization(t *testing.T) {
+	tbl := newTableControl(newAccess())
+	tbl.Insert(TableControlValue{Database: "%", Branch: "main", User: "a", Host: "%", Table: "owned", Predicate: "owner = 'a'", Permissions: Permissions_Write})
+	tbl.Insert(TableControlValue{Database: "db", Branch: "%", User: "b", Host: "localhost", Table: "%", Permissions: Permissions_Read})
+
+	b := fb.NewBuilder(0)
+	b.Finish(tbl.Serialize(b))
+	serialTbl, err := serial.TryGetRootAsBranchControlTableControl(b.FinishedBytes(), 0)
+	require.NoError(t, err)
+
+	loaded := newTableControl(newAccess())
+	require.NoError(t, loaded.Deserialize(serialTbl))
+	assert.Equal(t, tbl.Values, loaded.Values)
+	restricted, perms, predicates := loaded.Match("db", "main", "a", "localhost", "owned")
+	assert.True(t, restricted)
+	assert.Equal(t, Permissions_Write, perms)
+	assert.Equal(t, []string{"owner = 'a'"}, predicates)
+
+	// Files written before the table existed do not contain it
+	require.NoError(t, loaded.Deserialize(nil))
+	assert.Empty(t, loaded.Values)
+}
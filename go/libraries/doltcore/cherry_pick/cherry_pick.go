@@ -30,6 +30,11 @@ import (
 // ErrCherryPickUncommittedChanges is returned when a cherry-pick is attempted without a clean working set.
 var ErrCherryPickUncommittedChanges = errors.New("cannot cherry-pick with uncommitted changes")
 
+// ErrCherryPickCommitBecameEmpty is returned when a cherry-picked commit becomes empty after its changes are applied
+// and StopOnEmptyCommit handling was specified for commits that become empty. No commit is created, so the caller can
+// decide how to handle the commit.
+var ErrCherryPickCommitBecameEmpty = errors.New("cherry-picked commit became empty")
+
 // CherryPickOptions specifies optional parameters specifying how a cherry-pick is performed.
 type CherryPickOptions struct {
 	// Amend controls whether the commit at HEAD is amended and combined with the commit to be cherry-picked.
@@ -42,8 +47,8 @@ type CherryPickOptions struct {
 	// the changes, should be handled. For example, if cherry-picking a change from another branch, but the changes
 	// have already been applied on the target branch in another commit, the new commit will be empty. Note that this
 	// is distinct from how to handle commits that start off empty. By default, in Git, the cherry-pick command will
-	// stop when processing a commit that becomes empty and allow the user to take additional action. Dolt doesn't
-	// support this flow, so instead, Dolt's default is to fail the cherry-pick operation. In Git rebase, and in Dolt
+	// stop when processing a commit that becomes empty and allow the user to take additional action. Dolt's
+	// cherry-pick doesn't support this flow, so instead, Dolt's default is to fail the cherry-pick operation. In Git rebase, and in Dolt
 	// rebase, the default for handling commits that become empty while being processed is to drop them.
 	CommitBecomesEmptyHandling doltdb.EmptyCommitHandling
 
@@ -130,7 +135,9 @@ func CherryPick(ctx *sql.Context, commit string, options CherryPickOptions) (str
 		return "", nil, err
 	}
 	if pendingCommit == nil {
-		if commitProps.SkipEmpty {
+		if options.CommitBecomesEmptyHandling == doltdb.StopOnEmptyCommit {
+			return "", nil, ErrCherryPickCommitBecameEmpty
+		} else if commitProps.SkipEmpty {
 			return "", nil, nil
 		} else if !commitProps.AllowEmpty {
 			return "", nil, errors.New("nothing to commit")
@@ -169,12 +176,13 @@ func CreateCommitStagedPropsFromCherryPickOptions(ctx *sql.Context, options Cher
 		commitProps.AllowEmpty = true
 	}
 
-	if options.CommitBecomesEmptyHandling == doltdb.DropEmptyCommit {
+	// Commits that become empty are skipped when stopping on them, too, so that no commit is created. CherryPick
+	// then reports that the commit became empty, so the caller can stop.
+	if options.CommitBecomesEmptyHandling == doltdb.DropEmptyCommit ||
+		options.CommitBecomesEmptyHandling == doltdb.StopOnEmptyCommit {
 		commitProps.SkipEmpty = true
 	} else if options.CommitBecomesEmptyHandling == doltdb.KeepEmptyCommit {
 		commitProps.AllowEmpty = true
-	} else if options.CommitBecomesEmptyHandling == doltdb.StopOnEmptyCommit {
-		return nil, fmt.Errorf("stop on empty commit is not currently supported")
 	}
 
 	return &commitProps, nil
@@ -338,6 +346,10 @@ func cherryPick(ctx *sql.Context, dSess *dsess.DoltSession, roots doltdb.Roots,
 		return nil, "", err
 	}
 
+	if err = dsess.CheckUnrestrictedTableChanges(ctx, roots.Working, result.Root); err != nil {
+		return nil, "", err
+	}
+
 	// If the cherry-pick modifies a deleted table, we don't have a good way to surface that. Abort.
 	for _, schConflict := range result.SchemaConflicts {
 		if schConflict.ModifyDeleteConflict {
@@ -29,6 +29,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 
 	"github.com/dolthub/dolt/go/libraries/utils/awsrefreshcreds"
+	"github.com/dolthub/dolt/go/store/blobstore"
 	"github.com/dolthub/dolt/go/store/chunks"
 	"github.com/dolthub/dolt/go/store/datas"
 	"github.com/dolthub/dolt/go/store/nbs"
@@ -157,9 +158,42 @@ func (fact AWSFactory) newChunkStore(ctx context.Context, nbf *types.NomsBinForm
 	}
 
 	q := nbs.NewUnlimitedMemQuotaProvider()
+	if _, ok := EncryptionKeyFile(urlObj); ok {
+		// The table files of encrypted databases are encrypted before they are uploaded to the bucket. Their manifest
+		// is kept in the DynamoDB table, as it only holds the addresses of the root and the table files.
+		bs, err := encryptBlobstore(ctx, urlObj, blobstore.NewS3Blobstore(s3.NewFromConfig(cfg), parts[1], dbName))
+		if err != nil {
+			return nil, err
+		}
+		return nbs.NewDynamoBSStore(ctx, nbf.VersionString(), parts[0], dbName, dynamodb.NewFromConfig(cfg), bs, defaultMemTableSize, q)
+	}
+
 	return nbs.NewAWSStore(ctx, nbf.VersionString(), parts[0], dbName, parts[1], s3.NewFromConfig(cfg), dynamodb.NewFromConfig(cfg), defaultMemTableSize, q)
 }
 
+// Blobstore returns the blobstore for the S3 bucket the table files of the database are stored in. The manifest of the
+// database is stored in the DynamoDB table, and is not part of the blobstore.
+func (fact AWSFactory) Blobstore(ctx context.Context, urlObj *url.URL, params map[string]interface{}) (blobstore.Blobstore, error) {
+	parts := strings.SplitN(urlObj.Hostname(), ":", 2) // [table]:[bucket]
+	if len(parts) != 2 {
+		return nil, errors.New("aws url has an invalid format")
+	}
+
+	cfg, err := awsConfigFromParams(ctx, params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	dbName, err := validatePath(urlObj.Path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return blobstore.NewS3Blobstore(s3.NewFromConfig(cfg), parts[1], dbName), nil
+}
+
 func validatePath(path string) (string, error) {
 	for len(path) > 0 && path[0] == '/' {
 		path = path[1:]
@@ -0,0 +1,117 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbfactory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/dconfig"
+	"github.com/dolthub/dolt/go/store/blobstore"
+	"github.com/dolthub/dolt/go/store/chunks"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/dolt/go/store/nbs"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/types"
+)
+
+// AzureFactory is a DBFactory implementation for creating Azure Blob Storage backed databases
+type AzureFactory struct {
+}
+
+// PrepareDB prepares an Azure Blob Storage backed database
+func (fact AzureFactory) PrepareDB(ctx context.Context, nbf *types.NomsBinFormat, urlObj *url.URL, params map[string]interface{}) error {
+	// nothing to prepare
+	return nil
+}
+
+// CreateDB creates an Azure Blob Storage backed database
+func (fact AzureFactory) CreateDB(ctx context.Context, nbf *types.NomsBinFormat, urlObj *url.URL, params map[string]interface{}) (datas.Database, types.ValueReadWriter, tree.NodeStore, error) {
+	azureStore, err := fact.newChunkStore(ctx, nbf, urlObj, params)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	vrw := types.NewValueStore(azureStore)
+	ns := tree.NewNodeStore(azureStore)
+	db := datas.NewTypesDatabase(vrw, ns)
+
+	return db, vrw, ns, nil
+}
+
+func (fact AzureFactory) newChunkStore(ctx context.Context, nbf *types.NomsBinFormat, urlObj *url.URL, params map[string]interface{}) (chunks.ChunkStore, error) {
+	bs, err := fact.Blobstore(ctx, urlObj, params)
+	if err != nil {
+		return nil, err
+	}
+
+	bs, err = encryptBlobstore(ctx, urlObj, bs)
+	if err != nil {
+		return nil, err
+	}
+
+	q := nbs.NewUnlimitedMemQuotaProvider()
+	return nbs.NewBSStore(ctx, nbf.VersionString(), bs, defaultMemTableSize, q)
+}
+
+// Blobstore returns the Azure Blob Storage blobstore the database is stored in
+func (fact AzureFactory) Blobstore(ctx context.Context, urlObj *url.URL, params map[string]interface{}) (blobstore.Blobstore, error) {
+	// azure://[account]/[container]/[path]
+	account := urlObj.Hostname()
+	containerName, prefix, _ := strings.Cut(strings.TrimPrefix(urlObj.Path, "/"), "/")
+	if account == "" || containerName == "" {
+		return nil, errors.New("azure url has an invalid format; expected azure://[account]/[container]/[database]")
+	}
+
+	dbName, err := validatePath(prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newAzureContainerClient(account, containerName)
+	if err != nil {
+		return nil, err
+	}
+	return blobstore.NewAzureBlobstore(client, dbName), nil
+}
+
+// newAzureContainerClient returns a client for the container |containerName| of the storage account |account|. The
+// credentials of the account are read from the environment. A connection string, which also gives the endpoint of the
+// account, is used if there is one, followed by the account's shared key, and then a SAS token.
+func newAzureContainerClient(account, containerName string) (*container.Client, error) {
+	if connStr := os.Getenv(dconfig.EnvAzureStorageConnectionString); connStr != "" {
+		return container.NewClientFromConnectionString(connStr, containerName, nil)
+	}
+
+	containerURL := fmt.Sprintf("https://%s.blob.core.windows.net/%s", account, containerName)
+	if key := os.Getenv(dconfig.EnvAzureStorageKey); key != "" {
+		cred, err := container.NewSharedKeyCredential(account, key)
+		if err != nil {
+			return nil, err
+		}
+		return container.NewClientWithSharedKeyCredential(containerURL, cred, nil)
+	}
+	if sasToken := os.Getenv(dconfig.EnvAzureStorageSASToken); sasToken != "" {
+		return container.NewClientWithNoCredential(containerURL+"?"+strings.TrimPrefix(sasToken, "?"), nil)
+	}
+
+	return nil, fmt.Errorf("failed to find azure credentials; set %s, %s or %s", dconfig.EnvAzureStorageConnectionString, dconfig.EnvAzureStorageKey, dconfig.EnvAzureStorageSASToken)
+}
@@ -0,0 +1,99 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbfactory
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/dconfig"
+	"github.com/dolthub/dolt/go/libraries/utils/earl"
+)
+
+func TestAzureBlobstore(t *testing.T) {
+	t.Setenv(dconfig.EnvAzureStorageConnectionString, "")
+	t.Setenv(dconfig.EnvAzureStorageKey, "")
+	t.Setenv(dconfig.EnvAzureStorageSASToken, "")
+	ctx := context.Background()
+	key := base64.StdEncoding.EncodeToString([]byte("account key"))
+
+	tests := []struct {
+		name         string
+		url          string
+		env          map[string]string
+		expectedPath string
+		expectedErr  string
+	}{
+		{
+			name:        "no credentials",
+			url:         "azure://account/container/database",
+			expectedErr: "failed to find azure credentials",
+		},
+		{
+			name:         "shared key",
+			url:          "azure://account/container/database",
+			env:          map[string]string{dconfig.EnvAzureStorageKey: key},
+			expectedPath: "account.blob.core.windows.net/container/database",
+		},
+		{
+			name:         "sas token",
+			url:          "azure://account/container/path/to/database/",
+			env:          map[string]string{dconfig.EnvAzureStorageSASToken: "?sv=2022-11-02&sig=signature"},
+			expectedPath: "account.blob.core.windows.net/container/path/to/database",
+		},
+		{
+			name: "connection string",
+			url:  "azure://devstoreaccount1/container/database",
+			env: map[string]string{
+				dconfig.EnvAzureStorageConnectionString: "DefaultEndpointsProtocol=http;AccountName=devstoreaccount1;AccountKey=" + key + ";BlobEndpoint=http://127.0.0.1:10000/devstoreaccount1;",
+			},
+			expectedPath: "127.0.0.1:10000/devstoreaccount1/container/database",
+		},
+		{
+			name:        "missing container",
+			url:         "azure://account",
+			env:         map[string]string{dconfig.EnvAzureStorageKey: key},
+			expectedErr: "azure url has an invalid format",
+		},
+		{
+			name:        "missing database",
+			url:         "azure://account/container/",
+			env:         map[string]string{dconfig.EnvAzureStorageKey: key},
+			expectedErr: "invalid database name",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for k, v := range test.env {
+				t.Setenv(k, v)
+			}
+			urlObj, err := earl.Parse(test.url)
+			require.NoError(t, err)
+
+			bs, err := AzureFactory{}.Blobstore(ctx, urlObj, nil)
+			if test.expectedErr != "" {
+				assert.ErrorContains(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedPath, bs.Path())
+		})
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbfactory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/utils/earl"
+	"github.com/dolthub/dolt/go/store/blobstore"
+)
+
+// EncryptParam is the URL query parameter which enables client-side encryption of a database stored in a blobstore,
+// e.g. s3://bucket/db?encrypt=/path/to/keyfile. Its value is the path of a file holding the base64 encoded
+// 256-bit key which encrypts the database's data keys. Table files and manifests are encrypted before they are
+// uploaded, and decrypted after they are downloaded.
+const EncryptParam = "encrypt"
+
+// BlobstoreFactory is implemented by the DBFactory implementations whose databases are stored in a blobstore.Blobstore.
+type BlobstoreFactory interface {
+	// Blobstore returns the Blobstore the database at the URL given is stored in, without any encryption applied.
+	Blobstore(ctx context.Context, urlObj *url.URL, params map[string]interface{}) (blobstore.Blobstore, error)
+}
+
+// EncryptionKeyFile returns the path of the key file given by the EncryptParam of |urlObj|, and whether there is one.
+// A nil |urlObj|, which in-memory databases may be created with, has no key file.
+func EncryptionKeyFile(urlObj *url.URL) (string, bool) {
+	if urlObj == nil {
+		return "", false
+	}
+	keyFile := urlObj.Query().Get(EncryptParam)
+	return keyFile, keyFile != ""
+}
+
+// encryptBlobstore returns |bs| wrapped in a blobstore.EncryptedBlobstore if |urlObj| enables encryption, and |bs|
+// itself otherwise.
+func encryptBlobstore(ctx context.Context, urlObj *url.URL, bs blobstore.Blobstore) (blobstore.Blobstore, error) {
+	keyFile, ok := EncryptionKeyFile(urlObj)
+	if !ok {
+		return bs, nil
+	}
+
+	kek, err := blobstore.ReadEncryptionKeyFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	ebs, err := blobstore.NewEncryptedBlobstore(ctx, bs, kek)
+	if errors.Is(err, blobstore.ErrWrongEncryptionKey) {
+		return nil, fmt.Errorf("unable to open %s: %w", bs.Path(), err)
+	} else if err != nil {
+		return nil, err
+	}
+	return ebs, nil
+}
+
+// errIfEncrypted returns an error if |urlObj| enables encryption, for URL schemes which do not support it.
+func errIfEncrypted(urlObj *url.URL) error {
+	if _, ok := EncryptionKeyFile(urlObj); ok {
+		return fmt.Errorf("client-side encryption is not supported for %s:// urls", urlObj.Scheme)
+	}
+	return nil
+}
+
+// RotateEncryptionKey adds a new data key to the encrypted database at |urlStr|, which is used to encrypt all the data
+// written to it afterward. If |newKeyFile| is not empty, the data keys of the database are also re-encrypted with the
+// key it holds, and the database must be opened with |newKeyFile| from then on.
+func RotateEncryptionKey(ctx context.Context, urlStr string, params map[string]interface{}, newKeyFile string) error {
+	urlObj, err := earl.Parse(urlStr)
+	if err != nil {
+		return err
+	}
+
+	keyFile, ok := EncryptionKeyFile(urlObj)
+	if !ok {
+		return fmt.Errorf("%s is not encrypted; its url has no %s parameter", urlStr, EncryptParam)
+	}
+	fact, ok := DBFactories[strings.ToLower(urlObj.Scheme)].(BlobstoreFactory)
+	if !ok {
+		return fmt.Errorf("client-side encryption is not supported for %s:// urls", urlObj.Scheme)
+	}
+
+	kek, err := blobstore.ReadEncryptionKeyFile(keyFile)
+	if err != nil {
+		return err
+	}
+	newKEK := kek
+	if newKeyFile != "" {
+		newKEK, err = blobstore.ReadEncryptionKeyFile(newKeyFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	bs, err := fact.Blobstore(ctx, urlObj, params)
+	if err != nil {
+		return err
+	}
+	return blobstore.RotateEncryptionKeys(ctx, bs, kek, newKEK)
+}
@@ -53,6 +53,12 @@ const (
 
 	OSSScheme = "oss"
 
+	// AzureScheme is the scheme of databases stored in an Azure Blob Storage container
+	AzureScheme = "azure"
+
+	// S3Scheme is the scheme of databases stored in an S3 compatible object store, without a DynamoDB table
+	S3Scheme = "s3"
+
 	defaultScheme       = HTTPSScheme
 	defaultMemTableSize = 256 * 1024 * 1024
 )
@@ -71,6 +77,8 @@ type DBFactory interface {
 var DBFactories = map[string]DBFactory{
 	AWSScheme:     AWSFactory{},
 	OSSScheme:     OSSFactory{},
+	AzureScheme:   AzureFactory{},
+	S3Scheme:      S3Factory{},
 	GSScheme:      GSFactory{},
 	OCIScheme:     OCIFactory{},
 	FileScheme:    FileFactory{},
@@ -116,6 +116,10 @@ func (fact FileFactory) PrepareDB(ctx context.Context, nbf *types.NomsBinFormat,
 
 // CreateDB creates a local filesys backed database
 func (fact FileFactory) CreateDB(ctx context.Context, nbf *types.NomsBinFormat, urlObj *url.URL, params map[string]interface{}) (datas.Database, types.ValueReadWriter, tree.NodeStore, error) {
+	if err := errIfEncrypted(urlObj); err != nil {
+		return nil, nil, nil, err
+	}
+
 	singletonLock.Lock()
 	defer singletonLock.Unlock()
 
@@ -40,13 +40,18 @@ func (fact GSFactory) PrepareDB(ctx context.Context, nbf *types.NomsBinFormat, u
 // CreateDB creates an GCS backed database
 func (fact GSFactory) CreateDB(ctx context.Context, nbf *types.NomsBinFormat, urlObj *url.URL, params map[string]interface{}) (datas.Database, types.ValueReadWriter, tree.NodeStore, error) {
 	var db datas.Database
-	gcs, err := storage.NewClient(ctx)
+	bs, err := fact.Blobstore(ctx, urlObj, params)
+
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	bs, err = encryptBlobstore(ctx, urlObj, bs)
 
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
-	bs := blobstore.NewGCSBlobstore(gcs, urlObj.Host, urlObj.Path)
 	q := nbs.NewUnlimitedMemQuotaProvider()
 	gcsStore, err := nbs.NewBSStore(ctx, nbf.VersionString(), bs, defaultMemTableSize, q)
 
@@ -61,6 +66,17 @@ func (fact GSFactory) CreateDB(ctx context.Context, nbf *types.NomsBinFormat, ur
 	return db, vrw, ns, nil
 }
 
+// Blobstore returns the GCS blobstore the database is stored in
+func (fact GSFactory) Blobstore(ctx context.Context, urlObj *url.URL, params map[string]interface{}) (blobstore.Blobstore, error) {
+	gcs, err := storage.NewClient(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return blobstore.NewGCSBlobstore(gcs, urlObj.Host, urlObj.Path), nil
+}
+
 // LocalBSFactory is a DBFactory implementation for creating a local filesystem blobstore backed databases for testing
 type LocalBSFactory struct {
 }
@@ -73,13 +89,18 @@ func (fact LocalBSFactory) PrepareDB(ctx context.Context, nbf *types.NomsBinForm
 // CreateDB creates a local filesystem blobstore backed database
 func (fact LocalBSFactory) CreateDB(ctx context.Context, nbf *types.NomsBinFormat, urlObj *url.URL, params map[string]interface{}) (datas.Database, types.ValueReadWriter, tree.NodeStore, error) {
 	var db datas.Database
-	absPath, err := filepath.Abs(filepath.Join(urlObj.Host, urlObj.Path))
+	bs, err := fact.Blobstore(ctx, urlObj, params)
+
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	bs, err = encryptBlobstore(ctx, urlObj, bs)
 
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
-	bs := blobstore.NewLocalBlobstore(absPath)
 	q := nbs.NewUnlimitedMemQuotaProvider()
 	bsStore, err := nbs.NewBSStore(ctx, nbf.VersionString(), bs, defaultMemTableSize, q)
 
@@ -93,3 +114,14 @@ func (fact LocalBSFactory) CreateDB(ctx context.Context, nbf *types.NomsBinForma
 
 	return db, vrw, ns, err
 }
+
+// Blobstore returns the local filesystem blobstore the database is stored in
+func (fact LocalBSFactory) Blobstore(ctx context.Context, urlObj *url.URL, params map[string]interface{}) (blobstore.Blobstore, error) {
+	absPath, err := filepath.Abs(filepath.Join(urlObj.Host, urlObj.Path))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return blobstore.NewLocalBlobstore(absPath), nil
+}
@@ -40,7 +40,11 @@ func (fact MemFactory) PrepareDB(ctx context.Context, nbf *types.NomsBinFormat,
 func (fact MemFactory) CreateDB(ctx context.Context, nbf *types.NomsBinFormat, urlObj *url.URL, params map[string]interface{}) (datas.Database, types.ValueReadWriter, tree.NodeStore, error) {
 	var db datas.Database
 
-	bs := blobstore.NewInMemoryBlobstore(uuid.New().String())
+	bs, err := encryptBlobstore(ctx, urlObj, blobstore.NewInMemoryBlobstore(uuid.New().String()))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
 	q := nbs.NewUnlimitedMemQuotaProvider()
 	cs, err := nbs.NewBSStore(ctx, nbf.VersionString(), bs, defaultMemTableSize, q)
 	if err != nil {
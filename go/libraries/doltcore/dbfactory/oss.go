@@ -81,6 +81,22 @@ func (fact OSSFactory) CreateDB(ctx context.Context, nbf *types.NomsBinFormat, u
 }
 
 func (fact OSSFactory) newChunkStore(ctx context.Context, nbf *types.NomsBinFormat, urlObj *url.URL, params map[string]interface{}) (chunks.ChunkStore, error) {
+	bs, err := fact.Blobstore(ctx, urlObj, params)
+	if err != nil {
+		return nil, err
+	}
+
+	bs, err = encryptBlobstore(ctx, urlObj, bs)
+	if err != nil {
+		return nil, err
+	}
+
+	q := nbs.NewUnlimitedMemQuotaProvider()
+	return nbs.NewBSStore(ctx, nbf.VersionString(), bs, defaultMemTableSize, q)
+}
+
+// Blobstore returns the OSS blobstore the database is stored in
+func (fact OSSFactory) Blobstore(ctx context.Context, urlObj *url.URL, params map[string]interface{}) (blobstore.Blobstore, error) {
 	// oss://[bucket]/[key]
 	bucket := urlObj.Hostname()
 	prefix := urlObj.Path
@@ -95,9 +111,7 @@ func (fact OSSFactory) newChunkStore(ctx context.Context, nbf *types.NomsBinForm
 	if err != nil {
 		return nil, errors.New("failed to initialize oss blob store")
 	}
-
-	q := nbs.NewUnlimitedMemQuotaProvider()
-	return nbs.NewBSStore(ctx, nbf.VersionString(), bs, defaultMemTableSize, q)
+	return bs, nil
 }
 
 func ossConfigFromParams(params map[string]interface{}) ossCredential {
@@ -0,0 +1,106 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbfactory
+
+import (
+	"context"
+	"errors"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"github.com/dolthub/dolt/go/store/blobstore"
+	"github.com/dolthub/dolt/go/store/chunks"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/dolt/go/store/nbs"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/types"
+)
+
+// S3Factory is a DBFactory implementation for creating databases stored in S3, or an S3 compatible object store such
+// as MinIO or Ceph. Unlike AWSFactory, the manifest is stored in the bucket with the table files, and is updated with
+// S3 conditional writes instead of through a DynamoDB table.
+type S3Factory struct {
+}
+
+// PrepareDB prepares an S3 backed database
+func (fact S3Factory) PrepareDB(ctx context.Context, nbf *types.NomsBinFormat, urlObj *url.URL, params map[string]interface{}) error {
+	// nothing to prepare
+	return nil
+}
+
+// CreateDB creates an S3 backed database
+func (fact S3Factory) CreateDB(ctx context.Context, nbf *types.NomsBinFormat, urlObj *url.URL, params map[string]interface{}) (datas.Database, types.ValueReadWriter, tree.NodeStore, error) {
+	s3Store, err := fact.newChunkStore(ctx, nbf, urlObj, params)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	vrw := types.NewValueStore(s3Store)
+	ns := tree.NewNodeStore(s3Store)
+	db := datas.NewTypesDatabase(vrw, ns)
+
+	return db, vrw, ns, nil
+}
+
+func (fact S3Factory) newChunkStore(ctx context.Context, nbf *types.NomsBinFormat, urlObj *url.URL, params map[string]interface{}) (chunks.ChunkStore, error) {
+	bs, err := fact.Blobstore(ctx, urlObj, params)
+	if err != nil {
+		return nil, err
+	}
+
+	bs, err = encryptBlobstore(ctx, urlObj, bs)
+	if err != nil {
+		return nil, err
+	}
+
+	q := nbs.NewUnlimitedMemQuotaProvider()
+	return nbs.NewBSStore(ctx, nbf.VersionString(), bs, defaultMemTableSize, q)
+}
+
+// Blobstore returns the S3 blobstore the database is stored in. The endpoint of an S3 compatible object store is
+// configured the same way as any other AWS endpoint, e.g. with AWS_ENDPOINT_URL_S3, or the endpoint_url of the AWS
+// profile in use.
+func (fact S3Factory) Blobstore(ctx context.Context, urlObj *url.URL, params map[string]interface{}) (blobstore.Blobstore, error) {
+	// s3://[bucket]/[path]
+	bucket := urlObj.Hostname()
+	if bucket == "" {
+		return nil, errors.New("s3 url has an invalid format; expected s3://[bucket]/[database]")
+	}
+
+	cfg, err := awsConfigFromParams(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	dbName, err := validatePath(urlObj.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	client := s3.NewFromConfig(cfg, s3CompatibleOptions)
+	return blobstore.NewS3Blobstore(client, bucket, dbName), nil
+}
+
+// s3CompatibleOptions configures clients of S3 compatible object stores, which have an endpoint other than the AWS
+// endpoints. They generally do not support virtual hosted buckets, or checksums which are not required by the S3 API.
+func s3CompatibleOptions(o *s3.Options) {
+	if o.BaseEndpoint != nil {
+		o.UsePathStyle = true
+		o.RequestChecksumCalculation = aws.RequestChecksumCalculationWhenRequired
+		o.ResponseChecksumValidation = aws.ResponseChecksumValidationWhenRequired
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbfactory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/utils/earl"
+)
+
+// These tests are not Parallel safe, since they modify the environment of the running test process.
+func TestS3Blobstore(t *testing.T) {
+	t.Setenv("HOME", "/does_not_exist")
+	t.Setenv("AWS_ENDPOINT_URL", "")
+	t.Setenv("AWS_ENDPOINT_URL_S3", "")
+	ctx := context.Background()
+	params := map[string]interface{}{AWSRegionParam: "us-east-1"}
+
+	urlObj, err := earl.Parse("s3://bucket/path/to/database/")
+	require.NoError(t, err)
+	bs, err := S3Factory{}.Blobstore(ctx, urlObj, params)
+	require.NoError(t, err)
+	assert.Equal(t, "bucket/path/to/database", bs.Path())
+
+	urlObj, err = earl.Parse("s3://bucket")
+	require.NoError(t, err)
+	_, err = S3Factory{}.Blobstore(ctx, urlObj, params)
+	assert.ErrorContains(t, err, "invalid database name")
+}
+
+func TestS3CompatibleOptions(t *testing.T) {
+	t.Setenv("HOME", "/does_not_exist")
+	t.Setenv("AWS_ENDPOINT_URL", "")
+	t.Setenv("AWS_ENDPOINT_URL_S3", "")
+	ctx := context.Background()
+	params := map[string]interface{}{AWSRegionParam: "us-east-1"}
+
+	cfg, err := awsConfigFromParams(ctx, params)
+	require.NoError(t, err)
+	opts := s3.NewFromConfig(cfg, s3CompatibleOptions).Options()
+	assert.Nil(t, opts.BaseEndpoint)
+	assert.False(t, opts.UsePathStyle)
+
+	// an S3 compatible object store, e.g. MinIO, is configured with the AWS endpoint environment variables
+	t.Setenv("AWS_ENDPOINT_URL_S3", "http://127.0.0.1:9000")
+	cfg, err = awsConfigFromParams(ctx, params)
+	require.NoError(t, err)
+	opts = s3.NewFromConfig(cfg, s3CompatibleOptions).Options()
+	assert.Equal(t, "http://127.0.0.1:9000", aws.ToString(opts.BaseEndpoint))
+	assert.True(t, opts.UsePathStyle)
+	assert.Equal(t, aws.RequestChecksumCalculationWhenRequired, opts.RequestChecksumCalculation)
+}
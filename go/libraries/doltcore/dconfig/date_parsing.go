@@ -33,6 +33,9 @@ var SupportedLayouts = []string{
 	"2006-01-02",
 	"2006-01-02T15:04:05",
 	"2006-01-02T15:04:05Z07:00",
+	"2006-01-02 15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04:05Z07:00",
 }
 
 // ParseDate attempt to parse a date string into a time.Time object.
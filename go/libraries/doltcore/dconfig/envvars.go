@@ -35,6 +35,9 @@ const (
 	EnvOssEndpoint                   = "OSS_ENDPOINT"
 	EnvOssAccessKeyID                = "OSS_ACCESS_KEY_ID"
 	EnvOssAccessKeySecret            = "OSS_ACCESS_KEY_SECRET"
+	EnvAzureStorageConnectionString  = "AZURE_STORAGE_CONNECTION_STRING"
+	EnvAzureStorageKey               = "AZURE_STORAGE_KEY"
+	EnvAzureStorageSASToken          = "AZURE_STORAGE_SAS_TOKEN"
 	EnvVerboseAssertTableFilesClosed = "DOLT_VERBOSE_ASSERT_TABLE_FILES_CLOSED"
 	EnvDisableGcProcedure            = "DOLT_DISABLE_GC_PROCEDURE"
 	EnvEditTableBufferRows           = "DOLT_EDIT_TABLE_BUFFER_ROWS"
@@ -45,6 +48,8 @@ const (
 	EnvDbNameReplace                 = "DOLT_DBNAME_REPLACE"
 	EnvDoltRootHost                  = "DOLT_ROOT_HOST"
 	EnvDoltRootPassword              = "DOLT_ROOT_PASSWORD"
+	EnvEncryptionKey                 = "DOLT_ENCRYPTION_KEY"
+	EnvEncryptionKeyFile             = "DOLT_ENCRYPTION_KEY_FILE"
 
 	// If set, must be "kill_connections" or "session_aware"
 	// Will go away after session_aware is made default-and-only.
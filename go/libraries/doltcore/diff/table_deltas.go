@@ -255,6 +255,24 @@ func filterUnmodifiedTableDeltas(deltas []TableDelta) ([]TableDelta, error) {
 	return filtered, nil
 }
 
+// FilterSparseTableDeltas returns the subset of |deltas| for tables which are part of the sparse checkout given. A
+// renamed table is kept if either of its names is part of the sparse checkout, and deltas which aren't for a table are
+// always kept.
+func FilterSparseTableDeltas(sc *doltdb.SparseCheckout, deltas []TableDelta) []TableDelta {
+	if sc == nil {
+		return deltas
+	}
+	filtered := make([]TableDelta, 0, len(deltas))
+	for _, d := range deltas {
+		if (d.FromTable == nil && d.ToTable == nil) ||
+			(d.FromTable != nil && sc.Includes(d.FromName)) ||
+			(d.ToTable != nil && sc.Includes(d.ToName)) {
+			filtered = append(filtered, d)
+		}
+	}
+	return filtered
+}
+
 func matchTableDeltas(fromDeltas, toDeltas []TableDelta) (deltas []TableDelta) {
 	var matchedNames []doltdb.TableName
 	from := make(map[doltdb.TableName]TableDelta, len(fromDeltas))
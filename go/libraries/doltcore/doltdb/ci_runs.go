@@ -0,0 +1,101 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doltdb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// CIRunStatusPassed is the status of a workflow run in which every step passed.
+	CIRunStatusPassed = "passed"
+	// CIRunStatusFailed is the status of a workflow run in which at least one step failed.
+	CIRunStatusFailed = "failed"
+	// CIRunStatusError is the status of a workflow run that could not be completed.
+	CIRunStatusError = "error"
+)
+
+// ciRunsKey is the key of the tuple which stores the CIRuns of a database.
+const ciRunsKey = "ci_runs"
+
+// maxCIRuns is the number of workflow runs retained for each database. Older runs are discarded first.
+const maxCIRuns = 1024
+
+// CIRun is a record of a dolt_ci workflow run triggered by a branch head update.
+type CIRun struct {
+	RunId       string    `json:"run_id"`
+	Workflow    string    `json:"workflow"`
+	Branch      string    `json:"branch"`
+	Commit      string    `json:"commit"`
+	Status      string    `json:"status"`
+	FailingStep string    `json:"failing_step,omitempty"`
+	Output      string    `json:"output,omitempty"`
+	StartedAt   time.Time `json:"started_at"`
+	FinishedAt  time.Time `json:"finished_at"`
+}
+
+// CIRuns is the list of workflow runs recorded in a database, oldest first.
+type CIRuns []CIRun
+
+// Passed returns whether a run of the named workflow passed on the given commit.
+func (runs CIRuns) Passed(workflow string, commit string) bool {
+	for _, run := range runs {
+		if strings.EqualFold(run.Workflow, workflow) && run.Commit == commit && run.Status == CIRunStatusPassed {
+			return true
+		}
+	}
+	return false
+}
+
+// GetCIRuns returns the workflow runs recorded in this database. The runs are stored in the database itself, outside
+// of any branch, so they are kept across restarts and are not pushed, pulled or cloned.
+func (ddb *DoltDB) GetCIRuns(ctx context.Context) (CIRuns, error) {
+	data, ok, err := ddb.GetTuple(ctx, ciRunsKey)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	var runs CIRuns
+	if err = json.Unmarshal(data, &runs); err != nil {
+		return nil, fmt.Errorf("unable to read the dolt_ci workflow runs: %w", err)
+	}
+	return runs, nil
+}
+
+// AddCIRun records |run| in this database, discarding the oldest run if the maximum number of runs are recorded.
+// Runs are recorded by a single thread, so concurrent calls are not supported.
+func (ddb *DoltDB) AddCIRun(ctx context.Context, run CIRun) error {
+	runs, err := ddb.GetCIRuns(ctx)
+	if err != nil {
+		return err
+	}
+	runs = append(runs, run)
+	if len(runs) > maxCIRuns {
+		runs = runs[len(runs)-maxCIRuns:]
+	}
+
+	data, err := json.Marshal(runs)
+	if err != nil {
+		return err
+	}
+	return ddb.SetTuple(ctx, ciRunsKey, data)
+}
@@ -0,0 +1,59 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doltdb
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/utils/filesys"
+	"github.com/dolthub/dolt/go/store/types"
+)
+
+func TestCIRuns(t *testing.T) {
+	ctx := context.Background()
+	ddb, err := LoadDoltDB(ctx, types.Format_Default, InMemDoltDB, filesys.LocalFS)
+	require.NoError(t, err)
+	defer ddb.Close()
+	require.NoError(t, ddb.WriteEmptyRepo(ctx, "main", "Bill Billerson", "bigbillieb@fake.horse"))
+
+	runs, err := ddb.GetCIRuns(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, runs)
+
+	require.NoError(t, ddb.AddCIRun(ctx, CIRun{RunId: "1", Workflow: "Workflow_1", Commit: "abc", Status: CIRunStatusFailed}))
+	require.NoError(t, ddb.AddCIRun(ctx, CIRun{RunId: "2", Workflow: "Workflow_1", Commit: "def", Status: CIRunStatusPassed}))
+	runs, err = ddb.GetCIRuns(ctx)
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	assert.Equal(t, "1", runs[0].RunId)
+	assert.False(t, runs.Passed("workflow_1", "abc"))
+	assert.True(t, runs.Passed("workflow_1", "def"))
+	assert.False(t, runs.Passed("workflow_2", "def"))
+
+	// the oldest runs are discarded once the maximum number of runs are recorded
+	for i := 3; i <= maxCIRuns+1; i++ {
+		require.NoError(t, ddb.AddCIRun(ctx, CIRun{RunId: strconv.Itoa(i), Workflow: "workflow_1", Status: CIRunStatusPassed}))
+	}
+	runs, err = ddb.GetCIRuns(ctx)
+	require.NoError(t, err)
+	require.Len(t, runs, maxCIRuns)
+	assert.Equal(t, "2", runs[0].RunId)
+	assert.Equal(t, strconv.Itoa(maxCIRuns+1), runs[len(runs)-1].RunId)
+}
@@ -1777,18 +1777,60 @@ func (ddb *DoltDB) GC(ctx context.Context, mode types.GCMode, safepointControlle
 		return fmt.Errorf("this database does not support garbage collection")
 	}
 
-	err := ddb.pruneUnreferencedDatasets(ctx)
+	oldGen, newGen, err := ddb.gcGenerations(ctx)
 	if err != nil {
 		return err
 	}
 
-	datasets, err := ddb.db.Datasets(ctx)
+	return collector.GC(ctx, mode, oldGen, newGen, safepointController)
+}
+
+// CanReclaimStorage returns whether garbage collecting this ddb deletes the table files it no longer references. Only
+// stores on the local filesystem do; stores backed by a blobstore or a remote API keep every table file written to them.
+func (ddb *DoltDB) CanReclaimStorage() bool {
+	local, ok := datas.ChunkStoreFromDatabase(ddb.db).(interface{ Path() (string, bool) })
+	if !ok {
+		return false
+	}
+	_, ok = local.Path()
+	return ok
+}
+
+// GCRetainingRoots performs a full garbage collection on this ddb which, in addition to every chunk reachable from its
+// datasets, keeps every chunk reachable from the past store roots |roots|. Backups use this to retain the roots of
+// their earlier syncs, which are not reachable from any dataset.
+func (ddb *DoltDB) GCRetainingRoots(ctx context.Context, roots []hash.Hash) error {
+	collector, ok := ddb.db.Database.(datas.GarbageCollector)
+	if !ok {
+		return fmt.Errorf("this database does not support garbage collection")
+	}
+
+	oldGen, newGen, err := ddb.gcGenerations(ctx)
 	if err != nil {
 		return err
 	}
+	for _, root := range roots {
+		newGen.Insert(root)
+	}
 
-	newGen := make(hash.HashSet)
-	oldGen := make(hash.HashSet)
+	return collector.GC(ctx, types.GCModeFull, oldGen, newGen, nil)
+}
+
+// gcGenerations prunes unreferenced datasets and returns the heads of the remaining datasets, split into those which
+// belong in the old generation and those which belong in the new generation of a generational store.
+func (ddb *DoltDB) gcGenerations(ctx context.Context) (oldGen, newGen hash.HashSet, err error) {
+	err = ddb.pruneUnreferencedDatasets(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	datasets, err := ddb.db.Datasets(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newGen = make(hash.HashSet)
+	oldGen = make(hash.HashSet)
 	err = datasets.IterAll(ctx, func(keyStr string, h hash.Hash) error {
 		var isOldGen bool
 		switch {
@@ -1812,10 +1854,10 @@ func (ddb *DoltDB) GC(ctx context.Context, mode types.GCMode, safepointControlle
 	})
 
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	return collector.GC(ctx, mode, oldGen, newGen, safepointController)
+	return oldGen, newGen, nil
 }
 
 func (ddb *DoltDB) ShallowGC(ctx context.Context) error {
@@ -2011,6 +2053,69 @@ func (ddb *DoltDB) TableFileStoreHasJournal(ctx context.Context) (bool, error) {
 	return false, nil
 }
 
+// TableFiles returns the table files of this ddb's TableFileStore.
+func (ddb *DoltDB) TableFiles(ctx context.Context) ([]chunks.TableFile, error) {
+	tableFileStore, ok := datas.ChunkStoreFromDatabase(ddb.db).(chunks.TableFileStore)
+	if !ok {
+		return nil, errors.New("unsupported operation, doltDB.TableFiles on non-TableFileStore")
+	}
+	_, tableFiles, _, err := tableFileStore.Sources(ctx)
+	return tableFiles, err
+}
+
+// VerifyChunks walks every chunk reachable from the store root |root| and returns an error if any of them is missing
+// from this ddb or does not hash to its address. Chunks in |visited| are not read again, and every chunk read is added
+// to it, so that several roots sharing most of their chunks can be verified cheaply.
+func (ddb *DoltDB) VerifyChunks(ctx context.Context, root hash.Hash, visited hash.HashSet) error {
+	cs := datas.ChunkStoreFromDatabase(ddb.db)
+	next := hash.NewHashSet(root)
+	for len(next) > 0 {
+		batch := make(hash.HashSet, len(next))
+		for h := range next {
+			if !visited.Has(h) {
+				batch.Insert(h)
+			}
+		}
+		next = make(hash.HashSet)
+		if len(batch) == 0 {
+			break
+		}
+
+		// |found| may be called concurrently
+		var mu sync.Mutex
+		var walkErr error
+		found := make(hash.HashSet, len(batch))
+		err := cs.GetMany(ctx, batch, func(ctx context.Context, c *chunks.Chunk) {
+			mu.Lock()
+			defer mu.Unlock()
+			if walkErr != nil {
+				return
+			}
+			found.Insert(c.Hash())
+			if actual := hash.Of(c.Data()); actual != c.Hash() {
+				walkErr = fmt.Errorf("chunk %s is corrupt; its contents hash to %s", c.Hash().String(), actual.String())
+				return
+			}
+			walkErr = types.AddrsFromNomsValue(*c, ddb.Format(), next)
+		})
+		if err != nil {
+			return err
+		}
+		if walkErr != nil {
+			return walkErr
+		}
+
+		for h := range batch {
+			if !found.Has(h) {
+				return fmt.Errorf("chunk %s is missing", h.String())
+			}
+			visited.Insert(h)
+		}
+	}
+
+	return nil
+}
+
 // DatasetsByRootHash returns the DatasetsMap for the specified root |hashof|.
 func (ddb *DoltDB) DatasetsByRootHash(ctx context.Context, hashof hash.Hash) (datas.DatasetsMap, error) {
 	return ddb.db.DatasetsByRootHash(ctx, hashof)
@@ -2249,6 +2354,36 @@ func (ddb *DoltDB) PersistGhostCommits(ctx context.Context, ghostCommits hash.Ha
 	return ddb.db.Database.PersistGhostCommitIDs(ctx, ghostCommits)
 }
 
+// UpdateGhostTables adds |ghostTables| to the set of ghost chunks persisted in the database, and removes |present|
+// from it. Unlike PersistGhostCommits, the existing ghosts are retained, since a partial clone accumulates the
+// addresses of excluded tables over the course of many fetches. Addresses are removed when a table that is included in
+// the partial clone happens to share its address with a table that was previously excluded, so that it gets fetched.
+func (ddb *DoltDB) UpdateGhostTables(ctx context.Context, ghostTables, present hash.HashSet) error {
+	gcs, ok := datas.ChunkStoreFromDatabase(ddb.db).(*nbs.GenerationalNBS)
+	if !ok {
+		return errors.New("partial clones are not supported by this database's storage")
+	}
+	ghostStore, ok := gcs.GhostGen().(*nbs.GhostBlockStore)
+	if !ok || ghostStore == nil {
+		return errors.New("partial clones are not supported by this database's storage")
+	}
+
+	ghosts := ghostStore.GhostHashes()
+	size := ghosts.Size()
+	ghosts.InsertAll(ghostTables)
+	changed := ghosts.Size() != size
+	for h := range present {
+		if ghosts.Has(h) {
+			ghosts.Remove(h)
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	return ddb.db.Database.PersistGhostCommitIDs(ctx, ghosts)
+}
+
 // Purge in-memory read caches associated with this DoltDB. This needs
 // to be done at a specific point during a GC operation to ensure that
 // everything the application layer sees still exists in the database
@@ -50,6 +50,9 @@ const (
 
 var (
 	ErrUnknownAutoIncrementValue = fmt.Errorf("auto increment set for non-numeric column type")
+	// ErrGhostTable is returned when loading a table whose data was deliberately not fetched from a remote, such as a
+	// table that was excluded from a partial clone.
+	ErrGhostTable = errors.New("table is not present in this partial clone")
 )
 
 var (
@@ -184,6 +187,9 @@ func TableFromAddr(ctx context.Context, vrw types.ValueReadWriter, ns tree.NodeS
 	if err != nil {
 		return nil, err
 	}
+	if _, ok := val.(types.GhostValue); ok {
+		return nil, ErrGhostTable
+	}
 
 	if !vrw.Format().UsesFlatbuffers() {
 		st, ok := val.(types.Struct)
@@ -0,0 +1,212 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doltdb
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/types"
+	"github.com/dolthub/dolt/go/store/val"
+)
+
+// MergeStrategy is the name of a strategy used to resolve concurrent modifications of the same cell during a merge.
+type MergeStrategy string
+
+const (
+	// MergeStrategyOurs resolves a cell to the value on our side of the merge.
+	MergeStrategyOurs MergeStrategy = "ours"
+	// MergeStrategyTheirs resolves a cell to the value on their side of the merge.
+	MergeStrategyTheirs MergeStrategy = "theirs"
+	// MergeStrategyMax resolves a cell to the greater of the two values.
+	MergeStrategyMax MergeStrategy = "max"
+	// MergeStrategyMin resolves a cell to the lesser of the two values.
+	MergeStrategyMin MergeStrategy = "min"
+	// MergeStrategySum resolves a numeric cell by applying the changes of both sides to the ancestor value, which
+	// suits counters.
+	MergeStrategySum MergeStrategy = "sum"
+	// MergeStrategyLatest resolves a cell to the value on the side of the merge whose order by column is greater,
+	// e.g. the side with the later updated_at timestamp.
+	MergeStrategyLatest MergeStrategy = "latest"
+	// MergeStrategyUnion resolves a SET cell by keeping the elements added on either side, and dropping the
+	// elements removed on either side.
+	MergeStrategyUnion MergeStrategy = "union"
+)
+
+// MergeRulesAllColumns is the column name of a merge rule which applies to every column of a table that does not
+// have a merge rule of its own.
+const MergeRulesAllColumns = "*"
+
+var mergeStrategies = []MergeStrategy{
+	MergeStrategyOurs,
+	MergeStrategyTheirs,
+	MergeStrategyMax,
+	MergeStrategyMin,
+	MergeStrategySum,
+	MergeStrategyLatest,
+	MergeStrategyUnion,
+}
+
+// MergeRule is a row of the dolt_merge_rules table, declaring how concurrent modifications of a column of a table
+// are resolved during a merge.
+type MergeRule struct {
+	Table    string
+	Column   string
+	Strategy MergeStrategy
+	// OrderBy is the column compared by the latest strategy.
+	OrderBy string
+}
+
+// NewMergeRule returns a validated merge rule.
+func NewMergeRule(table, column, strategy, orderBy string) (MergeRule, error) {
+	rule := MergeRule{
+		Table:    table,
+		Column:   column,
+		Strategy: MergeStrategy(strings.ToLower(strings.TrimSpace(strategy))),
+		OrderBy:  orderBy,
+	}
+	if len(strings.TrimSpace(table)) == 0 {
+		return MergeRule{}, fmt.Errorf("invalid merge rule: table_name cannot be empty")
+	}
+	if len(strings.TrimSpace(column)) == 0 {
+		return MergeRule{}, fmt.Errorf("invalid merge rule for %s: column_name cannot be empty; use '%s' for all columns", table, MergeRulesAllColumns)
+	}
+	if !isMergeStrategy(rule.Strategy) {
+		return MergeRule{}, fmt.Errorf("invalid merge rule for %s.%s: unknown strategy '%s'; expected one of %s", table, column, strategy, mergeStrategyNames())
+	}
+	if rule.Strategy == MergeStrategyLatest && len(orderBy) == 0 {
+		return MergeRule{}, fmt.Errorf("invalid merge rule for %s.%s: the %s strategy requires an order_by_column", table, column, MergeStrategyLatest)
+	}
+	if rule.Strategy != MergeStrategyLatest && len(orderBy) != 0 {
+		return MergeRule{}, fmt.Errorf("invalid merge rule for %s.%s: order_by_column is only used by the %s strategy", table, column, MergeStrategyLatest)
+	}
+	return rule, nil
+}
+
+func isMergeStrategy(strategy MergeStrategy) bool {
+	for _, s := range mergeStrategies {
+		if s == strategy {
+			return true
+		}
+	}
+	return false
+}
+
+func mergeStrategyNames() string {
+	names := make([]string, len(mergeStrategies))
+	for i, s := range mergeStrategies {
+		names[i] = string(s)
+	}
+	return strings.Join(names, ", ")
+}
+
+// MergeRules are the merge rules of a single table, keyed by lower case column name.
+type MergeRules map[string]MergeRule
+
+// ForColumn returns the merge rule for the column named, falling back to the rule for all columns of the table.
+func (mr MergeRules) ForColumn(column string) (MergeRule, bool) {
+	if rule, ok := mr[strings.ToLower(column)]; ok {
+		return rule, true
+	}
+	rule, ok := mr[MergeRulesAllColumns]
+	return rule, ok
+}
+
+// GetMergeRules reads the merge rules for the table named from the dolt_merge_rules table of |root|, which is in the
+// same schema as the table. Table names are matched case-insensitively. Returns nil if there are no rules for the
+// table.
+func GetMergeRules(ctx context.Context, root RootValue, tableName TableName) (MergeRules, error) {
+	table, found, err := root.GetTable(ctx, TableName{Name: MergeRulesTableName, Schema: tableName.Schema})
+	if err != nil {
+		return nil, err
+	}
+	if !found || table.Format() == types.Format_LD_1 {
+		// merge rules are not supported for the legacy storage format
+		return nil, nil
+	}
+
+	sch, err := table.GetSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if sch.GetPKCols().Size() != 2 || sch.GetNonPKCols().Size() != 2 {
+		return nil, fmt.Errorf("%s had an unexpected schema, this should never happen", MergeRulesTableName)
+	}
+	index, err := table.GetRowData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m := durable.MapFromIndex(index)
+	ns := m.NodeStore()
+	keyDesc, valueDesc := sch.GetMapDescriptors(ns)
+
+	iter, err := m.IterAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules MergeRules
+	for {
+		k, v, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		fields := make([]string, 4)
+		for i := 0; i < 2; i++ {
+			if fields[i], err = getMergeRuleField(ctx, keyDesc, i, k, ns); err != nil {
+				return nil, err
+			}
+			if fields[i+2], err = getMergeRuleField(ctx, valueDesc, i, v, ns); err != nil {
+				return nil, err
+			}
+		}
+		if !strings.EqualFold(fields[0], tableName.Name) {
+			continue
+		}
+
+		rule, err := NewMergeRule(fields[0], fields[1], fields[2], fields[3])
+		if err != nil {
+			return nil, err
+		}
+		if rules == nil {
+			rules = make(MergeRules)
+		}
+		rules[strings.ToLower(rule.Column)] = rule
+	}
+	return rules, nil
+}
+
+func getMergeRuleField(ctx context.Context, td val.TupleDesc, i int, tup val.Tuple, ns tree.NodeStore) (string, error) {
+	v, err := tree.GetField(ctx, td, i, tup, ns)
+	if err != nil {
+		return "", err
+	}
+	if v == nil {
+		return "", nil
+	}
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("%s had an unexpected value type %T, this should never happen", MergeRulesTableName, v)
+	}
+	return s, nil
+}
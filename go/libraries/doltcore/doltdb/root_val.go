@@ -87,7 +87,8 @@ type RootValue interface {
 	HandlePostMerge(ctx context.Context, ourRoot, theirRoot, ancRoot RootValue) (RootValue, error)
 	// HasTable returns whether the root has a table with the given case-sensitive name.
 	HasTable(ctx context.Context, tName TableName) (bool, error)
-	// IterTables calls the callback function cb on each table in this RootValue.
+	// IterTables calls the callback function cb on each table in this RootValue. Tables that were excluded from a
+	// partial clone are skipped.
 	IterTables(ctx context.Context, cb func(name TableName, table *Table, sch schema.Schema) (stop bool, err error)) error
 	// NodeStore returns this root's NodeStore.
 	NodeStore() tree.NodeStore
@@ -497,7 +498,13 @@ func (root *rootValue) SetTableHash(ctx context.Context, tName TableName, h hash
 		return nil, err
 	}
 
-	ref, err := types.NewRef(val, root.vrw.Format())
+	var ref types.Ref
+	if ghost, ok := val.(types.GhostValue); ok && root.vrw.Format().UsesFlatbuffers() {
+		// The table was excluded from a partial clone, so we can only refer to it by its address
+		ref, err = types.NewGhostRef(ghost, root.vrw.Format())
+	} else {
+		ref, err = types.NewRef(val, root.vrw.Format())
+	}
 
 	if err != nil {
 		return nil, err
@@ -548,7 +555,11 @@ func (root *rootValue) GetTable(ctx context.Context, tName TableName) (*Table, b
 		return nil, false, err
 	}
 
-	return GetTable(ctx, root, addr)
+	tbl, ok, err := GetTable(ctx, root, addr)
+	if errors.Is(err, durable.ErrGhostTable) {
+		return nil, false, fmt.Errorf("%w: %s", err, tName.String())
+	}
+	return tbl, ok, err
 }
 
 func GetTable(ctx context.Context, root RootValue, addr hash.Hash) (*Table, bool, error) {
@@ -637,7 +648,10 @@ func TablesWithDataConflicts(ctx context.Context, root RootValue) ([]TableName,
 	conflicted := make([]TableName, 0, len(names))
 	for _, name := range names {
 		tbl, _, err := root.GetTable(ctx, name)
-		if err != nil {
+		if errors.Is(err, durable.ErrGhostTable) {
+			// Tables that were excluded from a partial clone are never modified, so they can't be conflicted
+			continue
+		} else if err != nil {
 			return nil, err
 		}
 
@@ -663,7 +677,10 @@ func TablesWithConstraintViolations(ctx context.Context, root RootValue) ([]Tabl
 	violating := make([]TableName, 0, len(names))
 	for _, name := range names {
 		tbl, _, err := root.GetTable(ctx, name)
-		if err != nil {
+		if errors.Is(err, durable.ErrGhostTable) {
+			// Tables that were excluded from a partial clone are never modified, so they can't be conflicted
+			continue
+		} else if err != nil {
 			return nil, err
 		}
 
@@ -699,7 +716,8 @@ func HasConstraintViolations(ctx context.Context, root RootValue) (bool, error)
 	return len(tbls) > 0, nil
 }
 
-// IterTables calls the callback function cb on each table in this RootValue.
+// IterTables calls the callback function cb on each table in this RootValue. Tables that were excluded from a partial
+// clone are skipped.
 func (root *rootValue) IterTables(ctx context.Context, cb func(name TableName, table *Table, sch schema.Schema) (stop bool, err error)) error {
 	schemaNames, err := schemaNames(ctx, root)
 	if err != nil {
@@ -714,7 +732,10 @@ func (root *rootValue) IterTables(ctx context.Context, cb func(name TableName, t
 
 		err = tm.Iter(ctx, func(name string, addr hash.Hash) (bool, error) {
 			nt, err := durable.TableFromAddr(ctx, root.VRW(), root.ns, addr)
-			if err != nil {
+			if errors.Is(err, durable.ErrGhostTable) {
+				// Tables that were excluded from a partial clone have no data to iterate over
+				return false, nil
+			} else if err != nil {
 				return true, err
 			}
 			tbl := &Table{table: nt}
@@ -886,7 +907,7 @@ func (root *rootValue) putTable(ctx context.Context, tName TableName, ref types.
 	var preserveTablesHash bool
 	if root.tablesHash != 0 {
 		var err error
-		_, preserveTablesHash, err = root.GetTable(ctx, tName)
+		_, preserveTablesHash, err = root.GetTableHash(ctx, tName)
 		if err != nil {
 			return nil, err
 		}
@@ -1112,9 +1133,14 @@ func ValidateForeignKeysOnSchemas(ctx context.Context, root RootValue) (RootValu
 		return nil, err
 	}
 	allTablesSet := make(map[TableName]schema.Schema)
+	// Tables that were excluded from a partial clone can't be loaded, but they also can't have been modified
+	ghostTables := make(map[TableName]struct{})
 	for _, tableName := range allTablesSlice {
 		tbl, ok, err := root.GetTable(ctx, tableName)
-		if err != nil {
+		if errors.Is(err, durable.ErrGhostTable) {
+			ghostTables[tableName] = struct{}{}
+			continue
+		} else if err != nil {
 			return nil, err
 		}
 		if !ok {
@@ -1130,11 +1156,17 @@ func ValidateForeignKeysOnSchemas(ctx context.Context, root RootValue) (RootValu
 	// some of these checks are sanity checks and should never happen
 	allForeignKeys := fkCollection.AllKeys()
 	for _, foreignKey := range allForeignKeys {
+		if _, ok := ghostTables[foreignKey.TableName]; ok {
+			continue
+		}
 		tblSch, existsInRoot := allTablesSet[foreignKey.TableName]
 		if existsInRoot {
 			if err := foreignKey.ValidateTableSchema(tblSch); err != nil {
 				return nil, err
 			}
+			if _, ok := ghostTables[foreignKey.ReferencedTableName]; ok {
+				continue
+			}
 			parentSch, existsInRoot := allTablesSet[foreignKey.ReferencedTableName]
 			if !existsInRoot {
 				return nil, fmt.Errorf("foreign key `%s` requires the referenced table `%s`", foreignKey.Name, foreignKey.ReferencedTableName)
@@ -0,0 +1,110 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doltdb
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// SparseCheckout is a compiled set of table name patterns which limits the tables of a working set that are shown in
+// SHOW TABLES, status, and diffs, and which are merged row by row. The patterns use the same syntax as dolt_ignore,
+// where * and % match any sequence of characters and ? matches a single character, but match case-insensitively.
+// System tables are always part of a sparse checkout.
+type SparseCheckout struct {
+	patterns []string
+	regexps  []*regexp.Regexp
+}
+
+// NewSparseCheckout compiles the sparse checkout table name |patterns| given.
+func NewSparseCheckout(patterns []string) (*SparseCheckout, error) {
+	sc := &SparseCheckout{
+		patterns: patterns,
+		regexps:  make([]*regexp.Regexp, len(patterns)),
+	}
+	for i, pattern := range patterns {
+		if len(strings.TrimSpace(pattern)) == 0 {
+			return nil, fmt.Errorf("invalid sparse checkout pattern: pattern cannot be empty")
+		}
+		re, err := compilePattern(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sparse checkout pattern '%s': %w", pattern, err)
+		}
+		sc.regexps[i], err = regexp.Compile("(?i)" + re.String())
+		if err != nil {
+			return nil, err
+		}
+	}
+	return sc, nil
+}
+
+// Patterns returns the table name patterns of this sparse checkout.
+func (sc *SparseCheckout) Patterns() []string {
+	if sc == nil {
+		return nil
+	}
+	return sc.patterns
+}
+
+// Includes returns whether the table named is part of this sparse checkout. Every table is part of a nil sparse
+// checkout.
+func (sc *SparseCheckout) Includes(name TableName) bool {
+	if sc == nil || HasDoltPrefix(name.Name) || HasDoltCIPrefix(name.Name) {
+		return true
+	}
+	for _, re := range sc.regexps {
+		if re.MatchString(name.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterTableNames returns the subset of |names| which are part of this sparse checkout.
+func (sc *SparseCheckout) FilterTableNames(names []TableName) []TableName {
+	if sc == nil {
+		return names
+	}
+	filtered := make([]TableName, 0, len(names))
+	for _, name := range names {
+		if sc.Includes(name) {
+			filtered = append(filtered, name)
+		}
+	}
+	return filtered
+}
+
+// NormalizeSparsePatterns trims and deduplicates the sparse checkout table name |patterns| given, and validates that
+// each of them compiles.
+func NormalizeSparsePatterns(patterns []string) ([]string, error) {
+	var normalized []string
+	seen := make(map[string]struct{})
+	for _, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+		if len(pattern) == 0 {
+			continue
+		}
+		if _, ok := seen[strings.ToLower(pattern)]; ok {
+			continue
+		}
+		seen[strings.ToLower(pattern)] = struct{}{}
+		normalized = append(normalized, pattern)
+	}
+	if _, err := NewSparseCheckout(normalized); err != nil {
+		return nil, err
+	}
+	return normalized, nil
+}
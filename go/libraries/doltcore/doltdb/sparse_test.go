@@ -0,0 +1,76 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doltdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSparseCheckoutIncludes(t *testing.T) {
+	sc, err := NewSparseCheckout([]string{"t1", "sales_*", "log?"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		included bool
+	}{
+		{"t1", true},
+		{"T1", true},
+		{"t2", false},
+		{"sales_2024", true},
+		{"SALES_eu", true},
+		{"sales", false},
+		{"log1", true},
+		{"log10", false},
+		{"dolt_schemas", true},
+		{"dolt_ci_workflows", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.included, sc.Includes(TableName{Name: test.name}))
+		})
+	}
+
+	var nilSparse *SparseCheckout
+	assert.True(t, nilSparse.Includes(TableName{Name: "t2"}))
+	assert.Equal(t, []TableName{{Name: "t1"}, {Name: "dolt_docs"}},
+		sc.FilterTableNames([]TableName{{Name: "t1"}, {Name: "t2"}, {Name: "dolt_docs"}}))
+}
+
+func TestNormalizeSparsePatterns(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		expected []string
+	}{
+		{"single pattern", []string{"t1"}, []string{"t1"}},
+		{"whitespace", []string{" t1 ", "t2 "}, []string{"t1", "t2"}},
+		{"duplicates", []string{"t1", "T1", "t*", "t1"}, []string{"t1", "t*"}},
+		{"empty entries", []string{"t1", "", " "}, []string{"t1"}},
+		{"empty", nil, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := NormalizeSparsePatterns(test.patterns)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -191,6 +191,7 @@ var getWriteableSystemTables = func() []string {
 		SchemasTableName,
 		ProceduresTableName,
 		IgnoreTableName,
+		MergeRulesTableName,
 		GetRebaseTableName(),
 
 		// TODO: find way to make these writable by the dolt process
@@ -203,6 +204,9 @@ var getWriteableSystemTables = func() []string {
 		WorkflowStepsTableName,
 		WorkflowSavedQueryStepsTableName,
 		WorkflowSavedQueryStepExpectedRowColumnResultsTableName,
+		WorkflowSchemaAssertionStepsTableName,
+		WorkflowDiffBudgetStepsTableName,
+		WorkflowVerifyConstraintsStepsTableName,
 	}
 }
 
@@ -439,11 +443,20 @@ const (
 	// IgnoreTableName is the ignore table name
 	IgnoreTableName = "dolt_ignore"
 
+	// MergeRulesTableName is the name of the system table declaring how conflicting cells are resolved during merges
+	MergeRulesTableName = "dolt_merge_rules"
+
 	// RebaseTableName is the rebase system table name.
 	RebaseTableName = "dolt_rebase"
 
 	// StatisticsTableName is the statistics system table name
 	StatisticsTableName = "dolt_statistics"
+
+	// WebhookDeliveriesTableName is the name of the read-only system table showing the status of webhook deliveries
+	WebhookDeliveriesTableName = "dolt_webhook_deliveries"
+
+	// SparseTableName is the name of the system table holding the table name patterns of a sparse checkout
+	SparseTableName = "dolt_sparse"
 )
 
 const (
@@ -581,6 +594,57 @@ const (
 
 	// WorkflowSavedQueryStepExpectedRowColumnResultsUpdatedAtColName is the name of the updated at column on the workflow saved query step expected row column results table
 	WorkflowSavedQueryStepExpectedRowColumnResultsUpdatedAtColName = "updated_at"
+
+	// WorkflowSchemaAssertionStepsTableName is the name of the workflow schema assertion steps table name
+	WorkflowSchemaAssertionStepsTableName = "dolt_ci_workflow_schema_assertion_steps"
+
+	// WorkflowSchemaAssertionStepsIdPkColName is the name of the id column on the workflow schema assertion steps table
+	WorkflowSchemaAssertionStepsIdPkColName = "id"
+
+	// WorkflowSchemaAssertionStepsWorkflowStepIdFkColName is the name of the workflow step id foreign key column on the workflow schema assertion steps table
+	WorkflowSchemaAssertionStepsWorkflowStepIdFkColName = "workflow_step_id_fk"
+
+	// WorkflowSchemaAssertionStepsTableNameColName is the name of the asserted table name column on the workflow schema assertion steps table
+	WorkflowSchemaAssertionStepsTableNameColName = "table_name"
+
+	// WorkflowSchemaAssertionStepsColumnNameColName is the name of the asserted column name column on the workflow schema assertion steps table
+	WorkflowSchemaAssertionStepsColumnNameColName = "column_name"
+
+	// WorkflowSchemaAssertionStepsColumnTypeColName is the name of the asserted column type column on the workflow schema assertion steps table
+	WorkflowSchemaAssertionStepsColumnTypeColName = "column_type"
+
+	// WorkflowDiffBudgetStepsTableName is the name of the workflow diff budget steps table name
+	WorkflowDiffBudgetStepsTableName = "dolt_ci_workflow_diff_budget_steps"
+
+	// WorkflowDiffBudgetStepsIdPkColName is the name of the id column on the workflow diff budget steps table
+	WorkflowDiffBudgetStepsIdPkColName = "id"
+
+	// WorkflowDiffBudgetStepsWorkflowStepIdFkColName is the name of the workflow step id foreign key column on the workflow diff budget steps table
+	WorkflowDiffBudgetStepsWorkflowStepIdFkColName = "workflow_step_id_fk"
+
+	// WorkflowDiffBudgetStepsTableNameColName is the name of the budgeted table name column on the workflow diff budget steps table
+	WorkflowDiffBudgetStepsTableNameColName = "table_name"
+
+	// WorkflowDiffBudgetStepsBaseRefColName is the name of the base ref column on the workflow diff budget steps table
+	WorkflowDiffBudgetStepsBaseRefColName = "base_ref"
+
+	// WorkflowDiffBudgetStepsMaxRowsChangedColName is the name of the max rows changed column on the workflow diff budget steps table
+	WorkflowDiffBudgetStepsMaxRowsChangedColName = "max_rows_changed"
+
+	// WorkflowVerifyConstraintsStepsTableName is the name of the workflow verify constraints steps table name
+	WorkflowVerifyConstraintsStepsTableName = "dolt_ci_workflow_verify_constraints_steps"
+
+	// WorkflowVerifyConstraintsStepsIdPkColName is the name of the id column on the workflow verify constraints steps table
+	WorkflowVerifyConstraintsStepsIdPkColName = "id"
+
+	// WorkflowVerifyConstraintsStepsWorkflowStepIdFkColName is the name of the workflow step id foreign key column on the workflow verify constraints steps table
+	WorkflowVerifyConstraintsStepsWorkflowStepIdFkColName = "workflow_step_id_fk"
+
+	// WorkflowVerifyConstraintsStepsTableNamesColName is the name of the comma separated table names column on the workflow verify constraints steps table
+	WorkflowVerifyConstraintsStepsTableNamesColName = "table_names"
+
+	// CIRunsTableName is the name of the read-only system table showing the dolt CI workflow runs triggered by branch updates
+	CIRunsTableName = "dolt_ci_runs"
 )
 
 const (
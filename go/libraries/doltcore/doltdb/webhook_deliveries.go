@@ -0,0 +1,109 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doltdb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	// WebhookDeliveryStatusPending is the status of a delivery which is queued or being retried.
+	WebhookDeliveryStatusPending = "pending"
+	// WebhookDeliveryStatusDelivered is the status of a delivery which the endpoint accepted with a 2xx response.
+	WebhookDeliveryStatusDelivered = "delivered"
+	// WebhookDeliveryStatusFailed is the status of a delivery which was abandoned after exhausting its retries.
+	WebhookDeliveryStatusFailed = "failed"
+	// WebhookDeliveryStatusDropped is the status of a delivery which was discarded because the webhook's queue was full.
+	WebhookDeliveryStatusDropped = "dropped"
+)
+
+// webhookDeliveriesKey is the key of the tuple which stores the WebhookDeliveries of a database.
+const webhookDeliveriesKey = "webhook_deliveries"
+
+// maxWebhookDeliveries is the number of webhook deliveries retained for each database. Older deliveries are discarded
+// first.
+const maxWebhookDeliveries = 1024
+
+// WebhookDelivery is a record of a webhook notification of a branch head update.
+type WebhookDelivery struct {
+	DeliveryId   string    `json:"delivery_id"`
+	Webhook      string    `json:"webhook"`
+	Branch       string    `json:"branch"`
+	OldCommit    string    `json:"old_commit,omitempty"`
+	NewCommit    string    `json:"new_commit,omitempty"`
+	Status       string    `json:"status"`
+	Attempts     int       `json:"attempts"`
+	ResponseCode int       `json:"response_code,omitempty"`
+	Error        string    `json:"error,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// WebhookDeliveries is the list of webhook deliveries recorded in a database, oldest first.
+type WebhookDeliveries []WebhookDelivery
+
+// GetWebhookDeliveries returns the webhook deliveries recorded in this database. Like workflow runs, they are stored
+// in the database itself, outside of any branch, so they are kept across restarts and are not pushed, pulled or cloned.
+func (ddb *DoltDB) GetWebhookDeliveries(ctx context.Context) (WebhookDeliveries, error) {
+	data, ok, err := ddb.GetTuple(ctx, webhookDeliveriesKey)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	var deliveries WebhookDeliveries
+	if err = json.Unmarshal(data, &deliveries); err != nil {
+		return nil, fmt.Errorf("unable to read the webhook deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+// RecordWebhookDeliveries records each of |deliveries| in this database. A delivery replaces the recorded delivery with
+// the same DeliveryId, and is added after the others if there is none. The oldest deliveries are discarded once the
+// maximum number of deliveries are recorded. Deliveries are recorded by a single thread, so concurrent calls are not
+// supported.
+func (ddb *DoltDB) RecordWebhookDeliveries(ctx context.Context, deliveries ...WebhookDelivery) error {
+	recorded, err := ddb.GetWebhookDeliveries(ctx)
+	if err != nil {
+		return err
+	}
+
+	idx := make(map[string]int, len(recorded))
+	for i, d := range recorded {
+		idx[d.DeliveryId] = i
+	}
+	for _, d := range deliveries {
+		if i, ok := idx[d.DeliveryId]; ok {
+			recorded[i] = d
+			continue
+		}
+		idx[d.DeliveryId] = len(recorded)
+		recorded = append(recorded, d)
+	}
+	if len(recorded) > maxWebhookDeliveries {
+		recorded = recorded[len(recorded)-maxWebhookDeliveries:]
+	}
+
+	data, err := json.Marshal(recorded)
+	if err != nil {
+		return err
+	}
+	return ddb.SetTuple(ctx, webhookDeliveriesKey, data)
+}
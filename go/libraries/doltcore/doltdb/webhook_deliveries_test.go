@@ -0,0 +1,61 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doltdb
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/utils/filesys"
+	"github.com/dolthub/dolt/go/store/types"
+)
+
+func TestWebhookDeliveries(t *testing.T) {
+	ctx := context.Background()
+	ddb, err := LoadDoltDB(ctx, types.Format_Default, InMemDoltDB, filesys.LocalFS)
+	require.NoError(t, err)
+	defer ddb.Close()
+	require.NoError(t, ddb.WriteEmptyRepo(ctx, "main", "Bill Billerson", "bigbillieb@fake.horse"))
+
+	deliveries, err := ddb.GetWebhookDeliveries(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, deliveries)
+
+	require.NoError(t, ddb.RecordWebhookDeliveries(ctx,
+		WebhookDelivery{DeliveryId: "1", Webhook: "hook", Branch: "main", Status: WebhookDeliveryStatusPending},
+		WebhookDelivery{DeliveryId: "2", Webhook: "hook", Branch: "main", Status: WebhookDeliveryStatusPending}))
+	require.NoError(t, ddb.RecordWebhookDeliveries(ctx, WebhookDelivery{DeliveryId: "1", Webhook: "hook", Branch: "main", Status: WebhookDeliveryStatusDelivered, Attempts: 1, ResponseCode: 200}))
+	deliveries, err = ddb.GetWebhookDeliveries(ctx)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	assert.Equal(t, "1", deliveries[0].DeliveryId)
+	assert.Equal(t, WebhookDeliveryStatusDelivered, deliveries[0].Status)
+	assert.Equal(t, 200, deliveries[0].ResponseCode)
+	assert.Equal(t, WebhookDeliveryStatusPending, deliveries[1].Status)
+
+	// the oldest deliveries are discarded once the maximum number of deliveries are recorded
+	for i := 3; i <= maxWebhookDeliveries+1; i++ {
+		require.NoError(t, ddb.RecordWebhookDeliveries(ctx, WebhookDelivery{DeliveryId: strconv.Itoa(i), Status: WebhookDeliveryStatusPending}))
+	}
+	deliveries, err = ddb.GetWebhookDeliveries(ctx)
+	require.NoError(t, err)
+	require.Len(t, deliveries, maxWebhookDeliveries)
+	assert.Equal(t, "2", deliveries[0].DeliveryId)
+	assert.Equal(t, strconv.Itoa(maxWebhookDeliveries+1), deliveries[len(deliveries)-1].DeliveryId)
+}
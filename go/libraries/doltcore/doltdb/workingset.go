@@ -52,6 +52,30 @@ const (
 	StopOnEmptyCommit
 )
 
+// RebaseStopReason describes why execution of a rebase plan was paused after attempting a step, to let the user take
+// additional action before continuing the rebase. Rebase steps that stop with conflicts are not recorded with a stop
+// reason, since the conflicts themselves are recorded in the working set.
+type RebaseStopReason uint8
+
+const (
+	// RebaseNotStopped indicates that the last attempted rebase step did not pause the rebase.
+	RebaseNotStopped RebaseStopReason = iota
+
+	// RebaseStoppedForEdit indicates that the last attempted rebase step was an edit action, which was applied, so
+	// that its commit can be amended before continuing the rebase.
+	RebaseStoppedForEdit
+
+	// RebaseStoppedForBreak indicates that the last attempted rebase step was a break action.
+	RebaseStoppedForBreak
+
+	// RebaseStoppedForFailedExec indicates that the last attempted rebase step was an exec action whose query failed.
+	RebaseStoppedForFailedExec
+
+	// RebaseStoppedForEmptyCommit indicates that the commit of the last attempted rebase step became empty, and the
+	// rebase was started with StopOnEmptyCommit handling for commits that become empty.
+	RebaseStoppedForEmptyCommit
+)
+
 // RebaseState tracks the state of an in-progress rebase action. It records the name of the branch being rebased, the
 // commit onto which the new commits will be rebased, and the root value of the previous working set, which is used if
 // the rebase is aborted and the working set needs to be restored to its previous state.
@@ -75,6 +99,10 @@ type RebaseState struct {
 	// rebasingStarted is true once the rebase plan has been started to execute. Once rebasingStarted is true, the
 	// value in lastAttemptedStep has been initialized and is valid to read.
 	rebasingStarted bool
+
+	// stopReason records why execution of the rebase plan was paused after lastAttemptedStep, so that the rebase can
+	// be continued correctly, even after a server restart.
+	stopReason RebaseStopReason
 }
 
 // Branch returns the name of the branch being actively rebased. This is the branch that will be updated to point
@@ -120,6 +148,17 @@ func (rs RebaseState) WithRebasingStarted(rebasingStarted bool) *RebaseState {
 	return &rs
 }
 
+// StopReason returns why execution of the rebase plan was paused after the last attempted step, or
+// RebaseNotStopped if the rebase plan was not paused by the last attempted step.
+func (rs RebaseState) StopReason() RebaseStopReason {
+	return rs.stopReason
+}
+
+func (rs RebaseState) WithStopReason(stopReason RebaseStopReason) *RebaseState {
+	rs.stopReason = stopReason
+	return &rs
+}
+
 type MergeState struct {
 	// the source commit
 	commit *Commit
@@ -265,6 +304,9 @@ type WorkingSet struct {
 	stagedRoot  RootValue
 	mergeState  *MergeState
 	rebaseState *RebaseState
+	// sparseTables holds the table name patterns of a sparse checkout of this working set, or nil if every table is
+	// checked out.
+	sparseTables []string
 }
 
 var _ Rootish = &WorkingSet{}
@@ -296,6 +338,16 @@ func (ws WorkingSet) WithRebaseState(rebaseState *RebaseState) *WorkingSet {
 	return &ws
 }
 
+// WithSparseTables returns a copy of this working set with the sparse checkout table patterns given. A nil or empty
+// slice of patterns disables the sparse checkout.
+func (ws WorkingSet) WithSparseTables(patterns []string) *WorkingSet {
+	if len(patterns) == 0 {
+		patterns = nil
+	}
+	ws.sparseTables = patterns
+	return &ws
+}
+
 func (ws WorkingSet) WithUnmergableTables(tables []TableName) *WorkingSet {
 	ws.mergeState.unmergableTables = tables
 	return &ws
@@ -395,6 +447,20 @@ func (ws *WorkingSet) RebaseState() *RebaseState {
 	return ws.rebaseState
 }
 
+// SparseTables returns the table name patterns of the sparse checkout of this working set, or nil if every table is
+// checked out.
+func (ws *WorkingSet) SparseTables() []string {
+	return ws.sparseTables
+}
+
+// SparseCheckout returns the compiled sparse checkout of this working set, or nil if every table is checked out.
+func (ws *WorkingSet) SparseCheckout() (*SparseCheckout, error) {
+	if ws == nil || len(ws.sparseTables) == 0 {
+		return nil, nil
+	}
+	return NewSparseCheckout(ws.sparseTables)
+}
+
 func (ws *WorkingSet) MergeActive() bool {
 	return ws.mergeState != nil
 }
@@ -548,19 +614,21 @@ func newWorkingSet(ctx context.Context, name string, vrw types.ValueReadWriter,
 			emptyCommitHandling:        EmptyCommitHandling(dsws.RebaseState.EmptyCommitHandling(ctx)),
 			lastAttemptedStep:          dsws.RebaseState.LastAttemptedStep(ctx),
 			rebasingStarted:            dsws.RebaseState.RebasingStarted(ctx),
+			stopReason:                 RebaseStopReason(dsws.RebaseState.StopReason(ctx)),
 		}
 	}
 
 	addr, _ := ds.MaybeHeadAddr()
 
 	return &WorkingSet{
-		Name:        name,
-		meta:        meta,
-		addr:        &addr,
-		workingRoot: workingRoot,
-		stagedRoot:  stagedRoot,
-		mergeState:  mergeState,
-		rebaseState: rebaseState,
+		Name:         name,
+		meta:         meta,
+		addr:         &addr,
+		workingRoot:  workingRoot,
+		stagedRoot:   stagedRoot,
+		mergeState:   mergeState,
+		rebaseState:  rebaseState,
+		sparseTables: dsws.SparseTables,
 	}, nil
 }
 
@@ -645,14 +713,15 @@ func (ws *WorkingSet) writeValues(ctx context.Context, db *DoltDB, meta *datas.W
 
 		rebaseState = datas.NewRebaseState(preRebaseWorking.TargetHash(), dCommit.Addr(), ws.rebaseState.branch,
 			uint8(ws.rebaseState.commitBecomesEmptyHandling), uint8(ws.rebaseState.emptyCommitHandling),
-			ws.rebaseState.lastAttemptedStep, ws.rebaseState.rebasingStarted)
+			ws.rebaseState.lastAttemptedStep, ws.rebaseState.rebasingStarted, uint8(ws.rebaseState.stopReason))
 	}
 
 	return &datas.WorkingSetSpec{
-		Meta:        meta,
-		WorkingRoot: workingRoot,
-		StagedRoot:  stagedRoot,
-		MergeState:  mergeState,
-		RebaseState: rebaseState,
+		Meta:         meta,
+		WorkingRoot:  workingRoot,
+		StagedRoot:   stagedRoot,
+		MergeState:   mergeState,
+		RebaseState:  rebaseState,
+		SparseTables: ws.sparseTables,
 	}, nil
 }
@@ -0,0 +1,221 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/store/datas/pull"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+// backupChainKey is the key of the tuple in a backup which stores its BackupChain.
+const backupChainKey = "backup_chain"
+
+// BackupTableFile is a table file added to a backup by one of its syncs.
+type BackupTableFile struct {
+	ID        string `json:"id"`
+	NumChunks int    `json:"num_chunks"`
+}
+
+// BackupChainEntry records a single sync of a database to a backup: the store root it synced, and the table files it
+// added to the backup. Every chunk of a backup's root is in the table files added by it or by an earlier backup.
+type BackupChainEntry struct {
+	ID         int               `json:"id"`
+	Time       time.Time         `json:"time"`
+	Root       string            `json:"root"`
+	TableFiles []BackupTableFile `json:"table_files"`
+}
+
+// BackupChain is the list of syncs to a backup, oldest first.
+type BackupChain []BackupChainEntry
+
+// Last returns the most recent entry of the chain, if there is one.
+func (c BackupChain) Last() (BackupChainEntry, bool) {
+	if len(c) == 0 {
+		return BackupChainEntry{}, false
+	}
+	return c[len(c)-1], true
+}
+
+// LoadBackupChain returns the chain of syncs recorded in |backupDb|. Backups synced by older versions of Dolt have no
+// chain, and an empty chain is returned for them.
+func LoadBackupChain(ctx context.Context, backupDb *doltdb.DoltDB) (BackupChain, error) {
+	data, ok, err := backupDb.GetTuple(ctx, backupChainKey)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	var chain BackupChain
+	if err = json.Unmarshal(data, &chain); err != nil {
+		return nil, fmt.Errorf("unable to read the backup chain: %w", err)
+	}
+	return chain, nil
+}
+
+func writeBackupChain(ctx context.Context, backupDb *doltdb.DoltDB, chain BackupChain) error {
+	data, err := json.Marshal(chain)
+	if err != nil {
+		return err
+	}
+	return backupDb.SetTuple(ctx, backupChainKey, data)
+}
+
+// SyncBackup incrementally syncs the roots of |srcDb| to |backupDb|, copying only the chunks the backup does not
+// already have, and appends an entry for the sync to the backup's chain. Returns pull.ErrDBUpToDate if the roots of
+// |srcDb| have not changed since the last sync.
+func SyncBackup(ctx context.Context, srcDb, backupDb *doltdb.DoltDB, tempTableDir string, progStarter ProgStarter, progStopper ProgStopper) (BackupChainEntry, error) {
+	chain, err := LoadBackupChain(ctx, backupDb)
+	if err != nil {
+		return BackupChainEntry{}, err
+	}
+
+	srcRoot, err := srcDb.NomsRoot(ctx)
+	if err != nil {
+		return BackupChainEntry{}, err
+	}
+	last, ok := chain.Last()
+	if ok && last.Root == srcRoot.String() {
+		return last, pull.ErrDBUpToDate
+	}
+
+	before, err := backupDb.TableFiles(ctx)
+	if err != nil {
+		return BackupChainEntry{}, err
+	}
+
+	err = SyncRoots(ctx, srcDb, backupDb, tempTableDir, progStarter, progStopper)
+	if err != nil && err != pull.ErrDBUpToDate {
+		return BackupChainEntry{}, err
+	}
+
+	// SyncRoots sets the root of the backup to the root of |srcDb| it read, which may differ from |srcRoot| if |srcDb|
+	// was written to since
+	syncedRoot, err := backupDb.NomsRoot(ctx)
+	if err != nil {
+		return BackupChainEntry{}, err
+	}
+	after, err := backupDb.TableFiles(ctx)
+	if err != nil {
+		return BackupChainEntry{}, err
+	}
+
+	existing := make(map[string]struct{}, len(before))
+	for _, tf := range before {
+		existing[tf.FileID()] = struct{}{}
+	}
+	entry := BackupChainEntry{
+		ID:         last.ID + 1,
+		Time:       time.Now().UTC(),
+		Root:       syncedRoot.String(),
+		TableFiles: []BackupTableFile{},
+	}
+	for _, tf := range after {
+		if _, ok := existing[tf.FileID()]; !ok {
+			entry.TableFiles = append(entry.TableFiles, BackupTableFile{ID: tf.FileID(), NumChunks: tf.NumChunks()})
+		}
+	}
+
+	return entry, writeBackupChain(ctx, backupDb, append(chain, entry))
+}
+
+// VerifyBackup checks that every chunk reachable from the root of every backup in the chain of |backupDb| exists and
+// hashes to its address, and returns the chain that was verified. For backups with no chain, the current root of the
+// backup is verified instead.
+func VerifyBackup(ctx context.Context, backupDb *doltdb.DoltDB) (BackupChain, error) {
+	chain, err := LoadBackupChain(ctx, backupDb)
+	if err != nil {
+		return nil, err
+	}
+
+	visited := make(hash.HashSet)
+	if len(chain) == 0 {
+		root, err := backupDb.NomsRoot(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if root.IsEmpty() {
+			return nil, errors.New("the backup is empty")
+		}
+		if err = backupDb.VerifyChunks(ctx, root, visited); err != nil {
+			return nil, fmt.Errorf("backup failed verification: %w", err)
+		}
+		return nil, nil
+	}
+
+	for _, entry := range chain {
+		if err = backupDb.VerifyChunks(ctx, hash.Parse(entry.Root), visited); err != nil {
+			return nil, fmt.Errorf("backup %d failed verification: %w", entry.ID, err)
+		}
+	}
+	return chain, nil
+}
+
+// PruneBackups removes all but the |keepLast| most recent backups from the chain of |backupDb|, and then garbage
+// collects the chunks which are only reachable from the roots of the removed backups. Returns the removed entries.
+// Backups whose storage can't be reclaimed, such as those in aws, gs or oci, can't be pruned.
+func PruneBackups(ctx context.Context, backupDb *doltdb.DoltDB, keepLast int) (BackupChain, error) {
+	if keepLast < 1 {
+		return nil, errors.New("at least one backup must be kept")
+	}
+	if !backupDb.CanReclaimStorage() {
+		return nil, errors.New("pruning is only supported for backups on the local filesystem")
+	}
+
+	chain, err := LoadBackupChain(ctx, backupDb)
+	if err != nil {
+		return nil, err
+	}
+	if len(chain) <= keepLast {
+		return nil, nil
+	}
+
+	pruned, kept := chain[:len(chain)-keepLast], chain[len(chain)-keepLast:]
+	roots := make([]hash.Hash, len(kept))
+	for i, entry := range kept {
+		roots[i] = hash.Parse(entry.Root)
+	}
+	if err = backupDb.GCRetainingRoots(ctx, roots); err != nil {
+		return nil, err
+	}
+
+	// garbage collection rewrites every chunk that is kept into new table files, so the table files recorded by the
+	// kept entries no longer exist. All of the new table files are attributed to the oldest kept entry, whose root
+	// needs their chunks along with every later root.
+	tableFiles, err := backupDb.TableFiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	kept = append(BackupChain(nil), kept...)
+	for i := range kept {
+		kept[i].TableFiles = []BackupTableFile{}
+	}
+	for _, tf := range tableFiles {
+		kept[0].TableFiles = append(kept[0].TableFiles, BackupTableFile{ID: tf.FileID(), NumChunks: tf.NumChunks()})
+	}
+
+	if err = writeBackupChain(ctx, backupDb, kept); err != nil {
+		return nil, err
+	}
+	return pruned, nil
+}
@@ -180,13 +180,28 @@ func CloneRemote(ctx context.Context, srcDB *doltdb.DoltDB, remoteName, branch s
 		remoteName = "origin"
 	}
 
+	remotes, err := dEnv.GetRemotes()
+	if err != nil {
+		return err
+	}
+	remote, _ := remotes.Get(remoteName)
+	if remote.IsPartial() {
+		err = validatePartialCloneTables(ctx, srcDB, remote, branch)
+		if err != nil {
+			return fmt.Errorf("%w; %s", ErrCloneFailed, err.Error())
+		}
+	}
+
 	var checkedOutCommit *doltdb.Commit
 
-	// Step 1) Pull the remote information we care about to a local disk.
-	if depth <= 0 {
-		checkedOutCommit, err = fullClone(ctx, srcDB, dEnv, srcRefHashes, branch, remoteName, singleBranch)
-	} else {
+	// Step 1) Pull the remote information we care about to a local disk. A partial clone can't copy the remote's
+	// table files wholesale, so it fetches in the same manner as a shallow clone.
+	if depth > 0 {
 		checkedOutCommit, err = shallowCloneDataPull(ctx, dEnv.DbData(ctx), srcDB, remoteName, branch, depth)
+	} else if remote.IsPartial() {
+		checkedOutCommit, err = partialCloneDataPull(ctx, dEnv.DbData(ctx), srcDB, remoteName, branch, singleBranch)
+	} else {
+		checkedOutCommit, err = fullClone(ctx, srcDB, dEnv, srcRefHashes, branch, remoteName, singleBranch)
 	}
 
 	if err != nil {
@@ -342,6 +357,41 @@ func shallowCloneDataPull(ctx context.Context, destData env.DbData, srcDB *doltd
 	}
 
 	// After the fetch approach, we just need to create the local branch. The single remote branch already exists.
+	return createClonedBranch(ctx, destData, srcDB, branch)
+}
+
+// partialCloneDataPull is a partial clone specific helper function to pull only the data of the tables included in the
+// partial clone, for either the given branch or every branch of the remote.
+func partialCloneDataPull(ctx context.Context, destData env.DbData, srcDB *doltdb.DoltDB, remoteName, branch string, singleBranch bool) (*doltdb.Commit, error) {
+	remotes, err := destData.Rsr.GetRemotes()
+	if err != nil {
+		return nil, err
+	}
+	remote, ok := remotes.Get(remoteName)
+	if !ok {
+		// By the time we get to this point, the remote should be created, so this should never happen.
+		return nil, fmt.Errorf("remote %s not found", remoteName)
+	}
+
+	var args []string
+	if singleBranch {
+		args = []string{branch}
+	}
+	specs, defaultSpecs, err := env.ParseRefSpecs(args, destData.Rsr, remote)
+	if err != nil {
+		return nil, err
+	}
+
+	err = FetchRefSpecs(ctx, destData, srcDB, specs, defaultSpecs, &remote, ref.ForceUpdate, NoopRunProgFuncs, NoopStopProgFuncs)
+	if err != nil {
+		return nil, err
+	}
+
+	return createClonedBranch(ctx, destData, srcDB, branch)
+}
+
+// createClonedBranch creates the local branch checked out by a clone which was performed by fetching from the remote.
+func createClonedBranch(ctx context.Context, destData env.DbData, srcDB *doltdb.DoltDB, branch string) (*doltdb.Commit, error) {
 	br := ref.NewBranchRef(branch)
 
 	cmt, err := srcDB.ResolveCommitRef(ctx, br)
@@ -28,9 +28,12 @@ import (
 // WrappedTableName is a struct that wraps a doltdb.TableName
 // and specifies whether the tables should still be created.
 // Deprecated tables will have Deprecated: true
+// Tables added after dolt ci was first released will have Optional: true.
+// They are not required to exist, and are created when a workflow is stored.
 type WrappedTableName struct {
 	TableName  doltdb.TableName
 	Deprecated bool
+	Optional   bool
 }
 
 type WrappedTableNameSlice []WrappedTableName
@@ -45,6 +48,17 @@ func (w WrappedTableNameSlice) ActiveTableNames() []doltdb.TableName {
 	return tableNames
 }
 
+// RequiredTableNames returns the active table names which every database with dolt ci tables must have.
+func (w WrappedTableNameSlice) RequiredTableNames() []doltdb.TableName {
+	tableNames := make([]doltdb.TableName, 0)
+	for _, wrapt := range w {
+		if !wrapt.Deprecated && !wrapt.Optional {
+			tableNames = append(tableNames, wrapt.TableName)
+		}
+	}
+	return tableNames
+}
+
 // ExpectedDoltCITablesOrdered contains the tables names for the dolt ci workflow tables, in parent to child table order.
 // This is exported for use in DoltHub/DoltLab.
 var ExpectedDoltCITablesOrdered = WrappedTableNameSlice{
@@ -57,6 +71,16 @@ var ExpectedDoltCITablesOrdered = WrappedTableNameSlice{
 	{TableName: doltdb.TableName{Name: doltdb.WorkflowStepsTableName}},
 	{TableName: doltdb.TableName{Name: doltdb.WorkflowSavedQueryStepsTableName}},
 	{TableName: doltdb.TableName{Name: doltdb.WorkflowSavedQueryStepExpectedRowColumnResultsTableName}},
+	{TableName: doltdb.TableName{Name: doltdb.WorkflowSchemaAssertionStepsTableName}, Optional: true},
+	{TableName: doltdb.TableName{Name: doltdb.WorkflowDiffBudgetStepsTableName}, Optional: true},
+	{TableName: doltdb.TableName{Name: doltdb.WorkflowVerifyConstraintsStepsTableName}, Optional: true},
+}
+
+// optionalDoltCITableCreateQueries maps the name of each optional dolt ci table to the query which creates it.
+var optionalDoltCITableCreateQueries = map[string]func() string{
+	doltdb.WorkflowSchemaAssertionStepsTableName:   createWorkflowSchemaAssertionStepsTableQuery,
+	doltdb.WorkflowDiffBudgetStepsTableName:        createWorkflowDiffBudgetStepsTableQuery,
+	doltdb.WorkflowVerifyConstraintsStepsTableName: createWorkflowVerifyConstraintsStepsTableQuery,
 }
 
 type queryFunc func(ctx *sql.Context, query string) (sql.Schema, sql.RowIter, *sql.QueryFlags, error)
@@ -71,8 +95,13 @@ func HasDoltCITables(ctx *sql.Context) (bool, error) {
 		return false, err
 	}
 
-	root := ws.WorkingRoot()
-	activeOnly := ExpectedDoltCITablesOrdered.ActiveTableNames()
+	return hasDoltCITablesInRoot(ctx, ws.WorkingRoot())
+}
+
+// hasDoltCITablesInRoot returns true if |root| contains the active dolt ci tables, and an error if it
+// contains only some of them.
+func hasDoltCITablesInRoot(ctx *sql.Context, root doltdb.RootValue) (bool, error) {
+	activeOnly := ExpectedDoltCITablesOrdered.RequiredTableNames()
 
 	exists := 0
 	var hasSome bool
@@ -122,6 +151,36 @@ func getExistingDoltCITables(ctx *sql.Context) ([]doltdb.TableName, error) {
 	return existing, nil
 }
 
+// createMissingOptionalDoltCITables creates the optional dolt ci tables which do not exist in the working set, for
+// databases whose dolt ci tables were initialized before those tables existed.
+func createMissingOptionalDoltCITables(ctx *sql.Context, queryFunc queryFunc) error {
+	existing, err := getExistingDoltCITables(ctx)
+	if err != nil {
+		return err
+	}
+
+	existingMap := make(map[string]struct{})
+	for _, tn := range existing {
+		existingMap[tn.Name] = struct{}{}
+	}
+
+	newCtx := doltdb.ContextWithDoltCICreateBypassKey(ctx)
+	for _, wrapt := range ExpectedDoltCITablesOrdered {
+		if !wrapt.Optional || wrapt.Deprecated {
+			continue
+		}
+		if _, ok := existingMap[wrapt.TableName.Name]; ok {
+			continue
+		}
+		err = sqlWriteQuery(newCtx, queryFunc, optionalDoltCITableCreateQueries[wrapt.TableName.Name]())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func sqlWriteQuery(ctx *sql.Context, queryFunc queryFunc, query string) error {
 	_, rowIter, _, err := queryFunc(ctx, query)
 	if err != nil {
@@ -205,6 +264,9 @@ func CreateDoltCITables(ctx *sql.Context, db sqle.Database, queryFunc queryFunc,
 		createWorkflowStepsTableQuery(),
 		createWorkflowSavedQueryStepsTableQuery(),
 		createWorkflowSavedQueryStepExpectedRowColumnResultsTableQuery(),
+		createWorkflowSchemaAssertionStepsTableQuery(),
+		createWorkflowDiffBudgetStepsTableQuery(),
+		createWorkflowVerifyConstraintsStepsTableQuery(),
 		deleteAllFromWorkflowsTableQuery(), // as last step run delete to create resolve all indexes/fks
 	}
 
@@ -252,6 +314,18 @@ func createWorkflowSavedQueryStepExpectedRowColumnResultsTableQuery() string {
 	return fmt.Sprintf("create table %s (`%s` varchar(36) primary key,`%s` int not null, `%s` int not null,`%s` bigint not null,`%s` bigint not null,`%s` datetime(6) not null,`%s` datetime(6) not null,`%s` varchar(36) not null, foreign key (`%s`) references %s (`%s`) on delete cascade);", doltdb.WorkflowSavedQueryStepExpectedRowColumnResultsTableName, doltdb.WorkflowSavedQueryStepExpectedRowColumnResultsIdPkColName, doltdb.WorkflowSavedQueryStepExpectedRowColumnResultsExpectedColumnCountComparisonTypeColName, doltdb.WorkflowSavedQueryStepExpectedRowColumnResultsExpectedRowCountComparisonTypeColName, doltdb.WorkflowSavedQueryStepExpectedRowColumnResultsExpectedColumnCountColName, doltdb.WorkflowSavedQueryStepExpectedRowColumnResultsExpectedRowCountColName, doltdb.WorkflowSavedQueryStepExpectedRowColumnResultsCreatedAtColName, doltdb.WorkflowSavedQueryStepExpectedRowColumnResultsUpdatedAtColName, doltdb.WorkflowSavedQueryStepExpectedRowColumnResultsSavedQueryStepIdFkColName, doltdb.WorkflowSavedQueryStepExpectedRowColumnResultsSavedQueryStepIdFkColName, doltdb.WorkflowSavedQueryStepsTableName, doltdb.WorkflowSavedQueryStepsIdPkColName)
 }
 
+func createWorkflowSchemaAssertionStepsTableQuery() string {
+	return fmt.Sprintf("create table %s (`%s` varchar(36) primary key, `%s` varchar(2048) collate utf8mb4_0900_ai_ci not null, `%s` varchar(2048) collate utf8mb4_0900_ai_ci, `%s` varchar(2048) collate utf8mb4_0900_ai_ci, `%s` varchar(36) not null, foreign key (`%s`) references %s (`%s`) on delete cascade);", doltdb.WorkflowSchemaAssertionStepsTableName, doltdb.WorkflowSchemaAssertionStepsIdPkColName, doltdb.WorkflowSchemaAssertionStepsTableNameColName, doltdb.WorkflowSchemaAssertionStepsColumnNameColName, doltdb.WorkflowSchemaAssertionStepsColumnTypeColName, doltdb.WorkflowSchemaAssertionStepsWorkflowStepIdFkColName, doltdb.WorkflowSchemaAssertionStepsWorkflowStepIdFkColName, doltdb.WorkflowStepsTableName, doltdb.WorkflowStepsIdPkColName)
+}
+
+func createWorkflowDiffBudgetStepsTableQuery() string {
+	return fmt.Sprintf("create table %s (`%s` varchar(36) primary key, `%s` varchar(2048) collate utf8mb4_0900_ai_ci not null, `%s` varchar(2048) collate utf8mb4_0900_ai_ci not null, `%s` bigint not null, `%s` varchar(36) not null, foreign key (`%s`) references %s (`%s`) on delete cascade);", doltdb.WorkflowDiffBudgetStepsTableName, doltdb.WorkflowDiffBudgetStepsIdPkColName, doltdb.WorkflowDiffBudgetStepsTableNameColName, doltdb.WorkflowDiffBudgetStepsBaseRefColName, doltdb.WorkflowDiffBudgetStepsMaxRowsChangedColName, doltdb.WorkflowDiffBudgetStepsWorkflowStepIdFkColName, doltdb.WorkflowDiffBudgetStepsWorkflowStepIdFkColName, doltdb.WorkflowStepsTableName, doltdb.WorkflowStepsIdPkColName)
+}
+
+func createWorkflowVerifyConstraintsStepsTableQuery() string {
+	return fmt.Sprintf("create table %s (`%s` varchar(36) primary key, `%s` text collate utf8mb4_0900_ai_ci, `%s` varchar(36) not null, foreign key (`%s`) references %s (`%s`) on delete cascade);", doltdb.WorkflowVerifyConstraintsStepsTableName, doltdb.WorkflowVerifyConstraintsStepsIdPkColName, doltdb.WorkflowVerifyConstraintsStepsTableNamesColName, doltdb.WorkflowVerifyConstraintsStepsWorkflowStepIdFkColName, doltdb.WorkflowVerifyConstraintsStepsWorkflowStepIdFkColName, doltdb.WorkflowStepsTableName, doltdb.WorkflowStepsIdPkColName)
+}
+
 func deleteAllFromWorkflowsTableQuery() string {
 	return fmt.Sprintf("delete from %s;", doltdb.WorkflowsTableName)
 }
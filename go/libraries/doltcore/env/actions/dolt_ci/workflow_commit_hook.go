@@ -0,0 +1,344 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dolt_ci
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+// pullRequestClosedActivity is the pull request activity that a merge into a branch corresponds to.
+const pullRequestClosedActivity = "closed"
+
+// workflowRunnerUser is the account that is used to run workflows. Anyone who can write a branch controls the queries
+// its workflows run, so this account may only read the database whose workflows are running. It is created
+// automatically, is never persisted, and is locked so that it cannot be used to login.
+const workflowRunnerUser = "dolt-ci-workflow-runner"
+
+// workflowHookQueueSize bounds the number of branch updates waiting to have their workflows run. Updates which
+// arrive while the queue is full are dropped and logged.
+const workflowHookQueueSize = 128
+
+// WorkflowHookController runs dolt_ci workflows in response to branch head updates in a running sql-server.
+//
+// A doltdb.CommitHook is installed on every database. When a branch head moves, the hook enqueues the update for a
+// single background thread, which reads the workflows stored at the new head commit, selects those with a push
+// event trigger for the branch (or a pull request trigger for the branch, if the new head is a merge commit), and
+// runs them against the new head commit. The results are recorded in the database, see doltdb.DoltDB.AddCIRun, and
+// are shown by its dolt_ci_runs system table.
+type WorkflowHookController struct {
+	workCh chan workflowHookWork
+	lgr    *logrus.Logger
+
+	ctxF      func(context.Context) (*sql.Context, error)
+	queryFunc queryFunc
+
+	mysqlDb *mysql_db.MySQLDb
+}
+
+// NewWorkflowHookController returns a new WorkflowHookController.
+func NewWorkflowHookController(lgr *logrus.Logger) *WorkflowHookController {
+	return &WorkflowHookController{
+		workCh: make(chan workflowHookWork, workflowHookQueueSize),
+		lgr:    lgr,
+	}
+}
+
+type workflowHookWork struct {
+	dbName string
+	ddb    *doltdb.DoltDB
+	branch string
+	commit hash.Hash
+}
+
+// RunBackgroundThread starts the thread which runs the workflows for enqueued branch updates. |queryFunc| is used to
+// run the queries of each workflow, as a locked, read-only user added to |mysqlDb|.
+func (c *WorkflowHookController) RunBackgroundThread(threads *sql.BackgroundThreads, ctxF func(context.Context) (*sql.Context, error), queryFunc queryFunc, mysqlDb *mysql_db.MySQLDb) error {
+	c.ctxF = ctxF
+	c.queryFunc = queryFunc
+	c.mysqlDb = mysqlDb
+	return threads.Add("dolt_ci_workflow_hook_thread", c.bgThread)
+}
+
+func (c *WorkflowHookController) bgThread(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case work := <-c.workCh:
+			c.doWork(ctx, work)
+		}
+	}
+}
+
+// ApplyCommitHooks is called during engine initialization to install the workflow commit hook on the original set
+// of databases.
+func (c *WorkflowHookController) ApplyCommitHooks(ctx context.Context, mrEnv *env.MultiRepoEnv, dbs ...dsess.SqlDatabase) error {
+	for _, db := range dbs {
+		denv := mrEnv.GetEnv(db.Name())
+		if denv == nil {
+			continue
+		}
+		ddb := denv.DoltDB(ctx)
+		ddb.PrependCommitHooks(ctx, c.newCommitHook(db.Name(), ddb))
+	}
+	return nil
+}
+
+// InitDatabaseHook returns a sqle.InitDatabaseHook which installs the workflow commit hook on new databases.
+func (c *WorkflowHookController) InitDatabaseHook() sqle.InitDatabaseHook {
+	return func(ctx *sql.Context, _ *sqle.DoltDatabaseProvider, name string, env *env.DoltEnv, _ dsess.SqlDatabase) error {
+		ddb := env.DoltDB(ctx)
+		ddb.PrependCommitHooks(ctx, c.newCommitHook(name, ddb))
+		return nil
+	}
+}
+
+func (c *WorkflowHookController) newCommitHook(name string, ddb *doltdb.DoltDB) *workflowCommitHook {
+	return &workflowCommitHook{c: c, name: name, ddb: ddb}
+}
+
+func (c *WorkflowHookController) enqueue(work workflowHookWork) {
+	select {
+	case c.workCh <- work:
+	default:
+		c.lgr.Warnf("dolt_ci: workflow queue is full, not running workflows for %s branch %s at commit %s", work.dbName, work.branch, work.commit.String())
+	}
+}
+
+func (c *WorkflowHookController) doWork(ctx context.Context, work workflowHookWork) {
+	sqlCtx, err := c.ctxF(ctx)
+	if err != nil {
+		c.lgr.Warnf("dolt_ci: could not create session to run workflows for %s: %v", work.dbName, err)
+		return
+	}
+	defer sql.SessionEnd(sqlCtx.Session)
+	sql.SessionCommandBegin(sqlCtx.Session)
+	defer sql.SessionCommandEnd(sqlCtx.Session)
+
+	err = c.runWorkflowsForBranchUpdate(sqlCtx, work)
+	if err != nil {
+		c.lgr.Warnf("dolt_ci: failed to run workflows for %s branch %s at commit %s: %v", work.dbName, work.branch, work.commit.String(), err)
+	}
+}
+
+func (c *WorkflowHookController) runWorkflowsForBranchUpdate(ctx *sql.Context, work workflowHookWork) error {
+	optCmt, err := work.ddb.ReadCommit(ctx, work.commit)
+	if err != nil {
+		return err
+	}
+	cm, ok := optCmt.ToCommit()
+	if !ok {
+		return doltdb.ErrGhostCommitEncountered
+	}
+
+	root, err := cm.GetRootValue(ctx)
+	if err != nil {
+		return err
+	}
+
+	hasTables, err := hasDoltCITablesInRoot(ctx, root)
+	if err != nil || !hasTables {
+		return err
+	}
+
+	isMerge := cm.NumParents() > 1
+
+	c.configureRunnerUser(work.dbName)
+	ctx.SetClient(sql.Client{
+		User:    workflowRunnerUser,
+		Address: "localhost",
+	})
+
+	// workflows are read from, and run against, the commit which triggered them
+	err = sqlWriteQuery(ctx, c.queryFunc, fmt.Sprintf("use `%s/%s`;", work.dbName, work.commit.String()))
+	if err != nil {
+		return err
+	}
+
+	wm := NewWorkflowManager("", "", c.queryFunc)
+	workflows, err := wm.listWorkflows(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, workflow := range workflows {
+		config, err := wm.getWorkflowConfig(ctx, string(*workflow.Name))
+		if err != nil {
+			return err
+		}
+
+		if !workflowTriggeredByBranchUpdate(config, work.branch, isMerge) {
+			continue
+		}
+
+		run := doltdb.CIRun{
+			RunId:     uuid.NewString(),
+			Workflow:  string(*workflow.Name),
+			Branch:    work.branch,
+			Commit:    work.commit.String(),
+			StartedAt: time.Now().UTC(),
+		}
+
+		result, err := wm.runWorkflow(ctx, string(*workflow.Name))
+		if err != nil {
+			run.Status = doltdb.CIRunStatusError
+			run.Output = err.Error()
+		} else {
+			run.Status = doltdb.CIRunStatusPassed
+			if !result.Passed() {
+				run.Status = doltdb.CIRunStatusFailed
+			}
+			run.FailingStep = result.FailingStep()
+			run.Output = result.Report()
+		}
+		run.FinishedAt = time.Now().UTC()
+
+		if err = work.ddb.AddCIRun(ctx, run); err != nil {
+			return err
+		}
+		c.lgr.Infof("dolt_ci: workflow %s %s for %s branch %s at commit %s", run.Workflow, run.Status, work.dbName, work.branch, run.Commit)
+	}
+
+	return nil
+}
+
+// configureRunnerUser sets up the locked account that workflows are run as, so that it may only read |dbName|.
+// Workflows run one at a time, so the account is granted access to the database of each run in turn. Any account of
+// the same name, such as the super user that earlier versions created, is replaced.
+func (c *WorkflowHookController) configureRunnerUser(dbName string) {
+	// like the super user accounts, the runner account turns on privilege checks if they are not already on
+	c.mysqlDb.SetEnabled(true)
+
+	privileges := mysql_db.NewPrivilegeSet()
+	privileges.AddDatabase(dbName, sql.PrivilegeType_Select)
+	// verify constraints steps call dolt_verify_constraints, the only procedure workflows may run
+	privileges.AddRoutine(dbName, "dolt_verify_constraints", true, sql.PrivilegeType_Execute)
+
+	ed := c.mysqlDb.Editor()
+	defer ed.Close()
+	ed.PutUser(&mysql_db.User{
+		User:                workflowRunnerUser,
+		Host:                "localhost",
+		PrivilegeSet:        privileges,
+		Plugin:              "mysql_native_password",
+		PasswordLastChanged: time.Unix(1, 0).UTC(),
+		Locked:              true,
+		IsEphemeral:         true,
+	})
+}
+
+// workflowTriggeredByBranchUpdate returns true if |config| has an event trigger matching an update to |branch|. Every
+// branch update matches push events, and updates that are merge commits additionally match pull request events.
+func workflowTriggeredByBranchUpdate(config *WorkflowConfig, branch string, isMerge bool) bool {
+	if config.On.Push != nil && branchesMatch(config.On.Push.Branches, branch) {
+		return true
+	}
+
+	if isMerge && config.On.PullRequest != nil && branchesMatch(config.On.PullRequest.Branches, branch) {
+		if len(config.On.PullRequest.Activities) == 0 {
+			return true
+		}
+		for _, activity := range config.On.PullRequest.Activities {
+			if strings.EqualFold(activity.Value, pullRequestClosedActivity) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// branchesMatch returns true if |branch| is one of |branches|, or if |branches| is empty.
+func branchesMatch(branches []yaml.Node, branch string) bool {
+	if len(branches) == 0 {
+		return true
+	}
+	for _, b := range branches {
+		if strings.EqualFold(b.Value, branch) {
+			return true
+		}
+	}
+	return false
+}
+
+// workflowCommitHook is the doltdb.CommitHook which enqueues branch head updates for the WorkflowHookController.
+type workflowCommitHook struct {
+	c    *WorkflowHookController
+	name string
+	ddb  *doltdb.DoltDB
+}
+
+var _ doltdb.CommitHook = (*workflowCommitHook)(nil)
+
+// Execute implements doltdb.CommitHook
+func (h *workflowCommitHook) Execute(ctx context.Context, ds datas.Dataset, db *doltdb.DoltDB) (func(context.Context) error, error) {
+	if !ref.IsRef(ds.ID()) {
+		return nil, nil
+	}
+	dref, err := ref.Parse(ds.ID())
+	if err != nil {
+		return nil, err
+	}
+	if dref.GetType() != ref.BranchRefType {
+		return nil, nil
+	}
+
+	addr, ok := ds.MaybeHeadAddr()
+	if !ok {
+		// the branch was deleted
+		return nil, nil
+	}
+
+	h.c.enqueue(workflowHookWork{
+		dbName: h.name,
+		ddb:    h.ddb,
+		branch: dref.GetPath(),
+		commit: addr,
+	})
+	return nil, nil
+}
+
+// HandleError implements doltdb.CommitHook
+func (h *workflowCommitHook) HandleError(ctx context.Context, err error) error {
+	return nil
+}
+
+// SetLogger implements doltdb.CommitHook
+func (h *workflowCommitHook) SetLogger(ctx context.Context, wr io.Writer) error {
+	return nil
+}
+
+// ExecuteForWorkingSets implements doltdb.CommitHook
+func (h *workflowCommitHook) ExecuteForWorkingSets() bool {
+	return false
+}
@@ -0,0 +1,52 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dolt_ci
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowTriggeredByBranchUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		on      string
+		branch  string
+		isMerge bool
+		want    bool
+	}{
+		{name: "push any branch", on: "  push: {}\n", branch: "feature", want: true},
+		{name: "push matching branch", on: "  push:\n    branches:\n      - main\n", branch: "main", want: true},
+		{name: "push matching branch ignores case", on: "  push:\n    branches:\n      - Main\n", branch: "main", want: true},
+		{name: "push other branch", on: "  push:\n    branches:\n      - main\n", branch: "feature", want: false},
+		{name: "pull request without merge", on: "  pull_request: {}\n", branch: "main", want: false},
+		{name: "pull request merge", on: "  pull_request: {}\n", branch: "main", isMerge: true, want: true},
+		{name: "pull request merge other branch", on: "  pull_request:\n    branches:\n      - main\n", branch: "feature", isMerge: true, want: false},
+		{name: "pull request merge closed activity", on: "  pull_request:\n    activities:\n      - closed\n", branch: "main", isMerge: true, want: true},
+		{name: "pull request merge other activity", on: "  pull_request:\n    activities:\n      - opened\n", branch: "main", isMerge: true, want: false},
+		{name: "workflow dispatch only", on: "  workflow_dispatch: {}\n", branch: "main", isMerge: true, want: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			yml := "name: wf\non:\n" + test.on + "jobs:\n  - name: job\n    steps:\n      - name: step\n        saved_query_name: sq\n"
+			config, err := ParseWorkflowConfig(strings.NewReader(yml))
+			require.NoError(t, err)
+			require.Equal(t, test.want, workflowTriggeredByBranchUpdate(config, test.branch, test.isMerge))
+		})
+	}
+}
@@ -19,15 +19,41 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strconv"
+	"strings"
 
 	"gopkg.in/yaml.v3"
 )
 
 type Step struct {
-	Name            yaml.Node `yaml:"name"`
-	SavedQueryName  yaml.Node `yaml:"saved_query_name"`
-	ExpectedColumns yaml.Node `yaml:"expected_columns,omitempty"`
-	ExpectedRows    yaml.Node `yaml:"expected_rows,omitempty"`
+	Name              yaml.Node          `yaml:"name"`
+	SavedQueryName    yaml.Node          `yaml:"saved_query_name,omitempty"`
+	ExpectedColumns   yaml.Node          `yaml:"expected_columns,omitempty"`
+	ExpectedRows      yaml.Node          `yaml:"expected_rows,omitempty"`
+	SchemaAssertion   *SchemaAssertion   `yaml:"schema_assertion,omitempty"`
+	DiffBudget        *DiffBudget        `yaml:"diff_budget,omitempty"`
+	VerifyConstraints *VerifyConstraints `yaml:"verify_constraints,omitempty"`
+}
+
+// SchemaAssertion asserts that Table exists. If Column is set, the table must have the column, and if ColumnType is
+// also set, the column must have that type.
+type SchemaAssertion struct {
+	Table      yaml.Node `yaml:"table"`
+	Column     yaml.Node `yaml:"column,omitempty"`
+	ColumnType yaml.Node `yaml:"column_type,omitempty"`
+}
+
+// DiffBudget limits the number of rows of Table that may change between Base and the commit the workflow is run
+// against to MaxRowsChanged.
+type DiffBudget struct {
+	Table          yaml.Node `yaml:"table"`
+	Base           yaml.Node `yaml:"base"`
+	MaxRowsChanged yaml.Node `yaml:"max_rows_changed"`
+}
+
+// VerifyConstraints verifies the constraints of Tables, or of every table if Tables is empty.
+type VerifyConstraints struct {
+	Tables []yaml.Node `yaml:"tables,omitempty"`
 }
 
 type Job struct {
@@ -156,11 +182,85 @@ func ValidateWorkflowConfig(workflow *WorkflowConfig) error {
 			} else {
 				steps[step.Name.Value] = true
 			}
-			if step.SavedQueryName.Value == "" {
-				return fmt.Errorf("invalid config: step %s is missing saved_query_name", step.Name.Value)
+			err := validateStep(step)
+			if err != nil {
+				return err
 			}
 		}
 	}
 
 	return nil
 }
+
+func validateStep(step Step) error {
+	kinds := 0
+	if step.SavedQueryName.Value != "" {
+		kinds++
+	}
+	if step.SchemaAssertion != nil {
+		kinds++
+	}
+	if step.DiffBudget != nil {
+		kinds++
+	}
+	if step.VerifyConstraints != nil {
+		kinds++
+	}
+
+	if kinds == 0 {
+		return fmt.Errorf("invalid config: step %s is missing saved_query_name, schema_assertion, diff_budget or verify_constraints", step.Name.Value)
+	}
+	if kinds > 1 {
+		return fmt.Errorf("invalid config: step %s must define only one of saved_query_name, schema_assertion, diff_budget or verify_constraints", step.Name.Value)
+	}
+
+	if step.SavedQueryName.Value == "" && (step.ExpectedColumns.Value != "" || step.ExpectedRows.Value != "") {
+		return fmt.Errorf("invalid config: step %s defines expected results without a saved_query_name", step.Name.Value)
+	}
+
+	if step.SchemaAssertion != nil {
+		if step.SchemaAssertion.Table.Value == "" {
+			return fmt.Errorf("invalid config: schema_assertion step %s is missing table", step.Name.Value)
+		}
+		if step.SchemaAssertion.ColumnType.Value != "" && step.SchemaAssertion.Column.Value == "" {
+			return fmt.Errorf("invalid config: schema_assertion step %s defines column_type without a column", step.Name.Value)
+		}
+	}
+
+	if step.DiffBudget != nil {
+		if step.DiffBudget.Table.Value == "" {
+			return fmt.Errorf("invalid config: diff_budget step %s is missing table", step.Name.Value)
+		}
+		if step.DiffBudget.Base.Value == "" {
+			return fmt.Errorf("invalid config: diff_budget step %s is missing base", step.Name.Value)
+		}
+		_, err := parseMaxRowsChanged(step.DiffBudget.MaxRowsChanged.Value)
+		if err != nil {
+			return fmt.Errorf("invalid config: diff_budget step %s: %w", step.Name.Value, err)
+		}
+	}
+
+	if step.VerifyConstraints != nil {
+		tables := make(map[string]bool)
+		for _, table := range step.VerifyConstraints.Tables {
+			if tables[table.Value] {
+				return fmt.Errorf("invalid config: verify_constraints step %s table duplicated: %s", step.Name.Value, table.Value)
+			}
+			tables[table.Value] = true
+		}
+	}
+
+	return nil
+}
+
+// parseMaxRowsChanged parses the max_rows_changed value of a diff_budget step, which must be a non-negative integer.
+func parseMaxRowsChanged(str string) (int64, error) {
+	if str == "" {
+		return 0, errors.New("missing max_rows_changed")
+	}
+	i, err := strconv.ParseInt(strings.TrimSpace(str), 10, 64)
+	if err != nil || i < 0 {
+		return 0, fmt.Errorf("max_rows_changed must be a non-negative integer: %s", str)
+	}
+	return i, nil
+}
@@ -95,3 +95,79 @@ jobs:
 
 	// todo: check expected stuff
 }
+
+func TestParseWorkflowStepKinds(t *testing.T) {
+	yml := `name: checks
+on:
+  push: {}
+jobs:
+  - name: validate
+    steps:
+      - name: has column
+        schema_assertion:
+          table: t
+          column: c
+          column_type: varchar(20)
+      - name: small diff
+        diff_budget:
+          table: t
+          base: main
+          max_rows_changed: 10
+      - name: fks
+        verify_constraints:
+          tables:
+            - t
+            - u
+`
+
+	wf, err := ParseWorkflowConfig(strings.NewReader(yml))
+	require.NoError(t, err)
+
+	steps := wf.Jobs[0].Steps
+	require.Len(t, steps, 3)
+	require.Equal(t, WorkflowStepTypeSchemaAssertion, stepTypeFromConfig(steps[0]))
+	require.Equal(t, "t", steps[0].SchemaAssertion.Table.Value)
+	require.Equal(t, "c", steps[0].SchemaAssertion.Column.Value)
+	require.Equal(t, "varchar(20)", steps[0].SchemaAssertion.ColumnType.Value)
+	require.Equal(t, WorkflowStepTypeDiffBudget, stepTypeFromConfig(steps[1]))
+	require.Equal(t, "main", steps[1].DiffBudget.Base.Value)
+	require.Equal(t, "10", steps[1].DiffBudget.MaxRowsChanged.Value)
+	require.Equal(t, WorkflowStepTypeVerifyConstraints, stepTypeFromConfig(steps[2]))
+	require.Len(t, steps[2].VerifyConstraints.Tables, 2)
+}
+
+func TestValidateStep(t *testing.T) {
+	tests := []struct {
+		name  string
+		step  string
+		valid bool
+	}{
+		{name: "saved query", step: "saved_query_name: sq", valid: true},
+		{name: "no kind", step: "expected_rows: \"1\"", valid: false},
+		{name: "two kinds", step: "saved_query_name: sq\n        verify_constraints: {}", valid: false},
+		{name: "expected results without saved query", step: "verify_constraints: {}\n        expected_rows: \"1\"", valid: false},
+		{name: "schema assertion table only", step: "schema_assertion:\n          table: t", valid: true},
+		{name: "schema assertion missing table", step: "schema_assertion:\n          column: c", valid: false},
+		{name: "schema assertion type without column", step: "schema_assertion:\n          table: t\n          column_type: int", valid: false},
+		{name: "diff budget", step: "diff_budget:\n          table: t\n          base: HEAD~1\n          max_rows_changed: 0", valid: true},
+		{name: "diff budget missing base", step: "diff_budget:\n          table: t\n          max_rows_changed: 1", valid: false},
+		{name: "diff budget negative max", step: "diff_budget:\n          table: t\n          base: main\n          max_rows_changed: -1", valid: false},
+		{name: "diff budget non-integer max", step: "diff_budget:\n          table: t\n          base: main\n          max_rows_changed: lots", valid: false},
+		{name: "verify constraints all tables", step: "verify_constraints: {}", valid: true},
+		{name: "verify constraints duplicate table", step: "verify_constraints:\n          tables:\n            - t\n            - t", valid: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			yml := "name: wf\non:\n  push: {}\njobs:\n  - name: job\n    steps:\n      - name: step\n        " + test.step + "\n"
+			wf, err := ParseWorkflowConfig(strings.NewReader(yml))
+			require.NoError(t, err)
+			err = ValidateWorkflowConfig(wf)
+			if test.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dolt_ci
+
+type WorkflowDiffBudgetStepId string
+
+// WorkflowDiffBudgetStep fails if more than MaxRowsChanged rows of a table changed between BaseRef and the commit
+// the workflow is run against.
+type WorkflowDiffBudgetStep struct {
+	Id               *WorkflowDiffBudgetStepId `db:"id"`
+	WorkflowStepIdFK *WorkflowStepId           `db:"workflow_step_id_fk"`
+	TableName        string                    `db:"table_name"`
+	BaseRef          string                    `db:"base_ref"`
+	MaxRowsChanged   int64                     `db:"max_rows_changed"`
+}
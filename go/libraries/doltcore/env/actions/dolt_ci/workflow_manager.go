@@ -48,6 +48,8 @@ type WorkflowManager interface {
 	GetWorkflowConfig(ctx *sql.Context, db sqle.Database, workflowName string) (*WorkflowConfig, error)
 	// StoreAndCommit creates or updates a workflow and creates a Dolt commit
 	StoreAndCommit(ctx *sql.Context, db sqle.Database, config *WorkflowConfig) error
+	// RunWorkflow executes the steps of each job of a workflow and returns their results.
+	RunWorkflow(ctx *sql.Context, db sqle.Database, workflowName string) (*WorkflowRunResult, error)
 }
 
 type doltWorkflowManager struct {
@@ -100,6 +102,18 @@ func (d *doltWorkflowManager) selectAllFromSavedQueryStepsTableByWorkflowStepIdQ
 	return fmt.Sprintf("select * from %s where `%s` = '%s' limit 1;", doltdb.WorkflowSavedQueryStepsTableName, doltdb.WorkflowSavedQueryStepsWorkflowStepIdFkColName, stepID)
 }
 
+func (d *doltWorkflowManager) selectAllFromSchemaAssertionStepsTableByWorkflowStepIdQuery(stepID string) string {
+	return fmt.Sprintf("select * from %s where `%s` = '%s' limit 1;", doltdb.WorkflowSchemaAssertionStepsTableName, doltdb.WorkflowSchemaAssertionStepsWorkflowStepIdFkColName, stepID)
+}
+
+func (d *doltWorkflowManager) selectAllFromDiffBudgetStepsTableByWorkflowStepIdQuery(stepID string) string {
+	return fmt.Sprintf("select * from %s where `%s` = '%s' limit 1;", doltdb.WorkflowDiffBudgetStepsTableName, doltdb.WorkflowDiffBudgetStepsWorkflowStepIdFkColName, stepID)
+}
+
+func (d *doltWorkflowManager) selectAllFromVerifyConstraintsStepsTableByWorkflowStepIdQuery(stepID string) string {
+	return fmt.Sprintf("select * from %s where `%s` = '%s' limit 1;", doltdb.WorkflowVerifyConstraintsStepsTableName, doltdb.WorkflowVerifyConstraintsStepsWorkflowStepIdFkColName, stepID)
+}
+
 func (d *doltWorkflowManager) selectAllFromWorkflowStepsTableByWorkflowJobIdQuery(jobID string) string {
 	return fmt.Sprintf("select * from %s where `%s` = '%s'", doltdb.WorkflowStepsTableName, doltdb.WorkflowStepsWorkflowJobIdFkColName, jobID)
 }
@@ -161,14 +175,29 @@ func (d *doltWorkflowManager) insertIntoWorkflowSavedQueryStepExpectedRowColumnR
 	return expectedResultID, fmt.Sprintf("insert into %s (`%s`, `%s`, `%s`,`%s`, `%s`, `%s`, `%s`, `%s`) values ('%s', '%s', %d, %d, %d, %d, now(), now());", doltdb.WorkflowSavedQueryStepExpectedRowColumnResultsTableName, doltdb.WorkflowSavedQueryStepExpectedRowColumnResultsIdPkColName, doltdb.WorkflowSavedQueryStepExpectedRowColumnResultsSavedQueryStepIdFkColName, doltdb.WorkflowSavedQueryStepExpectedRowColumnResultsExpectedColumnCountComparisonTypeColName, doltdb.WorkflowSavedQueryStepExpectedRowColumnResultsExpectedRowCountComparisonTypeColName, doltdb.WorkflowSavedQueryStepExpectedRowColumnResultsExpectedColumnCountColName, doltdb.WorkflowSavedQueryStepExpectedRowColumnResultsExpectedRowCountColName, doltdb.WorkflowSavedQueryStepExpectedRowColumnResultsCreatedAtColName, doltdb.WorkflowSavedQueryStepExpectedRowColumnResultsUpdatedAtColName, expectedResultID, savedQueryStepID, expectedColumnComparisonType, expectedRowComparisonType, expectedColumnCount, expectedRowCount)
 }
 
+func (d *doltWorkflowManager) insertIntoWorkflowSchemaAssertionStepsTableQuery(stepID, tableName, columnName, columnType string) (string, string) {
+	schemaAssertionStepID := uuid.NewString()
+	return schemaAssertionStepID, fmt.Sprintf("insert into %s (`%s`, `%s`, `%s`, `%s`, `%s`) values ('%s', '%s', %s, %s, %s);", doltdb.WorkflowSchemaAssertionStepsTableName, doltdb.WorkflowSchemaAssertionStepsIdPkColName, doltdb.WorkflowSchemaAssertionStepsWorkflowStepIdFkColName, doltdb.WorkflowSchemaAssertionStepsTableNameColName, doltdb.WorkflowSchemaAssertionStepsColumnNameColName, doltdb.WorkflowSchemaAssertionStepsColumnTypeColName, schemaAssertionStepID, stepID, quoteString(tableName), toNullableSqlString(columnName), toNullableSqlString(columnType))
+}
+
+func (d *doltWorkflowManager) insertIntoWorkflowDiffBudgetStepsTableQuery(stepID, tableName, baseRef string, maxRowsChanged int64) (string, string) {
+	diffBudgetStepID := uuid.NewString()
+	return diffBudgetStepID, fmt.Sprintf("insert into %s (`%s`, `%s`, `%s`, `%s`, `%s`) values ('%s', '%s', %s, %s, %d);", doltdb.WorkflowDiffBudgetStepsTableName, doltdb.WorkflowDiffBudgetStepsIdPkColName, doltdb.WorkflowDiffBudgetStepsWorkflowStepIdFkColName, doltdb.WorkflowDiffBudgetStepsTableNameColName, doltdb.WorkflowDiffBudgetStepsBaseRefColName, doltdb.WorkflowDiffBudgetStepsMaxRowsChangedColName, diffBudgetStepID, stepID, quoteString(tableName), quoteString(baseRef), maxRowsChanged)
+}
+
+func (d *doltWorkflowManager) insertIntoWorkflowVerifyConstraintsStepsTableQuery(stepID string, tableNames []string) (string, string) {
+	verifyConstraintsStepID := uuid.NewString()
+	return verifyConstraintsStepID, fmt.Sprintf("insert into %s (`%s`, `%s`, `%s`) values ('%s', '%s', %s);", doltdb.WorkflowVerifyConstraintsStepsTableName, doltdb.WorkflowVerifyConstraintsStepsIdPkColName, doltdb.WorkflowVerifyConstraintsStepsWorkflowStepIdFkColName, doltdb.WorkflowVerifyConstraintsStepsTableNamesColName, verifyConstraintsStepID, stepID, toNullableSqlString(strings.Join(tableNames, ",")))
+}
+
 // updates
 
 func (d *doltWorkflowManager) updateWorkflowJobsTableQuery(jobID, jobName string) string {
-	return fmt.Sprintf("update %s set `%s` = '%s', `%s` = now(), where `%s` = '%s';", doltdb.WorkflowJobsTableName, doltdb.WorkflowJobsNameColName, jobName, doltdb.WorkflowJobsUpdatedAtColName, doltdb.WorkflowJobsIdPkColName, jobID)
+	return fmt.Sprintf("update %s set `%s` = '%s', `%s` = now() where `%s` = '%s';", doltdb.WorkflowJobsTableName, doltdb.WorkflowJobsNameColName, jobName, doltdb.WorkflowJobsUpdatedAtColName, doltdb.WorkflowJobsIdPkColName, jobID)
 }
 
 func (d *doltWorkflowManager) updateWorkflowStepsTableQuery(stepID string, stepOrder int) string {
-	return fmt.Sprintf("update %s set `%s` = %d, `%s` = now(), where `%s` = '%s';", doltdb.WorkflowStepsTableName, doltdb.WorkflowStepsStepOrderColName, stepOrder, doltdb.WorkflowStepsUpdatedAtColName, doltdb.WorkflowStepsIdPkColName, stepID)
+	return fmt.Sprintf("update %s set `%s` = %d, `%s` = now() where `%s` = '%s';", doltdb.WorkflowStepsTableName, doltdb.WorkflowStepsStepOrderColName, stepOrder, doltdb.WorkflowStepsUpdatedAtColName, doltdb.WorkflowStepsIdPkColName, stepID)
 }
 
 func (d *doltWorkflowManager) updateWorkflowSavedQueryStepsTableQuery(savedQueryStepID, savedQueryName string, expectedResultsType int) string {
@@ -179,6 +208,18 @@ func (d *doltWorkflowManager) updateWorkflowSavedQueryStepsExpectedRowColumnResu
 	return fmt.Sprintf("update %s set `%s` = %d, `%s` = %d, `%s` = %d, `%s` = %d, `%s` = now() where `%s` = '%s';", doltdb.WorkflowSavedQueryStepExpectedRowColumnResultsTableName, doltdb.WorkflowSavedQueryStepExpectedRowColumnResultsExpectedColumnCountComparisonTypeColName, expectedColumnComparisonType, doltdb.WorkflowSavedQueryStepExpectedRowColumnResultsExpectedRowCountComparisonTypeColName, expectedRowComparisonType, doltdb.WorkflowSavedQueryStepExpectedRowColumnResultsExpectedColumnCountColName, expectedColumnCount, doltdb.WorkflowSavedQueryStepExpectedRowColumnResultsExpectedRowCountColName, expectedRowCount, doltdb.WorkflowSavedQueryStepExpectedRowColumnResultsUpdatedAtColName, doltdb.WorkflowSavedQueryStepExpectedRowColumnResultsIdPkColName, expectedResultID)
 }
 
+func (d *doltWorkflowManager) updateWorkflowSchemaAssertionStepsTableQuery(schemaAssertionStepID, tableName, columnName, columnType string) string {
+	return fmt.Sprintf("update %s set `%s` = %s, `%s` = %s, `%s` = %s where `%s` = '%s';", doltdb.WorkflowSchemaAssertionStepsTableName, doltdb.WorkflowSchemaAssertionStepsTableNameColName, quoteString(tableName), doltdb.WorkflowSchemaAssertionStepsColumnNameColName, toNullableSqlString(columnName), doltdb.WorkflowSchemaAssertionStepsColumnTypeColName, toNullableSqlString(columnType), doltdb.WorkflowSchemaAssertionStepsIdPkColName, schemaAssertionStepID)
+}
+
+func (d *doltWorkflowManager) updateWorkflowDiffBudgetStepsTableQuery(diffBudgetStepID, tableName, baseRef string, maxRowsChanged int64) string {
+	return fmt.Sprintf("update %s set `%s` = %s, `%s` = %s, `%s` = %d where `%s` = '%s';", doltdb.WorkflowDiffBudgetStepsTableName, doltdb.WorkflowDiffBudgetStepsTableNameColName, quoteString(tableName), doltdb.WorkflowDiffBudgetStepsBaseRefColName, quoteString(baseRef), doltdb.WorkflowDiffBudgetStepsMaxRowsChangedColName, maxRowsChanged, doltdb.WorkflowDiffBudgetStepsIdPkColName, diffBudgetStepID)
+}
+
+func (d *doltWorkflowManager) updateWorkflowVerifyConstraintsStepsTableQuery(verifyConstraintsStepID string, tableNames []string) string {
+	return fmt.Sprintf("update %s set `%s` = %s where `%s` = '%s';", doltdb.WorkflowVerifyConstraintsStepsTableName, doltdb.WorkflowVerifyConstraintsStepsTableNamesColName, toNullableSqlString(strings.Join(tableNames, ",")), doltdb.WorkflowVerifyConstraintsStepsIdPkColName, verifyConstraintsStepID)
+}
+
 // deletes
 
 func (d *doltWorkflowManager) deleteFromWorkflowsTableByWorkflowNameQuery(workflowName string) string {
@@ -415,6 +456,92 @@ func (d *doltWorkflowManager) newWorkflowSavedQueryStep(cvs columnValues) (*Work
 	return sq, nil
 }
 
+func (d *doltWorkflowManager) newWorkflowSchemaAssertionStep(cvs columnValues) (*WorkflowSchemaAssertionStep, error) {
+	sa := &WorkflowSchemaAssertionStep{}
+
+	for _, cv := range cvs {
+		// nullable columns have no value
+		if cv == nil {
+			continue
+		}
+		switch cv.ColumnName {
+		case doltdb.WorkflowSchemaAssertionStepsIdPkColName:
+			id := WorkflowSchemaAssertionStepId(cv.Value)
+			sa.Id = &id
+		case doltdb.WorkflowSchemaAssertionStepsWorkflowStepIdFkColName:
+			id := WorkflowStepId(cv.Value)
+			sa.WorkflowStepIdFK = &id
+		case doltdb.WorkflowSchemaAssertionStepsTableNameColName:
+			sa.TableName = cv.Value
+		case doltdb.WorkflowSchemaAssertionStepsColumnNameColName:
+			sa.ColumnName = cv.Value
+		case doltdb.WorkflowSchemaAssertionStepsColumnTypeColName:
+			sa.ColumnType = cv.Value
+		default:
+			return nil, errors.New(fmt.Sprintf("unknown schema assertion step column: %s", cv.ColumnName))
+		}
+	}
+
+	return sa, nil
+}
+
+func (d *doltWorkflowManager) newWorkflowDiffBudgetStep(cvs columnValues) (*WorkflowDiffBudgetStep, error) {
+	db := &WorkflowDiffBudgetStep{}
+
+	for _, cv := range cvs {
+		if cv == nil {
+			continue
+		}
+		switch cv.ColumnName {
+		case doltdb.WorkflowDiffBudgetStepsIdPkColName:
+			id := WorkflowDiffBudgetStepId(cv.Value)
+			db.Id = &id
+		case doltdb.WorkflowDiffBudgetStepsWorkflowStepIdFkColName:
+			id := WorkflowStepId(cv.Value)
+			db.WorkflowStepIdFK = &id
+		case doltdb.WorkflowDiffBudgetStepsTableNameColName:
+			db.TableName = cv.Value
+		case doltdb.WorkflowDiffBudgetStepsBaseRefColName:
+			db.BaseRef = cv.Value
+		case doltdb.WorkflowDiffBudgetStepsMaxRowsChangedColName:
+			i, err := strconv.ParseInt(cv.Value, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			db.MaxRowsChanged = i
+		default:
+			return nil, errors.New(fmt.Sprintf("unknown diff budget step column: %s", cv.ColumnName))
+		}
+	}
+
+	return db, nil
+}
+
+func (d *doltWorkflowManager) newWorkflowVerifyConstraintsStep(cvs columnValues) (*WorkflowVerifyConstraintsStep, error) {
+	vc := &WorkflowVerifyConstraintsStep{}
+
+	for _, cv := range cvs {
+		// nullable columns have no value
+		if cv == nil {
+			continue
+		}
+		switch cv.ColumnName {
+		case doltdb.WorkflowVerifyConstraintsStepsIdPkColName:
+			id := WorkflowVerifyConstraintsStepId(cv.Value)
+			vc.Id = &id
+		case doltdb.WorkflowVerifyConstraintsStepsWorkflowStepIdFkColName:
+			id := WorkflowStepId(cv.Value)
+			vc.WorkflowStepIdFK = &id
+		case doltdb.WorkflowVerifyConstraintsStepsTableNamesColName:
+			vc.TableNames = strings.Split(cv.Value, ",")
+		default:
+			return nil, errors.New(fmt.Sprintf("unknown verify constraints step column: %s", cv.ColumnName))
+		}
+	}
+
+	return vc, nil
+}
+
 func (d *doltWorkflowManager) newWorkflowStep(cvs columnValues) (*WorkflowStep, error) {
 	ws := &WorkflowStep{}
 
@@ -545,8 +672,8 @@ func (d *doltWorkflowManager) validateWorkflowTables(ctx *sql.Context) error {
 		}
 	}
 
-	activeOnly := ExpectedDoltCITablesOrdered.ActiveTableNames()
-	for _, tn := range activeOnly {
+	required := ExpectedDoltCITablesOrdered.RequiredTableNames()
+	for _, tn := range required {
 		_, ok := tableMap[tn.Name]
 		if !ok {
 			return errors.New(fmt.Sprintf("expected workflow table not found: %s", tn.Name))
@@ -651,6 +778,51 @@ func (d *doltWorkflowManager) getWorkflowSavedQueryStepByStepId(ctx *sql.Context
 	return savedQuerySteps[0], nil
 }
 
+func (d *doltWorkflowManager) getWorkflowSchemaAssertionStepByStepId(ctx *sql.Context, stepID WorkflowStepId) (*WorkflowSchemaAssertionStep, error) {
+	query := d.selectAllFromSchemaAssertionStepsTableByWorkflowStepIdQuery(string(stepID))
+	steps, err := d.retrieveWorkflowSchemaAssertionSteps(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if len(steps) < 1 {
+		return nil, nil
+	}
+	if len(steps) > 1 {
+		return nil, errors.New(fmt.Sprintf("expected no more than one schema assertion step for step: %s", stepID))
+	}
+	return steps[0], nil
+}
+
+func (d *doltWorkflowManager) getWorkflowDiffBudgetStepByStepId(ctx *sql.Context, stepID WorkflowStepId) (*WorkflowDiffBudgetStep, error) {
+	query := d.selectAllFromDiffBudgetStepsTableByWorkflowStepIdQuery(string(stepID))
+	steps, err := d.retrieveWorkflowDiffBudgetSteps(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if len(steps) < 1 {
+		return nil, nil
+	}
+	if len(steps) > 1 {
+		return nil, errors.New(fmt.Sprintf("expected no more than one diff budget step for step: %s", stepID))
+	}
+	return steps[0], nil
+}
+
+func (d *doltWorkflowManager) getWorkflowVerifyConstraintsStepByStepId(ctx *sql.Context, stepID WorkflowStepId) (*WorkflowVerifyConstraintsStep, error) {
+	query := d.selectAllFromVerifyConstraintsStepsTableByWorkflowStepIdQuery(string(stepID))
+	steps, err := d.retrieveWorkflowVerifyConstraintsSteps(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if len(steps) < 1 {
+		return nil, nil
+	}
+	if len(steps) > 1 {
+		return nil, errors.New(fmt.Sprintf("expected no more than one verify constraints step for step: %s", stepID))
+	}
+	return steps[0], nil
+}
+
 func (d *doltWorkflowManager) listWorkflowStepsByJobId(ctx *sql.Context, jobID WorkflowJobId) ([]*WorkflowStep, error) {
 	query := d.selectAllFromWorkflowStepsTableByWorkflowJobIdQuery(string(jobID))
 	return d.retrieveWorkflowSteps(ctx, query)
@@ -743,6 +915,69 @@ func (d *doltWorkflowManager) retrieveWorkflowSavedQuerySteps(ctx *sql.Context,
 	return workflowSavedQuerySteps, nil
 }
 
+func (d *doltWorkflowManager) retrieveWorkflowSchemaAssertionSteps(ctx *sql.Context, query string) ([]*WorkflowSchemaAssertionStep, error) {
+	workflowSchemaAssertionSteps := make([]*WorkflowSchemaAssertionStep, 0)
+
+	cb := func(cbCtx *sql.Context, cvs columnValues) error {
+		sa, rerr := d.newWorkflowSchemaAssertionStep(cvs)
+		if rerr != nil {
+			return rerr
+		}
+
+		workflowSchemaAssertionSteps = append(workflowSchemaAssertionSteps, sa)
+		return nil
+	}
+
+	err := d.sqlReadQuery(ctx, query, cb)
+	if err != nil {
+		return nil, err
+	}
+
+	return workflowSchemaAssertionSteps, nil
+}
+
+func (d *doltWorkflowManager) retrieveWorkflowDiffBudgetSteps(ctx *sql.Context, query string) ([]*WorkflowDiffBudgetStep, error) {
+	workflowDiffBudgetSteps := make([]*WorkflowDiffBudgetStep, 0)
+
+	cb := func(cbCtx *sql.Context, cvs columnValues) error {
+		db, rerr := d.newWorkflowDiffBudgetStep(cvs)
+		if rerr != nil {
+			return rerr
+		}
+
+		workflowDiffBudgetSteps = append(workflowDiffBudgetSteps, db)
+		return nil
+	}
+
+	err := d.sqlReadQuery(ctx, query, cb)
+	if err != nil {
+		return nil, err
+	}
+
+	return workflowDiffBudgetSteps, nil
+}
+
+func (d *doltWorkflowManager) retrieveWorkflowVerifyConstraintsSteps(ctx *sql.Context, query string) ([]*WorkflowVerifyConstraintsStep, error) {
+	workflowVerifyConstraintsSteps := make([]*WorkflowVerifyConstraintsStep, 0)
+
+	cb := func(cbCtx *sql.Context, cvs columnValues) error {
+		vc, rerr := d.newWorkflowVerifyConstraintsStep(cvs)
+		if rerr != nil {
+			return rerr
+		}
+
+		workflowVerifyConstraintsSteps = append(workflowVerifyConstraintsSteps, vc)
+		return nil
+	}
+
+	err := d.sqlReadQuery(ctx, query, cb)
+	if err != nil {
+		return nil, err
+	}
+
+	return workflowVerifyConstraintsSteps, nil
+}
+
 func (d *doltWorkflowManager) retrieveWorkflowSteps(ctx *sql.Context, query string) ([]*WorkflowStep, error) {
 	workflowSteps := make([]*WorkflowStep, 0)
 
@@ -1269,6 +1504,12 @@ func (d *doltWorkflowManager) updateExistingWorkflow(ctx *sql.Context, config *W
 					if err != nil {
 						return err
 					}
+				} else if step.StepType != stepTypeFromConfig(configStep) {
+					// the step changed kind, so delete it and recreate it below
+					err = d.deleteWorkflowStep(ctx, *step.Id)
+					if err != nil {
+						return err
+					}
 				} else {
 					orderIdx, ok := orderedSteps[step.Name]
 					if !ok {
@@ -1342,6 +1583,11 @@ func (d *doltWorkflowManager) updateExistingWorkflow(ctx *sql.Context, config *W
 								}
 							}
 						}
+					} else {
+						err = d.updateWorkflowStepDetailRows(ctx, step, configStep)
+						if err != nil {
+							return err
+						}
 					}
 
 					delete(configSteps, step.Name)
@@ -1356,11 +1602,22 @@ func (d *doltWorkflowManager) updateExistingWorkflow(ctx *sql.Context, config *W
 				}
 
 				stepOrder := orderIdx + 1
-				stepID, err := d.writeWorkflowStepRow(ctx, *job.Id, step.Name.Value, stepOrder, WorkflowStepTypeSavedQuery)
+				stepType := stepTypeFromConfig(step)
+				stepID, err := d.writeWorkflowStepRow(ctx, *job.Id, step.Name.Value, stepOrder, stepType)
 				if err != nil {
 					return err
 				}
 
+				if stepType != WorkflowStepTypeSavedQuery {
+					err = d.writeWorkflowStepDetailRows(ctx, stepID, step)
+					if err != nil {
+						return err
+					}
+					delete(configSteps, step.Name.Value)
+					delete(orderedSteps, step.Name.Value)
+					continue
+				}
+
 				savedQueryStepID, err := d.writeWorkflowSavedQueryStepRow(ctx, stepID, step.SavedQueryName.Value, WorkflowSavedQueryExpectedResultsTypeRowColumnCount)
 				if err != nil {
 					return err
@@ -1396,11 +1653,20 @@ func (d *doltWorkflowManager) updateExistingWorkflow(ctx *sql.Context, config *W
 			return err
 		}
 		for idx, step := range job.Steps {
-			stepID, err := d.writeWorkflowStepRow(ctx, jobID, step.Name.Value, idx+1, WorkflowStepTypeSavedQuery)
+			stepType := stepTypeFromConfig(step)
+			stepID, err := d.writeWorkflowStepRow(ctx, jobID, step.Name.Value, idx+1, stepType)
 			if err != nil {
 				return err
 			}
 
+			if stepType != WorkflowStepTypeSavedQuery {
+				err = d.writeWorkflowStepDetailRows(ctx, stepID, step)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
 			savedQueryStepID, err := d.writeWorkflowSavedQueryStepRow(ctx, stepID, step.SavedQueryName.Value, WorkflowSavedQueryExpectedResultsTypeRowColumnCount)
 			if err != nil {
 				return err
@@ -1560,6 +1826,48 @@ func (d *doltWorkflowManager) writeWorkflowSavedQueryStepExpectedRowColumnResult
 	return WorkflowSavedQueryExpectedRowColumnResultId(resultID), nil
 }
 
+func (d *doltWorkflowManager) writeWorkflowSchemaAssertionStepRow(ctx *sql.Context, stepID WorkflowStepId, tableName, columnName, columnType string) (WorkflowSchemaAssertionStepId, error) {
+	schemaAssertionStepID, query := d.insertIntoWorkflowSchemaAssertionStepsTableQuery(string(stepID), tableName, columnName, columnType)
+	err := d.sqlWriteQuery(ctx, query)
+	if err != nil {
+		return "", err
+	}
+	return WorkflowSchemaAssertionStepId(schemaAssertionStepID), nil
+}
+
+func (d *doltWorkflowManager) writeWorkflowDiffBudgetStepRow(ctx *sql.Context, stepID WorkflowStepId, tableName, baseRef string, maxRowsChanged int64) (WorkflowDiffBudgetStepId, error) {
+	diffBudgetStepID, query := d.insertIntoWorkflowDiffBudgetStepsTableQuery(string(stepID), tableName, baseRef, maxRowsChanged)
+	err := d.sqlWriteQuery(ctx, query)
+	if err != nil {
+		return "", err
+	}
+	return WorkflowDiffBudgetStepId(diffBudgetStepID), nil
+}
+
+func (d *doltWorkflowManager) writeWorkflowVerifyConstraintsStepRow(ctx *sql.Context, stepID WorkflowStepId, tableNames []string) (WorkflowVerifyConstraintsStepId, error) {
+	verifyConstraintsStepID, query := d.insertIntoWorkflowVerifyConstraintsStepsTableQuery(string(stepID), tableNames)
+	err := d.sqlWriteQuery(ctx, query)
+	if err != nil {
+		return "", err
+	}
+	return WorkflowVerifyConstraintsStepId(verifyConstraintsStepID), nil
+}
+
+func (d *doltWorkflowManager) updateWorkflowSchemaAssertionStepRow(ctx *sql.Context, schemaAssertionStepID WorkflowSchemaAssertionStepId, tableName, columnName, columnType string) error {
+	query := d.updateWorkflowSchemaAssertionStepsTableQuery(string(schemaAssertionStepID), tableName, columnName, columnType)
+	return d.sqlWriteQuery(ctx, query)
+}
+
+func (d *doltWorkflowManager) updateWorkflowDiffBudgetStepRow(ctx *sql.Context, diffBudgetStepID WorkflowDiffBudgetStepId, tableName, baseRef string, maxRowsChanged int64) error {
+	query := d.updateWorkflowDiffBudgetStepsTableQuery(string(diffBudgetStepID), tableName, baseRef, maxRowsChanged)
+	return d.sqlWriteQuery(ctx, query)
+}
+
+func (d *doltWorkflowManager) updateWorkflowVerifyConstraintsStepRow(ctx *sql.Context, verifyConstraintsStepID WorkflowVerifyConstraintsStepId, tableNames []string) error {
+	query := d.updateWorkflowVerifyConstraintsStepsTableQuery(string(verifyConstraintsStepID), tableNames)
+	return d.sqlWriteQuery(ctx, query)
+}
+
 func (d *doltWorkflowManager) parseSavedQueryExpectedResultString(str string) (WorkflowSavedQueryExpectedRowColumnComparisonType, int64, error) {
 	if str == "" {
 		return WorkflowSavedQueryExpectedRowColumnComparisonTypeUnspecified, 0, nil
@@ -1730,10 +2038,7 @@ func (d *doltWorkflowManager) createWorkflow(ctx *sql.Context, config *WorkflowC
 			// insert into step
 			order := idx + 1
 
-			var stepType WorkflowStepType
-			if step.SavedQueryName.Value != "" {
-				stepType = WorkflowStepTypeSavedQuery
-			}
+			stepType := stepTypeFromConfig(step)
 
 			stepID, err := d.writeWorkflowStepRow(ctx, jobID, step.Name.Value, order, stepType)
 			if err != nil {
@@ -1769,12 +2074,86 @@ func (d *doltWorkflowManager) createWorkflow(ctx *sql.Context, config *WorkflowC
 						return err
 					}
 				}
+			} else {
+				err = d.writeWorkflowStepDetailRows(ctx, stepID, step)
+				if err != nil {
+					return err
+				}
 			}
 		}
 	}
 	return nil
 }
 
+// writeWorkflowStepDetailRows writes the rows describing a schema assertion, diff budget or verify constraints |step|.
+func (d *doltWorkflowManager) writeWorkflowStepDetailRows(ctx *sql.Context, stepID WorkflowStepId, step Step) error {
+	switch stepTypeFromConfig(step) {
+	case WorkflowStepTypeSchemaAssertion:
+		_, err := d.writeWorkflowSchemaAssertionStepRow(ctx, stepID, step.SchemaAssertion.Table.Value, step.SchemaAssertion.Column.Value, step.SchemaAssertion.ColumnType.Value)
+		return err
+	case WorkflowStepTypeDiffBudget:
+		maxRowsChanged, err := parseMaxRowsChanged(step.DiffBudget.MaxRowsChanged.Value)
+		if err != nil {
+			return err
+		}
+		_, err = d.writeWorkflowDiffBudgetStepRow(ctx, stepID, step.DiffBudget.Table.Value, step.DiffBudget.Base.Value, maxRowsChanged)
+		return err
+	case WorkflowStepTypeVerifyConstraints:
+		_, err := d.writeWorkflowVerifyConstraintsStepRow(ctx, stepID, yamlNodeValues(step.VerifyConstraints.Tables))
+		return err
+	default:
+		return fmt.Errorf("%w: step %s", ErrUnknownWorkflowStepType, step.Name.Value)
+	}
+}
+
+// updateWorkflowStepDetailRows updates the rows describing the schema assertion, diff budget or verify constraints
+// |step| so they match |configStep|. Rows are only written when they have changed.
+func (d *doltWorkflowManager) updateWorkflowStepDetailRows(ctx *sql.Context, step *WorkflowStep, configStep Step) error {
+	switch step.StepType {
+	case WorkflowStepTypeSchemaAssertion:
+		sa, err := d.getWorkflowSchemaAssertionStepByStepId(ctx, *step.Id)
+		if err != nil {
+			return err
+		}
+		if sa == nil {
+			return d.writeWorkflowStepDetailRows(ctx, *step.Id, configStep)
+		}
+		c := configStep.SchemaAssertion
+		if sa.TableName != c.Table.Value || sa.ColumnName != c.Column.Value || sa.ColumnType != c.ColumnType.Value {
+			return d.updateWorkflowSchemaAssertionStepRow(ctx, *sa.Id, c.Table.Value, c.Column.Value, c.ColumnType.Value)
+		}
+	case WorkflowStepTypeDiffBudget:
+		db, err := d.getWorkflowDiffBudgetStepByStepId(ctx, *step.Id)
+		if err != nil {
+			return err
+		}
+		if db == nil {
+			return d.writeWorkflowStepDetailRows(ctx, *step.Id, configStep)
+		}
+		c := configStep.DiffBudget
+		maxRowsChanged, err := parseMaxRowsChanged(c.MaxRowsChanged.Value)
+		if err != nil {
+			return err
+		}
+		if db.TableName != c.Table.Value || db.BaseRef != c.Base.Value || db.MaxRowsChanged != maxRowsChanged {
+			return d.updateWorkflowDiffBudgetStepRow(ctx, *db.Id, c.Table.Value, c.Base.Value, maxRowsChanged)
+		}
+	case WorkflowStepTypeVerifyConstraints:
+		vc, err := d.getWorkflowVerifyConstraintsStepByStepId(ctx, *step.Id)
+		if err != nil {
+			return err
+		}
+		if vc == nil {
+			return d.writeWorkflowStepDetailRows(ctx, *step.Id, configStep)
+		}
+		tableNames := yamlNodeValues(configStep.VerifyConstraints.Tables)
+		if strings.Join(vc.TableNames, ",") != strings.Join(tableNames, ",") {
+			return d.updateWorkflowVerifyConstraintsStepRow(ctx, *vc.Id, tableNames)
+		}
+	}
+	return nil
+}
+
 func (d *doltWorkflowManager) getWorkflowConfig(ctx *sql.Context, workflowName string) (*WorkflowConfig, error) {
 	config := &WorkflowConfig{}
 
@@ -1900,6 +2279,12 @@ func (d *doltWorkflowManager) getWorkflowConfig(ctx *sql.Context, workflowName s
 					}
 				}
 
+				steps = append(steps, step)
+			} else {
+				step, err := d.getWorkflowStepDetailConfig(ctx, stp)
+				if err != nil {
+					return nil, err
+				}
 				steps = append(steps, step)
 			}
 		}
@@ -1916,6 +2301,58 @@ func (d *doltWorkflowManager) getWorkflowConfig(ctx *sql.Context, workflowName s
 	return config, nil
 }
 
+// getWorkflowStepDetailConfig returns the Step config for the schema assertion, diff budget or verify constraints |stp|.
+func (d *doltWorkflowManager) getWorkflowStepDetailConfig(ctx *sql.Context, stp *WorkflowStep) (Step, error) {
+	step := Step{Name: newScalarDoubleQuotedYamlNode(stp.Name)}
+
+	switch stp.StepType {
+	case WorkflowStepTypeSchemaAssertion:
+		sa, err := d.getWorkflowSchemaAssertionStepByStepId(ctx, *stp.Id)
+		if err != nil {
+			return Step{}, err
+		}
+		if sa == nil {
+			return Step{}, fmt.Errorf("schema assertion step not found for step: %s", stp.Name)
+		}
+		step.SchemaAssertion = &SchemaAssertion{Table: newScalarDoubleQuotedYamlNode(sa.TableName)}
+		if sa.ColumnName != "" {
+			step.SchemaAssertion.Column = newScalarDoubleQuotedYamlNode(sa.ColumnName)
+		}
+		if sa.ColumnType != "" {
+			step.SchemaAssertion.ColumnType = newScalarDoubleQuotedYamlNode(sa.ColumnType)
+		}
+	case WorkflowStepTypeDiffBudget:
+		db, err := d.getWorkflowDiffBudgetStepByStepId(ctx, *stp.Id)
+		if err != nil {
+			return Step{}, err
+		}
+		if db == nil {
+			return Step{}, fmt.Errorf("diff budget step not found for step: %s", stp.Name)
+		}
+		step.DiffBudget = &DiffBudget{
+			Table:          newScalarDoubleQuotedYamlNode(db.TableName),
+			Base:           newScalarDoubleQuotedYamlNode(db.BaseRef),
+			MaxRowsChanged: newScalarDoubleQuotedYamlNode(strconv.FormatInt(db.MaxRowsChanged, 10)),
+		}
+	case WorkflowStepTypeVerifyConstraints:
+		vc, err := d.getWorkflowVerifyConstraintsStepByStepId(ctx, *stp.Id)
+		if err != nil {
+			return Step{}, err
+		}
+		if vc == nil {
+			return Step{}, fmt.Errorf("verify constraints step not found for step: %s", stp.Name)
+		}
+		step.VerifyConstraints = &VerifyConstraints{}
+		for _, tableName := range vc.TableNames {
+			step.VerifyConstraints.Tables = append(step.VerifyConstraints.Tables, newScalarDoubleQuotedYamlNode(tableName))
+		}
+	default:
+		return Step{}, fmt.Errorf("%w: %d", ErrUnknownWorkflowStepType, stp.StepType)
+	}
+
+	return step, nil
+}
+
 func (d *doltWorkflowManager) storeFromConfig(ctx *sql.Context, config *WorkflowConfig) error {
 	_, err := d.getWorkflow(ctx, config.Name.Value)
 	if err != nil {
@@ -1965,7 +2402,12 @@ func (d *doltWorkflowManager) RemoveWorkflow(ctx *sql.Context, db sqle.Database,
 	if err != nil {
 		return err
 	}
-	return d.commitRemoveWorkflow(ctx, ExpectedDoltCITablesOrdered.ActiveTableNames(), workflowName)
+
+	tableNames, err := getExistingDoltCITables(ctx)
+	if err != nil {
+		return err
+	}
+	return d.commitRemoveWorkflow(ctx, tableNames, workflowName)
 }
 
 func (d *doltWorkflowManager) StoreAndCommit(ctx *sql.Context, db sqle.Database, config *WorkflowConfig) error {
@@ -1973,12 +2415,55 @@ func (d *doltWorkflowManager) StoreAndCommit(ctx *sql.Context, db sqle.Database,
 		return err
 	}
 
-	err := d.storeFromConfig(ctx, config)
+	err := createMissingOptionalDoltCITables(ctx, d.queryFunc)
+	if err != nil {
+		return err
+	}
+
+	err = d.storeFromConfig(ctx, config)
+	if err != nil {
+		return err
+	}
+
+	tableNames, err := getExistingDoltCITables(ctx)
 	if err != nil {
 		return err
 	}
+	return d.commitWorkflow(ctx, tableNames, config.Name.Value)
+}
+
+func (d *doltWorkflowManager) RunWorkflow(ctx *sql.Context, db sqle.Database, workflowName string) (*WorkflowRunResult, error) {
+	if err := dsess.CheckAccessForDb(ctx, db, branch_control.Permissions_Read); err != nil {
+		return nil, err
+	}
+	return d.runWorkflow(ctx, workflowName)
+}
+
+func toNullableSqlString(value string) string {
+	if value == "" {
+		return "NULL"
+	}
+	return quoteString(value)
+}
+
+// quoteIdentifier quotes |id| with backticks, doubling any backticks it contains, so that workflow supplied names can
+// be used as SQL identifiers.
+func quoteIdentifier(id string) string {
+	return "`" + strings.ReplaceAll(id, "`", "``") + "`"
+}
+
+// quoteString quotes |s| as a single quoted SQL string literal, escaping any backslashes and single quotes it contains.
+func quoteString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
 
-	return d.commitWorkflow(ctx, ExpectedDoltCITablesOrdered.ActiveTableNames(), config.Name.Value)
+func yamlNodeValues(nodes []yaml.Node) []string {
+	values := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		values = append(values, n.Value)
+	}
+	return values
 }
 
 func newScalarDoubleQuotedYamlNode(value string) yaml.Node {
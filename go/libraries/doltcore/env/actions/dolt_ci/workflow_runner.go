@@ -0,0 +1,471 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dolt_ci
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+)
+
+var ErrSavedQueryNotFound = errors.New("saved query not found")
+
+// WorkflowStepRunResult is the outcome of running a single workflow step.
+type WorkflowStepRunResult struct {
+	StepName string
+	Passed   bool
+	// Message describes why the step failed. It is empty for passing steps.
+	Message string
+}
+
+// WorkflowJobRunResult is the outcome of running every step of a single workflow job.
+type WorkflowJobRunResult struct {
+	JobName string
+	Steps   []*WorkflowStepRunResult
+}
+
+// Passed returns true if every step of the job passed.
+func (r *WorkflowJobRunResult) Passed() bool {
+	for _, s := range r.Steps {
+		if !s.Passed {
+			return false
+		}
+	}
+	return true
+}
+
+// WorkflowRunResult is the outcome of running every job of a workflow.
+type WorkflowRunResult struct {
+	WorkflowName string
+	Jobs         []*WorkflowJobRunResult
+}
+
+// Passed returns true if every job of the workflow passed.
+func (r *WorkflowRunResult) Passed() bool {
+	for _, j := range r.Jobs {
+		if !j.Passed() {
+			return false
+		}
+	}
+	return true
+}
+
+// FailingStep returns the name of the first step that failed, or the empty string if every step passed.
+func (r *WorkflowRunResult) FailingStep() string {
+	for _, j := range r.Jobs {
+		for _, s := range j.Steps {
+			if !s.Passed {
+				return s.StepName
+			}
+		}
+	}
+	return ""
+}
+
+// Report returns a plain text report of the passing and failing steps of each job.
+func (r *WorkflowRunResult) Report() string {
+	var sb strings.Builder
+	for _, j := range r.Jobs {
+		sb.WriteString(fmt.Sprintf("Running job: %s\n", j.JobName))
+		for _, s := range j.Steps {
+			if s.Passed {
+				sb.WriteString(fmt.Sprintf("  PASS  %s\n", s.StepName))
+			} else {
+				sb.WriteString(fmt.Sprintf("  FAIL  %s: %s\n", s.StepName, s.Message))
+			}
+		}
+	}
+	return sb.String()
+}
+
+func (d *doltWorkflowManager) selectQueryFromQueryCatalogByNameQuery(savedQueryName string) string {
+	return fmt.Sprintf("select `%s` from %s where `%s` = %s order by `%s` limit 1;", doltdb.QueryCatalogQueryCol, doltdb.DoltQueryCatalogTableName, doltdb.QueryCatalogNameCol, quoteString(savedQueryName), doltdb.QueryCatalogOrderCol)
+}
+
+func (d *doltWorkflowManager) getSavedQuery(ctx *sql.Context, savedQueryName string) (string, error) {
+	query := d.selectQueryFromQueryCatalogByNameQuery(savedQueryName)
+	found := false
+	savedQuery := ""
+	cb := func(cbCtx *sql.Context, cvs columnValues) error {
+		found = true
+		for _, cv := range cvs {
+			if cv != nil && cv.ColumnName == doltdb.QueryCatalogQueryCol {
+				savedQuery = cv.Value
+			}
+		}
+		return nil
+	}
+	err := d.sqlReadQuery(ctx, query, cb)
+	if err != nil {
+		if sql.ErrTableNotFound.Is(err) {
+			return "", fmt.Errorf("%w: %s", ErrSavedQueryNotFound, savedQueryName)
+		}
+		return "", err
+	}
+	if !found {
+		return "", fmt.Errorf("%w: %s", ErrSavedQueryNotFound, savedQueryName)
+	}
+	return savedQuery, nil
+}
+
+// runSavedQuery executes |query| and returns the number of columns and rows it produced.
+func (d *doltWorkflowManager) runSavedQuery(ctx *sql.Context, query string) (int64, int64, error) {
+	sch, rowIter, _, err := d.queryFunc(ctx, query)
+	if err != nil {
+		return 0, 0, err
+	}
+	rows, err := sql.RowIterToRows(ctx, rowIter)
+	if err != nil {
+		return 0, 0, err
+	}
+	return int64(len(sch)), int64(len(rows)), nil
+}
+
+// compareExpectedCount returns true if |actual| satisfies |comparisonType| when compared with |expected|. An
+// unspecified comparison type is always satisfied.
+func compareExpectedCount(comparisonType WorkflowSavedQueryExpectedRowColumnComparisonType, expected, actual int64) (bool, error) {
+	switch comparisonType {
+	case WorkflowSavedQueryExpectedRowColumnComparisonTypeUnspecified:
+		return true, nil
+	case WorkflowSavedQueryExpectedRowColumnComparisonTypeEquals:
+		return actual == expected, nil
+	case WorkflowSavedQueryExpectedRowColumnComparisonTypeNotEquals:
+		return actual != expected, nil
+	case WorkflowSavedQueryExpectedRowColumnComparisonTypeLessThan:
+		return actual < expected, nil
+	case WorkflowSavedQueryExpectedRowColumnComparisonTypeGreaterThan:
+		return actual > expected, nil
+	case WorkflowSavedQueryExpectedRowColumnComparisonTypeLessThanOrEqual:
+		return actual <= expected, nil
+	case WorkflowSavedQueryExpectedRowColumnComparisonTypeGreaterThanOrEqual:
+		return actual >= expected, nil
+	default:
+		return false, ErrUnknownWorkflowSavedQueryExpectedRowColumnComparisonType
+	}
+}
+
+func (d *doltWorkflowManager) runSavedQueryStep(ctx *sql.Context, step *WorkflowStep) (*WorkflowStepRunResult, error) {
+	result := &WorkflowStepRunResult{StepName: step.Name}
+
+	savedQueryStep, err := d.getWorkflowSavedQueryStepByStepId(ctx, *step.Id)
+	if err != nil {
+		return nil, err
+	}
+	if savedQueryStep == nil {
+		return nil, fmt.Errorf("saved query step not found for step: %s", step.Name)
+	}
+
+	query, err := d.getSavedQuery(ctx, savedQueryStep.SavedQueryName)
+	if err != nil {
+		if errors.Is(err, ErrSavedQueryNotFound) {
+			result.Message = err.Error()
+			return result, nil
+		}
+		return nil, err
+	}
+
+	columnCount, rowCount, err := d.runSavedQuery(ctx, query)
+	if err != nil {
+		result.Message = fmt.Sprintf("query error: %s", err.Error())
+		return result, nil
+	}
+
+	if savedQueryStep.SavedQueryExpectedResultsType == WorkflowSavedQueryExpectedResultsTypeRowColumnCount {
+		expected, err := d.getWorkflowSavedQueryExpectedRowColumnResultBySavedQueryStepId(ctx, *savedQueryStep.Id)
+		if err != nil {
+			return nil, err
+		}
+		if expected != nil {
+			ok, err := compareExpectedCount(expected.ExpectedColumnCountComparisonType, expected.ExpectedColumnCount, columnCount)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				expectedStr, err := d.toSavedQueryExpectedResultString(expected.ExpectedColumnCountComparisonType, expected.ExpectedColumnCount)
+				if err != nil {
+					return nil, err
+				}
+				result.Message = fmt.Sprintf("expected column count %s, got %d", expectedStr, columnCount)
+				return result, nil
+			}
+
+			ok, err = compareExpectedCount(expected.ExpectedRowCountComparisonType, expected.ExpectedRowCount, rowCount)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				expectedStr, err := d.toSavedQueryExpectedResultString(expected.ExpectedRowCountComparisonType, expected.ExpectedRowCount)
+				if err != nil {
+					return nil, err
+				}
+				result.Message = fmt.Sprintf("expected row count %s, got %d", expectedStr, rowCount)
+				return result, nil
+			}
+		}
+	}
+
+	result.Passed = true
+	return result, nil
+}
+
+func (d *doltWorkflowManager) runSchemaAssertionStep(ctx *sql.Context, step *WorkflowStep) (*WorkflowStepRunResult, error) {
+	result := &WorkflowStepRunResult{StepName: step.Name}
+
+	sa, err := d.getWorkflowSchemaAssertionStepByStepId(ctx, *step.Id)
+	if err != nil {
+		return nil, err
+	}
+	if sa == nil {
+		return nil, fmt.Errorf("schema assertion step not found for step: %s", step.Name)
+	}
+
+	columnTypes := make(map[string]string)
+	cb := func(cbCtx *sql.Context, cvs columnValues) error {
+		var field, typ string
+		for _, cv := range cvs {
+			if cv == nil {
+				continue
+			}
+			switch strings.ToLower(cv.ColumnName) {
+			case "field":
+				field = cv.Value
+			case "type":
+				typ = cv.Value
+			}
+		}
+		columnTypes[strings.ToLower(field)] = typ
+		return nil
+	}
+	err = d.sqlReadQuery(ctx, fmt.Sprintf("show columns from %s;", quoteIdentifier(sa.TableName)), cb)
+	if err != nil {
+		if sql.ErrTableNotFound.Is(err) {
+			result.Message = fmt.Sprintf("table not found: %s", sa.TableName)
+			return result, nil
+		}
+		return nil, err
+	}
+
+	if sa.ColumnName != "" {
+		actualType, ok := columnTypes[strings.ToLower(sa.ColumnName)]
+		if !ok {
+			result.Message = fmt.Sprintf("column not found: %s.%s", sa.TableName, sa.ColumnName)
+			return result, nil
+		}
+		if sa.ColumnType != "" && normalizeColumnType(actualType) != normalizeColumnType(sa.ColumnType) {
+			result.Message = fmt.Sprintf("expected column %s.%s to have type %s, got %s", sa.TableName, sa.ColumnName, sa.ColumnType, actualType)
+			return result, nil
+		}
+	}
+
+	result.Passed = true
+	return result, nil
+}
+
+// normalizeColumnType lowercases |typ| and removes its whitespace so that equivalent type strings compare equal.
+func normalizeColumnType(typ string) string {
+	return strings.ToLower(strings.Join(strings.Fields(typ), ""))
+}
+
+func (d *doltWorkflowManager) runDiffBudgetStep(ctx *sql.Context, step *WorkflowStep) (*WorkflowStepRunResult, error) {
+	result := &WorkflowStepRunResult{StepName: step.Name}
+
+	db, err := d.getWorkflowDiffBudgetStepByStepId(ctx, *step.Id)
+	if err != nil {
+		return nil, err
+	}
+	if db == nil {
+		return nil, fmt.Errorf("diff budget step not found for step: %s", step.Name)
+	}
+
+	baseRef, err := d.resolveHeadRelativeRef(ctx, db.BaseRef)
+	if err != nil {
+		result.Message = fmt.Sprintf("query error: %s", err.Error())
+		return result, nil
+	}
+
+	var rowsChanged int64
+	cb := func(cbCtx *sql.Context, cvs columnValues) error {
+		for _, cv := range cvs {
+			if cv == nil {
+				continue
+			}
+			i, err := strconv.ParseInt(cv.Value, 10, 64)
+			if err != nil {
+				return err
+			}
+			rowsChanged += i
+		}
+		return nil
+	}
+	query := fmt.Sprintf("select `rows_added`, `rows_deleted`, `rows_modified` from dolt_diff_stat(%s, 'WORKING', %s);", quoteString(baseRef), quoteString(db.TableName))
+	err = d.sqlReadQuery(ctx, query, cb)
+	if err != nil {
+		result.Message = fmt.Sprintf("query error: %s", err.Error())
+		return result, nil
+	}
+
+	if rowsChanged > db.MaxRowsChanged {
+		result.Message = fmt.Sprintf("expected at most %d rows changed in %s since %s, got %d", db.MaxRowsChanged, db.TableName, db.BaseRef, rowsChanged)
+		return result, nil
+	}
+
+	result.Passed = true
+	return result, nil
+}
+
+// resolveHeadRelativeRef replaces a leading HEAD in |ref| with the hash of the current head commit. Workflows triggered
+// by branch updates run against a detached commit, where HEAD cannot be resolved by name.
+func (d *doltWorkflowManager) resolveHeadRelativeRef(ctx *sql.Context, ref string) (string, error) {
+	if !isHeadRelativeRef(ref) {
+		return ref, nil
+	}
+
+	headHash := ""
+	cb := func(cbCtx *sql.Context, cvs columnValues) error {
+		for _, cv := range cvs {
+			if cv != nil {
+				headHash = cv.Value
+			}
+		}
+		return nil
+	}
+	err := d.sqlReadQuery(ctx, "select `commit_hash` from dolt_log limit 1;", cb)
+	if err != nil {
+		return "", err
+	}
+	if headHash == "" {
+		return "", fmt.Errorf("unable to resolve %s", ref)
+	}
+
+	return headHash + ref[len("HEAD"):], nil
+}
+
+// isHeadRelativeRef returns whether |ref| is HEAD, or HEAD followed by ancestry operators such as HEAD~2 or HEAD^.
+// Refs which merely start with HEAD, such as a branch named HEADS-UP, are not head relative.
+func isHeadRelativeRef(ref string) bool {
+	if len(ref) < len("HEAD") || !strings.EqualFold(ref[:len("HEAD")], "HEAD") {
+		return false
+	}
+	rest := ref[len("HEAD"):]
+	return rest == "" || rest[0] == '~' || rest[0] == '^'
+}
+
+func (d *doltWorkflowManager) runVerifyConstraintsStep(ctx *sql.Context, step *WorkflowStep) (*WorkflowStepRunResult, error) {
+	result := &WorkflowStepRunResult{StepName: step.Name}
+
+	vc, err := d.getWorkflowVerifyConstraintsStepByStepId(ctx, *step.Id)
+	if err != nil {
+		return nil, err
+	}
+	if vc == nil {
+		return nil, fmt.Errorf("verify constraints step not found for step: %s", step.Name)
+	}
+
+	args := []string{"'--all'", "'--output-only'"}
+	for _, tableName := range vc.TableNames {
+		args = append(args, quoteString(tableName))
+	}
+
+	violations := false
+	cb := func(cbCtx *sql.Context, cvs columnValues) error {
+		for _, cv := range cvs {
+			if cv != nil && cv.Value != "0" {
+				violations = true
+			}
+		}
+		return nil
+	}
+	err = d.sqlReadQuery(ctx, fmt.Sprintf("call dolt_verify_constraints(%s);", strings.Join(args, ", ")), cb)
+	if err != nil {
+		result.Message = fmt.Sprintf("query error: %s", err.Error())
+		return result, nil
+	}
+
+	if violations {
+		result.Message = "constraint violations found"
+		return result, nil
+	}
+
+	result.Passed = true
+	return result, nil
+}
+
+func (d *doltWorkflowManager) runWorkflowStep(ctx *sql.Context, step *WorkflowStep) (*WorkflowStepRunResult, error) {
+	switch step.StepType {
+	case WorkflowStepTypeSavedQuery:
+		return d.runSavedQueryStep(ctx, step)
+	case WorkflowStepTypeSchemaAssertion:
+		return d.runSchemaAssertionStep(ctx, step)
+	case WorkflowStepTypeDiffBudget:
+		return d.runDiffBudgetStep(ctx, step)
+	case WorkflowStepTypeVerifyConstraints:
+		return d.runVerifyConstraintsStep(ctx, step)
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnknownWorkflowStepType, step.StepType)
+	}
+}
+
+func (d *doltWorkflowManager) runWorkflow(ctx *sql.Context, workflowName string) (*WorkflowRunResult, error) {
+	workflow, err := d.getWorkflow(ctx, workflowName)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &WorkflowRunResult{WorkflowName: string(*workflow.Name)}
+
+	jobs, err := d.listWorkflowJobsByWorkflowName(ctx, *workflow.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].Name < jobs[j].Name
+	})
+
+	for _, job := range jobs {
+		jobResult := &WorkflowJobRunResult{JobName: job.Name}
+
+		steps, err := d.listWorkflowStepsByJobId(ctx, *job.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		sort.Slice(steps, func(i, j int) bool {
+			return steps[i].StepOrder < steps[j].StepOrder
+		})
+
+		for _, step := range steps {
+			stepResult, err := d.runWorkflowStep(ctx, step)
+			if err != nil {
+				return nil, err
+			}
+			jobResult.Steps = append(jobResult.Steps, stepResult)
+			// steps within a job depend on one another, so a job stops at its first failure
+			if !stepResult.Passed {
+				break
+			}
+		}
+
+		result.Jobs = append(result.Jobs, jobResult)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,76 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dolt_ci
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareExpectedCount(t *testing.T) {
+	tests := []struct {
+		comparisonType WorkflowSavedQueryExpectedRowColumnComparisonType
+		expected       int64
+		actual         int64
+		ok             bool
+	}{
+		{WorkflowSavedQueryExpectedRowColumnComparisonTypeUnspecified, 10, 1, true},
+		{WorkflowSavedQueryExpectedRowColumnComparisonTypeEquals, 2, 2, true},
+		{WorkflowSavedQueryExpectedRowColumnComparisonTypeEquals, 2, 3, false},
+		{WorkflowSavedQueryExpectedRowColumnComparisonTypeNotEquals, 2, 3, true},
+		{WorkflowSavedQueryExpectedRowColumnComparisonTypeNotEquals, 2, 2, false},
+		{WorkflowSavedQueryExpectedRowColumnComparisonTypeLessThan, 2, 1, true},
+		{WorkflowSavedQueryExpectedRowColumnComparisonTypeLessThan, 2, 2, false},
+		{WorkflowSavedQueryExpectedRowColumnComparisonTypeGreaterThan, 2, 3, true},
+		{WorkflowSavedQueryExpectedRowColumnComparisonTypeGreaterThan, 2, 2, false},
+		{WorkflowSavedQueryExpectedRowColumnComparisonTypeLessThanOrEqual, 2, 2, true},
+		{WorkflowSavedQueryExpectedRowColumnComparisonTypeLessThanOrEqual, 2, 3, false},
+		{WorkflowSavedQueryExpectedRowColumnComparisonTypeGreaterThanOrEqual, 2, 2, true},
+		{WorkflowSavedQueryExpectedRowColumnComparisonTypeGreaterThanOrEqual, 2, 1, false},
+	}
+
+	for _, test := range tests {
+		ok, err := compareExpectedCount(test.comparisonType, test.expected, test.actual)
+		require.NoError(t, err)
+		require.Equal(t, test.ok, ok, "comparison type %d, expected %d, actual %d", test.comparisonType, test.expected, test.actual)
+	}
+
+	_, err := compareExpectedCount(WorkflowSavedQueryExpectedRowColumnComparisonType(100), 1, 1)
+	require.ErrorIs(t, err, ErrUnknownWorkflowSavedQueryExpectedRowColumnComparisonType)
+}
+
+func TestNormalizeColumnType(t *testing.T) {
+	require.Equal(t, normalizeColumnType("varchar(20)"), normalizeColumnType("VARCHAR( 20 )"))
+	require.Equal(t, "decimal(10,2)", normalizeColumnType("DECIMAL(10, 2)"))
+	require.NotEqual(t, normalizeColumnType("int"), normalizeColumnType("bigint"))
+}
+
+func TestIsHeadRelativeRef(t *testing.T) {
+	for _, ref := range []string{"HEAD", "head", "HEAD~", "HEAD~3", "HEAD^", "HEAD^2~1"} {
+		require.True(t, isHeadRelativeRef(ref), ref)
+	}
+	for _, ref := range []string{"", "HEA", "HEADS-UP", "headroom", "main", "main~1", "abc123"} {
+		require.False(t, isHeadRelativeRef(ref), ref)
+	}
+}
+
+func TestQuoteSql(t *testing.T) {
+	require.Equal(t, "`t1`", quoteIdentifier("t1"))
+	require.Equal(t, "`a``; drop table t; --`", quoteIdentifier("a`; drop table t; --"))
+	require.Equal(t, "'main'", quoteString("main"))
+	require.Equal(t, `'it''s'`, quoteString("it's"))
+	require.Equal(t, `'a\\'''`, quoteString(`a\'`))
+}
@@ -26,6 +26,9 @@ type WorkflowStepType int
 const (
 	WorkflowStepTypeUnspecified WorkflowStepType = iota
 	WorkflowStepTypeSavedQuery
+	WorkflowStepTypeSchemaAssertion
+	WorkflowStepTypeDiffBudget
+	WorkflowStepTypeVerifyConstraints
 )
 
 type WorkflowStepId string
@@ -45,7 +48,29 @@ func ToWorkflowStepType(t int) (WorkflowStepType, error) {
 	switch t {
 	case int(WorkflowStepTypeSavedQuery):
 		return WorkflowStepTypeSavedQuery, nil
+	case int(WorkflowStepTypeSchemaAssertion):
+		return WorkflowStepTypeSchemaAssertion, nil
+	case int(WorkflowStepTypeDiffBudget):
+		return WorkflowStepTypeDiffBudget, nil
+	case int(WorkflowStepTypeVerifyConstraints):
+		return WorkflowStepTypeVerifyConstraints, nil
 	default:
 		return WorkflowStepTypeUnspecified, ErrUnknownWorkflowStepType
 	}
 }
+
+// stepTypeFromConfig returns the WorkflowStepType of a step defined in a WorkflowConfig
+func stepTypeFromConfig(step Step) WorkflowStepType {
+	switch {
+	case step.SavedQueryName.Value != "":
+		return WorkflowStepTypeSavedQuery
+	case step.SchemaAssertion != nil:
+		return WorkflowStepTypeSchemaAssertion
+	case step.DiffBudget != nil:
+		return WorkflowStepTypeDiffBudget
+	case step.VerifyConstraints != nil:
+		return WorkflowStepTypeVerifyConstraints
+	default:
+		return WorkflowStepTypeUnspecified
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+// ParsePartialCloneTables parses the comma separated list of tables given to a partial clone.
+func ParsePartialCloneTables(tables string) ([]string, error) {
+	var parsed []string
+	seen := make(map[string]struct{})
+	for _, table := range strings.Split(tables, ",") {
+		table = strings.TrimSpace(table)
+		// System tables are always included, so there's no need to track them
+		if len(table) == 0 || doltdb.HasDoltPrefix(table) || doltdb.HasDoltCIPrefix(table) {
+			continue
+		}
+		if _, ok := seen[strings.ToLower(table)]; ok {
+			continue
+		}
+		seen[strings.ToLower(table)] = struct{}{}
+		parsed = append(parsed, table)
+	}
+	if len(parsed) == 0 {
+		return nil, fmt.Errorf("a partial clone must include at least one non-system table")
+	}
+	return parsed, nil
+}
+
+// validatePartialCloneTables returns an error if any of the tables of the partial clone described by |remote| does not
+// exist on |branch| in |srcDB|, as a typo would otherwise silently result in a clone without that table's data.
+func validatePartialCloneTables(ctx context.Context, srcDB *doltdb.DoltDB, remote env.Remote, branch string) error {
+	cm, err := srcDB.ResolveCommitRef(ctx, ref.NewBranchRef(branch))
+	if err != nil {
+		return err
+	}
+	root, err := cm.GetRootValue(ctx)
+	if err != nil {
+		return err
+	}
+	for _, table := range remote.Tables {
+		_, ok, err := root.ResolveTableName(ctx, doltdb.TableName{Name: table})
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("%w: '%s' on branch '%s'", doltdb.ErrTableNotFound, table, branch)
+		}
+	}
+	return nil
+}
+
+// partialCloneGhosts walks the commits of |srcDB| which are reachable from |toFetch| and not yet present in |destDB|,
+// and returns the addresses of every table which is excluded from the partial clone described by |remote|. These are
+// left behind on the remote as ghost chunks. Commits in |skipCmts| are not walked, as they are ghosts of a shallow
+// clone. The addresses of included tables are also returned, since an included table may share its address with an
+// excluded one, in which case it must still be fetched.
+func partialCloneGhosts(
+	ctx context.Context,
+	srcDB, destDB *doltdb.DoltDB,
+	remote *env.Remote,
+	toFetch []hash.Hash,
+	skipCmts hash.HashSet,
+) (ghosts hash.HashSet, present hash.HashSet, err error) {
+	included := make(map[string]struct{}, len(remote.Tables))
+	for _, table := range remote.Tables {
+		included[strings.ToLower(table)] = struct{}{}
+	}
+
+	ghosts = hash.NewHashSet()
+	present = hash.NewHashSet()
+	visited := hash.NewHashSet()
+	queue := append([]hash.Hash{}, toFetch...)
+	for len(queue) > 0 {
+		h := queue[0]
+		queue = queue[1:]
+		if visited.Has(h) || skipCmts.Has(h) {
+			continue
+		}
+		visited.Insert(h)
+
+		// Commits we already have were walked by an earlier fetch, as were all of their ancestors
+		has, err := destDB.Has(ctx, h)
+		if err != nil {
+			return nil, nil, err
+		}
+		if has {
+			continue
+		}
+
+		optCmt, err := srcDB.ReadCommit(ctx, h)
+		if err != nil {
+			return nil, nil, err
+		}
+		cm, ok := optCmt.ToCommit()
+		if !ok {
+			return nil, nil, doltdb.ErrGhostCommitEncountered
+		}
+		root, err := cm.GetRootValue(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		tableHashes, err := doltdb.MapTableHashes(ctx, root)
+		if err != nil {
+			return nil, nil, err
+		}
+		for name, addr := range tableHashes {
+			if isPartialCloneTable(name.Name, included) {
+				present.Insert(addr)
+			} else {
+				ghosts.Insert(addr)
+			}
+		}
+
+		parents, err := cm.ParentHashes(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		queue = append(queue, parents...)
+	}
+
+	for addr := range present {
+		ghosts.Remove(addr)
+	}
+	return ghosts, present, nil
+}
+
+// isPartialCloneTable returns whether the table with the given name is fetched by a partial clone of the |included|
+// tables. System tables are always fetched, as they hold the schemas of views, triggers, and the like.
+func isPartialCloneTable(name string, included map[string]struct{}) bool {
+	if doltdb.HasDoltPrefix(name) || doltdb.HasDoltCIPrefix(name) {
+		return true
+	}
+	_, ok := included[strings.ToLower(name)]
+	return ok
+}
@@ -0,0 +1,60 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePartialCloneTables(t *testing.T) {
+	tests := []struct {
+		name     string
+		tables   string
+		expected []string
+		err      bool
+	}{
+		{"single table", "t1", []string{"t1"}, false},
+		{"multiple tables", "t1,t2", []string{"t1", "t2"}, false},
+		{"whitespace", " t1 , t2 ", []string{"t1", "t2"}, false},
+		{"duplicates", "t1,T1,t2,t1", []string{"t1", "t2"}, false},
+		{"empty entries", "t1,,t2,", []string{"t1", "t2"}, false},
+		{"system tables", "dolt_schemas,t1,dolt_ci_workflows", []string{"t1"}, false},
+		{"only system tables", "dolt_schemas", nil, true},
+		{"empty", "", nil, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := ParsePartialCloneTables(test.tables)
+			if test.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestIsPartialCloneTable(t *testing.T) {
+	included := map[string]struct{}{"t1": {}}
+	assert.True(t, isPartialCloneTable("t1", included))
+	assert.True(t, isPartialCloneTable("T1", included))
+	assert.True(t, isPartialCloneTable("dolt_schemas", included))
+	assert.False(t, isPartialCloneTable("t2", included))
+}
@@ -608,6 +608,21 @@ func fetchRefSpecsWithDepth(
 		}
 	}
 
+	// A partial clone leaves the data of excluded tables behind on the remote as ghost chunks
+	skipAddrs := skipCmts
+	if remote.IsPartial() {
+		ghostTables, presentTables, err := partialCloneGhosts(ctx, srcDB, dbData.Ddb, remote, toFetch, skipCmts)
+		if err != nil {
+			return err
+		}
+		err = dbData.Ddb.UpdateGhostTables(ctx, ghostTables, presentTables)
+		if err != nil {
+			return err
+		}
+		skipAddrs = skipCmts.Copy()
+		skipAddrs.InsertAll(ghostTables)
+	}
+
 	err = func() error {
 		newCtx := ctx
 		var statsCh chan pull.Stats
@@ -620,7 +635,7 @@ func fetchRefSpecsWithDepth(
 			defer progStopper(cancelFunc, wg, statsCh)
 		}
 
-		err = dbData.Ddb.PullChunks(ctx, tmpDir, srcDB, toFetch, statsCh, skipCmts)
+		err = dbData.Ddb.PullChunks(ctx, tmpDir, srcDB, toFetch, statsCh, skipAddrs)
 		if err == pull.ErrDBUpToDate {
 			err = nil
 		}
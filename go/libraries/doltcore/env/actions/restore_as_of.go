@@ -0,0 +1,102 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+// ErrRestoreAsOfNoJournal is returned when restoring a database as of a point in time that has no chunk journal to
+// find that point in.
+var ErrRestoreAsOfNoJournal = errors.New("point-in-time restore requires a database with a chunk journal")
+
+// RestoreAsOf recreates every branch, tag, and working set of |srcDb| in the new, empty database of |dEnv|, as they
+// were after the last root update written to the chunk journal of |srcDb| at or before |asOf|. |srcDb| is not
+// modified. The checked out branch of |dEnv| is kept if the restored database has it, and set to another restored
+// branch otherwise. Returns the time of the root update which was restored.
+//
+// Only root updates still in the chunk journal can be restored: garbage collection starts a new journal, and the
+// chunks of roots which are no longer referenced may have been collected.
+func RestoreAsOf(ctx context.Context, srcDb *doltdb.DoltDB, dEnv *env.DoltEnv, asOf time.Time) (time.Time, error) {
+	journal := srcDb.ChunkJournal()
+	if journal == nil {
+		return time.Time{}, ErrRestoreAsOfNoJournal
+	}
+
+	root, timestamp, ok, err := journal.RootAsOf(ctx, asOf)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !ok {
+		return time.Time{}, fmt.Errorf("no database state was recorded in the chunk journal at or before %s", asOf.Format(time.RFC3339))
+	}
+
+	destDb := dEnv.DoltDB(ctx)
+	destRoot, err := destDb.NomsRoot(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	tmpDir, err := dEnv.TempTableFilesDir()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	err = destDb.PullChunks(ctx, tmpDir, srcDb, []hash.Hash{root}, nil, nil)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unable to restore the database as of %s; its data may have been garbage collected: %w",
+			timestamp.Format(time.RFC3339), err)
+	}
+
+	success, err := destDb.CommitRoot(ctx, root, destRoot)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !success {
+		return time.Time{}, errors.New("could not set the root of the restored database; it was modified during the restore")
+	}
+
+	return timestamp, checkoutRestoredBranch(ctx, dEnv)
+}
+
+// checkoutRestoredBranch makes sure the checked out branch of |dEnv| is one of its branches, since a restored database
+// may not have the branch its repo state was created with.
+func checkoutRestoredBranch(ctx context.Context, dEnv *env.DoltEnv) error {
+	headRef, err := dEnv.RepoStateReader().CWBHeadRef()
+	if err != nil {
+		return err
+	}
+
+	branches, err := dEnv.DoltDB(ctx).GetBranches(ctx)
+	if err != nil {
+		return err
+	}
+	if len(branches) == 0 {
+		return nil
+	}
+	for _, branch := range branches {
+		if ref.Equals(branch, headRef) {
+			return nil
+		}
+	}
+	return dEnv.RepoStateWriter().SetCWBHeadRef(ctx, ref.MarshalableRef{Ref: branches[0]})
+}
@@ -76,10 +76,18 @@ type Remote struct {
 	Url        string            `json:"url"`
 	FetchSpecs []string          `json:"fetch_specs"`
 	Params     map[string]string `json:"params"`
+	// Tables is the set of tables fetched from this remote when the database is a partial clone. The data of all other
+	// tables is left behind on the remote. Empty for a remote whose tables are all fetched.
+	Tables []string `json:"tables,omitempty"`
 }
 
 func NewRemote(name, url string, params map[string]string) Remote {
-	return Remote{name, url, []string{"refs/heads/*:refs/remotes/" + name + "/*"}, params}
+	return Remote{Name: name, Url: url, FetchSpecs: []string{"refs/heads/*:refs/remotes/" + name + "/*"}, Params: params}
+}
+
+// IsPartial returns whether only a subset of tables is fetched from this remote.
+func (r *Remote) IsPartial() bool {
+	return len(r.Tables) > 0
 }
 
 func (r *Remote) GetParam(pName string) (string, bool) {
@@ -132,6 +140,39 @@ func (r *Remote) GetRemoteDBWithoutCaching(ctx context.Context, nbf *types.NomsB
 	return doltdb.LoadDoltDBWithParams(ctx, nbf, r.Url, filesys2.LocalFS, params)
 }
 
+// RotateEncryptionKey adds a new data key to the encrypted database at the remote's url, and if |newKeyFile| is not
+// empty, re-encrypts its data keys with the key in |newKeyFile|. Returns the remote with its url updated to use
+// |newKeyFile|.
+func (r Remote) RotateEncryptionKey(ctx context.Context, fs filesys2.Filesys, newKeyFile string) (Remote, error) {
+	params := make(map[string]interface{})
+	for k, v := range r.Params {
+		params[k] = v
+	}
+
+	if newKeyFile != "" {
+		absKeyFile, err := fs.Abs(newKeyFile)
+		if err != nil {
+			return Remote{}, err
+		}
+		newKeyFile = absKeyFile
+	}
+
+	err := dbfactory.RotateEncryptionKey(ctx, r.Url, params, newKeyFile)
+	if err != nil || newKeyFile == "" {
+		return r, err
+	}
+
+	u, err := earl.Parse(r.Url)
+	if err != nil {
+		return Remote{}, err
+	}
+	query := u.Query()
+	query.Set(dbfactory.EncryptParam, filepath.ToSlash(newKeyFile))
+	r.Url, _, _ = strings.Cut(r.Url, "?")
+	r.Url += encodeRemoteUrlQuery(query)
+	return r, nil
+}
+
 func (r Remote) WithParams(params map[string]string) Remote {
 	fetchSpecs := make([]string, len(r.FetchSpecs))
 	copy(fetchSpecs, r.FetchSpecs)
@@ -649,6 +690,12 @@ func GetAbsRemoteUrl(fs filesys2.Filesys, cfg config.ReadableConfig, urlArg stri
 	}
 
 	if u.Scheme != "" && fs != nil {
+		query, err := getAbsRemoteUrlQuery(u, fs)
+
+		if err != nil {
+			return "", "", err
+		}
+
 		if u.Scheme == dbfactory.FileScheme || u.Scheme == dbfactory.LocalBSScheme {
 			absUrl, err := getAbsFileRemoteUrl(u, fs)
 
@@ -656,7 +703,12 @@ func GetAbsRemoteUrl(fs filesys2.Filesys, cfg config.ReadableConfig, urlArg stri
 				return "", "", err
 			}
 
-			return u.Scheme, absUrl, err
+			return u.Scheme, absUrl + query, err
+		}
+
+		if query != "" {
+			urlArg, _, _ = strings.Cut(urlArg, "?")
+			urlArg += query
 		}
 
 		return u.Scheme, urlArg, nil
@@ -679,6 +731,32 @@ func GetAbsRemoteUrl(fs filesys2.Filesys, cfg config.ReadableConfig, urlArg stri
 	return dbfactory.HTTPSScheme, "https://" + path.Join(hostName, u.Path), nil
 }
 
+// getAbsRemoteUrlQuery returns the query of |u|, including its leading '?', with the path of its encryption key file
+// made absolute so that the remote can be used from any directory.
+func getAbsRemoteUrlQuery(u *url.URL, fs filesys2.Filesys) (string, error) {
+	if u.RawQuery == "" {
+		return "", nil
+	}
+
+	keyFile, ok := dbfactory.EncryptionKeyFile(u)
+	if !ok {
+		return "?" + u.RawQuery, nil
+	}
+
+	absKeyFile, err := fs.Abs(keyFile)
+	if err != nil {
+		return "", err
+	}
+	query := u.Query()
+	query.Set(dbfactory.EncryptParam, filepath.ToSlash(absKeyFile))
+	return encodeRemoteUrlQuery(query), nil
+}
+
+func encodeRemoteUrlQuery(query url.Values) string {
+	// slashes are valid in a query, and leaving them unescaped keeps the key file readable in the remote's url
+	return "?" + strings.ReplaceAll(query.Encode(), "%2F", "/")
+}
+
 func getAbsFileRemoteUrl(u *url.URL, fs filesys2.Filesys) (string, error) {
 	urlStr := u.Host + u.Path
 	scheme := u.Scheme
@@ -15,6 +15,7 @@
 package merge
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"strings"
@@ -52,6 +53,9 @@ func rebuildFullTextIndexes(ctx *sql.Context, mergedRoot, ourRoot, theirRoot dol
 	// tables, which no longer have a parent table, will be deleted, for
 	// example, because they will not appear in this set.
 	doNotDeleteTables := make(map[string]struct{})
+	// Tables that were excluded from a partial clone can't be loaded, so we can't determine which of the pseudo-index
+	// tables belong to them. When we encounter such a table, we leave all pseudo-index tables in place.
+	hasGhostTables := false
 
 	// The following loop will populate |doNotDeleteTables| and
 	// |tablesToRebuild|.
@@ -82,7 +86,10 @@ func rebuildFullTextIndexes(ctx *sql.Context, mergedRoot, ourRoot, theirRoot dol
 		doNotDeleteTables[tblName] = struct{}{}
 
 		tbl, ok, err := mergedRoot.GetTable(ctx, doltdb.TableName{Name: tblName})
-		if err != nil {
+		if errors.Is(err, durable.ErrGhostTable) {
+			hasGhostTables = true
+			continue
+		} else if err != nil {
 			return nil, err
 		}
 		if !ok {
@@ -120,6 +127,9 @@ func rebuildFullTextIndexes(ctx *sql.Context, mergedRoot, ourRoot, theirRoot dol
 	}
 
 	// Our last loop removes any orphaned pseudo-index tables
+	if hasGhostTables {
+		return mergedRoot, nil
+	}
 	for _, tblName := range allTableNames {
 		if _, doNotDelete := doNotDeleteTables[tblName]; doNotDelete || !doltdb.IsFullTextTable(tblName) {
 			continue
@@ -23,6 +23,7 @@ import (
 	goerrors "gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/editor"
 	"github.com/dolthub/dolt/go/store/hash"
@@ -48,7 +49,9 @@ var ErrMultipleViolationsForRow = errors.New("multiple violations for row not su
 
 var ErrSameTblAddedTwice = goerrors.NewKind("table with same name '%s' added in 2 commits can't be merged")
 
-func MergeCommits(ctx *sql.Context, commit, mergeCommit *doltdb.Commit, opts editor.Options) (*Result, error) {
+// MergeCommits merges |mergeCommit| into |commit|. Tables outside of the |sparse| checkout given, if any, are merged by
+// address when only one side changed them.
+func MergeCommits(ctx *sql.Context, commit, mergeCommit *doltdb.Commit, opts editor.Options, sparse *doltdb.SparseCheckout) (*Result, error) {
 	optCmt, err := doltdb.GetCommitAncestor(ctx, commit, mergeCommit)
 	if err != nil {
 		return nil, err
@@ -77,6 +80,7 @@ func MergeCommits(ctx *sql.Context, commit, mergeCommit *doltdb.Commit, opts edi
 	mo := MergeOpts{
 		IsCherryPick:        false,
 		KeepSchemaConflicts: true,
+		SparseCheckout:      sparse,
 	}
 	return MergeRoots(ctx, ourRoot, theirRoot, ancRoot, mergeCommit, ancCommit, opts, mo)
 }
@@ -250,7 +254,40 @@ func MergeRoots(
 	visitedTables := make(map[string]struct{})
 	var schConflicts []SchemaConflict
 	for _, tblName := range tblNames {
+		if !mergeOpts.SparseCheckout.Includes(tblName) {
+			// A table outside of a sparse checkout is carried over by address when one side left it untouched, and is
+			// merged row by row like any other table otherwise
+			addrRoot, addrStats, ok, err := mergeTableByAddress(ctx, tblName, mergedRoot, ourRoot, theirRoot, ancRoot)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				mergedRoot = addrRoot
+				if addrStats.Operation != TableUnmodified {
+					tblToStats[tblName] = addrStats
+					visitedTables[tblName.Name] = struct{}{}
+				}
+				continue
+			}
+		}
+
 		mergedTable, stats, err := merger.MergeTable(ctx, tblName, opts, mergeOpts)
+		if errors.Is(err, durable.ErrGhostTable) {
+			// A table excluded from a partial clone has no rows to merge, so it can only be carried over by address
+			addrRoot, addrStats, ok, err := mergeTableByAddress(ctx, tblName, mergedRoot, ourRoot, theirRoot, ancRoot)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				return nil, fmt.Errorf("cannot merge table '%s', which was modified on both sides of the merge: %w", tblName.String(), durable.ErrGhostTable)
+			}
+			mergedRoot = addrRoot
+			if addrStats.Operation != TableUnmodified {
+				tblToStats[tblName] = addrStats
+				visitedTables[tblName.Name] = struct{}{}
+			}
+			continue
+		}
 
 		if errors.Is(ErrTableDeletedAndModified, err) && doltdb.IsFullTextTable(tblName.Name) {
 			// If a Full-Text table was both modified and deleted, then we want to ignore the deletion.
@@ -469,7 +506,10 @@ func checkForConflicts(tblToStats map[doltdb.TableName]*MergeStats) bool {
 func getConstraintViolationStats(ctx context.Context, root doltdb.RootValue, tblToStats map[doltdb.TableName]*MergeStats) error {
 	for tblName, stats := range tblToStats {
 		tbl, ok, err := root.GetTable(ctx, tblName)
-		if err != nil {
+		if errors.Is(err, durable.ErrGhostTable) {
+			// Tables excluded from a partial clone are only merged when one side left them untouched
+			continue
+		} else if err != nil {
 			return err
 		}
 		if ok {
@@ -556,3 +596,38 @@ func diffTableHashes(headTableHashes, otherTableHashes map[doltdb.TableName]hash
 
 	return diffs
 }
+
+// mergeTableByAddress merges a table by comparing its addresses in each root, without looking at its rows. This is how
+// tables whose data is not present, as they were excluded from a partial clone, and tables outside of a sparse checkout
+// are merged. It returns false if both sides modified the table, in which case it can't be merged by address.
+func mergeTableByAddress(ctx context.Context, tblName doltdb.TableName, mergedRoot, ourRoot, theirRoot, ancRoot doltdb.RootValue) (doltdb.RootValue, *MergeStats, bool, error) {
+	ourHash, ourOk, err := ourRoot.GetTableHash(ctx, tblName)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	theirHash, theirOk, err := theirRoot.GetTableHash(ctx, tblName)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	ancHash, _, err := ancRoot.GetTableHash(ctx, tblName)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	switch {
+	case ourHash == theirHash || theirHash == ancHash:
+		return mergedRoot, &MergeStats{Operation: TableUnmodified}, true, nil
+	case ourHash == ancHash && !theirOk:
+		mergedRoot, err = mergedRoot.RemoveTables(ctx, false, false, tblName)
+		return mergedRoot, &MergeStats{Operation: TableRemoved}, true, err
+	case ourHash == ancHash:
+		operation := TableModified
+		if !ourOk {
+			operation = TableAdded
+		}
+		mergedRoot, err = mergedRoot.SetTableHash(ctx, tblName, theirHash)
+		return mergedRoot, &MergeStats{Operation: operation}, true, err
+	default:
+		return nil, nil, false, nil
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merge
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	goerrors "gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table/editor"
+)
+
+// ErrOctopusMergeConflicts is returned when merging one of several commits at once produces conflicts or constraint
+// violations. A working set can only record a single merged commit, so conflicts must be resolved by merging the
+// commits one at a time.
+var ErrOctopusMergeConflicts = goerrors.NewKind("merging %s produced conflicts or constraint violations; " +
+	"merges of multiple branches must be free of conflicts, merge the branches one at a time to resolve them")
+
+// MergeCommitsOctopus merges each of |mergeCommits| into |commit| in turn, so that the result can be committed with
+// all of them as parents. Each commit is three-way merged into the result of the merges before it, using as the base
+// the common ancestor it shares with |commit| or with one of the commits merged before it, whichever is most recent.
+// |mergeCommitSpecs| are the specs used to name |mergeCommits| in errors. If any of the merges produces conflicts or
+// constraint violations, ErrOctopusMergeConflicts is returned. Tables outside of the |sparse| checkout given, if
+// any, are merged by address when only one side changed them.
+func MergeCommitsOctopus(
+	ctx *sql.Context,
+	commit *doltdb.Commit,
+	mergeCommits []*doltdb.Commit,
+	mergeCommitSpecs []string,
+	opts editor.Options,
+	sparse *doltdb.SparseCheckout,
+) (*Result, error) {
+	root, err := commit.GetRootValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{Root: root, Stats: make(map[doltdb.TableName]*MergeStats)}
+	mo := MergeOpts{
+		IsCherryPick:        false,
+		KeepSchemaConflicts: true,
+		SparseCheckout:      sparse,
+	}
+	merged := []*doltdb.Commit{commit}
+	for i, mergeCommit := range mergeCommits {
+		ancCommit, err := octopusMergeBase(ctx, mergeCommit, merged)
+		if err != nil {
+			return nil, err
+		}
+
+		theirRoot, err := mergeCommit.GetRootValue(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ancRoot, err := ancCommit.GetRootValue(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		mergeResult, err := MergeRoots(ctx, result.Root, theirRoot, ancRoot, mergeCommit, ancCommit, opts, mo)
+		if err != nil {
+			return nil, err
+		}
+		if mergeResult.HasMergeArtifacts() {
+			return nil, ErrOctopusMergeConflicts.New(mergeCommitSpecs[i])
+		}
+
+		result.Root = mergeResult.Root
+		for tblName, stats := range mergeResult.Stats {
+			total, ok := result.Stats[tblName]
+			if !ok {
+				total = &MergeStats{Operation: stats.Operation}
+				result.Stats[tblName] = total
+			} else if total.Operation == TableUnmodified {
+				total.Operation = stats.Operation
+			}
+			total.Adds += stats.Adds
+			total.Deletes += stats.Deletes
+			total.Modifications += stats.Modifications
+		}
+		merged = append(merged, mergeCommit)
+	}
+
+	return result, nil
+}
+
+// octopusMergeBase returns the base for merging |mergeCommit| into the result of merging |merged| together: the most
+// recent of the common ancestors that |mergeCommit| shares with each of the commits in |merged|. If those ancestors
+// are not all on the same line of history, the common ancestor with the first commit in |merged| is used.
+func octopusMergeBase(ctx *sql.Context, mergeCommit *doltdb.Commit, merged []*doltdb.Commit) (*doltdb.Commit, error) {
+	var base *doltdb.Commit
+	for _, cm := range merged {
+		optCmt, err := doltdb.GetCommitAncestor(ctx, cm, mergeCommit)
+		if err != nil {
+			return nil, err
+		}
+		ancCommit, ok := optCmt.ToCommit()
+		if !ok {
+			// Ancestor commit should have been resolved before getting this far.
+			return nil, doltdb.ErrGhostCommitRuntimeFailure
+		}
+		if base == nil {
+			base = ancCommit
+			continue
+		}
+
+		// Use the new ancestor if the current base is one of its ancestors
+		canFF, err := base.CanFastForwardTo(ctx, ancCommit)
+		if err != nil && err != doltdb.ErrUpToDate && err != doltdb.ErrIsAhead {
+			return nil, err
+		}
+		if canFF {
+			base = ancCommit
+		}
+	}
+	return base, nil
+}
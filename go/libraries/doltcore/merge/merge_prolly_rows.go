@@ -77,7 +77,8 @@ func mergeProllyTable(
 	if err != nil {
 		return nil, nil, err
 	}
-	valueMerger := newValueMerger(mergedSch, tm.leftSch, tm.rightSch, tm.ancSch, leftRows.Pool(), tm.ns)
+	valueMerger := newValueMerger(mergedSch, tm.leftSch, tm.rightSch, tm.ancSch, leftRows.Pool(), tm.ns, tm.mergeRules)
+	valueMerger.conversionConflicts = tm.conversionConflicts
 
 	if !valueMerger.leftMapping.IsIdentityMapping() {
 		mergeInfo.LeftNeedsRewrite = true
@@ -191,6 +192,27 @@ func mergeProllyTableData(ctx *sql.Context, tm *TableMerger, finalSch schema.Sch
 		} else if err != nil {
 			return nil, nil, err
 		}
+
+		if tm.conversionConflicts {
+			// Rows that can't be converted to the merged schema are conflicts
+			converted, err := pri.convertsToMergedSchema(ctx, diff)
+			if err != nil {
+				return nil, nil, err
+			}
+			if !converted {
+				s.DataConflicts++
+				err = conflicts.merge(ctx, diff, nil)
+				if err != nil {
+					return nil, nil, err
+				}
+				switch diff.Op {
+				case tree.DiffOpRightAdd, tree.DiffOpRightModify:
+					// Keep our side's row until the conflict is resolved
+					continue
+				}
+			}
+		}
+
 		cnt, err := uniq.validateDiff(ctx, diff)
 		if err != nil {
 			return nil, nil, err
@@ -285,6 +307,14 @@ func mergeProllyTableData(ctx *sql.Context, tm *TableMerger, finalSch schema.Sch
 					return nil, nil, err
 				}
 			}
+			// Unless the merged schema was supplied to resolve a schema conflict, in which case it may differ from the
+			// schema both sides made the change in.
+			if tm.conversionConflicts && mergeInfo.LeftNeedsRewrite && diff.Op != tree.DiffOpConvergentDelete {
+				err = pri.merge(ctx, diff, tm.leftSch)
+				if err != nil {
+					return nil, nil, err
+				}
+			}
 		default:
 			// Currently, all changes are applied to the left-side of the merge, so for any left-side diff ops,
 			// we can simply ignore them since that data is already in the destination (the left-side).
@@ -1060,6 +1090,9 @@ func (m *conflictMerger) merge(ctx context.Context, diff tree.ThreeWayDiff, _ sc
 	switch diff.Op {
 	case tree.DiffOpDivergentModifyConflict, tree.DiffOpDivergentDeleteConflict,
 		tree.DiffOpConvergentAdd, tree.DiffOpConvergentModify, tree.DiffOpConvergentDelete:
+	case tree.DiffOpLeftAdd, tree.DiffOpLeftModify, tree.DiffOpRightAdd, tree.DiffOpRightModify:
+		// Rows changed on one side are conflicts when they can't be converted to a merged schema
+		// supplied to resolve a schema conflict.
 	default:
 		return fmt.Errorf("invalid conflict type: %s", diff.Op)
 	}
@@ -1177,7 +1210,8 @@ func (m *primaryMerger) merge(ctx *sql.Context, diff tree.ThreeWayDiff, sourceSc
 		}
 
 		return m.mut.Put(ctx, diff.Key, merged)
-	case tree.DiffOpLeftAdd, tree.DiffOpLeftModify, tree.DiffOpDivergentModifyConflict, tree.DiffOpDivergentDeleteConflict:
+	case tree.DiffOpLeftAdd, tree.DiffOpLeftModify, tree.DiffOpDivergentModifyConflict, tree.DiffOpDivergentDeleteConflict,
+		tree.DiffOpConvergentAdd, tree.DiffOpConvergentModify:
 		// Remapping when there's no schema change is harmless, but slow.
 		if !m.mergeInfo.LeftNeedsRewrite {
 			return nil
@@ -1206,6 +1240,43 @@ func (m *primaryMerger) merge(ctx *sql.Context, diff tree.ThreeWayDiff, sourceSc
 	}
 }
 
+// convertsToMergedSchema returns whether the row that |diff| changes on only one side of the merge, or in the same way
+// on both sides, can be converted to the post-merge schema. Other rows are reported as converting, since the value
+// merger reports the rows it can't convert as conflicts.
+func (m *primaryMerger) convertsToMergedSchema(ctx *sql.Context, diff tree.ThreeWayDiff) (bool, error) {
+	var tuple val.Tuple
+	var sourceSch schema.Schema
+	var mapping val.OrdinalMapping
+	var rightSide bool
+	switch diff.Op {
+	case tree.DiffOpLeftAdd, tree.DiffOpLeftModify, tree.DiffOpConvergentAdd, tree.DiffOpConvergentModify:
+		tuple, sourceSch, mapping = diff.Left, m.tableMerger.leftSch, m.valueMerger.leftMapping
+	case tree.DiffOpRightAdd, tree.DiffOpRightModify:
+		tuple, sourceSch, mapping, rightSide = diff.Right, m.tableMerger.rightSch, m.valueMerger.rightMapping, true
+	default:
+		return true, nil
+	}
+	if tuple == nil || schema.IsKeyless(sourceSch) {
+		return true, nil
+	}
+
+	desc := sourceSch.GetValueDescriptor(m.valueMerger.ns)
+	for to, from := range mapping {
+		if from == -1 {
+			continue
+		}
+		value, err := tree.GetField(ctx, desc, from, tuple, m.valueMerger.ns)
+		if err != nil {
+			return false, err
+		}
+		col := m.finalSch.GetNonPKCols().GetByStoredIndex(to)
+		if _, err = convertValueToNewType(value, col.TypeInfo, m.tableMerger, from, rightSide); err != nil {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 func resolveDefaults(ctx *sql.Context, tableName string, mergedSchema schema.Schema, sourceSchema schema.Schema) ([]sql.Expression, error) {
 	var exprs []sql.Expression
 	i := 0
@@ -1463,7 +1534,8 @@ func remapTuple(tuple val.Tuple, desc val.TupleDesc, mapping val.OrdinalMapping)
 // |defaultExprs| is a slice of expressions that represent the default or generated values for all columns, with
 // indexes in the same order as the tuple provided.
 // |rightSide| indicates if the tuple came from the right side of the merge; this is needed to determine if the tuple
-// data needs to be converted from the old schema type to a changed schema type.
+// data needs to be converted from the old schema type to a changed schema type. If |tm| records conversion failures
+// as conflicts, fields that can't be converted are left NULL.
 func remapTupleWithColumnDefaults(
 	ctx *sql.Context,
 	keyTuple, valueTuple val.Tuple,
@@ -1503,7 +1575,10 @@ func remapTupleWithColumnDefaults(
 
 			// If the type has changed, then call convert to convert the value to the new type
 			value, err = convertValueToNewType(value, col.TypeInfo, tm, from, rightSide)
-			if err != nil {
+			if err != nil && tm.conversionConflicts {
+				// The row is recorded as a conflict, so leave the value NULL until the conflict is resolved
+				continue
+			} else if err != nil {
 				return nil, err
 			}
 
@@ -1645,9 +1720,14 @@ type valueMerger struct {
 	syncPool                               pool.BuffPool
 	keyless                                bool
 	ns                                     tree.NodeStore
+	// mergeRules are the dolt_merge_rules rules of each column of the merged schema, or nil if there are none.
+	mergeRules []*columnMergeRule
+	// conversionConflicts is set when values that can't be converted to the merged schema should be treated as
+	// conflicts rather than errors. See TableMerger.conversionConflicts.
+	conversionConflicts bool
 }
 
-func newValueMerger(merged, leftSch, rightSch, baseSch schema.Schema, syncPool pool.BuffPool, ns tree.NodeStore) *valueMerger {
+func newValueMerger(merged, leftSch, rightSch, baseSch schema.Schema, syncPool pool.BuffPool, ns tree.NodeStore, rules doltdb.MergeRules) *valueMerger {
 	leftMapping, rightMapping, baseMapping := generateSchemaMappings(merged, leftSch, rightSch, baseSch)
 
 	baseToLeftMapping, baseToRightMapping, baseToResultMapping := generateSchemaMappings(baseSch, leftSch, rightSch, merged)
@@ -1670,6 +1750,7 @@ func newValueMerger(merged, leftSch, rightSch, baseSch schema.Schema, syncPool p
 		syncPool:            syncPool,
 		keyless:             schema.IsKeyless(merged),
 		ns:                  ns,
+		mergeRules:          bindMergeRules(merged, rules),
 	}
 }
 
@@ -1784,7 +1865,7 @@ func (m *valueMerger) processBaseColumn(ctx context.Context, i int, left, right,
 		// then this can be resolved.
 		baseCol, err = convert(ctx, m.baseVD, m.rightVD, m.rightSchema, i, rightColIdx, base, baseCol, m.ns)
 		if err != nil {
-			return false, err
+			return m.conversionConflicts, m.conversionError(err)
 		}
 		if isEqual(ctx, m.baseVD.Comparator(), i, baseCol, rightCol, m.rightVD.Types[rightColIdx]) {
 			// right column did not change, so there is no conflict.
@@ -1807,7 +1888,7 @@ func (m *valueMerger) processBaseColumn(ctx context.Context, i int, left, right,
 		// then this can be resolved.
 		baseCol, err = convert(ctx, m.baseVD, m.leftVD, m.leftSchema, i, leftColIdx, base, baseCol, m.ns)
 		if err != nil {
-			return false, err
+			return m.conversionConflicts, m.conversionError(err)
 		}
 		if isEqual(ctx, m.baseVD.Comparator(), i, baseCol, leftCol, m.leftVD.Types[leftColIdx]) {
 			// left column did not change, so there is no conflict.
@@ -1847,7 +1928,7 @@ func (m *valueMerger) processBaseColumn(ctx context.Context, i int, left, right,
 
 	baseCol, err = convert(ctx, m.baseVD, modifiedVD, modifiedSchema, i, modifiedColIdx, base, baseCol, m.ns)
 	if err != nil {
-		return false, err
+		return m.conversionConflicts, m.conversionError(err)
 	}
 	if modifiedVD.Comparator().CompareValues(ctx, i, baseCol, modifiedCol, modifiedVD.Types[modifiedColIdx]) == 0 {
 		return false, nil
@@ -1855,6 +1936,15 @@ func (m *valueMerger) processBaseColumn(ctx context.Context, i int, left, right,
 	return true, nil
 }
 
+// conversionError returns |err|, an error converting a value to the merged schema, unless conversion errors are
+// being treated as conflicts, in which case it returns nil.
+func (m *valueMerger) conversionError(err error) error {
+	if m.conversionConflicts {
+		return nil
+	}
+	return err
+}
+
 // processColumn returns the merged value of column |i| of the merged schema,
 // based on the |left|, |right|, and |base| schema.
 func (m *valueMerger) processColumn(ctx *sql.Context, i int, left, right, base val.Tuple) (result []byte, conflict bool, err error) {
@@ -1888,7 +1978,7 @@ func (m *valueMerger) processColumn(ctx *sql.Context, i int, left, right, base v
 
 		rightCol, err = convert(ctx, m.rightVD, m.resultVD, m.resultSchema, rightColIdx, i, right, rightCol, m.ns)
 		if err != nil {
-			return nil, false, err
+			return nil, m.conversionConflicts, m.conversionError(err)
 		}
 
 		if !leftColExists {
@@ -1897,7 +1987,7 @@ func (m *valueMerger) processColumn(ctx *sql.Context, i int, left, right, base v
 
 		leftCol, err = convert(ctx, m.leftVD, m.resultVD, m.resultSchema, leftColIdx, i, left, leftCol, m.ns)
 		if err != nil {
-			return nil, false, err
+			return nil, m.conversionConflicts, m.conversionError(err)
 		}
 
 		if isEqual(ctx, m.leftVD.Comparator(), i, leftCol, rightCol, resultType) {
@@ -1916,7 +2006,11 @@ func (m *valueMerger) processColumn(ctx *sql.Context, i int, left, right, base v
 			return leftCol, false, nil
 		}
 
-		// conflicting inserts
+		// conflicting inserts, unless the column has a merge rule that resolves them
+		result, resolved, err := m.resolveWithMergeRule(ctx, i, left, right, nil, leftCol, rightCol)
+		if err != nil || resolved {
+			return result, false, err
+		}
 		return nil, true, nil
 	}
 
@@ -1934,7 +2028,7 @@ func (m *valueMerger) processColumn(ctx *sql.Context, i int, left, right, base v
 	if baseCol != nil {
 		baseCol, err = convert(ctx, m.baseVD, m.resultVD, m.resultSchema, baseColIdx, i, base, baseCol, m.ns)
 		if err != nil {
-			return nil, false, err
+			return nil, m.conversionConflicts, m.conversionError(err)
 		}
 	}
 
@@ -1981,6 +2075,11 @@ func (m *valueMerger) processColumn(ctx *sql.Context, i int, left, right, base v
 			return leftCol, false, nil
 		}
 		// concurrent modification
+		// if the column has a merge rule, it takes precedence over the default resolution.
+		result, resolved, err := m.resolveWithMergeRule(ctx, i, left, right, baseCol, leftCol, rightCol)
+		if err != nil || resolved {
+			return result, false, err
+		}
 		// if the result type is JSON, we can attempt to merge the JSON changes.
 		dontMergeJsonVar, err := ctx.Session.GetSessionVariable(ctx, "dolt_dont_merge_json")
 		if err != nil {
@@ -0,0 +1,106 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merge
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table/editor"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/types"
+)
+
+// ResolveSchemaConflict resolves a schema conflict on table |tblName| by merging the table again with |sch| as its
+// merged schema, and returns |root| with the merged table in place of the conflicted one. The table is three-way
+// merged from |ourRoot|, |theirRoot| and |ancRoot| as MergeRoots would, except that the rows of each side are
+// remapped to |sch| instead of to a merge of the schemas of each side. Columns of |sch| are matched to the columns
+// of each side by tag, or by name if no column has the same tag. Rows with values that can't be converted to the
+// type of their column in |sch| are recorded as data conflicts, and the values that can't be converted are left
+// NULL in our side's row until the conflict is resolved.
+func ResolveSchemaConflict(
+	ctx *sql.Context,
+	root, ourRoot, theirRoot, ancRoot doltdb.RootValue,
+	theirs, ancestor doltdb.Rootish,
+	tblName doltdb.TableName,
+	sch schema.Schema,
+	opts editor.Options,
+) (doltdb.RootValue, *MergeStats, error) {
+	if !types.IsFormat_DOLT(root.VRW().Format()) {
+		return nil, nil, fmt.Errorf("resolving schema conflicts with a merged schema is only supported in the %s format",
+			types.Format_DOLT.VersionString())
+	}
+
+	merger, err := NewMerger(ourRoot, theirRoot, ancRoot, theirs, ancestor, root.VRW(), root.NodeStore())
+	if err != nil {
+		return nil, nil, err
+	}
+	mo := MergeOpts{
+		IsCherryPick:        false,
+		KeepSchemaConflicts: false,
+		MergedSchemas:       map[doltdb.TableName]schema.Schema{tblName: sch},
+	}
+	mergedTable, stats, err := merger.MergeTable(ctx, tblName, opts, mo)
+	if err != nil {
+		return nil, nil, err
+	}
+	if mergedTable.table == nil {
+		return nil, nil, fmt.Errorf("table %s was deleted on one side of the merge and can't be resolved with a merged schema", tblName)
+	}
+
+	root, err = root.PutTable(ctx, tblName, mergedTable.table)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	h, err := theirs.HashOf()
+	if err != nil {
+		return nil, nil, err
+	}
+	root, _, err = AddForeignKeyViolations(ctx, root, ancRoot, doltdb.NewTableNameSet([]doltdb.TableName{tblName}), h)
+	if err != nil {
+		return nil, nil, err
+	}
+	return root, stats, nil
+}
+
+// resolvedSchemaMergeInfo returns the MergeInfo and ThreeWayDiffInfo used to merge the table of |tm| with |mergedSch|,
+// a schema supplied to resolve a schema conflict on the table rather than one computed by SchemaMerge. Since nothing
+// is known about how |mergedSch| relates to the schemas of each side, every row of each side is considered modified
+// and secondary indexes are rebuilt.
+func resolvedSchemaMergeInfo(tm *TableMerger, mergedSch schema.Schema) (MergeInfo, tree.ThreeWayDiffInfo, error) {
+	format := tm.vrw.Format()
+	if !schema.ArePrimaryKeySetsDiffable(format, tm.leftSch, mergedSch) || !schema.ArePrimaryKeySetsDiffable(format, tm.rightSch, mergedSch) {
+		return MergeInfo{}, tree.ThreeWayDiffInfo{}, ErrMergeWithDifferentPks.New(tm.name)
+	}
+	if !schema.ArePrimaryKeySetsDiffable(format, tm.ancSch, mergedSch) {
+		return MergeInfo{}, tree.ThreeWayDiffInfo{}, ErrMergeWithDifferentPksFromAncestor.New(tm.name)
+	}
+
+	mergeInfo := MergeInfo{
+		LeftNeedsRewrite:           !schema.SchemasAreEqual(tm.leftSch, mergedSch),
+		RightNeedsRewrite:          !schema.SchemasAreEqual(tm.rightSch, mergedSch),
+		InvalidateSecondaryIndexes: true,
+	}
+	diffInfo := tree.ThreeWayDiffInfo{
+		LeftSchemaChange:          true,
+		RightSchemaChange:         true,
+		LeftAndRightSchemasDiffer: !schema.SchemasAreEqual(tm.leftSch, tm.rightSch),
+	}
+	return mergeInfo, diffInfo, nil
+}
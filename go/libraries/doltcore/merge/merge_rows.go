@@ -52,6 +52,14 @@ type MergeOpts struct {
 	// dolt_verify_constraints() stored procedure to allow callers to verify constraints for a
 	// subset of tables.
 	RecordViolationsForTables map[doltdb.TableName]struct{}
+	// SparseCheckout is the sparse checkout of the working set being merged into, if any. Tables outside of it are
+	// carried over by address rather than merged row by row, unless both sides modified them.
+	SparseCheckout *doltdb.SparseCheckout
+	// MergedSchemas is an optional map of tables to the schema each should be merged with, in place of the schema
+	// computed by SchemaMerge. It is used to resolve a schema conflict with a merged schema supplied by the user.
+	// Rows of these tables with values that can't be converted to the supplied schema are recorded as data
+	// conflicts rather than failing the merge.
+	MergedSchemas map[doltdb.TableName]schema.Schema
 }
 
 type TableMerger struct {
@@ -76,6 +84,15 @@ type TableMerger struct {
 	// exception is for the dolt_verify_constraints() stored procedure, which allows callers to
 	// only record constraint violations for a specified subset of tables.
 	recordViolations bool
+
+	// mergeRules are the dolt_merge_rules rules for this table on the left side of the merge, which resolve
+	// concurrent modifications of the same cell rather than recording them as conflicts.
+	mergeRules doltdb.MergeRules
+
+	// conversionConflicts is set when the table is merged with a schema supplied to resolve a schema conflict. Rows
+	// with values that can't be converted to the types of the merged schema are then recorded as data conflicts,
+	// instead of failing the merge.
+	conversionConflicts bool
 }
 
 func (tm TableMerger) tableHashes() (left, right, anc hash.Hash, err error) {
@@ -163,8 +180,19 @@ func (rm *RootMerger) MergeTable(
 		return &MergedTable{table: finished}, stats, err
 	}
 
-	// Calculate a merge of the schemas, but don't apply it yet
-	mergeSch, schConflicts, mergeInfo, diffInfo, err := SchemaMerge(ctx, tm.vrw.Format(), tm.leftSch, tm.rightSch, tm.ancSch, tblName)
+	var mergeSch schema.Schema
+	var schConflicts SchemaConflict
+	var mergeInfo MergeInfo
+	var diffInfo tree.ThreeWayDiffInfo
+	if resolvedSch, ok := mergeOpts.MergedSchemas[tblName]; ok {
+		// A schema supplied to resolve a schema conflict takes the place of merging the schemas
+		mergeSch = resolvedSch
+		mergeInfo, diffInfo, err = resolvedSchemaMergeInfo(tm, resolvedSch)
+		tm.conversionConflicts = true
+	} else {
+		// Calculate a merge of the schemas, but don't apply it yet
+		mergeSch, schConflicts, mergeInfo, diffInfo, err = SchemaMerge(ctx, tm.vrw.Format(), tm.leftSch, tm.rightSch, tm.ancSch, tblName)
+	}
 	if err != nil {
 		return nil, nil, err
 	}
@@ -258,6 +286,11 @@ func (rm *RootMerger) makeTableMerger(ctx context.Context, tblName doltdb.TableN
 		}
 	}
 
+	tm.mergeRules, err = doltdb.GetMergeRules(ctx, rm.left, tblName)
+	if err != nil {
+		return nil, err
+	}
+
 	tm.ancTbl, ancTableExists, err = rm.anc.GetTable(ctx, tblName)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,222 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merge
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/shopspring/decimal"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/val"
+)
+
+// columnMergeRule is a dolt_merge_rules rule bound to a column of the merged schema.
+type columnMergeRule struct {
+	strategy doltdb.MergeStrategy
+	// orderByIdx is the index of the order by column of the latest strategy in the merged value tuple, or -1 if
+	// the column is not part of the merged schema.
+	orderByIdx int
+}
+
+// bindMergeRules returns the merge rule of each stored non-primary key column of |mergedSch|, or nil if there are
+// no merge rules. Primary key columns can't be modified concurrently, so they never need resolving.
+func bindMergeRules(mergedSch schema.Schema, rules doltdb.MergeRules) []*columnMergeRule {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	storedIdx := make(map[uint64]int)
+	var cols []schema.Column
+	for _, col := range mergedSch.GetNonPKCols().GetColumns() {
+		if col.Virtual {
+			continue
+		}
+		storedIdx[col.Tag] = len(cols)
+		cols = append(cols, col)
+	}
+
+	bound := make([]*columnMergeRule, len(cols))
+	for i, col := range cols {
+		rule, ok := rules.ForColumn(col.Name)
+		if !ok {
+			continue
+		}
+		bound[i] = &columnMergeRule{strategy: rule.Strategy, orderByIdx: -1}
+		if rule.Strategy == doltdb.MergeStrategyLatest {
+			if orderBy, ok := mergedSch.GetNonPKCols().GetByNameCaseInsensitive(rule.OrderBy); ok {
+				if idx, ok := storedIdx[orderBy.Tag]; ok {
+					bound[i].orderByIdx = idx
+				}
+			}
+		}
+	}
+	return bound
+}
+
+// resolveWithMergeRule resolves concurrent modifications of column |i| of the merged schema with the column's merge
+// rule, if it has one. |baseCol|, |leftCol| and |rightCol| have been converted to the merged schema, and |baseCol| is
+// nil for concurrent inserts. Returns false if the cell can't be resolved, in which case it remains a conflict.
+func (m *valueMerger) resolveWithMergeRule(ctx *sql.Context, i int, left, right val.Tuple, baseCol, leftCol, rightCol []byte) ([]byte, bool, error) {
+	if m.mergeRules == nil || m.mergeRules[i] == nil {
+		return nil, false, nil
+	}
+	rule := m.mergeRules[i]
+	resultType := m.resultVD.Types[i]
+
+	switch rule.strategy {
+	case doltdb.MergeStrategyOurs:
+		return leftCol, true, nil
+	case doltdb.MergeStrategyTheirs:
+		return rightCol, true, nil
+	case doltdb.MergeStrategyMax, doltdb.MergeStrategyMin:
+		if leftCol == nil || rightCol == nil {
+			return nil, false, nil
+		}
+		cmp := m.resultVD.Comparator().CompareValues(ctx, i, leftCol, rightCol, resultType)
+		if (cmp > 0) == (rule.strategy == doltdb.MergeStrategyMax) {
+			return leftCol, true, nil
+		}
+		return rightCol, true, nil
+	case doltdb.MergeStrategyLatest:
+		return m.resolveLatest(ctx, rule.orderByIdx, left, right, leftCol, rightCol)
+	case doltdb.MergeStrategySum:
+		return m.resolveSum(ctx, i, baseCol, leftCol, rightCol)
+	case doltdb.MergeStrategyUnion:
+		return m.resolveUnion(ctx, i, baseCol, leftCol, rightCol)
+	default:
+		return nil, false, nil
+	}
+}
+
+// resolveLatest resolves a cell to the value on the side of the merge with the greater value in the order by column
+// at |orderByIdx|. Ties and NULLs can't be resolved.
+func (m *valueMerger) resolveLatest(ctx *sql.Context, orderByIdx int, left, right val.Tuple, leftCol, rightCol []byte) ([]byte, bool, error) {
+	if orderByIdx < 0 {
+		return nil, false, nil
+	}
+	leftOrder, leftOrderIdx, ok := getColumn(&left, &m.leftMapping, orderByIdx)
+	if !ok {
+		return nil, false, nil
+	}
+	rightOrder, rightOrderIdx, ok := getColumn(&right, &m.rightMapping, orderByIdx)
+	if !ok {
+		return nil, false, nil
+	}
+	leftOrder, err := convert(ctx, m.leftVD, m.resultVD, m.resultSchema, leftOrderIdx, orderByIdx, left, leftOrder, m.ns)
+	if err != nil {
+		return nil, false, err
+	}
+	rightOrder, err = convert(ctx, m.rightVD, m.resultVD, m.resultSchema, rightOrderIdx, orderByIdx, right, rightOrder, m.ns)
+	if err != nil {
+		return nil, false, err
+	}
+	if leftOrder == nil || rightOrder == nil {
+		return nil, false, nil
+	}
+
+	cmp := m.resultVD.Comparator().CompareValues(ctx, orderByIdx, leftOrder, rightOrder, m.resultVD.Types[orderByIdx])
+	switch {
+	case cmp > 0:
+		return leftCol, true, nil
+	case cmp < 0:
+		return rightCol, true, nil
+	default:
+		return nil, false, nil
+	}
+}
+
+// resolveSum resolves a numeric cell to the ancestor value plus the changes made on each side of the merge, treating
+// a missing ancestor as zero. NULLs, and sums which don't fit in the column's type, can't be resolved.
+func (m *valueMerger) resolveSum(ctx *sql.Context, i int, baseCol, leftCol, rightCol []byte) ([]byte, bool, error) {
+	sqlType := m.resultSchema.GetNonPKCols().GetByIndex(i).TypeInfo.ToSqlType()
+	if !types.IsInteger(sqlType) && !types.IsFloat(sqlType) && !types.IsDecimal(sqlType) {
+		return nil, false, nil
+	}
+	if leftCol == nil || rightCol == nil {
+		return nil, false, nil
+	}
+
+	vals := make([]decimal.Decimal, 3)
+	for j, col := range [][]byte{baseCol, leftCol, rightCol} {
+		v, err := m.getMergedField(ctx, i, col)
+		if err != nil {
+			return nil, false, err
+		}
+		if v == nil {
+			continue
+		}
+		d, _, err := types.InternalDecimalType.Convert(v)
+		if err != nil {
+			return nil, false, err
+		}
+		vals[j] = d.(decimal.Decimal)
+	}
+	sum := vals[1].Add(vals[2]).Sub(vals[0])
+
+	var v interface{} = sum
+	if types.IsFloat(sqlType) {
+		v = sum.InexactFloat64()
+	}
+	converted, inRange, err := sqlType.Convert(v)
+	if err != nil || inRange == sql.OutOfRange {
+		return nil, false, nil
+	}
+	result, err := tree.Serialize(ctx, m.ns, m.resultVD.Types[i], converted)
+	if err != nil {
+		return nil, false, err
+	}
+	return result, true, nil
+}
+
+// resolveUnion resolves a SET cell by keeping the elements added on either side of the merge, and dropping the
+// elements removed on either side, treating a missing ancestor as the empty set. NULLs can't be resolved.
+func (m *valueMerger) resolveUnion(ctx *sql.Context, i int, baseCol, leftCol, rightCol []byte) ([]byte, bool, error) {
+	sqlType := m.resultSchema.GetNonPKCols().GetByIndex(i).TypeInfo.ToSqlType()
+	if !types.IsSet(sqlType) || leftCol == nil || rightCol == nil {
+		return nil, false, nil
+	}
+
+	sets := make([]uint64, 3)
+	for j, col := range [][]byte{baseCol, leftCol, rightCol} {
+		v, err := m.getMergedField(ctx, i, col)
+		if err != nil {
+			return nil, false, err
+		}
+		if v != nil {
+			sets[j] = v.(uint64)
+		}
+	}
+	base, left, right := sets[0], sets[1], sets[2]
+	union := (left & right) | (left &^ base) | (right &^ base)
+
+	result, err := tree.Serialize(ctx, m.ns, m.resultVD.Types[i], union)
+	if err != nil {
+		return nil, false, err
+	}
+	return result, true, nil
+}
+
+// getMergedField deserializes |col|, a value of column |i| of the merged schema.
+func (m *valueMerger) getMergedField(ctx *sql.Context, i int, col []byte) (interface{}, error) {
+	if col == nil {
+		return nil, nil
+	}
+	typ := m.resultVD.Types[i]
+	typ.Nullable = true
+	return tree.GetField(ctx, val.NewTupleDescriptor(typ), 0, val.NewTuple(m.syncPool, col), m.ns)
+}
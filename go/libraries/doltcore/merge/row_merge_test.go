@@ -211,7 +211,7 @@ func TestRowMerge(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			v := newValueMerger(test.mergedSch, test.leftSch, test.rightSch, test.baseSch, syncPool, nil)
+			v := newValueMerger(test.mergedSch, test.leftSch, test.rightSch, test.baseSch, syncPool, nil, nil)
 
 			merged, ok, err := v.tryMerge(ctx, test.row, test.mergeRow, test.ancRow)
 			assert.NoError(t, err)
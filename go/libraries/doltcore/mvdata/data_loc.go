@@ -57,8 +57,20 @@ const (
 
 	// ParquetFile is the format of a data location that is a .paquet file
 	ParquetFile DataFormat = ".parquet"
+
+	// ArrowFile is the format of a data location that is an Arrow IPC (Feather V2) file
+	ArrowFile DataFormat = ".arrow"
+
+	// NdjsonFile is the format of a data location that is a newline delimited json file
+	NdjsonFile DataFormat = ".jsonl"
 )
 
+// featherExt is an alternate extension for ArrowFile data locations
+const featherExt = ".feather"
+
+// ndjsonExt is an alternate extension for NdjsonFile data locations
+const ndjsonExt = ".ndjson"
+
 // ReadableStr returns a human readable string for a DataFormat
 func (df DataFormat) ReadableStr() string {
 	switch df {
@@ -76,6 +88,10 @@ func (df DataFormat) ReadableStr() string {
 		return "sql file"
 	case ParquetFile:
 		return "parquet file"
+	case ArrowFile:
+		return "arrow file"
+	case NdjsonFile:
+		return "ndjson file"
 	default:
 		return "invalid"
 	}
@@ -120,6 +136,10 @@ func NewDataLocation(path, fileFmtStr string) DataLocation {
 			dataFmt = SqlFile
 		case string(ParquetFile):
 			dataFmt = ParquetFile
+		case string(ArrowFile), featherExt:
+			dataFmt = ArrowFile
+		case string(NdjsonFile), ndjsonExt:
+			dataFmt = NdjsonFile
 		}
 	}
 
@@ -85,6 +85,10 @@ func TestBasics(t *testing.T) {
 		{NewDataLocation("file.csv", ""), CsvFile.ReadableStr() + ":file.csv", true},
 		{NewDataLocation("file.psv", ""), PsvFile.ReadableStr() + ":file.psv", true},
 		{NewDataLocation("file.json", ""), JsonFile.ReadableStr() + ":file.json", true},
+		{NewDataLocation("file.arrow", ""), ArrowFile.ReadableStr() + ":file.arrow", true},
+		{NewDataLocation("file.feather", ""), ArrowFile.ReadableStr() + ":file.feather", true},
+		{NewDataLocation("file.jsonl", ""), NdjsonFile.ReadableStr() + ":file.jsonl", true},
+		{NewDataLocation("file.ndjson", ""), NdjsonFile.ReadableStr() + ":file.ndjson", true},
 		//{NewDataLocation("file.nbf", ""), NbfFile, "file.nbf", true},
 	}
 
@@ -25,6 +25,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/env"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions"
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema/typeinfo"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table"
 	"github.com/dolthub/dolt/go/libraries/utils/set"
@@ -46,6 +47,9 @@ type JSONOptions struct {
 type ParquetOptions struct {
 	TableName string
 	SchFile   string
+	// FileSchema is true when the columns should be read using the schema in the metadata of the parquet file, rather
+	// than the schema of the table or schema file.
+	FileSchema bool
 }
 
 type MoverOptions struct {
@@ -132,8 +136,34 @@ func InferSchema(ctx context.Context, root doltdb.RootValue, rd table.ReadCloser
 		return nil, err
 	}
 
+	return SchemaWithPrimaryKeys(ctx, root, infCols, tableName, pks)
+}
+
+// KeyableColumns returns |cols| with the text and blob columns named in |pks| changed to varchar and varbinary columns,
+// as text and blob columns can't be used in a primary key without a key length. It is used for the columns of typed
+// files, which are read as text and blob columns.
+func KeyableColumns(cols *schema.ColCollection, pks []string) *schema.ColCollection {
+	pkSet := set.NewStrSet(pks)
+	return schema.MapColCollection(cols, func(col schema.Column) schema.Column {
+		if !pkSet.Contains(col.Name) {
+			return col
+		}
+		switch col.TypeInfo.GetTypeIdentifier() {
+		case typeinfo.BlobStringTypeIdentifier:
+			col.TypeInfo = typeinfo.StringDefaultType
+		case typeinfo.VarBinaryTypeIdentifier:
+			col.TypeInfo = typeinfo.VarbinaryDefaultType
+		}
+		col.Kind = col.TypeInfo.NomsKind()
+		return col
+	})
+}
+
+// SchemaWithPrimaryKeys returns a schema for a new table |tableName| with the columns given, using the columns named
+// in |pks| as its primary key. Tags are generated for the columns of the new schema.
+func SchemaWithPrimaryKeys(ctx context.Context, root doltdb.RootValue, cols *schema.ColCollection, tableName string, pks []string) (schema.Schema, error) {
 	pkSet := set.NewStrSet(pks)
-	newCols := schema.MapColCollection(infCols, func(col schema.Column) schema.Column {
+	newCols := schema.MapColCollection(cols, func(col schema.Column) schema.Column {
 		col.IsPartOfPK = pkSet.Contains(col.Name)
 		if col.IsPartOfPK {
 			hasNotNull := false
@@ -159,7 +189,7 @@ func InferSchema(ctx context.Context, root doltdb.RootValue, rd table.ReadCloser
 	}
 
 	// NOTE: This code is only used in the import codepath for Dolt, so we don't use a schema to qualify the table name
-	newCols, err = doltdb.GenerateTagsForNewColColl(ctx, root, tableName, newCols)
+	newCols, err := doltdb.GenerateTagsForNewColColl(ctx, root, tableName, newCols)
 	if err != nil {
 		return nil, errhand.BuildDError("failed to generate new schema").AddCause(err).Build()
 	}
@@ -28,6 +28,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/editor"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table/typed/arrow"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/typed/json"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/typed/parquet"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/untyped/csv"
@@ -51,6 +52,10 @@ func DFFromString(dfStr string) DataFormat {
 		return SqlFile
 	case "parquet", ".parquet":
 		return ParquetFile
+	case "arrow", ".arrow", "feather", ".feather":
+		return ArrowFile
+	case "jsonl", ".jsonl", "ndjson", ".ndjson":
+		return NdjsonFile
 	default:
 		return InvalidDataFormat
 	}
@@ -152,7 +157,10 @@ func (dl FileDataLocation) NewReader(ctx context.Context, dEnv *env.DoltEnv, opt
 	case ParquetFile:
 		var tableSch schema.Schema
 		parquetOpts, _ := opts.(ParquetOptions)
-		if parquetOpts.SchFile != "" {
+		if parquetOpts.FileSchema {
+			rd, rErr := parquet.OpenParquetReader(root.VRW(), dl.Path, nil)
+			return rd, false, rErr
+		} else if parquetOpts.SchFile != "" {
 			tn, s, tnErr := SchAndTableNameFromFile(ctx, parquetOpts.SchFile, dEnv)
 			if tnErr != nil {
 				return nil, false, tnErr
@@ -179,6 +187,23 @@ func (dl FileDataLocation) NewReader(ctx context.Context, dEnv *env.DoltEnv, opt
 		}
 		rd, rErr := parquet.OpenParquetReader(root.VRW(), dl.Path, tableSch)
 		return rd, false, rErr
+
+	case ArrowFile:
+		rd, err := arrow.OpenArrowReader(dl.Path)
+		return rd, false, err
+
+	case NdjsonFile:
+		// without a schema file, the columns are read from the file and their types are inferred on import
+		var sch schema.Schema
+		jsonOpts, _ := opts.(JSONOptions)
+		if jsonOpts.SchFile != "" {
+			sch, err = schemaForJSONOptions(ctx, dEnv, root, jsonOpts)
+			if err != nil {
+				return nil, false, err
+			}
+		}
+		rd, err := json.OpenNDJSONReader(root.VRW().Format(), dl.Path, fs, sch)
+		return rd, false, err
 	}
 
 	return nil, false, errors.New("unsupported format")
@@ -204,7 +229,39 @@ func (dl FileDataLocation) NewCreatingWriter(ctx context.Context, mvOpts DataMov
 		}
 	case ParquetFile:
 		return parquet.NewParquetRowWriterForFile(outSch, mvOpts.DestName())
+	case ArrowFile:
+		return arrow.NewArrowRowWriterForFile(outSch, mvOpts.DestName())
+	case NdjsonFile:
+		return json.NewNDJSONWriter(wr, outSch)
 	}
 
 	panic("Invalid Data Format." + string(dl.Format))
 }
+
+// schemaForJSONOptions returns the schema of the rows being imported, read from the schema file of |jsonOpts| if there
+// is one, and from the existing table otherwise.
+func schemaForJSONOptions(ctx context.Context, dEnv *env.DoltEnv, root doltdb.RootValue, jsonOpts JSONOptions) (schema.Schema, error) {
+	if jsonOpts.SchFile != "" {
+		tn, sch, err := SchAndTableNameFromFile(ctx, jsonOpts.SchFile, dEnv)
+		if err != nil {
+			return nil, err
+		}
+		if tn != jsonOpts.TableName {
+			return nil, fmt.Errorf("table name '%s' from schema file %s does not match table arg '%s'", tn, jsonOpts.SchFile, jsonOpts.TableName)
+		}
+		return sch, nil
+	}
+
+	tbl, exists, err := root.GetTable(ctx, doltdb.TableName{Name: jsonOpts.TableName})
+	if err != nil {
+		return nil, fmt.Errorf("An error occurred attempting to read the table:\n%v", err.Error())
+	}
+	if !exists {
+		return nil, fmt.Errorf("The following table could not be found:\n%v", jsonOpts.TableName)
+	}
+	sch, err := tbl.GetSchema(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("An error occurred attempting to read the table schema:\n%v", err.Error())
+	}
+	return sch, nil
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/editor"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table/typed/json"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/untyped/csv"
 	"github.com/dolthub/dolt/go/libraries/utils/filesys"
 	"github.com/dolthub/dolt/go/libraries/utils/iohelp"
@@ -73,6 +74,16 @@ func (dl StreamDataLocation) NewReader(ctx context.Context, dEnv *env.DoltEnv, o
 	case PsvFile:
 		rd, err := csv.NewCSVReader(root.VRW().Format(), io.NopCloser(dl.Reader), csv.NewCSVInfo().SetDelim("|"))
 		return rd, false, err
+
+	case NdjsonFile:
+		// a stream can't be scanned for its columns ahead of time, so rows are read with the schema of the table
+		jsonOpts, _ := opts.(JSONOptions)
+		sch, err := schemaForJSONOptions(ctx, dEnv, root, jsonOpts)
+		if err != nil {
+			return nil, false, err
+		}
+		rd, err := json.NewNDJSONReader(root.VRW().Format(), io.NopCloser(dl.Reader), sch)
+		return rd, false, err
 	}
 
 	return nil, false, errors.New(string(dl.Format) + "is an unsupported format to read from stdin")
@@ -87,6 +98,9 @@ func (dl StreamDataLocation) NewCreatingWriter(ctx context.Context, mvOpts DataM
 
 	case PsvFile:
 		return csv.NewCSVWriter(iohelp.NopWrCloser(dl.Writer), outSch, csv.NewCSVInfo().SetDelim("|"))
+
+	case NdjsonFile:
+		return json.NewNDJSONWriter(iohelp.NopWrCloser(dl.Writer), outSch)
 	}
 
 	return nil, errors.New(string(dl.Format) + "is an unsupported format to write to stdout")
@@ -17,6 +17,7 @@ package rebase
 import (
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/shopspring/decimal"
@@ -33,11 +34,18 @@ const (
 	RebaseActionFixup  = "fixup"
 	RebaseActionDrop   = "drop"
 	RebaseActionReword = "reword"
+	RebaseActionEdit   = "edit"
+	RebaseActionBreak  = "break"
+	RebaseActionExec   = "exec"
 )
 
 // ErrInvalidRebasePlanSquashFixupWithoutPick is returned when a rebase plan attempts to squash or
 // fixup a commit without first picking or rewording a commit.
-var ErrInvalidRebasePlanSquashFixupWithoutPick = fmt.Errorf("invalid rebase plan: squash and fixup actions must appear after a pick or reword action")
+var ErrInvalidRebasePlanSquashFixupWithoutPick = fmt.Errorf("invalid rebase plan: squash and fixup actions must appear after a pick, reword or edit action")
+
+// ErrInvalidRebasePlanExecWithoutQuery is returned when a rebase plan contains an exec action
+// without a query to run in its commit_message column.
+var ErrInvalidRebasePlanExecWithoutQuery = fmt.Errorf("invalid rebase plan: exec actions must specify the query to run in the commit_message column")
 
 // RebasePlanDatabase is a database that can save and load a rebase plan.
 type RebasePlanDatabase interface {
@@ -54,7 +62,8 @@ type RebasePlan struct {
 }
 
 // RebasePlanStep describes a single step in a rebase plan, such as dropping a
-// commit, squashing a commit into the previous commit, etc.
+// commit, squashing a commit into the previous commit, etc. Break and exec steps
+// don't refer to a commit; the CommitMsg of an exec step holds the SQL query it runs.
 type RebasePlanStep struct {
 	RebaseOrder decimal.Decimal
 	Action      string
@@ -69,6 +78,12 @@ func (rps *RebasePlanStep) RebaseOrderAsFloat() float32 {
 	return float32(f64)
 }
 
+// AppliesCommit returns true if this step cherry-picks the commit in CommitHash, or false
+// if the step doesn't refer to a commit, such as a break or exec step.
+func (rps *RebasePlanStep) AppliesCommit() bool {
+	return rps.Action != RebaseActionBreak && rps.Action != RebaseActionExec
+}
+
 // CreateDefaultRebasePlan creates and returns the default rebase plan for the commits between
 // |startCommit| and |upstreamCommit|, equivalent to the log of startCommit..upstreamCommit. The
 // default plan includes each of those commits, in the same order they were originally applied, and
@@ -107,8 +122,95 @@ func CreateDefaultRebasePlan(ctx *sql.Context, startCommit, upstreamCommit *dolt
 	return &plan, nil
 }
 
+// Commit message prefixes that mark a commit to be fixed up or squashed into an earlier commit by AutosquashRebasePlan.
+const (
+	autosquashFixupPrefix  = "fixup! "
+	autosquashSquashPrefix = "squash! "
+)
+
+// AutosquashRebasePlan reorders the steps of |plan| so that each commit whose message begins with "fixup! " or
+// "squash! " comes right after the commit it names, with the fixup or squash action, like Git's --autosquash option.
+// The rest of the first line of the message names a commit by its message's first line or by a prefix of its hash.
+// Commits that don't name an earlier commit in the plan keep their place in the plan. The steps of the reordered plan
+// are numbered from 1.
+func AutosquashRebasePlan(plan *RebasePlan) {
+	steps := plan.Steps
+
+	// followers maps the index of each step to the indexes of the steps moved after it
+	followers := make(map[int][]int)
+	moved := make(map[int]bool)
+	for i := range steps {
+		action, target, ok := parseAutosquashMessage(steps[i].CommitMsg)
+		if !ok {
+			continue
+		}
+		if j := findAutosquashTarget(steps[:i], moved, target); j >= 0 {
+			steps[i].Action = action
+			followers[j] = append(followers[j], i)
+			moved[i] = true
+		}
+	}
+
+	reordered := make([]RebasePlanStep, 0, len(steps))
+	for i := range steps {
+		if moved[i] {
+			continue
+		}
+		reordered = append(reordered, steps[i])
+		for _, follower := range followers[i] {
+			reordered = append(reordered, steps[follower])
+		}
+	}
+	for i := range reordered {
+		reordered[i].RebaseOrder = decimal.NewFromFloat32(float32(i + 1))
+	}
+	plan.Steps = reordered
+}
+
+// parseAutosquashMessage returns the autosquash action for the commit message |msg| and the commit it names, or false
+// if the message doesn't begin with "fixup! " or "squash! ". Repeated prefixes, e.g. "fixup! fixup! ", all name the
+// same commit, and the first prefix determines the action.
+func parseAutosquashMessage(msg string) (action string, target string, ok bool) {
+	subject := strings.SplitN(msg, "\n", 2)[0]
+	for {
+		if strings.HasPrefix(subject, autosquashFixupPrefix) {
+			subject = strings.TrimPrefix(subject, autosquashFixupPrefix)
+			if action == "" {
+				action = RebaseActionFixup
+			}
+		} else if strings.HasPrefix(subject, autosquashSquashPrefix) {
+			subject = strings.TrimPrefix(subject, autosquashSquashPrefix)
+			if action == "" {
+				action = RebaseActionSquash
+			}
+		} else {
+			break
+		}
+	}
+	target = strings.TrimSpace(subject)
+	return action, target, action != "" && target != ""
+}
+
+// findAutosquashTarget returns the index of the step in |steps| for the commit named by |target|, or -1 if there is
+// none. Commits are matched by the first line of their message first, and then by a prefix of their hash of at least
+// four characters. Steps in |moved| are never matched.
+func findAutosquashTarget(steps []RebasePlanStep, moved map[int]bool, target string) int {
+	for i, step := range steps {
+		if !moved[i] && step.AppliesCommit() && strings.SplitN(step.CommitMsg, "\n", 2)[0] == target {
+			return i
+		}
+	}
+	for i, step := range steps {
+		if !moved[i] && step.AppliesCommit() && len(target) >= 4 && strings.HasPrefix(step.CommitHash, target) {
+			return i
+		}
+	}
+	return -1
+}
+
 // ValidateRebasePlan returns a validation error for invalid states in a rebase plan, such as
-// squash or fixup actions appearing in the plan before a pick or reword action.
+// squash or fixup actions appearing in the plan before a pick, reword or edit action, or exec
+// actions without a query.
 func ValidateRebasePlan(ctx *sql.Context, plan *RebasePlan) error {
 	seenPick := false
 	seenReword := false
@@ -120,7 +222,7 @@ func ValidateRebasePlan(ctx *sql.Context, plan *RebasePlan) error {
 		}
 
 		switch step.Action {
-		case RebaseActionPick:
+		case RebaseActionPick, RebaseActionEdit:
 			seenPick = true
 
 		case RebaseActionReword:
@@ -130,8 +232,16 @@ func ValidateRebasePlan(ctx *sql.Context, plan *RebasePlan) error {
 			if !seenPick && !seenReword {
 				return ErrInvalidRebasePlanSquashFixupWithoutPick
 			}
+
+		case RebaseActionExec:
+			if len(strings.TrimSpace(step.CommitMsg)) == 0 {
+				return ErrInvalidRebasePlanExecWithoutQuery
+			}
 		}
 
+		if !step.AppliesCommit() {
+			continue
+		}
 		if err := validateCommit(ctx, step.CommitHash); err != nil {
 			return err
 		}
@@ -0,0 +1,100 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rebase
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutosquashRebasePlan(t *testing.T) {
+	tests := []struct {
+		name     string
+		steps    []RebasePlanStep
+		expected []RebasePlanStep
+	}{
+		{
+			name: "no autosquash commits",
+			steps: []RebasePlanStep{
+				{Action: RebaseActionPick, CommitHash: "aaaaaaaa", CommitMsg: "one"},
+				{Action: RebaseActionPick, CommitHash: "bbbbbbbb", CommitMsg: "two"},
+			},
+			expected: []RebasePlanStep{
+				{Action: RebaseActionPick, CommitHash: "aaaaaaaa", CommitMsg: "one"},
+				{Action: RebaseActionPick, CommitHash: "bbbbbbbb", CommitMsg: "two"},
+			},
+		},
+		{
+			name: "fixup and squash commits are moved after the commits they name",
+			steps: []RebasePlanStep{
+				{Action: RebaseActionPick, CommitHash: "aaaaaaaa", CommitMsg: "one"},
+				{Action: RebaseActionPick, CommitHash: "bbbbbbbb", CommitMsg: "two"},
+				{Action: RebaseActionPick, CommitHash: "cccccccc", CommitMsg: "fixup! one"},
+				{Action: RebaseActionPick, CommitHash: "dddddddd", CommitMsg: "squash! two\n\nmore details"},
+				{Action: RebaseActionPick, CommitHash: "eeeeeeee", CommitMsg: "squash! one"},
+			},
+			expected: []RebasePlanStep{
+				{Action: RebaseActionPick, CommitHash: "aaaaaaaa", CommitMsg: "one"},
+				{Action: RebaseActionFixup, CommitHash: "cccccccc", CommitMsg: "fixup! one"},
+				{Action: RebaseActionSquash, CommitHash: "eeeeeeee", CommitMsg: "squash! one"},
+				{Action: RebaseActionPick, CommitHash: "bbbbbbbb", CommitMsg: "two"},
+				{Action: RebaseActionSquash, CommitHash: "dddddddd", CommitMsg: "squash! two\n\nmore details"},
+			},
+		},
+		{
+			name: "commits are named by hash prefix and repeated prefixes",
+			steps: []RebasePlanStep{
+				{Action: RebaseActionPick, CommitHash: "aaaaaaaa", CommitMsg: "one"},
+				{Action: RebaseActionPick, CommitHash: "bbbbbbbb", CommitMsg: "two"},
+				{Action: RebaseActionPick, CommitHash: "cccccccc", CommitMsg: "fixup! aaaa"},
+				{Action: RebaseActionPick, CommitHash: "dddddddd", CommitMsg: "squash! fixup! two"},
+			},
+			expected: []RebasePlanStep{
+				{Action: RebaseActionPick, CommitHash: "aaaaaaaa", CommitMsg: "one"},
+				{Action: RebaseActionFixup, CommitHash: "cccccccc", CommitMsg: "fixup! aaaa"},
+				{Action: RebaseActionPick, CommitHash: "bbbbbbbb", CommitMsg: "two"},
+				{Action: RebaseActionSquash, CommitHash: "dddddddd", CommitMsg: "squash! fixup! two"},
+			},
+		},
+		{
+			name: "commits that don't name an earlier commit keep their place",
+			steps: []RebasePlanStep{
+				{Action: RebaseActionPick, CommitHash: "aaaaaaaa", CommitMsg: "fixup! two"},
+				{Action: RebaseActionPick, CommitHash: "bbbbbbbb", CommitMsg: "two"},
+				{Action: RebaseActionPick, CommitHash: "cccccccc", CommitMsg: "fixup! three"},
+				{Action: RebaseActionPick, CommitHash: "dddddddd", CommitMsg: "fixup! bbb"},
+			},
+			expected: []RebasePlanStep{
+				{Action: RebaseActionPick, CommitHash: "aaaaaaaa", CommitMsg: "fixup! two"},
+				{Action: RebaseActionPick, CommitHash: "bbbbbbbb", CommitMsg: "two"},
+				{Action: RebaseActionPick, CommitHash: "cccccccc", CommitMsg: "fixup! three"},
+				{Action: RebaseActionPick, CommitHash: "dddddddd", CommitMsg: "fixup! bbb"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			plan := &RebasePlan{Steps: test.steps}
+			for i := range test.expected {
+				test.expected[i].RebaseOrder = decimal.NewFromFloat32(float32(i + 1))
+			}
+			AutosquashRebasePlan(plan)
+			require.Equal(t, test.expected, plan.Steps)
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/url"
 	"path/filepath"
 	"runtime"
 	"strings"
@@ -58,7 +59,11 @@ const (
 	DefaultLogFormat                 = LogFormat_Text
 	DefaultAutoCommit                = true
 	DefaultAutoGCBehaviorEnable      = false
+	DefaultWebhookMaxRetries         = 3
+	DefaultWebhookTimeout            = 5 * time.Second
+	DefaultWebhookQueueSize          = 128
 	DefaultDoltTransactionCommit     = false
+	DefaultRunCIWorkflows            = false
 	DefaultMaxConnections            = 1000
 	DefaultMaxWaitConnections        = 50
 	DefaultMaxWaitConnectionsTimeout = 60 * time.Second
@@ -219,6 +224,10 @@ type ServerConfig interface {
 	ValueSet(value string) bool
 	// AutoGCBehavior defines parameters around how auto-GC works for the running server.
 	AutoGCBehavior() AutoGCBehavior
+	// Webhooks are the HTTP endpoints notified when a branch head is updated in the running server.
+	Webhooks() []WebhookConfig
+	// RunCIWorkflows returns whether the dolt_ci workflows of a database are run when its branch heads are updated.
+	RunCIWorkflows() bool
 }
 
 // DefaultServerConfig creates a `*ServerConfig` that has all of the options set to their default values.
@@ -236,6 +245,7 @@ func defaultServerConfigYAML() *YAMLConfig {
 			ReadOnly:              ptr(DefaultReadOnly),
 			AutoCommit:            ptr(DefaultAutoCommit),
 			DoltTransactionCommit: ptr(DefaultDoltTransactionCommit),
+			RunCIWorkflows:        ptr(DefaultRunCIWorkflows),
 			AutoGCBehavior: &AutoGCBehaviorYAMLConfig{
 				Enable_: ptr(DefaultAutoGCBehaviorEnable),
 			},
@@ -298,7 +308,11 @@ func ValidateConfig(config ServerConfig) error {
 	if config.RequireSecureTransport() && config.TLSCert() == "" && config.TLSKey() == "" {
 		return fmt.Errorf("require_secure_transport can only be `true` when a tls_key and tls_cert are provided.")
 	}
-	return ValidateClusterConfig(config.ClusterConfig())
+	err := ValidateClusterConfig(config.ClusterConfig())
+	if err != nil {
+		return err
+	}
+	return ValidateWebhooksConfig(config.Webhooks())
 }
 
 const (
@@ -338,6 +352,8 @@ const (
 	RemotesapiReadOnlyKey           = "remotesapi_read_only"
 	ClusterConfigKey                = "cluster_config"
 	EventSchedulerKey               = "event_scheduler"
+	WebhooksKey                     = "webhooks"
+	RunCIWorkflowsKey               = "run_ci_workflows"
 )
 
 type SystemVariableTarget interface {
@@ -411,6 +427,25 @@ func ValidateClusterConfig(config ClusterConfig) error {
 	return nil
 }
 
+func ValidateWebhooksConfig(webhooks []WebhookConfig) error {
+	for i, webhook := range webhooks {
+		u, err := url.Parse(webhook.URL())
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("webhooks[%d]: url: is \"%s\" but must be a valid http or https url", i, webhook.URL())
+		}
+		if webhook.MaxRetries() < 0 {
+			return fmt.Errorf("webhooks[%d]: max_retries: is %d but must be >= 0", i, webhook.MaxRetries())
+		}
+		if webhook.Timeout() <= 0 {
+			return fmt.Errorf("webhooks[%d]: timeout_millis: is %d but must be > 0", i, webhook.Timeout().Milliseconds())
+		}
+		if webhook.QueueSize() <= 0 {
+			return fmt.Errorf("webhooks[%d]: queue_size: is %d but must be > 0", i, webhook.QueueSize())
+		}
+	}
+	return nil
+}
+
 // ConnectionString returns a Data Source Name (DSN) to be used by go clients for connecting to a running server.
 // If unix socket file path is defined in ServerConfig, then `unix` DSN will be returned.
 func ConnectionString(config ServerConfig, database string) string {
@@ -483,3 +518,25 @@ func CheckForUnixSocket(config ServerConfig) (string, bool, error) {
 type AutoGCBehavior interface {
 	Enable() bool
 }
+
+// WebhookConfig is the configuration of an HTTP endpoint which is sent a JSON payload describing every branch head
+// update in the running server.
+type WebhookConfig interface {
+	// Name identifies the webhook in logs and in the dolt_webhook_deliveries system table. Defaults to the URL.
+	Name() string
+	// URL is the http or https endpoint that payloads are POSTed to.
+	URL() string
+	// Secret, if not empty, is the key used to sign each payload with HMAC-SHA256.
+	Secret() string
+	// Databases limits the webhook to updates of the named databases. Empty matches every database.
+	Databases() []string
+	// Branches limits the webhook to updates of the named branches. Empty matches every branch.
+	Branches() []string
+	// MaxRetries is the number of times a failed delivery is retried before it is abandoned.
+	MaxRetries() int
+	// Timeout is the time allowed for each delivery attempt.
+	Timeout() time.Duration
+	// QueueSize bounds the number of deliveries waiting to be sent. Updates which arrive while the queue is full are
+	// dropped.
+	QueueSize() int
+}
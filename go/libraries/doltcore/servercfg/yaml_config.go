@@ -68,6 +68,9 @@ type BehaviorYAMLConfig struct {
 	EventSchedulerStatus *string `yaml:"event_scheduler,omitempty" minver:"1.17.0"`
 
 	AutoGCBehavior *AutoGCBehaviorYAMLConfig `yaml:"auto_gc_behavior,omitempty" minver:"1.50.0"`
+
+	// RunCIWorkflows enables running the dolt_ci workflows of a database when its branch heads are updated.
+	RunCIWorkflows *bool `yaml:"run_ci_workflows,omitempty" minver:"TBD"`
 }
 
 // UserYAMLConfig contains server configuration regarding the user account clients must use to connect
@@ -149,6 +152,7 @@ type YAMLConfig struct {
 	GoldenMysqlConn *string                `yaml:"golden_mysql_conn,omitempty"`
 	MetricsConfig   MetricsYAMLConfig      `yaml:"metrics,omitempty"`
 	ClusterCfg      *ClusterYAMLConfig     `yaml:"cluster,omitempty"`
+	Webhooks_       []WebhookYAMLConfig    `yaml:"webhooks,omitempty" minver:"TBD"`
 }
 
 var _ ServerConfig = YAMLConfig{}
@@ -195,6 +199,7 @@ func ServerConfigAsYAMLConfig(cfg ServerConfig) *YAMLConfig {
 			DoltTransactionCommit:        ptr(cfg.DoltTransactionCommit()),
 			EventSchedulerStatus:         ptr(cfg.EventSchedulerStatus()),
 			AutoGCBehavior:               autoGCBehavior,
+			RunCIWorkflows:               ptr(cfg.RunCIWorkflows()),
 		},
 		ListenerConfig: ListenerYAMLConfig{
 			HostStr:                 ptr(cfg.Host()),
@@ -227,6 +232,7 @@ func ServerConfigAsYAMLConfig(cfg ServerConfig) *YAMLConfig {
 		SystemVars_:       systemVars,
 		Vars:              cfg.UserVars(),
 		Jwks:              cfg.JwksConfig(),
+		Webhooks_:         webhooksAsYAMLConfig(cfg.Webhooks()),
 	}
 }
 
@@ -251,6 +257,27 @@ func clusterConfigAsYAMLConfig(config ClusterConfig) *ClusterYAMLConfig {
 	}
 }
 
+func webhooksAsYAMLConfig(webhooks []WebhookConfig) []WebhookYAMLConfig {
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	ret := make([]WebhookYAMLConfig, len(webhooks))
+	for i, webhook := range webhooks {
+		ret[i] = WebhookYAMLConfig{
+			Name_:          nillableStrPtr(webhook.Name()),
+			URL_:           ptr(webhook.URL()),
+			Secret_:        nillableStrPtr(webhook.Secret()),
+			Databases_:     webhook.Databases(),
+			Branches_:      webhook.Branches(),
+			MaxRetries_:    ptr(webhook.MaxRetries()),
+			TimeoutMillis_: ptr(uint64(webhook.Timeout().Milliseconds())),
+			QueueSize_:     ptr(webhook.QueueSize()),
+		}
+	}
+	return ret
+}
+
 // ServerConfigSetValuesAsYAMLConfig returns a YAMLConfig containing only values
 // that were explicitly set in the given ServerConfig.
 func ServerConfigSetValuesAsYAMLConfig(cfg ServerConfig) *YAMLConfig {
@@ -267,6 +294,7 @@ func ServerConfigSetValuesAsYAMLConfig(cfg ServerConfig) *YAMLConfig {
 			DisableClientMultiStatements: zeroIf(ptr(cfg.DisableClientMultiStatements()), !cfg.ValueSet(DisableClientMultiStatementsKey)),
 			DoltTransactionCommit:        zeroIf(ptr(cfg.DoltTransactionCommit()), !cfg.ValueSet(DoltTransactionCommitKey)),
 			EventSchedulerStatus:         zeroIf(ptr(cfg.EventSchedulerStatus()), !cfg.ValueSet(EventSchedulerKey)),
+			RunCIWorkflows:               zeroIf(ptr(cfg.RunCIWorkflows()), !cfg.ValueSet(RunCIWorkflowsKey)),
 		},
 		ListenerConfig: ListenerYAMLConfig{
 			HostStr:                 zeroIf(ptr(cfg.Host()), !cfg.ValueSet(HostKey)),
@@ -299,6 +327,7 @@ func ServerConfigSetValuesAsYAMLConfig(cfg ServerConfig) *YAMLConfig {
 		SystemVars_:       zeroIf(systemVars, !cfg.ValueSet(SystemVarsKey)),
 		Vars:              zeroIf(cfg.UserVars(), !cfg.ValueSet(UserVarsKey)),
 		Jwks:              zeroIf(cfg.JwksConfig(), !cfg.ValueSet(JwksConfigKey)),
+		Webhooks_:         zeroIf(webhooksAsYAMLConfig(cfg.Webhooks()), !cfg.ValueSet(WebhooksKey)),
 	}
 }
 
@@ -484,6 +513,9 @@ func (cfg YAMLConfig) withDefaultsFilledIn() YAMLConfig {
 	if withDefaults.BehaviorConfig.AutoGCBehavior == nil {
 		withDefaults.BehaviorConfig.AutoGCBehavior = defaults.BehaviorConfig.AutoGCBehavior
 	}
+	if withDefaults.BehaviorConfig.RunCIWorkflows == nil {
+		withDefaults.BehaviorConfig.RunCIWorkflows = defaults.BehaviorConfig.RunCIWorkflows
+	}
 
 	if withDefaults.ListenerConfig.HostStr == nil {
 		withDefaults.ListenerConfig.HostStr = defaults.ListenerConfig.HostStr
@@ -875,6 +907,25 @@ func (cfg YAMLConfig) AutoGCBehavior() AutoGCBehavior {
 	return cfg.BehaviorConfig.AutoGCBehavior
 }
 
+// RunCIWorkflows returns whether the dolt_ci workflows of a database are run when its branch heads are updated.
+func (cfg YAMLConfig) RunCIWorkflows() bool {
+	if cfg.BehaviorConfig.RunCIWorkflows == nil {
+		return DefaultRunCIWorkflows
+	}
+	return *cfg.BehaviorConfig.RunCIWorkflows
+}
+
+func (cfg YAMLConfig) Webhooks() []WebhookConfig {
+	if len(cfg.Webhooks_) == 0 {
+		return nil
+	}
+	ret := make([]WebhookConfig, len(cfg.Webhooks_))
+	for i := range cfg.Webhooks_ {
+		ret[i] = cfg.Webhooks_[i]
+	}
+	return ret
+}
+
 func (cfg YAMLConfig) EventSchedulerStatus() string {
 	if cfg.BehaviorConfig.EventSchedulerStatus == nil {
 		return "ON"
@@ -981,6 +1032,10 @@ func (cfg YAMLConfig) ValueSet(value string) bool {
 		return cfg.ListenerConfig.MaxConnectionsTimeoutMs != nil
 	case EventSchedulerKey:
 		return cfg.BehaviorConfig.EventSchedulerStatus != nil
+	case WebhooksKey:
+		return cfg.Webhooks_ != nil
+	case RunCIWorkflowsKey:
+		return cfg.BehaviorConfig.RunCIWorkflows != nil
 	}
 	return false
 }
@@ -1001,3 +1056,67 @@ func toAutoGCBehaviorYAML(a AutoGCBehavior) *AutoGCBehaviorYAMLConfig {
 		Enable_: ptr(a.Enable()),
 	}
 }
+
+// WebhookYAMLConfig is the YAML configuration of a single webhook. See WebhookConfig.
+type WebhookYAMLConfig struct {
+	Name_          *string  `yaml:"name,omitempty" minver:"TBD"`
+	URL_           *string  `yaml:"url,omitempty" minver:"TBD"`
+	Secret_        *string  `yaml:"secret,omitempty" minver:"TBD"`
+	Databases_     []string `yaml:"databases,omitempty" minver:"TBD"`
+	Branches_      []string `yaml:"branches,omitempty" minver:"TBD"`
+	MaxRetries_    *int     `yaml:"max_retries,omitempty" minver:"TBD"`
+	TimeoutMillis_ *uint64  `yaml:"timeout_millis,omitempty" minver:"TBD"`
+	QueueSize_     *int     `yaml:"queue_size,omitempty" minver:"TBD"`
+}
+
+var _ WebhookConfig = WebhookYAMLConfig{}
+
+func (w WebhookYAMLConfig) Name() string {
+	if w.Name_ == nil || *w.Name_ == "" {
+		return w.URL()
+	}
+	return *w.Name_
+}
+
+func (w WebhookYAMLConfig) URL() string {
+	if w.URL_ == nil {
+		return ""
+	}
+	return *w.URL_
+}
+
+func (w WebhookYAMLConfig) Secret() string {
+	if w.Secret_ == nil {
+		return ""
+	}
+	return *w.Secret_
+}
+
+func (w WebhookYAMLConfig) Databases() []string {
+	return w.Databases_
+}
+
+func (w WebhookYAMLConfig) Branches() []string {
+	return w.Branches_
+}
+
+func (w WebhookYAMLConfig) MaxRetries() int {
+	if w.MaxRetries_ == nil {
+		return DefaultWebhookMaxRetries
+	}
+	return *w.MaxRetries_
+}
+
+func (w WebhookYAMLConfig) Timeout() time.Duration {
+	if w.TimeoutMillis_ == nil {
+		return DefaultWebhookTimeout
+	}
+	return time.Duration(*w.TimeoutMillis_) * time.Millisecond
+}
+
+func (w WebhookYAMLConfig) QueueSize() int {
+	if w.QueueSize_ == nil {
+		return DefaultWebhookQueueSize
+	}
+	return *w.QueueSize_
+}
@@ -16,6 +16,7 @@ package servercfg
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -36,6 +37,7 @@ behavior:
     event_scheduler: ON
     auto_gc_behavior:
         enable: false
+    run_ci_workflows: true
 
 listener:
     host: localhost
@@ -88,6 +90,7 @@ jwks:
 	expected := ServerConfigAsYAMLConfig(DefaultServerConfig())
 
 	expected.BehaviorConfig.DoltTransactionCommit = &trueValue
+	expected.BehaviorConfig.RunCIWorkflows = &trueValue
 	expected.CfgDirStr = nillableStrPtr("")
 	expected.PrivilegeFile = ptr("some other nonsense")
 	expected.BranchControlFile = ptr("third nonsense")
@@ -287,6 +290,126 @@ cluster:
 	}
 }
 
+func TestUnmarshallWebhooks(t *testing.T) {
+	testStr := `
+webhooks:
+- name: audit
+  url: https://example.com/dolt
+  secret: s3cr3t
+  databases: [db1]
+  branches: [main, release]
+  max_retries: 5
+  timeout_millis: 2500
+  queue_size: 16
+- url: http://localhost:8080/hook
+`
+	config, err := NewYamlConfig([]byte(testStr))
+	require.NoError(t, err)
+	require.True(t, config.ValueSet(WebhooksKey))
+	webhooks := config.Webhooks()
+	require.Len(t, webhooks, 2)
+
+	require.Equal(t, "audit", webhooks[0].Name())
+	require.Equal(t, "https://example.com/dolt", webhooks[0].URL())
+	require.Equal(t, "s3cr3t", webhooks[0].Secret())
+	require.Equal(t, []string{"db1"}, webhooks[0].Databases())
+	require.Equal(t, []string{"main", "release"}, webhooks[0].Branches())
+	require.Equal(t, 5, webhooks[0].MaxRetries())
+	require.Equal(t, 2500*time.Millisecond, webhooks[0].Timeout())
+	require.Equal(t, 16, webhooks[0].QueueSize())
+
+	require.Equal(t, "http://localhost:8080/hook", webhooks[1].Name())
+	require.Equal(t, "", webhooks[1].Secret())
+	require.Empty(t, webhooks[1].Databases())
+	require.Empty(t, webhooks[1].Branches())
+	require.Equal(t, DefaultWebhookMaxRetries, webhooks[1].MaxRetries())
+	require.Equal(t, DefaultWebhookTimeout, webhooks[1].Timeout())
+	require.Equal(t, DefaultWebhookQueueSize, webhooks[1].QueueSize())
+
+	roundTripped, err := NewYamlConfig([]byte(ServerConfigSetValuesAsYAMLConfig(config).String()))
+	require.NoError(t, err)
+	require.Equal(t, config.Webhooks_[0], roundTripped.Webhooks_[0])
+}
+
+func TestValidateWebhooksConfig(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Config string
+		Error  bool
+	}{
+		{
+			Name:   "no webhooks: config",
+			Config: "",
+			Error:  false,
+		},
+		{
+			Name: "all fields valid",
+			Config: `
+webhooks:
+- url: https://example.com/dolt
+  max_retries: 0
+  timeout_millis: 100
+  queue_size: 1
+`,
+			Error: false,
+		},
+		{
+			Name: "missing url",
+			Config: `
+webhooks:
+- name: audit
+`,
+			Error: true,
+		},
+		{
+			Name: "bad url scheme",
+			Config: `
+webhooks:
+- url: ftp://example.com/dolt
+`,
+			Error: true,
+		},
+		{
+			Name: "negative max_retries",
+			Config: `
+webhooks:
+- url: https://example.com/dolt
+  max_retries: -1
+`,
+			Error: true,
+		},
+		{
+			Name: "zero timeout_millis",
+			Config: `
+webhooks:
+- url: https://example.com/dolt
+  timeout_millis: 0
+`,
+			Error: true,
+		},
+		{
+			Name: "zero queue_size",
+			Config: `
+webhooks:
+- url: https://example.com/dolt
+  queue_size: 0
+`,
+			Error: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			cfg, err := NewYamlConfig([]byte(c.Config))
+			require.NoError(t, err)
+			if c.Error {
+				require.Error(t, ValidateWebhooksConfig(cfg.Webhooks()))
+			} else {
+				require.NoError(t, ValidateWebhooksConfig(cfg.Webhooks()))
+			}
+		})
+	}
+}
+
 // Tests that a common YAML error (incorrect indentation) throws an error
 func TestUnmarshallError(t *testing.T) {
 	testStr := `
@@ -334,6 +457,7 @@ func TestYAMLConfigDefaults(t *testing.T) {
 	assert.Equal(t, DefaultLogFormat, cfg.LogFormat())
 	assert.Equal(t, DefaultAutoCommit, cfg.AutoCommit())
 	assert.Equal(t, DefaultDoltTransactionCommit, cfg.DoltTransactionCommit())
+	assert.Equal(t, DefaultRunCIWorkflows, cfg.RunCIWorkflows())
 	assert.Equal(t, uint64(DefaultMaxConnections), cfg.MaxConnections())
 	assert.Equal(t, "", cfg.TLSKey())
 	assert.Equal(t, "", cfg.TLSCert())
@@ -0,0 +1,423 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	cdcapi "github.com/dolthub/dolt/go/gen/proto/dolt/services/cdcapi/v1alpha1"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dtables"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table/typed/json"
+	"github.com/dolthub/dolt/go/libraries/utils/iohelp"
+	"github.com/dolthub/dolt/go/store/hash"
+	"github.com/dolthub/dolt/go/store/prolly"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/val"
+)
+
+var errCommitNotOnBranch = errors.New("commit is not on the first-parent history of the branch")
+
+type hashedCommit struct {
+	cm *doltdb.Commit
+	h  hash.Hash
+}
+
+// firstParentHistory returns the commits on the first-parent history of |head| which follow |stop|, oldest first. If
+// |inclusive| is true, |stop| itself is also returned. If |stop| is empty, the entire history is returned.
+func firstParentHistory(ctx context.Context, head *doltdb.Commit, stop hash.Hash, inclusive bool) ([]hashedCommit, error) {
+	var commits []hashedCommit
+	cm := head
+	for {
+		h, err := cm.HashOf()
+		if err != nil {
+			return nil, err
+		}
+		if h == stop {
+			if inclusive {
+				commits = append(commits, hashedCommit{cm: cm, h: h})
+			}
+			break
+		}
+		commits = append(commits, hashedCommit{cm: cm, h: h})
+
+		if cm.NumParents() == 0 {
+			if !stop.IsEmpty() {
+				return nil, errCommitNotOnBranch
+			}
+			break
+		}
+		optCmt, err := cm.GetParent(ctx, 0)
+		if err != nil {
+			return nil, err
+		}
+		var ok bool
+		cm, ok = optCmt.ToCommit()
+		if !ok {
+			return nil, doltdb.ErrGhostCommitEncountered
+		}
+	}
+
+	for i, j := 0, len(commits)-1; i < j; i, j = i+1, j-1 {
+		commits[i], commits[j] = commits[j], commits[i]
+	}
+	return commits, nil
+}
+
+// changeStream computes the row changes made by commits and passes them to |emit|.
+type changeStream struct {
+	ddb *doltdb.DoltDB
+	// tables holds the lower-cased names of the tables to stream, or is empty to stream every table.
+	tables map[string]struct{}
+	emit   func(*cdcapi.RowChange) error
+}
+
+func newChangeStream(ddb *doltdb.DoltDB, tables []string, emit func(*cdcapi.RowChange) error) *changeStream {
+	set := make(map[string]struct{}, len(tables))
+	for _, t := range tables {
+		set[strings.ToLower(t)] = struct{}{}
+	}
+	return &changeStream{ddb: ddb, tables: set, emit: emit}
+}
+
+func (cs *changeStream) includesTable(name string) bool {
+	if doltdb.IsFullTextTable(name) {
+		return false
+	}
+	if len(cs.tables) == 0 {
+		return true
+	}
+	_, ok := cs.tables[strings.ToLower(name)]
+	return ok
+}
+
+// streamCommit emits the changes |c| made relative to its first parent. If |after| is not nil, only the changes which
+// follow it are emitted.
+func (cs *changeStream) streamCommit(ctx context.Context, c hashedCommit, after *cursor) error {
+	toRoot, err := c.cm.GetRootValue(ctx)
+	if err != nil {
+		return err
+	}
+	var fromRoot doltdb.RootValue
+	if c.cm.NumParents() > 0 {
+		optCmt, err := c.cm.GetParent(ctx, 0)
+		if err != nil {
+			return err
+		}
+		parent, ok := optCmt.ToCommit()
+		if !ok {
+			return doltdb.ErrGhostCommitEncountered
+		}
+		fromRoot, err = parent.GetRootValue(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	names, err := tableNames(ctx, fromRoot, toRoot)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if !cs.includesTable(name) || after.skipsTable(name) {
+			continue
+		}
+
+		fromTbl, fromHash, err := getTable(ctx, fromRoot, name)
+		if err != nil {
+			return err
+		}
+		toTbl, toHash, err := getTable(ctx, toRoot, name)
+		if err != nil {
+			return err
+		}
+		if fromHash == toHash {
+			continue
+		}
+
+		if err = cs.streamTable(ctx, c.h, name, fromTbl, toTbl, after); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tableNames returns the sorted names of the tables in either |fromRoot| or |toRoot|. |fromRoot| is nil for the
+// initial commit.
+func tableNames(ctx context.Context, fromRoot, toRoot doltdb.RootValue) ([]string, error) {
+	names, err := toRoot.GetTableNames(ctx, doltdb.DefaultSchemaName)
+	if err != nil {
+		return nil, err
+	}
+	if fromRoot != nil {
+		fromNames, err := fromRoot.GetTableNames(ctx, doltdb.DefaultSchemaName)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, fromNames...)
+	}
+
+	sort.Strings(names)
+	deduped := names[:0]
+	for i, name := range names {
+		if i == 0 || name != names[i-1] {
+			deduped = append(deduped, name)
+		}
+	}
+	return deduped, nil
+}
+
+func getTable(ctx context.Context, root doltdb.RootValue, name string) (*doltdb.Table, hash.Hash, error) {
+	if root == nil {
+		return nil, hash.Hash{}, nil
+	}
+	tbl, ok, err := root.GetTable(ctx, doltdb.TableName{Name: name})
+	if err != nil || !ok {
+		return nil, hash.Hash{}, err
+	}
+	h, err := tbl.HashOf()
+	if err != nil {
+		return nil, hash.Hash{}, err
+	}
+	return tbl, h, nil
+}
+
+// streamTable emits the changes between |fromTbl| and |toTbl|. Either table is nil if it does not exist on that side
+// of the diff.
+func (cs *changeStream) streamTable(ctx context.Context, commit hash.Hash, name string, fromTbl, toTbl *doltdb.Table, after *cursor) error {
+	fromSch, from, err := tableRows(ctx, fromTbl)
+	if err != nil {
+		return err
+	}
+	toSch, to, err := tableRows(ctx, toTbl)
+	if err != nil {
+		return err
+	}
+
+	var ns tree.NodeStore
+	if toTbl != nil {
+		ns = toTbl.NodeStore()
+	} else {
+		ns = fromTbl.NodeStore()
+	}
+
+	if fromTbl == nil {
+		fromSch = toSch
+		if from, err = emptyMapLike(ctx, ns, to); err != nil {
+			return err
+		}
+	}
+	if toTbl == nil {
+		toSch = fromSch
+		if to, err = emptyMapLike(ctx, ns, from); err != nil {
+			return err
+		}
+	}
+
+	td := tableDiff{
+		commit:  commit,
+		name:    name,
+		keyless: schema.IsKeyless(fromSch) && schema.IsKeyless(toSch),
+		after:   after,
+		emit:    cs.emit,
+	}
+	if td.fromEnc, err = newRowEncoder(fromSch, ns); err != nil {
+		return err
+	}
+	if td.toEnc, err = newRowEncoder(toSch, ns); err != nil {
+		return err
+	}
+
+	if schema.ArePrimaryKeySetsDiffable(cs.ddb.Format(), fromSch, toSch) && from.KeyDesc().Equals(to.KeyDesc()) {
+		return td.diff(ctx, phaseDiff, from, to)
+	}
+
+	// The rows before and after the primary key change cannot be matched up, so every old row is deleted and every
+	// new row is inserted.
+	emptyFrom, err := emptyMapLike(ctx, ns, from)
+	if err != nil {
+		return err
+	}
+	emptyTo, err := emptyMapLike(ctx, ns, to)
+	if err != nil {
+		return err
+	}
+	td.keyless = schema.IsKeyless(fromSch)
+	if err = td.diff(ctx, phaseDeleteAll, from, emptyFrom); err != nil {
+		return err
+	}
+	td.keyless = schema.IsKeyless(toSch)
+	return td.diff(ctx, phaseInsertAll, emptyTo, to)
+}
+
+func tableRows(ctx context.Context, tbl *doltdb.Table) (schema.Schema, prolly.Map, error) {
+	if tbl == nil {
+		return nil, prolly.Map{}, nil
+	}
+	sch, err := tbl.GetSchema(ctx)
+	if err != nil {
+		return nil, prolly.Map{}, err
+	}
+	idx, err := tbl.GetRowData(ctx)
+	if err != nil {
+		return nil, prolly.Map{}, err
+	}
+	m, err := durable.ProllyMapFromIndex(idx)
+	if err != nil {
+		return nil, prolly.Map{}, err
+	}
+	return sch, m, nil
+}
+
+func emptyMapLike(ctx context.Context, ns tree.NodeStore, m prolly.Map) (prolly.Map, error) {
+	kd, vd := m.Descriptors()
+	return prolly.NewMapFromTuples(ctx, ns, kd, vd)
+}
+
+// tableDiff emits the changes to a single table made by a single commit.
+type tableDiff struct {
+	commit  hash.Hash
+	name    string
+	keyless bool
+	fromEnc *rowEncoder
+	toEnc   *rowEncoder
+	after   *cursor
+	emit    func(*cdcapi.RowChange) error
+}
+
+// diff emits a change for every row which differs between |from| and |to|, in key order. Rows in keyless tables are
+// emitted once for each duplicate added or removed.
+func (td tableDiff) diff(ctx context.Context, phase diffPhase, from, to prolly.Map) error {
+	if td.after.skipsPhase(td.name, phase) {
+		return nil
+	}
+	start := val.Tuple(td.after.startKey(td.name, phase))
+	err := prolly.DiffMapsKeyRange(ctx, from, to, start, nil, func(ctx context.Context, d tree.Diff) error {
+		op, n := changeOpAndCount(d, td.keyless)
+
+		var oldRow, newRow string
+		var err error
+		if op != cdcapi.ChangeOp_CHANGE_OP_INSERT {
+			if oldRow, err = td.fromEnc.encode(ctx, val.Tuple(d.Key), val.Tuple(d.From)); err != nil {
+				return err
+			}
+		}
+		if op != cdcapi.ChangeOp_CHANGE_OP_DELETE {
+			if newRow, err = td.toEnc.encode(ctx, val.Tuple(d.Key), val.Tuple(d.To)); err != nil {
+				return err
+			}
+		}
+		// a value tuple can change without any visible column changing, e.g. when a column is dropped
+		if op == cdcapi.ChangeOp_CHANGE_OP_UPDATE && oldRow == newRow {
+			return nil
+		}
+
+		key := bytes.Clone(d.Key)
+		for i := td.after.firstDup(td.name, phase, key); i < n; i++ {
+			c := cursor{Commit: td.commit, Table: td.name, Phase: phase, Key: key, Dup: i}
+			err = td.emit(&cdcapi.RowChange{
+				CommitHash: td.commit.String(),
+				TableName:  td.name,
+				Op:         op,
+				OldRowJson: oldRow,
+				NewRowJson: newRow,
+				Cursor:     c.encode(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err == io.EOF {
+		return nil
+	}
+	return err
+}
+
+// changeOpAndCount returns the operation |d| represents and the number of times it is applied. A change to the
+// cardinality of a row in a keyless table is represented as inserts or deletes of the duplicates added or removed.
+func changeOpAndCount(d tree.Diff, keyless bool) (cdcapi.ChangeOp, uint64) {
+	if !keyless {
+		switch d.Type {
+		case tree.AddedDiff:
+			return cdcapi.ChangeOp_CHANGE_OP_INSERT, 1
+		case tree.RemovedDiff:
+			return cdcapi.ChangeOp_CHANGE_OP_DELETE, 1
+		default:
+			return cdcapi.ChangeOp_CHANGE_OP_UPDATE, 1
+		}
+	}
+
+	switch d.Type {
+	case tree.AddedDiff:
+		return cdcapi.ChangeOp_CHANGE_OP_INSERT, val.ReadKeylessCardinality(val.Tuple(d.To))
+	case tree.RemovedDiff:
+		return cdcapi.ChangeOp_CHANGE_OP_DELETE, val.ReadKeylessCardinality(val.Tuple(d.From))
+	default:
+		fromN := val.ReadKeylessCardinality(val.Tuple(d.From))
+		toN := val.ReadKeylessCardinality(val.Tuple(d.To))
+		if fromN < toN {
+			return cdcapi.ChangeOp_CHANGE_OP_INSERT, toN - fromN
+		}
+		return cdcapi.ChangeOp_CHANGE_OP_DELETE, fromN - toN
+	}
+}
+
+// rowEncoder encodes the rows of a table as JSON objects keyed by column name.
+type rowEncoder struct {
+	sch  schema.Schema
+	conv dtables.ProllyRowConverter
+	buf  *bytes.Buffer
+	wr   *json.RowWriter
+}
+
+func newRowEncoder(sch schema.Schema, ns tree.NodeStore) (*rowEncoder, error) {
+	conv, err := dtables.NewProllyRowConverter(sch, sch, nil, ns)
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	wr, err := json.NewJSONWriterWithHeader(iohelp.NopWrCloser(buf), sch, "", "", "")
+	if err != nil {
+		return nil, err
+	}
+	return &rowEncoder{sch: sch, conv: conv, buf: buf, wr: wr}, nil
+}
+
+func (e *rowEncoder) encode(ctx context.Context, key, value val.Tuple) (string, error) {
+	row := make(sql.Row, e.sch.GetAllCols().Size())
+	if err := e.conv.PutConverted(ctx, key, value, row); err != nil {
+		return "", err
+	}
+	if err := e.wr.WriteSqlRow(ctx, row); err != nil {
+		return "", err
+	}
+	if err := e.wr.Flush(); err != nil {
+		return "", err
+	}
+	s := e.buf.String()
+	e.buf.Reset()
+	return s, nil
+}
@@ -0,0 +1,120 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+// diffPhase identifies one pass over the rows of a table when computing the changes a commit made to it. Tables whose
+// primary key did not change are diffed in a single pass. The rows of tables whose primary key changed cannot be
+// matched up, so all of their old rows are deleted in one pass and all of their new rows are inserted in a second.
+type diffPhase int
+
+const (
+	phaseDiff diffPhase = iota
+	phaseDeleteAll
+	phaseInsertAll
+)
+
+// cursor is the position of a single row change in the stream of changes of a branch. A change is identified by the
+// commit which made it, the table it was made to, the diff phase and key of the row, and, for keyless tables, which of
+// the duplicate rows with that key it is.
+type cursor struct {
+	Commit hash.Hash
+	Table  string
+	Phase  diffPhase
+	Key    []byte
+	Dup    uint64
+}
+
+// cursorVersion is the version prefix of encoded cursors, so that the encoding can be changed without misinterpreting
+// cursors handed out by older servers.
+const cursorVersion = "v1."
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+type encodedCursor struct {
+	Commit string `json:"c"`
+	Table  string `json:"t"`
+	Phase  int    `json:"p"`
+	Key    []byte `json:"k"`
+	Dup    uint64 `json:"d"`
+}
+
+// encode returns the opaque string form of |c| which is returned to clients.
+func (c cursor) encode() string {
+	bs, _ := json.Marshal(encodedCursor{
+		Commit: c.Commit.String(),
+		Table:  c.Table,
+		Phase:  int(c.Phase),
+		Key:    c.Key,
+		Dup:    c.Dup,
+	})
+	return cursorVersion + base64.RawURLEncoding.EncodeToString(bs)
+}
+
+// decodeCursor parses a cursor previously returned by encode.
+func decodeCursor(s string) (cursor, error) {
+	if len(s) <= len(cursorVersion) || s[:len(cursorVersion)] != cursorVersion {
+		return cursor{}, errInvalidCursor
+	}
+	bs, err := base64.RawURLEncoding.DecodeString(s[len(cursorVersion):])
+	if err != nil {
+		return cursor{}, fmt.Errorf("%w: %v", errInvalidCursor, err)
+	}
+	var ec encodedCursor
+	if err = json.Unmarshal(bs, &ec); err != nil {
+		return cursor{}, fmt.Errorf("%w: %v", errInvalidCursor, err)
+	}
+	h, ok := hash.MaybeParse(ec.Commit)
+	if !ok || ec.Table == "" || ec.Phase < int(phaseDiff) || ec.Phase > int(phaseInsertAll) {
+		return cursor{}, errInvalidCursor
+	}
+	return cursor{Commit: h, Table: ec.Table, Phase: diffPhase(ec.Phase), Key: ec.Key, Dup: ec.Dup}, nil
+}
+
+// skipsTable returns true if every change to |table| in the cursor's commit precedes the cursor.
+func (c *cursor) skipsTable(table string) bool {
+	return c != nil && table < c.Table
+}
+
+// skipsPhase returns true if every change in |phase| of |table| in the cursor's commit precedes the cursor.
+func (c *cursor) skipsPhase(table string, phase diffPhase) bool {
+	return c != nil && table == c.Table && phase < c.Phase
+}
+
+// startKey returns the key from which the diff of |phase| of |table| resumes, or nil if it starts at the beginning.
+func (c *cursor) startKey(table string, phase diffPhase) []byte {
+	if c != nil && table == c.Table && phase == c.Phase {
+		return c.Key
+	}
+	return nil
+}
+
+// firstDup returns the index of the first duplicate of the row with |key| in |phase| of |table| which follows the
+// cursor.
+func (c *cursor) firstDup(table string, phase diffPhase, key []byte) uint64 {
+	if c != nil && table == c.Table && phase == c.Phase && bytes.Equal(key, c.Key) {
+		return c.Dup + 1
+	}
+	return 0
+}
@@ -0,0 +1,193 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cdcapi "github.com/dolthub/dolt/go/gen/proto/dolt/services/cdcapi/v1alpha1"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/store/hash"
+	"github.com/dolthub/dolt/go/store/types"
+)
+
+const (
+	// DefaultPollInterval is how often the head of a followed branch is checked for new commits.
+	DefaultPollInterval = time.Second
+
+	// maxChangesPerResponse is the maximum number of changes batched into a single StreamChangesResponse.
+	maxChangesPerResponse = 128
+)
+
+// Server implements the change data capture service. It streams the row-level changes made by each commit on a
+// branch, computed by diffing the commit against its first parent.
+type Server struct {
+	cdcapi.UnimplementedCDCServiceServer
+
+	ctxFactory   func(context.Context) (*sql.Context, error)
+	pollInterval time.Duration
+}
+
+var _ cdcapi.CDCServiceServer = (*Server)(nil)
+
+// NewServer returns a new Server which reads databases through the session of the *sql.Context returned by
+// |ctxFactory|.
+func NewServer(ctxFactory func(context.Context) (*sql.Context, error), pollInterval time.Duration) *Server {
+	return &Server{ctxFactory: ctxFactory, pollInterval: pollInterval}
+}
+
+// RegisterGrpcService registers |s| with |srv|.
+func (s *Server) RegisterGrpcService(srv *grpc.Server) {
+	cdcapi.RegisterCDCServiceServer(srv, s)
+}
+
+// StreamChanges implements cdcapi.CDCServiceServer.
+func (s *Server) StreamChanges(req *cdcapi.StreamChangesRequest, stream cdcapi.CDCService_StreamChangesServer) error {
+	if req.Database == "" {
+		return status.Error(codes.InvalidArgument, "database is required")
+	}
+	if req.Branch == "" {
+		return status.Error(codes.InvalidArgument, "branch is required")
+	}
+
+	sqlCtx, err := s.ctxFactory(stream.Context())
+	if err != nil {
+		return err
+	}
+	ddb, err := getDoltDB(sqlCtx, req.Database)
+	if err != nil {
+		return err
+	}
+
+	// |stop| is the last commit whose changes precede the start of the stream. If |after| is set, the stream starts
+	// part way through the changes of |stop|, so it is streamed again.
+	var stop hash.Hash
+	var after *cursor
+	if req.Cursor != "" {
+		c, err := decodeCursor(req.Cursor)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		stop, after = c.Commit, &c
+	} else if req.FromCommit != "" {
+		var ok bool
+		stop, ok = hash.MaybeParse(req.FromCommit)
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "invalid commit hash: %s", req.FromCommit)
+		}
+	}
+
+	batcher := &changeBatcher{stream: stream}
+	cs := newChangeStream(ddb, req.Tables, batcher.add)
+	for {
+		head, err := ddb.ResolveCommitRef(sqlCtx, ref.NewBranchRef(req.Branch))
+		if errors.Is(err, doltdb.ErrBranchNotFound) {
+			return status.Errorf(codes.NotFound, "branch not found: %s", req.Branch)
+		} else if err != nil {
+			return err
+		}
+		headHash, err := head.HashOf()
+		if err != nil {
+			return err
+		}
+
+		if headHash != stop || after != nil {
+			commits, err := firstParentHistory(sqlCtx, head, stop, after != nil)
+			if errors.Is(err, errCommitNotOnBranch) {
+				return status.Errorf(codes.FailedPrecondition, "commit %s is not on the history of branch %s", stop.String(), req.Branch)
+			} else if err != nil {
+				return err
+			}
+			for _, c := range commits {
+				if err = cs.streamCommit(sqlCtx, c, after); err != nil {
+					return err
+				}
+				after = nil
+			}
+			stop = headHash
+		}
+
+		if err = batcher.flush(); err != nil {
+			return err
+		}
+		if !req.Follow {
+			return nil
+		}
+
+		// Let the session go idle while waiting for new commits, so that the stream does not hold up garbage
+		// collection. Commits are only referenced by hash between polls.
+		sql.SessionCommandEnd(sqlCtx.Session)
+		select {
+		case <-sqlCtx.Done():
+			sql.SessionCommandBegin(sqlCtx.Session)
+			return status.FromContextError(sqlCtx.Err()).Err()
+		case <-time.After(s.pollInterval):
+		}
+		if err = sql.SessionCommandBegin(sqlCtx.Session); err != nil {
+			return err
+		}
+	}
+}
+
+func getDoltDB(ctx *sql.Context, name string) (*doltdb.DoltDB, error) {
+	sess := dsess.DSessFromSess(ctx.Session)
+	db, err := sess.Provider().Database(ctx, name)
+	if sql.ErrDatabaseNotFound.Is(err) {
+		return nil, status.Errorf(codes.NotFound, "database not found: %s", name)
+	} else if err != nil {
+		return nil, err
+	}
+	sdb, ok := db.(dsess.SqlDatabase)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "database %s does not support change data capture", name)
+	}
+	ddb := sdb.DbData().Ddb
+	if !types.IsFormat_DOLT(ddb.Format()) {
+		return nil, status.Errorf(codes.Unimplemented, "database %s does not support change data capture: unsupported storage format", name)
+	}
+	return ddb, nil
+}
+
+// changeBatcher batches row changes into StreamChangesResponse messages.
+type changeBatcher struct {
+	stream  cdcapi.CDCService_StreamChangesServer
+	pending []*cdcapi.RowChange
+}
+
+func (b *changeBatcher) add(c *cdcapi.RowChange) error {
+	b.pending = append(b.pending, c)
+	if len(b.pending) >= maxChangesPerResponse {
+		return b.flush()
+	}
+	return nil
+}
+
+func (b *changeBatcher) flush() error {
+	if len(b.pending) == 0 {
+		return nil
+	}
+	err := b.stream.Send(&cdcapi.StreamChangesResponse{Changes: b.pending})
+	b.pending = nil
+	return err
+}
@@ -0,0 +1,307 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gms "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cdcapi "github.com/dolthub/dolt/go/gen/proto/dolt/services/cdcapi/v1alpha1"
+	"github.com/dolthub/dolt/go/libraries/doltcore/dtestutils"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table/editor"
+)
+
+// testStream is a cdcapi.CDCService_StreamChangesServer which records the changes sent to it.
+type testStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	changes []*cdcapi.RowChange
+	// onSend is called after each response is recorded, if it is set.
+	onSend func()
+}
+
+func (s *testStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testStream) Send(resp *cdcapi.StreamChangesResponse) error {
+	s.changes = append(s.changes, resp.Changes...)
+	if s.onSend != nil {
+		s.onSend()
+	}
+	return nil
+}
+
+type testChange struct {
+	table  string
+	op     cdcapi.ChangeOp
+	oldRow string
+	newRow string
+}
+
+func toTestChanges(changes []*cdcapi.RowChange) []testChange {
+	res := make([]testChange, len(changes))
+	for i, c := range changes {
+		res[i] = testChange{table: c.TableName, op: c.Op, oldRow: c.OldRowJson, newRow: c.NewRowJson}
+	}
+	return res
+}
+
+type testDB struct {
+	engine *gms.Engine
+	ctx    *sql.Context
+	dEnv   *env.DoltEnv
+}
+
+func newTestDB(t *testing.T) *testDB {
+	ctx := context.Background()
+	dEnv := dtestutils.CreateTestEnv()
+	t.Cleanup(func() { dEnv.DoltDB(ctx).Close() })
+
+	tmpDir, err := dEnv.TempTableFilesDir()
+	require.NoError(t, err)
+	opts := editor.Options{Deaf: dEnv.DbEaFactory(ctx), Tempdir: tmpDir}
+	db, err := sqle.NewDatabase(ctx, "dolt", dEnv.DbData(ctx), opts)
+	require.NoError(t, err)
+	engine, sqlCtx, err := sqle.NewTestEngine(dEnv, ctx, db)
+	require.NoError(t, err)
+	return &testDB{engine: engine, ctx: sqlCtx, dEnv: dEnv}
+}
+
+// exec runs |queries| and returns the first column of the last row returned by the last one.
+func (db *testDB) exec(t *testing.T, queries ...string) interface{} {
+	var res interface{}
+	for _, q := range queries {
+		_, iter, _, err := db.engine.Query(db.ctx, q)
+		require.NoError(t, err, q)
+		rows, err := sql.RowIterToRows(db.ctx, iter)
+		require.NoError(t, err, q)
+		if len(rows) > 0 && len(rows[len(rows)-1]) > 0 {
+			res = rows[len(rows)-1][0]
+		}
+	}
+	return res
+}
+
+// commit runs |queries| and commits the result, returning the hash of the new commit.
+func (db *testDB) commit(t *testing.T, queries ...string) string {
+	db.exec(t, queries...)
+	return db.exec(t, "call dolt_commit('-Am', 'cdc test', '--author', 'Bill Billerson <bill@example.com>')").(string)
+}
+
+// newSession returns a *sql.Context with a new session on the same database provider, for streams which run
+// concurrently with writes.
+func (db *testDB) newSession(ctx context.Context) *sql.Context {
+	pro := dsess.DSessFromSess(db.ctx.Session).Provider()
+	config, _ := db.dEnv.Config.GetConfig(env.GlobalConfig)
+	sqlCtx := sqle.NewTestSQLCtxWithProvider(ctx, pro, config, nil, nil)
+	sqlCtx.SetCurrentDatabase("dolt")
+	return sqlCtx
+}
+
+func (db *testDB) stream(t *testing.T, req *cdcapi.StreamChangesRequest) ([]*cdcapi.RowChange, error) {
+	srv := NewServer(func(context.Context) (*sql.Context, error) {
+		return db.ctx, nil
+	}, time.Millisecond)
+	if req.Database == "" {
+		req.Database = "dolt"
+	}
+	if req.Branch == "" {
+		req.Branch = "main"
+	}
+	stream := &testStream{ctx: db.ctx}
+	err := srv.StreamChanges(req, stream)
+	return stream.changes, err
+}
+
+func TestStreamChanges(t *testing.T) {
+	db := newTestDB(t)
+	first := db.commit(t,
+		"create table t (pk int primary key, c varchar(10))",
+		"insert into t values (1, 'a'), (2, 'b')")
+	second := db.commit(t,
+		"create table k (v int)",
+		"update t set c = 'aa' where pk = 1",
+		"delete from t where pk = 2",
+		"insert into t values (3, null)",
+		"insert into k values (5), (5), (6)")
+	db.commit(t, "delete from k where v = 5")
+
+	firstChanges := []testChange{
+		{table: "t", op: cdcapi.ChangeOp_CHANGE_OP_INSERT, newRow: `{"c":"a","pk":1}`},
+		{table: "t", op: cdcapi.ChangeOp_CHANGE_OP_INSERT, newRow: `{"c":"b","pk":2}`},
+	}
+	secondChanges := []testChange{
+		{table: "k", op: cdcapi.ChangeOp_CHANGE_OP_INSERT, newRow: `{"v":5}`},
+		{table: "k", op: cdcapi.ChangeOp_CHANGE_OP_INSERT, newRow: `{"v":5}`},
+		{table: "k", op: cdcapi.ChangeOp_CHANGE_OP_INSERT, newRow: `{"v":6}`},
+		{table: "t", op: cdcapi.ChangeOp_CHANGE_OP_UPDATE, oldRow: `{"c":"a","pk":1}`, newRow: `{"c":"aa","pk":1}`},
+		{table: "t", op: cdcapi.ChangeOp_CHANGE_OP_DELETE, oldRow: `{"c":"b","pk":2}`},
+		{table: "t", op: cdcapi.ChangeOp_CHANGE_OP_INSERT, newRow: `{"pk":3}`},
+	}
+	thirdChanges := []testChange{
+		{table: "k", op: cdcapi.ChangeOp_CHANGE_OP_DELETE, oldRow: `{"v":5}`},
+		{table: "k", op: cdcapi.ChangeOp_CHANGE_OP_DELETE, oldRow: `{"v":5}`},
+	}
+	// keyless rows are ordered by their hash, so the order of the inserts into k is not known ahead of time
+	sortKeyless := func(changes []testChange) []testChange {
+		if len(changes) >= 3 && changes[0].newRow == `{"v":6}` {
+			changes[0], changes[2] = changes[2], changes[0]
+		} else if len(changes) >= 3 && changes[1].newRow == `{"v":6}` {
+			changes[1], changes[2] = changes[2], changes[1]
+		}
+		return changes
+	}
+
+	t.Run("entire history", func(t *testing.T) {
+		changes, err := db.stream(t, &cdcapi.StreamChangesRequest{})
+		require.NoError(t, err)
+		all := toTestChanges(changes)
+		require.Len(t, all, len(firstChanges)+len(secondChanges)+len(thirdChanges))
+		assert.Equal(t, firstChanges, all[:2])
+		assert.Equal(t, secondChanges, sortKeyless(all[2:8]))
+		assert.Equal(t, thirdChanges, all[8:])
+
+		for _, c := range changes[:2] {
+			assert.Equal(t, first, c.CommitHash)
+		}
+		for _, c := range changes[2:8] {
+			assert.Equal(t, second, c.CommitHash)
+		}
+	})
+
+	t.Run("from commit", func(t *testing.T) {
+		changes, err := db.stream(t, &cdcapi.StreamChangesRequest{FromCommit: first})
+		require.NoError(t, err)
+		all := toTestChanges(changes)
+		require.Len(t, all, len(secondChanges)+len(thirdChanges))
+		assert.Equal(t, secondChanges, sortKeyless(all[:6]))
+		assert.Equal(t, thirdChanges, all[6:])
+	})
+
+	t.Run("table filter", func(t *testing.T) {
+		changes, err := db.stream(t, &cdcapi.StreamChangesRequest{FromCommit: first, Tables: []string{"T"}})
+		require.NoError(t, err)
+		assert.Equal(t, secondChanges[3:], toTestChanges(changes))
+	})
+
+	t.Run("resume from every cursor", func(t *testing.T) {
+		all, err := db.stream(t, &cdcapi.StreamChangesRequest{})
+		require.NoError(t, err)
+		for i, c := range all {
+			resumed, err := db.stream(t, &cdcapi.StreamChangesRequest{Cursor: c.Cursor})
+			require.NoError(t, err)
+			assert.Equal(t, toTestChanges(all[i+1:]), toTestChanges(resumed), "resuming after change %d", i)
+		}
+	})
+
+	t.Run("primary key change", func(t *testing.T) {
+		head := db.exec(t, "select dolt_hashof('HEAD')").(string)
+		db.commit(t, "alter table t drop primary key")
+		changes, err := db.stream(t, &cdcapi.StreamChangesRequest{FromCommit: head, Tables: []string{"t"}})
+		require.NoError(t, err)
+		assert.Equal(t, []testChange{
+			{table: "t", op: cdcapi.ChangeOp_CHANGE_OP_DELETE, oldRow: `{"c":"aa","pk":1}`},
+			{table: "t", op: cdcapi.ChangeOp_CHANGE_OP_DELETE, oldRow: `{"pk":3}`},
+		}, toTestChanges(changes[:2]))
+		require.Len(t, changes, 4)
+		for _, c := range changes[2:] {
+			assert.Equal(t, cdcapi.ChangeOp_CHANGE_OP_INSERT, c.Op)
+		}
+
+		resumed, err := db.stream(t, &cdcapi.StreamChangesRequest{Cursor: changes[1].Cursor, Tables: []string{"t"}})
+		require.NoError(t, err)
+		assert.Equal(t, toTestChanges(changes[2:]), toTestChanges(resumed))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := db.stream(t, &cdcapi.StreamChangesRequest{Branch: "nope"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		_, err = db.stream(t, &cdcapi.StreamChangesRequest{Database: "nope"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		_, err = db.stream(t, &cdcapi.StreamChangesRequest{FromCommit: "not a hash"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = db.stream(t, &cdcapi.StreamChangesRequest{Cursor: "v1.garbage"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		db.exec(t, "call dolt_branch('other')")
+		db.exec(t, "call dolt_checkout('other')")
+		other := db.commit(t, "insert into k values (7)")
+		db.exec(t, "call dolt_checkout('main')")
+		_, err = db.stream(t, &cdcapi.StreamChangesRequest{FromCommit: other})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+func TestStreamChangesFollow(t *testing.T) {
+	db := newTestDB(t)
+	head := db.commit(t, "create table t (pk int primary key)")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sqlCtx := db.newSession(ctx)
+	srv := NewServer(func(context.Context) (*sql.Context, error) {
+		return sqlCtx, nil
+	}, time.Millisecond)
+
+	received := make(chan struct{}, 16)
+	stream := &testStream{ctx: sqlCtx, onSend: func() { received <- struct{}{} }}
+	errCh := make(chan error)
+	go func() {
+		errCh <- srv.StreamChanges(&cdcapi.StreamChangesRequest{Database: "dolt", Branch: "main", FromCommit: head, Follow: true}, stream)
+	}()
+
+	db.commit(t, "insert into t values (1)")
+	select {
+	case <-received:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for changes")
+	}
+	cancel()
+	err := <-errCh
+	assert.Equal(t, codes.Canceled, status.Code(err))
+
+	assert.Equal(t, []testChange{
+		{table: "t", op: cdcapi.ChangeOp_CHANGE_OP_INSERT, newRow: `{"pk":1}`},
+	}, toTestChanges(stream.changes))
+}
+
+func TestCursorEncoding(t *testing.T) {
+	c := cursor{Table: "t", Phase: phaseInsertAll, Key: []byte{1, 2, 3}, Dup: 2}
+	c.Commit[0] = 1
+	decoded, err := decodeCursor(c.encode())
+	require.NoError(t, err)
+	assert.Equal(t, c, decoded)
+
+	for _, s := range []string{"", "v1.", "v2.abc", "v1.!!!", "v1." + "e30"} {
+		_, err = decodeCursor(s)
+		assert.ErrorIs(t, err, errInvalidCursor, s)
+	}
+}
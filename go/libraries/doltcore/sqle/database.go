@@ -346,6 +346,29 @@ func (db Database) getTableInsensitive(ctx *sql.Context, head *doltdb.Commit, ds
 		}
 		return dt, true, nil
 
+	case strings.HasPrefix(lwrName, doltdb.DoltBlameViewPrefix):
+		// Blame views of tables with a primary key are resolved by GetViewDefinition, so only keyless tables get here
+		baseTableName := tblName[len(doltdb.DoltBlameViewPrefix):]
+		tname, tbl, ok, err := resolve.Table(ctx, root, baseTableName)
+		if err != nil || !ok {
+			return nil, false, err
+		}
+		sch, err := tbl.GetSchema(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		if !schema.IsKeyless(sch) {
+			return nil, false, nil
+		}
+
+		if head == nil {
+			head, err = ds.GetHeadCommit(ctx, db.RevisionQualifiedName())
+			if err != nil {
+				return nil, false, err
+			}
+		}
+		return dtables.NewKeylessBlameTable(db.Name(), tname.Name, head), true, nil
+
 	case strings.HasPrefix(lwrName, doltdb.DoltCommitDiffTablePrefix):
 		baseTableName := tblName[len(doltdb.DoltCommitDiffTablePrefix):]
 		tname := doltdb.TableName{Name: baseTableName, Schema: db.schemaName}
@@ -635,6 +658,27 @@ func (db Database) getTableInsensitive(ctx *sql.Context, head *doltdb.Commit, ds
 				dt, found = dtables.NewBranchNamespaceControlTable(controller.Namespace), true
 			}
 		}
+	case dtables.TableControlTableName:
+		basCtx := branch_control.GetBranchAwareSession(ctx)
+		if basCtx != nil {
+			if controller := basCtx.GetController(); controller != nil {
+				dt, found = dtables.NewTableControlTable(controller.TableControl), true
+			}
+		}
+	case dtables.BranchProtectionTableName:
+		basCtx := branch_control.GetBranchAwareSession(ctx)
+		if basCtx != nil {
+			if controller := basCtx.GetController(); controller != nil {
+				dt, found = dtables.NewBranchProtectionTable(controller.Protection), true
+			}
+		}
+	case dtables.MergeApprovalsTableName:
+		basCtx := branch_control.GetBranchAwareSession(ctx)
+		if basCtx != nil {
+			if controller := basCtx.GetController(); controller != nil {
+				dt, found = dtables.NewMergeApprovalsTable(controller.Approvals), true
+			}
+		}
 	case doltdb.IgnoreTableName:
 		if resolve.UseSearchPath && db.schemaName == "" {
 			schemaName, err := resolve.FirstExistingSchemaOnSearchPath(ctx, root)
@@ -654,6 +698,25 @@ func (db Database) getTableInsensitive(ctx *sql.Context, head *doltdb.Commit, ds
 			versionableTable := backingTable.(dtables.VersionableTable)
 			dt, found = dtables.NewIgnoreTable(ctx, versionableTable, db.schemaName), true
 		}
+	case doltdb.MergeRulesTableName:
+		if resolve.UseSearchPath && db.schemaName == "" {
+			schemaName, err := resolve.FirstExistingSchemaOnSearchPath(ctx, root)
+			if err != nil {
+				return nil, false, err
+			}
+			db.schemaName = schemaName
+		}
+
+		backingTable, _, err := db.getTable(ctx, root, doltdb.MergeRulesTableName)
+		if err != nil {
+			return nil, false, err
+		}
+		if backingTable == nil {
+			dt, found = dtables.NewEmptyMergeRulesTable(ctx, db.schemaName), true
+		} else {
+			versionableTable := backingTable.(dtables.VersionableTable)
+			dt, found = dtables.NewMergeRulesTable(ctx, versionableTable, db.schemaName), true
+		}
 	case doltdb.GetDocTableName(), doltdb.DocTableName:
 		isDoltgresSystemTable, err := resolve.IsDoltgresSystemTable(ctx, tname, root)
 		if err != nil {
@@ -725,6 +788,12 @@ func (db Database) getTableInsensitive(ctx *sql.Context, head *doltdb.Commit, ds
 		if !resolve.UseSearchPath || isDoltgresSystemTable {
 			dt, found = dtables.NewHelpTable(ctx, db.Name(), lwrName), true
 		}
+	case doltdb.CIRunsTableName:
+		dt, found = dtables.NewCIRunsTable(ctx, db.Name(), lwrName, db.ddb), true
+	case doltdb.WebhookDeliveriesTableName:
+		dt, found = dtables.NewWebhookDeliveriesTable(ctx, db.Name(), lwrName, db.ddb), true
+	case doltdb.SparseTableName:
+		dt, found = dtables.NewSparseTable(db.RevisionQualifiedName(), lwrName), true
 	case doltdb.GetBackupsTableName(), doltdb.BackupsTableName:
 		isDoltgresSystemTable, err := resolve.IsDoltgresSystemTable(ctx, tname, root)
 		if err != nil {
@@ -1115,6 +1184,21 @@ func (db Database) GetTableNames(ctx *sql.Context) ([]string, error) {
 		return nil, err
 	}
 
+	// Tables outside of a sparse checkout are hidden, but can still be queried by name
+	sparse, err := dsess.DSessFromSess(ctx.Session).SparseCheckout(ctx, db.RevisionQualifiedName())
+	if err != nil {
+		return nil, err
+	}
+	if sparse != nil {
+		filtered := make([]string, 0, len(tblNames))
+		for _, tblName := range tblNames {
+			if sparse.Includes(doltdb.TableName{Name: tblName, Schema: db.schemaName}) {
+				filtered = append(filtered, tblName)
+			}
+		}
+		tblNames = filtered
+	}
+
 	if showSystemTables {
 		return tblNames, nil
 	}
@@ -1242,6 +1326,9 @@ func (db Database) DropTable(ctx *sql.Context, tableName string) error {
 	if err := dsess.CheckAccessForDb(ctx, db, branch_control.Permissions_Write); err != nil {
 		return err
 	}
+	if err := dsess.CheckUnrestrictedTableAccessForDb(ctx, db, tableName); err != nil {
+		return err
+	}
 	if doltdb.IsNonAlterableSystemTable(doltdb.TableName{Name: tableName, Schema: db.schemaName}) {
 		return ErrSystemTableAlter.New(tableName)
 	}
@@ -1358,6 +1445,9 @@ func (db Database) CreateTable(ctx *sql.Context, tableName string, sch sql.Prima
 	if err := dsess.CheckAccessForDb(ctx, db, branch_control.Permissions_Write); err != nil {
 		return err
 	}
+	if err := dsess.CheckUnrestrictedTableAccessForDb(ctx, db, tableName); err != nil {
+		return err
+	}
 
 	if doltdb.IsSystemTable(doltdb.TableName{Name: tableName, Schema: db.schemaName}) && !doltdb.IsFullTextTable(tableName) {
 		return ErrReservedTableName.New(tableName)
@@ -1385,6 +1475,9 @@ func (db Database) CreateIndexedTable(ctx *sql.Context, tableName string, sch sq
 	if err := dsess.CheckAccessForDb(ctx, db, branch_control.Permissions_Write); err != nil {
 		return err
 	}
+	if err := dsess.CheckUnrestrictedTableAccessForDb(ctx, db, tableName); err != nil {
+		return err
+	}
 
 	if doltdb.IsSystemTable(doltdb.TableName{Name: tableName, Schema: db.schemaName}) {
 		return ErrReservedTableName.New(tableName)
@@ -1717,6 +1810,11 @@ func (db Database) RenameTable(ctx *sql.Context, oldName, newName string) error
 	if err := dsess.CheckAccessForDb(ctx, db, branch_control.Permissions_Write); err != nil {
 		return err
 	}
+	for _, name := range []string{oldName, newName} {
+		if err := dsess.CheckUnrestrictedTableAccessForDb(ctx, db, name); err != nil {
+			return err
+		}
+	}
 	root, err := db.GetRoot(ctx)
 
 	if err != nil {
@@ -1787,10 +1885,14 @@ func (db Database) GetViewDefinition(ctx *sql.Context, viewName string) (sql.Vie
 	case strings.HasPrefix(lwrViewName, doltdb.DoltBlameViewPrefix):
 		tableName := lwrViewName[len(doltdb.DoltBlameViewPrefix):]
 
-		blameViewTextDef, err := dtables.NewBlameView(ctx, doltdb.TableName{Name: tableName, Schema: db.schemaName}, root)
+		blameViewTextDef, ok, err := dtables.NewBlameView(ctx, doltdb.TableName{Name: tableName, Schema: db.schemaName}, root)
 		if err != nil {
 			return sql.ViewDefinition{}, false, err
 		}
+		if !ok {
+			// keyless tables are blamed by a system table, so that it can be queried AS OF a revision
+			return sql.ViewDefinition{}, false, nil
+		}
 		return sql.ViewDefinition{Name: viewName, TextDefinition: blameViewTextDef, CreateViewStatement: fmt.Sprintf("CREATE VIEW `%s` AS %s", viewName, blameViewTextDef)}, true, nil
 	}
 
@@ -22,6 +22,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
 
@@ -66,6 +67,8 @@ type DoltDatabaseProvider struct {
 
 	dbFactoryUrl string
 	isStandby    *bool
+	// queryRunner is shared with the copies of this provider, as it is set after the engine has copied it
+	queryRunner *dsess.QueryRunner
 }
 
 var _ sql.DatabaseProvider = (*DoltDatabaseProvider)(nil)
@@ -155,6 +158,7 @@ func NewDoltDatabaseProviderWithDatabases(defaultBranch string, fs filesys.Files
 		defaultBranch:          defaultBranch,
 		dbFactoryUrl:           dbFactoryUrl,
 		isStandby:              new(bool),
+		queryRunner:            new(dsess.QueryRunner),
 		droppedDatabaseManager: newDroppedDatabaseManager(fs),
 	}, nil
 }
@@ -227,6 +231,20 @@ func (p *DoltDatabaseProvider) SetIsStandby(standby bool) {
 	*p.isStandby = standby
 }
 
+// SetQueryRunner sets the function stored procedures of this provider run queries with, see QueryRunner.
+func (p *DoltDatabaseProvider) SetQueryRunner(runner dsess.QueryRunner) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	*p.queryRunner = runner
+}
+
+// QueryRunner implements dsess.DoltDatabaseProvider
+func (p *DoltDatabaseProvider) QueryRunner() dsess.QueryRunner {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return *p.queryRunner
+}
+
 // FileSystemForDatabase returns a filesystem, with the working directory set to the root directory
 // of the requested database. If the requested database isn't found, a database not found error
 // is returned.
@@ -323,7 +341,7 @@ func (p *DoltDatabaseProvider) attemptCloneReplica(ctx *sql.Context, dbName stri
 	// TODO: remote params for AWS, others
 	// TODO: this needs to be robust in the face of the DB not having the default branch
 	// TODO: this treats every database not found error as a clone error, need to tighten
-	err := p.CloneDatabaseFromRemote(ctx, dbName, p.defaultBranch, remoteName, remoteUrl, -1, nil)
+	err := p.CloneDatabaseFromRemote(ctx, dbName, p.defaultBranch, remoteName, remoteUrl, -1, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -688,6 +706,7 @@ func (p *DoltDatabaseProvider) CloneDatabaseFromRemote(
 	ctx *sql.Context,
 	dbName, branch, remoteName, remoteUrl string,
 	depth int,
+	tables []string,
 	remoteParams map[string]string,
 ) error {
 	p.mu.Lock()
@@ -700,7 +719,7 @@ func (p *DoltDatabaseProvider) CloneDatabaseFromRemote(
 		return fmt.Errorf("cannot create DB, file exists at %s", dbName)
 	}
 
-	err := p.cloneDatabaseFromRemote(ctx, dbName, remoteName, branch, remoteUrl, depth, remoteParams)
+	err := p.cloneDatabaseFromRemote(ctx, dbName, remoteName, branch, remoteUrl, depth, tables, remoteParams)
 	if err != nil {
 		// Make a best effort to clean up any artifacts on disk from a failed clone
 		// before we return the error
@@ -726,6 +745,7 @@ func (p *DoltDatabaseProvider) cloneDatabaseFromRemote(
 	ctx *sql.Context,
 	dbName, remoteName, branch, remoteUrl string,
 	depth int,
+	tables []string,
 	remoteParams map[string]string,
 ) error {
 	if p.remoteDialer == nil {
@@ -733,6 +753,7 @@ func (p *DoltDatabaseProvider) cloneDatabaseFromRemote(
 	}
 
 	r := env.NewRemote(remoteName, remoteUrl, remoteParams)
+	r.Tables = tables
 	srcDB, err := r.GetRemoteDB(ctx, types.Format_Default, p.remoteDialer)
 	if err != nil {
 		return err
@@ -756,6 +777,56 @@ func (p *DoltDatabaseProvider) cloneDatabaseFromRemote(
 	return p.registerNewDatabase(ctx, dbName, dEnv)
 }
 
+// RestoreDatabaseAsOf implements DoltDatabaseProvider interface
+func (p *DoltDatabaseProvider) RestoreDatabaseAsOf(ctx *sql.Context, srcDb *doltdb.DoltDB, dbName string, asOf time.Time) (time.Time, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	exists, isDir := p.fs.Exists(dbName)
+	if exists && isDir {
+		return time.Time{}, sql.ErrDatabaseExists.New(dbName)
+	} else if exists {
+		return time.Time{}, fmt.Errorf("cannot create DB, file exists at %s", dbName)
+	}
+
+	restoredAt, err := p.restoreDatabaseAsOf(ctx, srcDb, dbName, asOf)
+	if err != nil {
+		// Make a best effort to clean up any artifacts on disk from a failed restore
+		// before we return the error
+		exists, _ := p.fs.Exists(dbName)
+		if exists {
+			deleteErr := p.fs.Delete(dbName, true)
+			if deleteErr != nil {
+				err = fmt.Errorf("%s: unable to clean up failed restore in directory '%s': %s",
+					err.Error(), dbName, deleteErr.Error())
+			}
+		}
+		return time.Time{}, err
+	}
+
+	return restoredAt, nil
+}
+
+// restoreDatabaseAsOf encapsulates the inner logic for restoring a database as of a point in time, so that the caller
+// can clean up the new database directory if any error is returned. Use RestoreDatabaseAsOf instead.
+func (p *DoltDatabaseProvider) restoreDatabaseAsOf(ctx *sql.Context, srcDb *doltdb.DoltDB, dbName string, asOf time.Time) (time.Time, error) {
+	dEnv, err := actions.EnvForClone(ctx, srcDb.Format(), env.NoRemote, dbName, p.fs, "VERSION", env.GetCurrentUserHomeDir)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if dEnv.RepoState, err = env.CreateRepoState(dEnv.FS, p.defaultBranch); err != nil {
+		return time.Time{}, err
+	}
+
+	restoredAt, err := actions.RestoreAsOf(ctx, srcDb, dEnv, asOf)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return restoredAt, p.registerNewDatabase(ctx, dbName, dEnv)
+}
+
 // DropDatabase implements the sql.MutableDatabaseProvider interface
 func (p *DoltDatabaseProvider) DropDatabase(ctx *sql.Context, name string) error {
 	_, revision := dsess.SplitRevisionDbName(name)
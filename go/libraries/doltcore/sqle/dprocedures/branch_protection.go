@@ -0,0 +1,87 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dprocedures
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+)
+
+// checkProtectedMerge returns an error if merging |mergeCommit| into |branchName| would violate the branch's
+// protection rules. A required workflow must have passed on |mergeCommit|, as recorded in the database's dolt_ci
+// workflow runs. Runs are recorded when a sql-server runs the workflows of a branch update, and are kept in the
+// database, so they are checked the same way from the CLI and after a restart.
+func checkProtectedMerge(ctx *sql.Context, ddb *doltdb.DoltDB, branchName string, mergeCommit *doltdb.Commit, fastForward bool) error {
+	h, err := mergeCommit.HashOf()
+	if err != nil {
+		return err
+	}
+	workflow, err := branch_control.CanMergeIntoBranch(ctx, branchName, h.String(), fastForward)
+	if err != nil {
+		return err
+	}
+	if len(workflow) > 0 {
+		runs, err := ddb.GetCIRuns(ctx)
+		if err != nil {
+			return err
+		}
+		if !runs.Passed(workflow, h.String()) {
+			return branch_control.ErrProtectedWorkflowFailed.New(branchName, workflow, h.String())
+		}
+	}
+	return nil
+}
+
+// checkProtectedBranchMove returns an error if moving the head of |branchName| from |oldHead| to |newHead| would
+// overwrite the history of a branch that does not allow force updates. Moving a branch to one of its descendants does
+// not overwrite any history, however protected branches should only advance through merges so that their other rules
+// are enforced, and therefore any move is rejected.
+func checkProtectedBranchMove(ctx *sql.Context, branchName string, oldHead *doltdb.Commit, newHead *doltdb.Commit) error {
+	oldHash, err := oldHead.HashOf()
+	if err != nil {
+		return err
+	}
+	newHash, err := newHead.HashOf()
+	if err != nil {
+		return err
+	}
+	if oldHash == newHash {
+		return nil
+	}
+	return branch_control.CanForceUpdateBranch(ctx, branchName)
+}
+
+// checkProtectedBranchOverwrite returns an error if forcibly creating |branchName| would overwrite an existing branch
+// that does not allow force updates.
+func checkProtectedBranchOverwrite(ctx *sql.Context, ddb *doltdb.DoltDB, branchName string) error {
+	existingName, exists, err := ddb.HasBranch(ctx, branchName)
+	if err != nil || !exists {
+		return err
+	}
+	return branch_control.CanForceUpdateBranch(ctx, existingName)
+}
+
+// checkProtectedCommit returns an error if a commit that does not conclude a merge may not be made on the current
+// branch, such as by committing, cherry-picking or reverting.
+func checkProtectedCommit(ctx *sql.Context) error {
+	branchName, err := dsess.DSessFromSess(ctx.Session).GetBranch(ctx)
+	if err != nil || len(branchName) == 0 {
+		return err
+	}
+	return branch_control.CanCommitToBranch(ctx, branchName)
+}
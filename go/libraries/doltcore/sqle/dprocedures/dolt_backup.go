@@ -106,6 +106,16 @@ func doDoltBackup(ctx *sql.Context, args []string) (int, error) {
 		if err != nil {
 			return statusErr, fmt.Errorf("error syncing backup: %w", err)
 		}
+	case cli.VerifyBackupId:
+		err = verifyBackup(ctx, dbData, sess, apr)
+		if err != nil {
+			return statusErr, fmt.Errorf("error verifying backup: %w", err)
+		}
+	case cli.PruneBackupId:
+		err = pruneBackup(ctx, dbData, sess, apr)
+		if err != nil {
+			return statusErr, fmt.Errorf("error pruning backup: %w", err)
+		}
 	default:
 		return statusErr, fmt.Errorf("unrecognized dolt_backup parameter: %s", apr.Arg(0))
 	}
@@ -297,18 +307,68 @@ func syncBackupViaName(ctx *sql.Context, dbData env.DbData, sess *dsess.DoltSess
 		return fmt.Errorf("usage: dolt_backup('sync', BACKUP_NAME)")
 	}
 
-	backupName := strings.TrimSpace(apr.Arg(1))
-	backups, err := dbData.Rsr.GetBackups()
+	b, err := getNamedBackup(dbData, apr.Arg(1))
 	if err != nil {
 		return err
 	}
 
+	return syncRootsToBackup(ctx, dbData, sess, b)
+}
+
+func getNamedBackup(dbData env.DbData, name string) (env.Remote, error) {
+	backupName := strings.TrimSpace(name)
+	backups, err := dbData.Rsr.GetBackups()
+	if err != nil {
+		return env.Remote{}, err
+	}
+
 	b, ok := backups.Get(backupName)
 	if !ok {
-		return fmt.Errorf("error: unknown backup: '%s'; %v", backupName, backups)
+		return env.Remote{}, fmt.Errorf("error: unknown backup: '%s'; %v", backupName, backups)
 	}
+	return b, nil
+}
 
-	return syncRootsToBackup(ctx, dbData, sess, b)
+func verifyBackup(ctx *sql.Context, dbData env.DbData, sess *dsess.DoltSession, apr *argparser.ArgParseResults) error {
+	if apr.NArg() != 2 {
+		return fmt.Errorf("usage: dolt_backup('verify', BACKUP_NAME)")
+	}
+
+	b, err := getNamedBackup(dbData, apr.Arg(1))
+	if err != nil {
+		return err
+	}
+	backupDb, err := sess.Provider().GetRemoteDB(ctx, dbData.Ddb.ValueReadWriter().Format(), b, true)
+	if err != nil {
+		return fmt.Errorf("error loading backup: %w", err)
+	}
+
+	_, err = actions.VerifyBackup(ctx, backupDb)
+	return err
+}
+
+func pruneBackup(ctx *sql.Context, dbData env.DbData, sess *dsess.DoltSession, apr *argparser.ArgParseResults) error {
+	keepLast, ok := apr.GetInt(cli.KeepLastFlag)
+	if !ok || apr.NArg() != 2 {
+		return fmt.Errorf("usage: dolt_backup('prune', '--keep-last', N, BACKUP_NAME)")
+	}
+
+	// Only allow admins to delete data from a backup
+	if err := checkBackupRestorePrivs(ctx); err != nil {
+		return err
+	}
+
+	b, err := getNamedBackup(dbData, apr.Arg(1))
+	if err != nil {
+		return err
+	}
+	backupDb, err := sess.Provider().GetRemoteDB(ctx, dbData.Ddb.ValueReadWriter().Format(), b, true)
+	if err != nil {
+		return fmt.Errorf("error loading backup: %w", err)
+	}
+
+	_, err = actions.PruneBackups(ctx, backupDb, keepLast)
+	return err
 }
 
 // syncRootsToBackup syncs the roots from |dbData| to the backup specified by |backup|.
@@ -323,7 +383,7 @@ func syncRootsToBackup(ctx *sql.Context, dbData env.DbData, sess *dsess.DoltSess
 		return err
 	}
 
-	err = actions.SyncRoots(ctx, dbData.Ddb, destDb, tmpDir, runProgFuncs, stopProgFuncs)
+	_, err = actions.SyncBackup(ctx, dbData.Ddb, destDb, tmpDir, runProgFuncs, stopProgFuncs)
 	if err != nil && err != pull.ErrDBUpToDate {
 		return fmt.Errorf("error syncing backup: %w", err)
 	}
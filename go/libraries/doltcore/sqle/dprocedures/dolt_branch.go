@@ -122,6 +122,9 @@ func renameBranch(ctx *sql.Context, dbData env.DbData, apr *argparser.ArgParseRe
 	if err := branch_control.CanDeleteBranch(ctx, oldBranchName); err != nil {
 		return err
 	}
+	if err := branch_control.CanDeleteProtectedBranch(ctx, oldBranchName); err != nil {
+		return err
+	}
 	if err := branch_control.CanCreateBranch(ctx, newBranchName); err != nil {
 		return err
 	}
@@ -149,6 +152,8 @@ func renameBranch(ctx *sql.Context, dbData env.DbData, apr *argparser.ArgParseRe
 		// If force is enabled, we can overwrite the destination branch, so we require a permission check here, even if the
 		// destination branch doesn't exist. An unauthorized user could simply rerun the command without the force flag.
 		return err
+	} else if err = checkProtectedBranchOverwrite(ctx, dbData.Ddb, newBranchName); err != nil {
+		return err
 	}
 
 	headRef, err := dbData.Rsr.CWBHeadRef()
@@ -223,6 +228,12 @@ func deleteBranches(ctx *sql.Context, dbData env.DbData, apr *argparser.ArgParse
 		if err = branch_control.CanDeleteBranch(ctx, branchName); err != nil {
 			return err
 		}
+		if apr.Contains(cli.RemoteParam) {
+			continue
+		}
+		if err = branch_control.CanDeleteProtectedBranch(ctx, branchName); err != nil {
+			return err
+		}
 	}
 
 	dSess := dsess.DSessFromSess(ctx.Session)
@@ -405,6 +416,11 @@ func createNewBranch(ctx *sql.Context, dbData env.DbData, apr *argparser.ArgPars
 	if err != nil {
 		return err
 	}
+	if apr.Contains(cli.ForceFlag) {
+		if err = checkProtectedBranchOverwrite(ctx, dbData.Ddb, branchName); err != nil {
+			return err
+		}
+	}
 
 	err = actions.CreateBranchWithStartPt(ctx, dbData, branchName, startPt, apr.Contains(cli.ForceFlag), rsc)
 	if err != nil {
@@ -451,6 +467,9 @@ func copyABranch(ctx *sql.Context, dbData env.DbData, srcBr string, destBr strin
 		if err := branch_control.CanDeleteBranch(ctx, destBr); err != nil {
 			return err
 		}
+		if err := checkProtectedBranchOverwrite(ctx, dbData.Ddb, destBr); err != nil {
+			return err
+		}
 	}
 	err := actions.CopyBranchOnDB(ctx, dbData.Ddb, srcBr, destBr, force, rsc)
 	if err != nil {
@@ -17,12 +17,14 @@ package dprocedures
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/types"
 
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
 	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions"
@@ -77,13 +79,25 @@ func doDoltCheckout(ctx *sql.Context, args []string) (statusCode int, successMes
 		return 1, "", err
 	}
 
+	sparsePatterns, setSparse, err := parseSparseArgs(apr)
+	if err != nil {
+		return 1, "", err
+	}
+
 	branchOrTrack := newBranch != "" || apr.Contains(cli.TrackFlag)
 	if apr.Contains(cli.TrackFlag) && apr.NArg() > 0 {
 		return 1, "", errors.New("Improper usage. Too many arguments provided.")
 	}
 	if !branchOrTrack && apr.NArg() == 0 {
+		if setSparse {
+			// Without a branch, the sparse checkout of the current working set is updated
+			return setSparseCheckout(ctx, currentDbName, sparsePatterns)
+		}
 		return 1, "", errors.New("Improper usage.")
 	}
+	if setSparse && apr.NArg() > 1 {
+		return 1, "", errors.New("Improper usage. --sparse and --no-sparse can only be used when checking out a branch.")
+	}
 
 	dSess := dsess.DSessFromSess(ctx.Session)
 	dbData, ok := dSess.GetDbData(ctx, currentDbName)
@@ -109,9 +123,13 @@ func doDoltCheckout(ctx *sql.Context, args []string) (statusCode int, successMes
 		newBranch, upstream, err := checkoutNewBranch(ctx, currentDbName, dbData, apr, &rsc, updateHead)
 		if err != nil {
 			return 1, "", err
-		} else {
-			return 0, generateSuccessMessage(newBranch, upstream), nil
 		}
+		if setSparse {
+			if _, _, err = setSparseCheckout(ctx, currentDbName, sparsePatterns); err != nil {
+				return 1, "", err
+			}
+		}
+		return 0, generateSuccessMessage(newBranch, upstream), nil
 	}
 
 	branchName := apr.Arg(0)
@@ -124,6 +142,9 @@ func doDoltCheckout(ctx *sql.Context, args []string) (statusCode int, successMes
 		return 1, "", err
 	}
 	if !isModification && apr.NArg() == 1 {
+		if setSparse {
+			return setSparseCheckout(ctx, currentDbName, sparsePatterns)
+		}
 		return 0, fmt.Sprintf("Already on branch '%s'", branchName), nil
 	}
 
@@ -176,9 +197,27 @@ func doDoltCheckout(ctx *sql.Context, args []string) (statusCode int, successMes
 		if err != nil {
 			return 1, "", err
 		}
+		if setSparse {
+			if _, _, err = setSparseCheckout(ctx, currentDbName, sparsePatterns); err != nil {
+				return 1, "", err
+			}
+		}
 		return 0, generateSuccessMessage(branchName, ""), nil
 	}
 
+	if setSparse {
+		// A sparse checkout is only valid for a branch, so the argument must name a remote branch to track
+		upstream, err := checkoutRemoteBranch(ctx, dSess, currentDbName, dbData, branchName, apr, &rsc)
+		if err != nil {
+			return 1, "", err
+		}
+		if _, _, err = setSparseCheckout(ctx, currentDbName, sparsePatterns); err != nil {
+			return 1, "", err
+		}
+		dsess.WaitForReplicationController(ctx, rsc)
+		return 0, generateSuccessMessage(branchName, upstream), nil
+	}
+
 	roots, ok := dSess.GetRoots(ctx, currentDbName)
 	if !ok {
 		return 1, "", fmt.Errorf("Could not load database %s", currentDbName)
@@ -199,6 +238,13 @@ func doDoltCheckout(ctx *sql.Context, args []string) (statusCode int, successMes
 		if !hasDb {
 			return 1, "", errors.New("Unable to load database")
 		}
+		_, newRoots, err := actions.ResetHardTables(ctx, dbData, "", roots)
+		if err != nil {
+			return 1, "", err
+		}
+		if err = dsess.CheckUnrestrictedTableChanges(ctx, roots.Working, newRoots.Working); err != nil {
+			return 1, "", err
+		}
 		err = actions.ResetHard(ctx, dbData, doltDb, dSess.Username(), dSess.Email(), "", roots, headRef, ws)
 		if err != nil {
 			return 1, "", err
@@ -207,7 +253,9 @@ func doDoltCheckout(ctx *sql.Context, args []string) (statusCode int, successMes
 	}
 
 	err = checkoutTablesFromHead(ctx, roots, currentDbName, apr.Args)
-	if err != nil && apr.NArg() == 1 {
+	if branch_control.ErrTableAccess.Is(err) || branch_control.ErrTableRestrictedAccess.Is(err) {
+		return 1, "", err
+	} else if err != nil && apr.NArg() == 1 {
 		upstream, err := checkoutRemoteBranch(ctx, dSess, currentDbName, dbData, branchName, apr, &rsc)
 		if err != nil {
 			return 1, "", err
@@ -220,6 +268,46 @@ func doDoltCheckout(ctx *sql.Context, args []string) (statusCode int, successMes
 	return 0, successMessage, nil
 }
 
+// parseSparseArgs returns the sparse checkout table patterns given by the --sparse flag, or nil if the --no-sparse flag
+// is given, along with whether either flag was given.
+func parseSparseArgs(apr *argparser.ArgParseResults) (patterns []string, setSparse bool, err error) {
+	sparse, ok := apr.GetValue(cli.SparseFlag)
+	if ok && apr.Contains(cli.NoSparseFlag) {
+		return nil, false, errors.New("Improper usage. Cannot use both --sparse and --no-sparse.")
+	}
+	if apr.Contains(cli.NoSparseFlag) {
+		return nil, true, nil
+	}
+	if !ok {
+		return nil, false, nil
+	}
+	patterns, err = doltdb.NormalizeSparsePatterns(strings.Split(sparse, ","))
+	if err != nil {
+		return nil, false, err
+	}
+	if len(patterns) == 0 {
+		return nil, false, errors.New("error: --sparse requires at least one table pattern; use --no-sparse to disable the sparse checkout")
+	}
+	return patterns, true, nil
+}
+
+// setSparseCheckout sets the sparse checkout table patterns of the current working set of the database named. Nil
+// |patterns| disable the sparse checkout.
+func setSparseCheckout(ctx *sql.Context, dbName string, patterns []string) (int, string, error) {
+	dSess := dsess.DSessFromSess(ctx.Session)
+	ws, err := dSess.WorkingSet(ctx, dbName)
+	if err != nil {
+		return 1, "", err
+	}
+	if err = dSess.SetWorkingSet(ctx, dbName, ws.WithSparseTables(patterns)); err != nil {
+		return 1, "", err
+	}
+	if len(patterns) == 0 {
+		return 0, "Disabled sparse checkout", nil
+	}
+	return 0, fmt.Sprintf("Sparse checkout of tables matching: %s", strings.Join(patterns, ", ")), nil
+}
+
 // parseBranchArgs returns the name of the new branch and whether or not it should be created forcibly. This asserts
 // that the provided branch name may not be empty, so an empty string is returned where no -b or -B flag is provided.
 func parseBranchArgs(apr *argparser.ArgParseResults) (newBranch string, createBranchForcibly bool, err error) {
@@ -567,6 +655,9 @@ func checkoutTablesFromCommit(
 	if err != nil {
 		return err
 	}
+	if err = dsess.CheckUnrestrictedTableChanges(ctx, ws.WorkingRoot(), newRoot); err != nil {
+		return err
+	}
 
 	return dSess.SetWorkingSet(ctx, databaseName, ws.WithStagedRoot(newRoot).WithWorkingRoot(newRoot))
 }
@@ -598,7 +689,7 @@ func checkoutTablesFromHead(ctx *sql.Context, roots doltdb.Roots, name string, t
 		tableNames[i] = tbl
 	}
 
-	roots, err := actions.MoveTablesFromHeadToWorking(ctx, roots, tableNames)
+	newRoots, err := actions.MoveTablesFromHeadToWorking(ctx, roots, tableNames)
 	if err != nil {
 		if doltdb.IsRootValUnreachable(err) {
 			rt := doltdb.GetUnreachableRootType(err)
@@ -610,6 +701,10 @@ func checkoutTablesFromHead(ctx *sql.Context, roots doltdb.Roots, name string, t
 		}
 	}
 
+	if err = dsess.CheckUnrestrictedTableChanges(ctx, roots.Working, newRoots.Working); err != nil {
+		return err
+	}
+
 	dSess := dsess.DSessFromSess(ctx.Session)
-	return dSess.SetRoots(ctx, name, roots)
+	return dSess.SetRoots(ctx, name, newRoots)
 }
@@ -83,6 +83,9 @@ func doDoltCherryPick(ctx *sql.Context, args []string) (string, int, int, int, e
 	if apr.Contains(cli.AbortParam) {
 		return "", 0, 0, 0, cherry_pick.AbortCherryPick(ctx, dbName)
 	}
+	if err = checkProtectedCommit(ctx); err != nil {
+		return "", 0, 0, 0, err
+	}
 
 	// we only support cherry-picking a single commit for now.
 	if apr.NArg() == 0 {
@@ -23,6 +23,7 @@ import (
 	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
 	"github.com/dolthub/dolt/go/libraries/doltcore/dbfactory"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
 	"github.com/dolthub/dolt/go/libraries/utils/argparser"
 	"github.com/dolthub/dolt/go/libraries/utils/config"
@@ -62,7 +63,15 @@ func doltClone(ctx *sql.Context, args ...string) (sql.RowIter, error) {
 		depth = -1
 	}
 
-	err = sess.Provider().CloneDatabaseFromRemote(ctx, dir, branch, remoteName, remoteUrl, depth, remoteParms)
+	var tables []string
+	if tableList, ok := apr.GetValue(cli.TablesFlag); ok {
+		tables, err = actions.ParsePartialCloneTables(tableList)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = sess.Provider().CloneDatabaseFromRemote(ctx, dir, branch, remoteName, remoteUrl, depth, tables, remoteParms)
 	if err != nil {
 		return nil, err
 	}
@@ -183,6 +183,17 @@ func doDoltCommit(ctx *sql.Context, args []string) (string, bool, error) {
 		return "", false, errors.New("nothing to commit")
 	}
 
+	// Concluding a merge is allowed, as the merge was already checked against the branch's protection rules
+	ws, err := dSess.WorkingSet(ctx, dbName)
+	if err != nil {
+		return "", false, err
+	}
+	if !ws.MergeCommitParents() || amend {
+		if err = checkProtectedCommit(ctx); err != nil {
+			return "", false, err
+		}
+	}
+
 	if apr.Contains(cli.SignFlag) || shouldSign {
 		keyId := apr.GetValueOrDefault(cli.SignFlag, "")
 
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"io"
 
+	gms "github.com/dolthub/go-mysql-server"
 	"github.com/dolthub/go-mysql-server/sql"
 
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
@@ -32,6 +33,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/resolve"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/editor"
 	"github.com/dolthub/dolt/go/store/hash"
@@ -361,10 +363,12 @@ func ResolveSchemaConflicts(ctx *sql.Context, ddb *doltdb.DoltDB, ws *doltdb.Wor
 	//	prevent auto-resolution of schema changes with `dolt conflicts resolve` until we have a fix
 	//	for resolving schema changes AND merging data (including dealing with any data conflicts).
 	//	For more details, see: https://github.com/dolthub/dolt/issues/6616
+	//	Schema conflicts can instead be resolved with a merged schema, see resolveSchemaConflictWithSchema.
 	if ws.MergeState().HasSchemaConflicts() {
 		return nil, fmt.Errorf("Unable to automatically resolve schema conflicts since data changes may " +
 			"not have been fully merged yet. " +
-			"To continue, abort this merge (dolt merge --abort) then apply ALTER TABLE statements to one " +
+			"To continue, resolve each table's schema conflict with the CREATE TABLE statement of its merged " +
+			"schema (dolt conflicts resolve --schema), or abort this merge (dolt merge --abort) then apply ALTER TABLE statements to one " +
 			"side of this merge to get the two schemas in sync with the desired schema, then rerun the merge. " +
 			"To track resolution of this limitation, follow https://github.com/dolthub/dolt/issues/6616")
 	}
@@ -408,6 +412,83 @@ func ResolveSchemaConflicts(ctx *sql.Context, ddb *doltdb.DoltDB, ws *doltdb.Wor
 	return ws.WithWorkingRoot(root).WithUnmergableTables(unmerged).WithMergedTables(merged), nil
 }
 
+// resolveSchemaConflictWithSchema resolves the schema conflict of the table created by |createTable|, a CREATE TABLE
+// statement that gives the merged schema of the table, by merging both sides' rows of the table into that schema. Any
+// rows that can't be converted to the merged schema are left as data conflicts. See merge.ResolveSchemaConflict.
+func resolveSchemaConflictWithSchema(ctx *sql.Context, dSess *dsess.DoltSession, dbName string, ws *doltdb.WorkingSet, createTable string) error {
+	if !ws.MergeActive() {
+		return fmt.Errorf("no merge is in progress, there are no schema conflicts to resolve")
+	}
+	mergeState := ws.MergeState()
+
+	headCommit, err := dSess.GetHeadCommit(ctx, dbName)
+	if err != nil {
+		return err
+	}
+	ourRoot, err := headCommit.GetRootValue(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Parsing the statement against our root keeps the tags of our columns, so rows are mapped to the merged
+	// schema by tag first, just as they are in a merge
+	name, sch, err := sqlutil.ParseCreateTableStatement(ctx, ourRoot, gms.NewDefault(dSess.Provider()), createTable)
+	if err != nil {
+		return err
+	}
+	tblName, _, ok, err := resolve.Table(ctx, ws.WorkingRoot(), name)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return doltdb.ErrTableNotFound
+	}
+
+	var unmerged []doltdb.TableName
+	for _, tbl := range mergeState.TablesWithSchemaConflicts() {
+		if tbl != tblName {
+			unmerged = append(unmerged, tbl)
+		}
+	}
+	if len(unmerged) == len(mergeState.TablesWithSchemaConflicts()) {
+		return fmt.Errorf("table %s has no schema conflicts to resolve", tblName)
+	}
+
+	theirCommit := mergeState.Commit()
+	theirRoot, err := theirCommit.GetRootValue(ctx)
+	if err != nil {
+		return err
+	}
+	optCmt, err := doltdb.GetCommitAncestor(ctx, headCommit, theirCommit)
+	if err != nil {
+		return err
+	}
+	ancCommit, ok := optCmt.ToCommit()
+	if !ok {
+		return doltdb.ErrGhostCommitEncountered
+	}
+	ancRoot, err := ancCommit.GetRootValue(ctx)
+	if err != nil {
+		return err
+	}
+
+	dbState, ok, err := dSess.LookupDbState(ctx, dbName)
+	if err != nil {
+		return err
+	} else if !ok {
+		return sql.ErrDatabaseNotFound.New(dbName)
+	}
+
+	root, _, err := merge.ResolveSchemaConflict(ctx, ws.WorkingRoot(), ourRoot, theirRoot, ancRoot, theirCommit, ancCommit, tblName, sch, dbState.EditOpts())
+	if err != nil {
+		return err
+	}
+
+	merged := append(mergeState.MergedTables(), tblName)
+	ws = ws.WithWorkingRoot(root).WithUnmergableTables(unmerged).WithMergedTables(merged)
+	return dSess.SetWorkingSet(ctx, dbName, ws)
+}
+
 func ResolveDataConflicts(ctx *sql.Context, dSess *dsess.DoltSession, root doltdb.RootValue, dbName string, ours bool, tblNames []doltdb.TableName) error {
 	for _, tblName := range tblNames {
 		tbl, ok, err := root.GetTable(ctx, tblName)
@@ -497,6 +578,18 @@ func DoDoltConflictsResolve(ctx *sql.Context, args []string) (int, error) {
 
 	ours := apr.Contains(cli.OursFlag)
 	theirs := apr.Contains(cli.TheirsFlag)
+	if createTable, ok := apr.GetValue(cli.SchemaParam); ok {
+		if ours || theirs {
+			return 1, fmt.Errorf("--%s can't be used with --ours or --theirs", cli.SchemaParam)
+		} else if apr.NArg() > 0 {
+			return 1, fmt.Errorf("--%s resolves the table named in its CREATE TABLE statement and takes no tables", cli.SchemaParam)
+		}
+		if err = resolveSchemaConflictWithSchema(ctx, dSess, dbName, ws, createTable); err != nil {
+			return 1, err
+		}
+		return 0, nil
+	}
+
 	if ours && theirs {
 		return 1, fmt.Errorf("specify only either --ours or --theirs")
 	} else if !ours && !theirs {
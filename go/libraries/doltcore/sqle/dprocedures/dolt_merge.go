@@ -54,6 +54,9 @@ const MergeProcFFIndex = 1
 
 var ErrUncommittedChanges = goerrors.NewKind("cannot merge with uncommitted changes")
 
+// oursMergeStrategy is the merge strategy that records a merge without taking any changes from the merged commits.
+const oursMergeStrategy = "ours"
+
 var doltMergeSchema = []*sql.Column{
 	{
 		Name:     "hash",
@@ -153,6 +156,18 @@ func doDoltMerge(ctx *sql.Context, args []string) (string, int, int, string, err
 		return "", noConflictsOrViolations, threeWayMerge, "merge aborted", nil
 	}
 
+	strategy, hasStrategy := apr.GetValue(cli.StrategyParam)
+	if hasStrategy && !strings.EqualFold(strategy, oursMergeStrategy) {
+		return "", noConflictsOrViolations, threeWayMerge, "", fmt.Errorf("error: unsupported merge strategy '%s'; the only supported strategy is '%s'", strategy, oursMergeStrategy)
+	}
+	if apr.NArg() > 1 || hasStrategy {
+		commit, message, err := performMultiParentMerge(ctx, sess, ws, dbName, apr, hasStrategy)
+		if err != nil {
+			return "", noConflictsOrViolations, threeWayMerge, "", err
+		}
+		return commit, noConflictsOrViolations, threeWayMerge, message, nil
+	}
+
 	branchName := apr.Arg(0)
 
 	mergeSpec, err := createMergeSpec(ctx, sess, dbName, apr, branchName)
@@ -225,6 +240,14 @@ func performMerge(
 		}
 	}
 
+	headRef, err := dbData.Rsr.CWBHeadRef()
+	if err != nil {
+		return ws, "", noConflictsOrViolations, threeWayMerge, "", err
+	}
+	if err = checkProtectedMerge(ctx, dbData.Ddb, headRef.GetPath(), spec.MergeC, canFF && !spec.NoFF && !spec.Squash); err != nil {
+		return ws, "", noConflictsOrViolations, threeWayMerge, "", err
+	}
+
 	if canFF {
 		if spec.NoFF {
 			var commit *doltdb.Commit
@@ -307,6 +330,156 @@ func performMerge(
 	return ws, commit, noConflictsOrViolations, threeWayMerge, "merge successful", nil
 }
 
+// performMultiParentMerge merges all the commits named in |apr| into the current branch at once, creating a single
+// merge commit with each of them as a parent. When |ours| is true, the merge commit records the merge without taking
+// any of the changes from the merged commits. These merges never fast-forward, are always committed, and require a
+// clean working set. Commits that are already merged into the current branch are skipped. If any of the commits
+// can't be merged without conflicts or constraint violations, an error is returned and the working set is left
+// unchanged. Returns the hash of the merge commit and a message for the caller.
+func performMultiParentMerge(
+	ctx *sql.Context,
+	sess *dsess.DoltSession,
+	ws *doltdb.WorkingSet,
+	dbName string,
+	apr *argparser.ArgParseResults,
+	ours bool,
+) (string, string, error) {
+	for _, flag := range []string{cli.SquashParam, cli.NoCommitFlag} {
+		if apr.Contains(flag) {
+			return "", "", fmt.Errorf("error: flag '--%s' is not supported when merging multiple branches or using a merge strategy", flag)
+		}
+	}
+	if ws.MergeActive() {
+		return "", "", doltdb.ErrMergeActive
+	}
+
+	roots, ok := sess.GetRoots(ctx, dbName)
+	if !ok {
+		return "", "", sql.ErrDatabaseNotFound.New(dbName)
+	}
+	hasChanges, _, _, err := actions.RootHasUncommittedChanges(roots)
+	if err != nil {
+		return "", "", err
+	}
+	if hasChanges {
+		return "", "", ErrUncommittedChanges.New()
+	}
+
+	dbData, ok := sess.GetDbData(ctx, dbName)
+	if !ok {
+		return "", "", fmt.Errorf("Could not load database %s", dbName)
+	}
+	headRef, err := dbData.Rsr.CWBHeadRef()
+	if err != nil {
+		return "", "", err
+	}
+
+	// Resolve the commits to merge, skipping any that are already merged
+	var specs []*merge.MergeSpec
+	seen := make(map[hash.Hash]bool)
+	for _, commitSpecStr := range apr.Args {
+		spec, err := createMergeSpec(ctx, sess, dbName, apr, commitSpecStr)
+		if err != nil {
+			return "", "", err
+		}
+		if seen[spec.MergeH] {
+			continue
+		}
+		seen[spec.MergeH] = true
+
+		_, err = spec.HeadC.CanFastForwardTo(ctx, spec.MergeC)
+		if err == doltdb.ErrIsAhead || err == doltdb.ErrUpToDate {
+			continue
+		} else if err != nil {
+			return "", "", err
+		}
+
+		if err = checkProtectedMerge(ctx, dbData.Ddb, headRef.GetPath(), spec.MergeC, false); err != nil {
+			return "", "", err
+		}
+		specs = append(specs, spec)
+	}
+	if len(specs) == 0 {
+		ctx.Warn(DoltMergeWarningCode, "%s", doltdb.ErrUpToDate.Error())
+		return "", doltdb.ErrUpToDate.Error(), nil
+	}
+
+	mergeCommits := make([]*doltdb.Commit, len(specs))
+	mergeCommitSpecs := make([]string, len(specs))
+	for i, spec := range specs {
+		mergeCommits[i] = spec.MergeC
+		mergeCommitSpecs[i] = spec.MergeCSpecStr
+	}
+
+	// With the ours strategy, the merged root is the root of HEAD
+	mergedRoot := roots.Head
+	if !ours {
+		dbState, ok, err := sess.LookupDbState(ctx, dbName)
+		if err != nil {
+			return "", "", err
+		} else if !ok {
+			return "", "", sql.ErrDatabaseNotFound.New(dbName)
+		}
+		sparse, err := ws.SparseCheckout()
+		if err != nil {
+			return "", "", err
+		}
+		result, err := merge.MergeCommitsOctopus(ctx, specs[0].HeadC, mergeCommits, mergeCommitSpecs, dbState.EditOpts(), sparse)
+		if err != nil {
+			return "", "", err
+		}
+		mergedRoot = result.Root
+	}
+
+	msg, ok := apr.GetValue(cli.MessageArg)
+	if !ok {
+		msg = multiParentMergeMessage(mergeCommitSpecs, headRef.GetPath())
+	}
+
+	if err = dsess.CheckUnrestrictedTableChanges(ctx, roots.Working, mergedRoot); err != nil {
+		return "", "", err
+	}
+
+	roots.Working, roots.Staged = mergedRoot, mergedRoot
+	pendingCommit, err := sess.NewPendingMergeCommit(ctx, dbName, roots, mergeCommits, actions.CommitStagedProps{
+		Message:    msg,
+		Date:       specs[0].Date,
+		AllowEmpty: true,
+		Force:      specs[0].Force,
+		Name:       specs[0].Name,
+		Email:      specs[0].Email,
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	commit, err := sess.DoltCommit(ctx, dbName, sess.GetTransaction(), pendingCommit)
+	if err != nil {
+		return "", "", err
+	}
+	h, err := commit.HashOf()
+	if err != nil {
+		return "", "", err
+	}
+
+	return h.String(), "merge successful", nil
+}
+
+// multiParentMergeMessage returns the default commit message for merging the commits named by |commitSpecStrs| into
+// the branch |branchName|, e.g. "Merge branches 'b1', 'b2' and 'b3' into main".
+func multiParentMergeMessage(commitSpecStrs []string, branchName string) string {
+	if len(commitSpecStrs) == 1 {
+		return fmt.Sprintf("Merge branch '%s' into %s", commitSpecStrs[0], branchName)
+	}
+
+	quoted := make([]string, len(commitSpecStrs))
+	for i, commitSpecStr := range commitSpecStrs {
+		quoted[i] = fmt.Sprintf("'%s'", commitSpecStr)
+	}
+	last := len(quoted) - 1
+	return fmt.Sprintf("Merge branches %s and %s into %s", strings.Join(quoted[:last], ", "), quoted[last], branchName)
+}
+
 func executeMerge(
 	ctx *sql.Context,
 	sess *dsess.DoltSession,
@@ -319,7 +492,11 @@ func executeMerge(
 	opts editor.Options,
 	workingDiffs map[doltdb.TableName]hash.Hash,
 ) (*doltdb.WorkingSet, error) {
-	result, err := merge.MergeCommits(ctx, head, cm, opts)
+	sparse, err := ws.SparseCheckout()
+	if err != nil {
+		return nil, err
+	}
+	result, err := merge.MergeCommits(ctx, head, cm, opts, sparse)
 	if err != nil {
 		switch err {
 		case doltdb.ErrUpToDate:
@@ -346,6 +523,10 @@ func executeFFMerge(ctx *sql.Context, dbName string, squash bool, ws *doltdb.Wor
 		}
 	}
 
+	if err = dsess.CheckUnrestrictedTableChanges(ctx, ws.WorkingRoot(), workingRoot); err != nil {
+		return ws, err
+	}
+
 	// TODO: This is all incredibly suspect, needs to be replaced with library code that is functional instead of
 	//  altering global state
 	if !squash {
@@ -530,6 +711,10 @@ func mergeRootToWorking(
 		}
 	}
 
+	if err = dsess.CheckUnrestrictedTableChanges(ctx, ws.WorkingRoot(), working); err != nil {
+		return ws, err
+	}
+
 	if !squash || merged.HasSchemaConflicts() {
 		ws = ws.StartMerge(cm2, cm2Spec)
 		tt := merge.SchemaConflictTableNames(merged.SchemaConflicts)
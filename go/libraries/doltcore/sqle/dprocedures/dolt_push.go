@@ -27,6 +27,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
 	"github.com/dolthub/dolt/go/libraries/utils/config"
 	"github.com/dolthub/dolt/go/store/datas"
@@ -82,6 +83,14 @@ func doDoltPush(ctx *sql.Context, args []string) (int, string, error) {
 	if err != nil {
 		return cmdFailure, "", err
 	}
+	// Protected branches are shared with the remote, so we can't force push over them
+	for _, target := range targets {
+		if target.Mode.Force && target.DestRef.GetType() == ref.BranchRefType {
+			if err = branch_control.CanForceUpdateBranch(ctx, target.DestRef.GetPath()); err != nil {
+				return cmdFailure, "", err
+			}
+		}
+	}
 
 	if user, hasUser := apr.GetValue(cli.UserFlag); hasUser {
 		rmt := (*remote).WithParams(map[string]string{
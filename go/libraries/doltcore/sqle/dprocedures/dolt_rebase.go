@@ -53,7 +53,10 @@ var RebaseActionEnumType = types.MustCreateEnumType([]string{
 	rebase.RebaseActionPick,
 	rebase.RebaseActionReword,
 	rebase.RebaseActionSquash,
-	rebase.RebaseActionFixup}, sql.Collation_Default)
+	rebase.RebaseActionFixup,
+	rebase.RebaseActionEdit,
+	rebase.RebaseActionBreak,
+	rebase.RebaseActionExec}, sql.Collation_Default)
 
 // GetDoltRebaseSystemTableSchema returns the schema for the dolt_rebase system table.
 // This is used by Doltgres to update the dolt_rebase schema using Doltgres types.
@@ -117,6 +120,16 @@ var ErrRebaseSchemaConflict = goerrors.NewKind(
 	"schema conflict detected while rebasing commit %s. " +
 		"the rebase has been automatically aborted")
 
+// ErrRebaseExecFailed is used when the query of an exec action in the rebase plan fails. The rebase is stopped, so that
+// the problem can be fixed before continuing the rebase.
+var ErrRebaseExecFailed = goerrors.NewKind("exec query failed while rebasing: %s: %s. \n\n" +
+	"Fix the problem, then continue the rebase by calling dolt_rebase('--continue')")
+
+// ErrRebaseStagedChangesAtStop is used when a rebase that was stopped by a break or exec action is continued, but
+// there are staged changes, which aren't part of any step of the rebase plan.
+var ErrRebaseStagedChangesAtStop = goerrors.NewKind("cannot continue a rebase stopped by a %s action with " +
+	"staged changes. Use dolt_commit() to commit them and then continue the rebase")
+
 // ErrRebaseConflictWithAbortError is used when a merge conflict is detected while rebasing a commit,
 // and we are unable to cleanly abort the rebase.
 var ErrRebaseConflictWithAbortError = goerrors.NewKind(
@@ -129,6 +142,10 @@ var SuccessfulRebaseMessage = "Successfully rebased and updated refs/heads/"
 
 var RebaseAbortedMessage = "Interactive rebase aborted"
 
+// RebaseStoppedMessage is the start of the message used when a rebase is stopped by a step of the rebase plan, such as
+// an edit or break action, so that the caller can take additional action before continuing the rebase.
+var RebaseStoppedMessage = "Stopped rebasing at "
+
 func doltRebase(ctx *sql.Context, args ...string) (sql.RowIter, error) {
 	res, message, err := doDoltRebase(ctx, args)
 	if err != nil {
@@ -157,11 +174,11 @@ func doDoltRebase(ctx *sql.Context, args []string) (int, string, error) {
 		}
 
 	case apr.Contains(cli.ContinueFlag):
-		rebaseBranch, err := continueRebase(ctx)
+		message, err := continueRebase(ctx)
 		if err != nil {
 			return 1, "", err
 		} else {
-			return 0, SuccessfulRebaseMessage + rebaseBranch, nil
+			return 0, message, nil
 		}
 
 	default:
@@ -182,7 +199,8 @@ func doDoltRebase(ctx *sql.Context, args []string) (int, string, error) {
 		if !apr.Contains(cli.InteractiveFlag) {
 			return 1, "", fmt.Errorf("non-interactive rebases not currently supported")
 		}
-		err = startRebase(ctx, apr.Arg(0), commitBecomesEmptyHandling, emptyCommitHandling)
+		onto, _ := apr.GetValue(cli.OntoParam)
+		err = startRebase(ctx, apr.Arg(0), onto, apr.Contains(cli.AutosquashFlag), commitBecomesEmptyHandling, emptyCommitHandling)
 		if err != nil {
 			return 1, "", err
 		}
@@ -206,8 +224,8 @@ func processCommitBecomesEmptyParams(apr *argparser.ArgParseResults) (doltdb.Emp
 	if !isCommitBecomesEmptySpecified {
 		// If no option is specified, then by default, commits that become empty are dropped. Git has the same
 		// default for non-interactive rebases; for interactive rebases, Git uses the default action of "stop" to
-		// let the user examine the changes and decide what to do next. We don't support the "stop" action yet, so
-		// we default to "drop" even in the interactive rebase case.
+		// let the user examine the changes and decide what to do next. Dolt supports "stop", but keeps "drop" as
+		// the default even in the interactive rebase case, so that existing rebase workflows aren't interrupted.
 		return doltdb.DropEmptyCommit, nil
 	}
 
@@ -215,19 +233,27 @@ func processCommitBecomesEmptyParams(apr *argparser.ArgParseResults) (doltdb.Emp
 		return doltdb.KeepEmptyCommit, nil
 	} else if strings.EqualFold(commitBecomesEmptyParam, "drop") {
 		return doltdb.DropEmptyCommit, nil
+	} else if strings.EqualFold(commitBecomesEmptyParam, "stop") {
+		return doltdb.StopOnEmptyCommit, nil
 	} else {
 		return -1, fmt.Errorf("unsupported option for the empty flag (%s); "+
-			"only 'keep' or 'drop' are allowed", commitBecomesEmptyParam)
+			"only 'keep', 'drop' or 'stop' are allowed", commitBecomesEmptyParam)
 	}
 }
 
 // startRebase starts a new interactive rebase operation. |upstreamPoint| specifies the commit where the new rebased
-// commits will be based off of, |commitBecomesEmptyHandling| specifies how to  handle commits that are not empty, but
-// do not produce any changes when applied, and |emptyCommitHandling| specifies how to handle empty commits.
-func startRebase(ctx *sql.Context, upstreamPoint string, commitBecomesEmptyHandling doltdb.EmptyCommitHandling, emptyCommitHandling doltdb.EmptyCommitHandling) error {
+// commits will be based off of, unless |ontoPoint| is specified, in which case the commits reachable from the current
+// branch but not from |upstreamPoint| are rebased onto |ontoPoint|. |autosquash| specifies whether fixup! and squash!
+// commits are moved in the rebase plan to fix up or squash the commits they name. |commitBecomesEmptyHandling|
+// specifies how to  handle commits that are not empty, but do not produce any changes when applied, and
+// |emptyCommitHandling| specifies how to handle empty commits.
+func startRebase(ctx *sql.Context, upstreamPoint string, ontoPoint string, autosquash bool, commitBecomesEmptyHandling doltdb.EmptyCommitHandling, emptyCommitHandling doltdb.EmptyCommitHandling) error {
 	if upstreamPoint == "" {
 		return fmt.Errorf("no upstream branch specified")
 	}
+	if ontoPoint == "" {
+		ontoPoint = upstreamPoint
+	}
 
 	err := validateWorkingSetCanStartRebase(ctx)
 	if err != nil {
@@ -268,11 +294,27 @@ func startRebase(ctx *sql.Context, upstreamPoint string, commitBecomesEmptyHandl
 		return doltdb.ErrGhostCommitEncountered
 	}
 
+	ontoCommit := upstreamCommit
+	if ontoPoint != upstreamPoint {
+		ontoSpec, err := doltdb.NewCommitSpec(ontoPoint)
+		if err != nil {
+			return err
+		}
+		optCmt, err = dbData.Ddb.Resolve(ctx, ontoSpec, headRef)
+		if err != nil {
+			return err
+		}
+		ontoCommit, ok = optCmt.ToCommit()
+		if !ok {
+			return doltdb.ErrGhostCommitEncountered
+		}
+	}
+
 	// rebaseWorkingBranch is the name of the temporary branch used when performing a rebase. In Git, a rebase
 	// happens with a detached HEAD, but Dolt doesn't support that, we use a temporary branch.
 	rebaseWorkingBranch := "dolt_rebase_" + rebaseBranch
 	var rsc doltdb.ReplicationStatusController
-	err = actions.CreateBranchWithStartPt(ctx, dbData, rebaseWorkingBranch, upstreamPoint, false, &rsc)
+	err = actions.CreateBranchWithStartPt(ctx, dbData, rebaseWorkingBranch, ontoPoint, false, &rsc)
 	if err != nil {
 		return err
 	}
@@ -311,7 +353,7 @@ func startRebase(ctx *sql.Context, upstreamPoint string, commitBecomesEmptyHandl
 		return err
 	}
 
-	newWorkingSet, err := workingSet.StartRebase(ctx, upstreamCommit, rebaseBranch, branchRoots.Working,
+	newWorkingSet, err := workingSet.StartRebase(ctx, ontoCommit, rebaseBranch, branchRoots.Working,
 		commitBecomesEmptyHandling, emptyCommitHandling)
 	if err != nil {
 		return err
@@ -331,6 +373,9 @@ func startRebase(ctx *sql.Context, upstreamPoint string, commitBecomesEmptyHandl
 		}
 		return err
 	}
+	if autosquash {
+		rebase.AutosquashRebasePlan(rebasePlan)
+	}
 	rdb, ok := db.(rebase.RebasePlanDatabase)
 	if !ok {
 		return fmt.Errorf("expected a dsess.RebasePlanDatabase implementation, but received a %T", db)
@@ -559,6 +604,25 @@ func workingSetStatus(ctx *sql.Context) (stagedChanges, unstagedChanges bool, er
 // as the rebase started flag indicating that execution of the rebase plan has been started. This
 // information is all stored in the RebaseState of the WorkingSet.
 func recordCurrentStep(ctx *sql.Context, step rebase.RebasePlanStep) error {
+	return updateRebaseState(ctx, func(rebaseState *doltdb.RebaseState) *doltdb.RebaseState {
+		return rebaseState.
+			WithLastAttemptedStep(step.RebaseOrderAsFloat()).
+			WithRebasingStarted(true).
+			WithStopReason(doltdb.RebaseNotStopped)
+	})
+}
+
+// recordStop updates working set metadata to record that the rebase was stopped after the last attempted step,
+// and why, so that the rebase can be continued correctly, even after a server restart.
+func recordStop(ctx *sql.Context, stopReason doltdb.RebaseStopReason) error {
+	return updateRebaseState(ctx, func(rebaseState *doltdb.RebaseState) *doltdb.RebaseState {
+		return rebaseState.WithStopReason(stopReason)
+	})
+}
+
+// updateRebaseState applies |update| to the RebaseState of the current working set, and commits the SQL
+// transaction to persist the new RebaseState in the branch head's working set.
+func updateRebaseState(ctx *sql.Context, update func(rebaseState *doltdb.RebaseState) *doltdb.RebaseState) error {
 	doltSession := dsess.DSessFromSess(ctx.Session)
 	if doltSession.GetTransaction() == nil {
 		_, err := doltSession.StartTransaction(ctx, sql.ReadWrite)
@@ -572,16 +636,14 @@ func recordCurrentStep(ctx *sql.Context, step rebase.RebasePlanStep) error {
 		return err
 	}
 
-	// Update the current step in the working set, so that we can continue the merge if this hits a conflict
-	newWorkingSet := workingSet.WithRebaseState(workingSet.RebaseState().
-		WithLastAttemptedStep(step.RebaseOrderAsFloat()).
-		WithRebasingStarted(true))
+	// Update the rebase state in the working set, so that we can continue the rebase if it hits a conflict or stops
+	newWorkingSet := workingSet.WithRebaseState(update(workingSet.RebaseState()))
 	err = doltSession.SetWorkingSet(ctx, ctx.GetCurrentDatabase(), newWorkingSet)
 	if err != nil {
 		return err
 	}
 
-	// Commit the SQL transaction with the LastAttemptedStep update to set that in the branch head's working set
+	// Commit the SQL transaction with the rebase state update to set that in the branch head's working set
 	if doltSession.GetTransaction() != nil {
 		err = doltSession.CommitTransaction(ctx, doltSession.GetTransaction())
 		if err != nil {
@@ -592,6 +654,9 @@ func recordCurrentStep(ctx *sql.Context, step rebase.RebasePlanStep) error {
 	return nil
 }
 
+// continueRebase continues executing the rebase plan, from the last attempted step, and returns the message describing
+// the result. If a step of the plan stops the rebase, such as an edit or break action, the rebase is left in progress
+// and a message starting with RebaseStoppedMessage is returned.
 func continueRebase(ctx *sql.Context) (string, error) {
 	// Validate that we are in an interactive rebase
 	if err := validateActiveRebase(ctx); err != nil {
@@ -656,7 +721,15 @@ func continueRebase(ctx *sql.Context) (string, error) {
 		// If we've already executed this step, but the working set has staged changes,
 		// then we need to make the commit for the manual changes made for this step.
 		if rebasingStarted && rebaseStepOrder == lastAttemptedStep && hasStagedChanges {
-			if err = commitManuallyStagedChangesForStep(ctx, step); err != nil {
+			switch stopReason := workingSet.RebaseState().StopReason(); stopReason {
+			case doltdb.RebaseStoppedForEdit:
+				err = amendCommitForEditStep(ctx)
+			case doltdb.RebaseStoppedForBreak, doltdb.RebaseStoppedForFailedExec:
+				err = ErrRebaseStagedChangesAtStop.New(step.Action)
+			default:
+				err = commitManuallyStagedChangesForStep(ctx, step)
+			}
+			if err != nil {
 				return "", err
 			}
 			continue
@@ -675,12 +748,18 @@ func continueRebase(ctx *sql.Context) (string, error) {
 				return "", err
 			}
 
-			err = processRebasePlanStep(ctx, &step,
+			stopReason, err := processRebasePlanStep(ctx, &step,
 				workingSet.RebaseState().CommitBecomesEmptyHandling(),
 				workingSet.RebaseState().EmptyCommitHandling())
 			if err != nil {
 				return "", err
 			}
+			if stopReason != doltdb.RebaseNotStopped {
+				if err = recordStop(ctx, stopReason); err != nil {
+					return "", err
+				}
+				return rebaseStoppedMessage(stopReason, step), nil
+			}
 		}
 
 		// Ensure a transaction has been started, so that the session is in sync with the latest changes
@@ -742,9 +821,78 @@ func continueRebase(ctx *sql.Context) (string, error) {
 	if !ok {
 		return "", fmt.Errorf("unable to lookup dbdata")
 	}
-	return rebaseBranch, actions.DeleteBranch(ctx, dbData, rebaseWorkingBranch, actions.DeleteOptions{
+	err = actions.DeleteBranch(ctx, dbData, rebaseWorkingBranch, actions.DeleteOptions{
 		Force: true,
 	}, doltSession.Provider(), nil)
+	if err != nil {
+		return "", err
+	}
+	return SuccessfulRebaseMessage + rebaseBranch, nil
+}
+
+// rebaseStoppedMessage returns the message describing why the rebase stopped at |step| for |stopReason|, and how to
+// continue the rebase.
+func rebaseStoppedMessage(stopReason doltdb.RebaseStopReason, step rebase.RebasePlanStep) string {
+	switch stopReason {
+	case doltdb.RebaseStoppedForEdit:
+		return fmt.Sprintf("%s%s %s (%s); amend the commit by staging changes with dolt_add(), "+
+			"then continue rebasing by calling dolt_rebase('--continue')", RebaseStoppedMessage, step.Action, step.CommitHash, step.CommitMsg)
+	case doltdb.RebaseStoppedForEmptyCommit:
+		return fmt.Sprintf("%s%s %s (%s), which became empty; stage changes with dolt_add() to commit them in its "+
+			"place, or continue rebasing by calling dolt_rebase('--continue') to drop the commit", RebaseStoppedMessage, step.Action, step.CommitHash, step.CommitMsg)
+	default:
+		return fmt.Sprintf("%s%s; continue rebasing by calling dolt_rebase('--continue')", RebaseStoppedMessage, step.Action)
+	}
+}
+
+// amendCommitForEditStep amends the commit at HEAD, which was created by an edit action in the rebase plan, with
+// the changes that were staged while the rebase was stopped for the edit action.
+func amendCommitForEditStep(ctx *sql.Context) error {
+	doltSession := dsess.DSessFromSess(ctx.Session)
+	commitMessage, err := previousCommitMessage(ctx)
+	if err != nil {
+		return err
+	}
+
+	options := cherry_pick.NewCherryPickOptions()
+	options.Amend = true
+	options.CommitMessage = commitMessage
+	commitProps, err := cherry_pick.CreateCommitStagedPropsFromCherryPickOptions(ctx, options)
+	if err != nil {
+		return err
+	}
+
+	roots, ok := doltSession.GetRoots(ctx, ctx.GetCurrentDatabase())
+	if !ok {
+		return fmt.Errorf("unable to get roots for current session")
+	}
+	pendingCommit, err := doltSession.NewPendingCommit(ctx, ctx.GetCurrentDatabase(), roots, *commitProps)
+	if err != nil {
+		return err
+	}
+
+	// Ensure a SQL transaction is set in the session
+	if doltSession.GetTransaction() == nil {
+		if _, err = doltSession.StartTransaction(ctx, sql.ReadWrite); err != nil {
+			return err
+		}
+	}
+	_, err = doltSession.DoltCommit(ctx, ctx.GetCurrentDatabase(), doltSession.GetTransaction(), pendingCommit)
+	return err
+}
+
+// previousCommitMessage returns the commit message of the commit at HEAD.
+func previousCommitMessage(ctx *sql.Context) (string, error) {
+	doltSession := dsess.DSessFromSess(ctx.Session)
+	headCommit, err := doltSession.GetHeadCommit(ctx, ctx.GetCurrentDatabase())
+	if err != nil {
+		return "", err
+	}
+	headCommitMeta, err := headCommit.GetCommitMeta(ctx)
+	if err != nil {
+		return "", err
+	}
+	return headCommitMeta.Description, nil
 }
 
 // commitManuallyStagedChangesForStep handles committing staged changes after a conflict has been manually
@@ -759,6 +907,9 @@ func commitManuallyStagedChangesForStep(ctx *sql.Context, step rebase.RebasePlan
 
 	options, err := createCherryPickOptionsForRebaseStep(ctx, &step, workingSet.RebaseState().CommitBecomesEmptyHandling(),
 		workingSet.RebaseState().EmptyCommitHandling())
+	if err != nil {
+		return err
+	}
 
 	commitProps, err := cherry_pick.CreateCommitStagedPropsFromCherryPickOptions(ctx, *options)
 	if err != nil {
@@ -791,8 +942,10 @@ func commitManuallyStagedChangesForStep(ctx *sql.Context, step rebase.RebasePlan
 	return err
 }
 
+// processRebasePlanStep executes |planStep| of the rebase plan, and returns the reason the rebase should stop after
+// the step, or doltdb.RebaseNotStopped if the rebase should continue with the next step.
 func processRebasePlanStep(ctx *sql.Context, planStep *rebase.RebasePlanStep,
-	commitBecomesEmptyHandling doltdb.EmptyCommitHandling, emptyCommitHandling doltdb.EmptyCommitHandling) error {
+	commitBecomesEmptyHandling doltdb.EmptyCommitHandling, emptyCommitHandling doltdb.EmptyCommitHandling) (doltdb.RebaseStopReason, error) {
 	// Make sure we have a transaction opened for the session
 	// NOTE: After our first call to cherry-pick, the tx is committed, so a new tx needs to be started
 	//       as we process additional rebase actions.
@@ -800,21 +953,74 @@ func processRebasePlanStep(ctx *sql.Context, planStep *rebase.RebasePlanStep,
 	if doltSession.GetTransaction() == nil {
 		_, err := doltSession.StartTransaction(ctx, sql.ReadWrite)
 		if err != nil {
-			return err
+			return doltdb.RebaseNotStopped, err
 		}
 	}
 
-	// If the action is "drop", then we don't need to do anything
-	if planStep.Action == rebase.RebaseActionDrop {
-		return nil
+	switch planStep.Action {
+	case rebase.RebaseActionDrop:
+		// If the action is "drop", then we don't need to do anything
+		return doltdb.RebaseNotStopped, nil
+	case rebase.RebaseActionBreak:
+		return doltdb.RebaseStoppedForBreak, nil
+	case rebase.RebaseActionExec:
+		return doltdb.RebaseNotStopped, handleRebaseExec(ctx, planStep)
 	}
 
 	options, err := createCherryPickOptionsForRebaseStep(ctx, planStep, commitBecomesEmptyHandling, emptyCommitHandling)
 	if err != nil {
-		return err
+		return doltdb.RebaseNotStopped, err
+	}
+
+	err = handleRebaseCherryPick(ctx, planStep, *options)
+	if errors.Is(err, cherry_pick.ErrCherryPickCommitBecameEmpty) {
+		return doltdb.RebaseStoppedForEmptyCommit, nil
+	} else if err != nil {
+		return doltdb.RebaseNotStopped, err
 	}
 
-	return handleRebaseCherryPick(ctx, planStep, *options)
+	if planStep.Action == rebase.RebaseActionEdit {
+		return doltdb.RebaseStoppedForEdit, nil
+	}
+	return doltdb.RebaseNotStopped, nil
+}
+
+// handleRebaseExec runs the query of the exec action |planStep|. If the query fails, or leaves uncommitted changes in
+// the working set, the rebase is stopped and ErrRebaseExecFailed is returned.
+func handleRebaseExec(ctx *sql.Context, planStep *rebase.RebasePlanStep) error {
+	query := planStep.CommitMsg
+	err := runRebaseExecQuery(ctx, query)
+	if err == nil {
+		var hasStagedChanges, hasUnstagedChanges bool
+		hasStagedChanges, hasUnstagedChanges, err = workingSetStatus(ctx)
+		if err != nil {
+			return err
+		}
+		if !hasStagedChanges && !hasUnstagedChanges {
+			return nil
+		}
+		err = fmt.Errorf("the query left uncommitted changes")
+	}
+
+	if recordErr := recordStop(ctx, doltdb.RebaseStoppedForFailedExec); recordErr != nil {
+		return recordErr
+	}
+	return ErrRebaseExecFailed.New(query, err.Error())
+}
+
+// runRebaseExecQuery runs |query| in the session that is rebasing, with the query runner of its provider, and drains
+// its results. Exec actions fail if the engine hasn't set a query runner.
+func runRebaseExecQuery(ctx *sql.Context, query string) error {
+	runQuery := dsess.DSessFromSess(ctx.Session).Provider().QueryRunner()
+	if runQuery == nil {
+		return fmt.Errorf("exec actions are not supported by this server")
+	}
+	_, iter, _, err := runQuery(ctx, query)
+	if err != nil {
+		return err
+	}
+	_, err = sql.RowIterToRows(ctx, iter)
+	return err
 }
 
 func createCherryPickOptionsForRebaseStep(ctx *sql.Context, planStep *rebase.RebasePlanStep, commitBecomesEmptyHandling doltdb.EmptyCommitHandling, emptyCommitHandling doltdb.EmptyCommitHandling) (*cherry_pick.CherryPickOptions, error) {
@@ -825,9 +1031,9 @@ func createCherryPickOptionsForRebaseStep(ctx *sql.Context, planStep *rebase.Reb
 	options.EmptyCommitHandling = emptyCommitHandling
 
 	switch planStep.Action {
-	case rebase.RebaseActionDrop, rebase.RebaseActionPick:
-		// Nothing to do – the drop action doesn't result in a cherry pick and the pick action
-		// doesn't require any special options (i.e. no amend, no custom commit message).
+	case rebase.RebaseActionDrop, rebase.RebaseActionPick, rebase.RebaseActionEdit:
+		// Nothing to do – the drop action doesn't result in a cherry pick and the pick and edit
+		// actions don't require any special options (i.e. no amend, no custom commit message).
 
 	case rebase.RebaseActionReword:
 		options.CommitMessage = planStep.CommitMsg
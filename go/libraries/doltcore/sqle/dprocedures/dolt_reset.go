@@ -250,11 +250,15 @@ func resetHard(
 	}
 
 	var newHead *doltdb.Commit
+	oldWorking := roots.Working
 	newHead, roots, err := actions.ResetHardTables(ctx, dbData, arg, roots)
 
 	if err != nil {
 		return err
 	}
+	if err = dsess.CheckUnrestrictedTableChanges(ctx, oldWorking, roots.Working); err != nil {
+		return err
+	}
 
 	// TODO: this overrides the transaction setting, needs to happen at commit, not here
 	if newHead != nil {
@@ -262,6 +266,13 @@ func resetHard(
 		if err != nil {
 			return err
 		}
+		oldHead, err := dbData.Ddb.ResolveCommitRef(ctx, headRef)
+		if err != nil {
+			return err
+		}
+		if err = checkProtectedBranchMove(ctx, headRef.GetPath(), oldHead, newHead); err != nil {
+			return err
+		}
 		if err := dbData.Ddb.SetHeadToCommit(ctx, headRef, newHead); err != nil {
 			return err
 		}
@@ -0,0 +1,85 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dprocedures
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/dconfig"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+)
+
+var doltRestoreAsOfSchema = []*sql.Column{
+	{
+		Name:     "status",
+		Type:     types.Int64,
+		Nullable: false,
+	},
+	{
+		Name:     "message",
+		Type:     types.LongText,
+		Nullable: true,
+	},
+}
+
+// doltRestoreAsOf restores the current database, as it was at a point in time, into a new database. The current
+// database is not modified.
+func doltRestoreAsOf(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+	res, message, err := doDoltRestoreAsOf(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return rowToIter(int64(res), message), nil
+}
+
+func doDoltRestoreAsOf(ctx *sql.Context, args []string) (int, string, error) {
+	if len(args) != 2 {
+		return 1, "", fmt.Errorf("usage: dolt_restore_as_of('time', 'database_name')")
+	}
+
+	dbName := ctx.GetCurrentDatabase()
+	if len(dbName) == 0 {
+		return 1, "", fmt.Errorf("Empty database name.")
+	}
+
+	// Only allow admins to restore a database
+	if err := checkBackupRestorePrivs(ctx); err != nil {
+		return 1, "", err
+	}
+
+	asOf, err := dconfig.ParseDate(strings.TrimSpace(args[0]))
+	if err != nil {
+		return 1, "", err
+	}
+	restoredDbName := strings.TrimSpace(args[1])
+
+	sess := dsess.DSessFromSess(ctx.Session)
+	dbData, ok := sess.GetDbData(ctx, dbName)
+	if !ok {
+		return 1, "", sql.ErrDatabaseNotFound.New(dbName)
+	}
+
+	restoredAt, err := sess.Provider().RestoreDatabaseAsOf(ctx, dbData.Ddb, restoredDbName, asOf)
+	if err != nil {
+		return 1, "", err
+	}
+
+	return 0, fmt.Sprintf("Restored database as of %s into %s", restoredAt.UTC().Format(time.RFC3339), restoredDbName), nil
+}
@@ -48,6 +48,9 @@ func doDoltRevert(ctx *sql.Context, args []string) (int, error) {
 	if err := branch_control.CheckAccess(ctx, branch_control.Permissions_Write); err != nil {
 		return 1, err
 	}
+	if err := checkProtectedCommit(ctx); err != nil {
+		return 1, err
+	}
 
 	roots, ok := dSess.GetRoots(ctx, dbName)
 	if !ok {
@@ -126,6 +129,9 @@ func doDoltRevert(ctx *sql.Context, args []string) (int, error) {
 		return 1, err
 	}
 	if !headHash.Equal(workingHash) {
+		if err = dsess.CheckUnrestrictedTableChanges(ctx, workingSet.WorkingRoot(), workingRoot); err != nil {
+			return 1, err
+		}
 		err = dSess.SetWorkingRoot(ctx, dbName, workingRoot)
 		if err != nil {
 			return 1, err
@@ -45,15 +45,12 @@ func doDoltConstraintsVerify(ctx *sql.Context, args []string) (int, error) {
 
 	dbName := ctx.GetCurrentDatabase()
 	dSess := dsess.DSessFromSess(ctx.Session)
-	workingSet, err := dSess.WorkingSet(ctx, dbName)
-	if err != nil {
-		return 1, err
-	}
-	workingRoot := workingSet.WorkingRoot()
-	headCommit, err := dSess.GetHeadCommit(ctx, dbName)
-	if err != nil {
-		return 1, err
+	// roots are used rather than the working set so that violations can be reported for a detached head
+	roots, ok := dSess.GetRoots(ctx, dbName)
+	if !ok {
+		return 1, fmt.Errorf("Could not load database %s", dbName)
 	}
+	workingRoot := roots.Working
 
 	apr, err := cli.CreateVerifyConstraintsArgParser("doltVerifyConstraints").Parse(args)
 	if err != nil {
@@ -70,10 +67,7 @@ func doDoltConstraintsVerify(ctx *sql.Context, args []string) (int, error) {
 			return 1, err
 		}
 	} else {
-		comparingRoot, err = headCommit.GetRootValue(ctx)
-		if err != nil {
-			return 1, err
-		}
+		comparingRoot = roots.Head
 	}
 
 	tableSet, err := parseTablesToCheck(ctx, workingRoot, apr)
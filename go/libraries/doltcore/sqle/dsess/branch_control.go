@@ -18,6 +18,7 @@ import (
 	"context"
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 )
 
 // CheckAccessForDb checks whether the current user has the given permissions for the given database.
@@ -57,3 +58,98 @@ func CheckAccessForDb(ctx context.Context, db SqlDatabase, flags branch_control.
 	}
 	return branch_control.ErrIncorrectPermissions.New(user, host, branch)
 }
+
+// CheckTableAccessForDb checks whether the current user may write to the given table in the given database, according
+// to the "dolt_table_control" table. Returns the predicates that written rows must satisfy (any one of them), which is
+// nil when all rows may be written. Users with admin permissions on the branch are never restricted.
+func CheckTableAccessForDb(ctx context.Context, db SqlDatabase, tableName string) ([]string, error) {
+	branchAwareSession := branch_control.GetBranchAwareSession(ctx)
+	// A nil session means we're not in the SQL context, so we allow all operations
+	if branchAwareSession == nil {
+		return nil, nil
+	}
+
+	controller := branchAwareSession.GetController()
+	// Any context that has a non-nil session should always have a non-nil controller, so this is an error
+	if controller == nil {
+		return nil, branch_control.ErrMissingController.New()
+	}
+
+	controller.Access.RWMutex.RLock()
+	defer controller.Access.RWMutex.RUnlock()
+
+	user := branchAwareSession.GetUser()
+	host := branchAwareSession.GetHost()
+
+	if db.RevisionType() != RevisionTypeBranch {
+		// not a branch db, no check necessary
+		return nil, nil
+	}
+
+	dbName, branch := SplitRevisionDbName(db.RevisionQualifiedName())
+
+	if _, perms := controller.Access.Match(dbName, branch, user, host); perms&branch_control.Permissions_Admin == branch_control.Permissions_Admin {
+		return nil, nil
+	}
+	restricted, perms, predicates := controller.TableControl.Match(dbName, branch, user, host, tableName)
+	if !restricted {
+		return nil, nil
+	}
+	if perms&branch_control.Permissions_Write != branch_control.Permissions_Write {
+		return nil, branch_control.ErrTableAccess.New(user, host, tableName, branch)
+	}
+	return predicates, nil
+}
+
+// CheckUnrestrictedTableAccessForDb checks whether the current user may write every row of the given table in the given
+// database, according to the "dolt_table_control" table. Statements which change a table as a whole, such as schema
+// changes and dropping or renaming the table, require this.
+func CheckUnrestrictedTableAccessForDb(ctx context.Context, db SqlDatabase, tableName string) error {
+	predicates, err := CheckTableAccessForDb(ctx, db, tableName)
+	if err != nil || len(predicates) == 0 {
+		return err
+	}
+	var user, host string
+	if branchAwareSession := branch_control.GetBranchAwareSession(ctx); branchAwareSession != nil {
+		user, host = branchAwareSession.GetUser(), branchAwareSession.GetHost()
+	}
+	_, branch := SplitRevisionDbName(db.RevisionQualifiedName())
+	return branch_control.ErrTableRestrictedAccess.New(user, host, tableName, branch)
+}
+
+// CheckUnrestrictedTableChanges checks whether the current user may write every row of each table that differs between
+// |from| and |to|, according to the "dolt_table_control" table. Procedures which replace a working or staged root as a
+// whole, such as merges, resets, and checkouts of tables, require this before setting the new root.
+func CheckUnrestrictedTableChanges(ctx context.Context, from, to doltdb.RootValue) error {
+	fromHash, err := from.HashOf()
+	if err != nil {
+		return err
+	}
+	toHash, err := to.HashOf()
+	if err != nil {
+		return err
+	}
+	if fromHash == toHash {
+		return nil
+	}
+
+	tableNames, err := doltdb.UnionTableNames(ctx, from, to)
+	if err != nil {
+		return err
+	}
+	var changed []string
+	for _, tableName := range tableNames {
+		fromTableHash, _, err := from.GetTableHash(ctx, tableName)
+		if err != nil {
+			return err
+		}
+		toTableHash, _, err := to.GetTableHash(ctx, tableName)
+		if err != nil {
+			return err
+		}
+		if fromTableHash != toTableHash {
+			changed = append(changed, tableName.Name)
+		}
+	}
+	return branch_control.CheckUnrestrictedTableAccess(ctx, changed...)
+}
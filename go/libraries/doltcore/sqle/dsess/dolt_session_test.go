@@ -17,6 +17,7 @@ package dsess
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	_ "github.com/dolthub/go-mysql-server/sql/variables"
@@ -307,10 +308,14 @@ func (e emptyRevisionDatabaseProvider) FileSystemForDatabase(dbname string) (fil
 	return nil, nil
 }
 
-func (e emptyRevisionDatabaseProvider) CloneDatabaseFromRemote(ctx *sql.Context, dbName, branch, remoteName, remoteUrl string, depth int, remoteParams map[string]string) error {
+func (e emptyRevisionDatabaseProvider) CloneDatabaseFromRemote(ctx *sql.Context, dbName, branch, remoteName, remoteUrl string, depth int, tables []string, remoteParams map[string]string) error {
 	return nil
 }
 
+func (e emptyRevisionDatabaseProvider) RestoreDatabaseAsOf(ctx *sql.Context, srcDb *doltdb.DoltDB, dbName string, asOf time.Time) (time.Time, error) {
+	return time.Time{}, nil
+}
+
 func (e emptyRevisionDatabaseProvider) CreateDatabase(ctx *sql.Context, dbName string) error {
 	return nil
 }
@@ -318,3 +323,7 @@ func (e emptyRevisionDatabaseProvider) CreateDatabase(ctx *sql.Context, dbName s
 func (e emptyRevisionDatabaseProvider) RevisionDbState(_ *sql.Context, revDB string) (InitialDbState, error) {
 	return InitialDbState{}, sql.ErrDatabaseNotFound.New(revDB)
 }
+
+func (e emptyRevisionDatabaseProvider) QueryRunner() QueryRunner {
+	return nil
+}
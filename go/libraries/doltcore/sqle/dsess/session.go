@@ -724,6 +724,30 @@ func (d *DoltSession) NewPendingCommit(
 	return d.newPendingCommit(ctx, branchState, roots, props)
 }
 
+// NewPendingMergeCommit returns a new |doltdb.PendingCommit| for the database named, using the roots given, with
+// |mergeParents| recorded as parents of the commit after the current HEAD. This is used for merges whose parents
+// can't be described by the merge state of the working set, such as merges of more than one commit at once.
+func (d *DoltSession) NewPendingMergeCommit(
+	ctx *sql.Context,
+	dbName string,
+	roots doltdb.Roots,
+	mergeParents []*doltdb.Commit,
+	props actions.CommitStagedProps,
+) (*doltdb.PendingCommit, error) {
+	branchState, ok, err := d.lookupDbState(ctx, dbName)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("session state for database %s not found", dbName)
+	}
+	if branchState.WorkingSet() == nil {
+		return nil, doltdb.ErrOperationNotSupportedInDetachedHead
+	}
+
+	return actions.GetCommitStaged(ctx, roots, branchState.WorkingSet(), mergeParents, branchState.dbData.Ddb, props)
+}
+
 // newPendingCommit returns a new |doltdb.PendingCommit| for the database and head named by |branchState|
 // See NewPendingCommit
 func (d *DoltSession) newPendingCommit(ctx *sql.Context, branchState *branchState, roots doltdb.Roots, props actions.CommitStagedProps) (*doltdb.PendingCommit, error) {
@@ -1265,6 +1289,16 @@ func (d *DoltSession) WorkingSet(ctx *sql.Context, dbName string) (*doltdb.Worki
 	return sessionState.WorkingSet(), nil
 }
 
+// SparseCheckout returns the sparse checkout of the working set of the database named, or nil if every table is checked
+// out or the database has no working set.
+func (d *DoltSession) SparseCheckout(ctx *sql.Context, dbName string) (*doltdb.SparseCheckout, error) {
+	sessionState, ok, err := d.LookupDbState(ctx, dbName)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return sessionState.WorkingSet().SparseCheckout()
+}
+
 // GetHeadCommit returns the parent commit of the current session.
 func (d *DoltSession) GetHeadCommit(ctx *sql.Context, dbName string) (*doltdb.Commit, error) {
 	branchState, ok, err := d.lookupDbState(ctx, dbName)
@@ -16,6 +16,7 @@ package dsess
 
 import (
 	"context"
+	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
 
@@ -88,7 +89,12 @@ type DoltDatabaseProvider interface {
 	// dbName is the name for the new database, branch is an optional parameter indicating which branch to clone
 	// (otherwise all branches are cloned), remoteName is the name for the remote created in the new database, and
 	// remoteUrl is a URL (e.g. "file:///dbs/db1") or an <org>/<database> path indicating a database hosted on DoltHub.
-	CloneDatabaseFromRemote(ctx *sql.Context, dbName, branch, remoteName, remoteUrl string, depth int, remoteParams map[string]string) error
+	// When tables is non-empty, a partial clone is performed which only fetches the data of those tables.
+	CloneDatabaseFromRemote(ctx *sql.Context, dbName, branch, remoteName, remoteUrl string, depth int, tables []string, remoteParams map[string]string) error
+	// RestoreDatabaseAsOf creates the new database |dbName| with every branch, tag, and working set of |srcDb| as they
+	// were at the last root update written to its chunk journal at or before |asOf|, and returns the time of that
+	// update.
+	RestoreDatabaseAsOf(ctx *sql.Context, srcDb *doltdb.DoltDB, dbName string, asOf time.Time) (time.Time, error)
 	// SessionDatabase returns the SessionDatabase for the specified database, which may name a revision of a base
 	// database.
 	SessionDatabase(ctx *sql.Context, dbName string) (SqlDatabase, bool, error)
@@ -111,8 +117,15 @@ type DoltDatabaseProvider interface {
 	// PurgeDroppedDatabases permanently deletes any dropped databases that are being held in temporary storage
 	// in case they need to be restored. This operation is not reversible, so use with caution!
 	PurgeDroppedDatabases(ctx *sql.Context) error
+	// QueryRunner returns the function stored procedures run queries with, or nil if the engine serving this provider
+	// hasn't set one.
+	QueryRunner() QueryRunner
 }
 
+// QueryRunner runs |query| in the session of |ctx|. Stored procedures can't run queries on their own, so the engine
+// serving a provider gives them one.
+type QueryRunner func(ctx *sql.Context, query string) (sql.Schema, sql.RowIter, *sql.QueryFlags, error)
+
 type SessionDatabaseBranchSpec struct {
 	RepoState env.RepoStateReadWriter
 	Branch    string
@@ -0,0 +1,101 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dsess
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/expranalysis"
+)
+
+// tableControlWriter is a TableWriter that only allows writing rows that satisfy at least one of the predicates granted
+// to the current user by the "dolt_table_control" table. For updates, both the old and new rows must satisfy a predicate.
+type tableControlWriter struct {
+	TableWriter
+	tableName  string
+	branch     string
+	predicates []sql.Expression
+}
+
+var _ TableWriter = tableControlWriter{}
+
+// NewTableControlWriter returns a TableWriter that wraps the given writer, rejecting any row that does not satisfy at
+// least one of the given predicates. The predicates are resolved against the given schema.
+func NewTableControlWriter(ctx *sql.Context, tw TableWriter, db SqlDatabase, tableName string, sch schema.Schema, predicates []string) (TableWriter, error) {
+	exprs := make([]sql.Expression, len(predicates))
+	for i, predicate := range predicates {
+		expr, err := expranalysis.ResolvePredicateExpression(ctx, tableName, sch, predicate)
+		if err != nil {
+			return nil, fmt.Errorf("unable to resolve the predicate `%s` for table `%s`: %w", predicate, tableName, err)
+		}
+		exprs[i] = expr
+	}
+	_, branch := SplitRevisionDbName(db.RevisionQualifiedName())
+	return tableControlWriter{
+		TableWriter: tw,
+		tableName:   tableName,
+		branch:      branch,
+		predicates:  exprs,
+	}, nil
+}
+
+// Insert implements the interface sql.RowInserter.
+func (w tableControlWriter) Insert(ctx *sql.Context, row sql.Row) error {
+	if err := w.checkRow(ctx, row); err != nil {
+		return err
+	}
+	return w.TableWriter.Insert(ctx, row)
+}
+
+// Update implements the interface sql.RowUpdater.
+func (w tableControlWriter) Update(ctx *sql.Context, old sql.Row, new sql.Row) error {
+	if err := w.checkRow(ctx, old); err != nil {
+		return err
+	}
+	if err := w.checkRow(ctx, new); err != nil {
+		return err
+	}
+	return w.TableWriter.Update(ctx, old, new)
+}
+
+// Delete implements the interface sql.RowDeleter.
+func (w tableControlWriter) Delete(ctx *sql.Context, row sql.Row) error {
+	if err := w.checkRow(ctx, row); err != nil {
+		return err
+	}
+	return w.TableWriter.Delete(ctx, row)
+}
+
+// checkRow returns an error if the given row does not satisfy any of the writer's predicates.
+func (w tableControlWriter) checkRow(ctx *sql.Context, row sql.Row) error {
+	for _, predicate := range w.predicates {
+		res, err := sql.EvaluateCondition(ctx, predicate, row)
+		if err != nil {
+			return err
+		}
+		if sql.IsTrue(res) {
+			return nil
+		}
+	}
+	var user, host string
+	if branchAwareSession := branch_control.GetBranchAwareSession(ctx); branchAwareSession != nil {
+		user, host = branchAwareSession.GetUser(), branchAwareSession.GetHost()
+	}
+	return branch_control.ErrTableRowAccess.New(user, host, sql.FormatRow(row), w.tableName, w.branch)
+}
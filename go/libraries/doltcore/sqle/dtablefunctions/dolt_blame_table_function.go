@@ -0,0 +1,329 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtablefunctions
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dtables"
+)
+
+const blameDefaultRowCount = 1000
+
+var _ sql.TableFunction = (*BlameTableFunction)(nil)
+var _ sql.ExecSourceRel = (*BlameTableFunction)(nil)
+var _ sql.AuthorizationCheckerNode = (*BlameTableFunction)(nil)
+
+// BlameTableFunction implements the dolt_blame table function, which reports, for every cell of a table, the commit
+// that last changed it. Rows are identified by their primary key, or by their row hash for keyless tables.
+type BlameTableFunction struct {
+	ctx *sql.Context
+
+	// commitExpr is the optional first expression, naming the revision to start blaming from
+	// dolt_blame('HEAD~1', 'table_name')
+	commitExpr sql.Expression
+
+	// tableNameExpr is the expression naming the table to blame
+	// dolt_blame('table_name') -> dolt_blame('my_table')
+	tableNameExpr sql.Expression
+
+	database sql.Database
+	sqlSch   sql.Schema
+}
+
+// NewInstance creates a new instance of TableFunction interface
+func (btf *BlameTableFunction) NewInstance(ctx *sql.Context, db sql.Database, expressions []sql.Expression) (sql.Node, error) {
+	newInstance := &BlameTableFunction{
+		ctx:      ctx,
+		database: db,
+	}
+
+	node, err := newInstance.WithExpressions(expressions...)
+	if err != nil {
+		return nil, err
+	}
+
+	return node, nil
+}
+
+func (btf *BlameTableFunction) DataLength(ctx *sql.Context) (uint64, error) {
+	numBytesPerRow := schema.SchemaAvgLength(btf.Schema())
+	numRows, _, err := btf.RowCount(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return numBytesPerRow * numRows, nil
+}
+
+func (btf *BlameTableFunction) RowCount(_ *sql.Context) (uint64, bool, error) {
+	return blameDefaultRowCount, false, nil
+}
+
+// Database implements the sql.Databaser interface
+func (btf *BlameTableFunction) Database() sql.Database {
+	return btf.database
+}
+
+// WithDatabase implements the sql.Databaser interface
+func (btf *BlameTableFunction) WithDatabase(database sql.Database) (sql.Node, error) {
+	nbtf := *btf
+	nbtf.database = database
+	return &nbtf, nil
+}
+
+// Name implements the sql.TableFunction interface
+func (btf *BlameTableFunction) Name() string {
+	return "dolt_blame"
+}
+
+// Resolved implements the sql.Resolvable interface
+func (btf *BlameTableFunction) Resolved() bool {
+	if btf.commitExpr != nil {
+		return btf.commitExpr.Resolved() && btf.tableNameExpr.Resolved()
+	}
+	return btf.tableNameExpr.Resolved()
+}
+
+func (btf *BlameTableFunction) IsReadOnly() bool {
+	return true
+}
+
+// String implements the Stringer interface
+func (btf *BlameTableFunction) String() string {
+	if btf.commitExpr != nil {
+		return fmt.Sprintf("DOLT_BLAME(%s, %s)", btf.commitExpr.String(), btf.tableNameExpr.String())
+	}
+	return fmt.Sprintf("DOLT_BLAME(%s)", btf.tableNameExpr.String())
+}
+
+// Schema implements the sql.Node interface.
+func (btf *BlameTableFunction) Schema() sql.Schema {
+	if !btf.Resolved() {
+		return nil
+	}
+
+	if btf.sqlSch == nil {
+		panic("schema hasn't been generated yet")
+	}
+
+	return btf.sqlSch
+}
+
+// Children implements the sql.Node interface.
+func (btf *BlameTableFunction) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (btf *BlameTableFunction) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("unexpected children")
+	}
+	return btf, nil
+}
+
+// CheckAuth implements the interface sql.AuthorizationCheckerNode.
+func (btf *BlameTableFunction) CheckAuth(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	_, tableName, err := btf.evaluateArguments()
+	if err != nil {
+		return ExpressionIsDeferred(btf.tableNameExpr)
+	}
+
+	subject := sql.PrivilegeCheckSubject{Database: btf.database.Name(), Table: tableName}
+	return opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(subject, sql.PrivilegeType_Select))
+}
+
+// Expressions implements the sql.Expressioner interface.
+func (btf *BlameTableFunction) Expressions() []sql.Expression {
+	if btf.commitExpr != nil {
+		return []sql.Expression{btf.commitExpr, btf.tableNameExpr}
+	}
+	return []sql.Expression{btf.tableNameExpr}
+}
+
+// WithExpressions implements the sql.Expressioner interface.
+func (btf *BlameTableFunction) WithExpressions(expressions ...sql.Expression) (sql.Node, error) {
+	if len(expressions) < 1 || len(expressions) > 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New(btf.Name(), "1 to 2", len(expressions))
+	}
+
+	// The schema of the result depends on the table being blamed, so only literal arguments are supported
+	for _, expr := range expressions {
+		if !expr.Resolved() {
+			return nil, ErrInvalidNonLiteralArgument.New(btf.Name(), expr.String())
+		}
+		// prepared statements resolve functions beforehand, so above check fails
+		if _, ok := expr.(sql.FunctionExpression); ok {
+			return nil, ErrInvalidNonLiteralArgument.New(btf.Name(), expr.String())
+		}
+	}
+
+	newBtf := *btf
+	if len(expressions) == 2 {
+		newBtf.commitExpr = expressions[0]
+		newBtf.tableNameExpr = expressions[1]
+	} else {
+		newBtf.tableNameExpr = expressions[0]
+	}
+
+	commit, tableName, err := newBtf.evaluateArguments()
+	if err != nil {
+		return nil, err
+	}
+
+	err = newBtf.generateSchema(newBtf.ctx, commit, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newBtf, nil
+}
+
+// evaluateArguments returns the revision and table name given to this function. The revision defaults to HEAD.
+func (btf *BlameTableFunction) evaluateArguments() (string, string, error) {
+	commit := "HEAD"
+	if btf.commitExpr != nil {
+		if !types.IsText(btf.commitExpr.Type()) {
+			return "", "", sql.ErrInvalidArgumentDetails.New(btf.Name(), btf.commitExpr.String())
+		}
+		commitVal, err := btf.commitExpr.Eval(btf.ctx, nil)
+		if err != nil {
+			return "", "", err
+		}
+		var ok bool
+		commit, ok = commitVal.(string)
+		if !ok {
+			return "", "", fmt.Errorf("received '%v' when expecting commit hash string", commitVal)
+		}
+	}
+
+	if !types.IsText(btf.tableNameExpr.Type()) {
+		return "", "", sql.ErrInvalidArgumentDetails.New(btf.Name(), btf.tableNameExpr.String())
+	}
+	tableNameVal, err := btf.tableNameExpr.Eval(btf.ctx, nil)
+	if err != nil {
+		return "", "", err
+	}
+	tableName, ok := tableNameVal.(string)
+	if !ok {
+		return "", "", ErrInvalidTableName.New(btf.tableNameExpr.String())
+	}
+
+	return commit, tableName, nil
+}
+
+// generateSchema builds the result schema: the primary key columns of the blamed table (or the row hash for keyless
+// tables), followed by the name of the column and the details of the commit that last changed it.
+func (btf *BlameTableFunction) generateSchema(ctx *sql.Context, commit, tableName string) error {
+	if !btf.Resolved() {
+		return nil
+	}
+
+	cm, err := btf.resolveStartCommit(ctx, commit)
+	if err != nil {
+		return err
+	}
+
+	_, sch, err := dtables.BlameTableAtCommit(ctx, cm, tableName)
+	if err != nil {
+		return err
+	}
+	if sch == nil {
+		return sql.ErrTableNotFound.New(tableName)
+	}
+
+	var sqlSch sql.Schema
+	if schema.IsKeyless(sch) {
+		sqlSch = append(sqlSch, &sql.Column{Name: dtables.BlameRowHashColumn, Type: types.Text})
+	} else {
+		for _, col := range sch.GetPKCols().GetColumns() {
+			sqlSch = append(sqlSch, &sql.Column{Name: col.Name, Type: col.TypeInfo.ToSqlType()})
+		}
+	}
+	btf.sqlSch = append(sqlSch,
+		&sql.Column{Name: "column_name", Type: types.Text},
+		&sql.Column{Name: "commit", Type: types.Text},
+		&sql.Column{Name: "commit_date", Type: types.DatetimeMaxPrecision},
+		&sql.Column{Name: "committer", Type: types.Text},
+		&sql.Column{Name: "email", Type: types.Text},
+		&sql.Column{Name: "message", Type: types.Text},
+	)
+
+	return nil
+}
+
+func (btf *BlameTableFunction) resolveStartCommit(ctx *sql.Context, commit string) (*doltdb.Commit, error) {
+	sqledb, ok := btf.database.(dsess.SqlDatabase)
+	if !ok {
+		return nil, fmt.Errorf("unexpected database type: %T", btf.database)
+	}
+
+	sess := dsess.DSessFromSess(ctx.Session)
+	headRef, err := sess.CWBHeadRef(ctx, sqledb.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	return resolveCommit(ctx, sqledb.DbData().Ddb, headRef, commit)
+}
+
+// RowIter implements the sql.Node interface
+func (btf *BlameTableFunction) RowIter(ctx *sql.Context, _ sql.Row) (sql.RowIter, error) {
+	commit, tableName, err := btf.evaluateArguments()
+	if err != nil {
+		return nil, err
+	}
+
+	cm, err := btf.resolveStartCommit(ctx, commit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &blameTableFunctionRowIter{start: cm, tableName: tableName}, nil
+}
+
+// blameTableFunctionRowIter starts blaming the table on the first call to Next, so that callers only interested in the
+// result schema don't pay for walking the history of the table.
+type blameTableFunctionRowIter struct {
+	start     *doltdb.Commit
+	tableName string
+	cells     sql.RowIter
+}
+
+var _ sql.RowIter = (*blameTableFunctionRowIter)(nil)
+
+func (itr *blameTableFunctionRowIter) Next(ctx *sql.Context) (sql.Row, error) {
+	if itr.cells == nil {
+		var err error
+		itr.cells, err = dtables.BlameCells(ctx, itr.start, itr.tableName)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return itr.cells.Next(ctx)
+}
+
+func (itr *blameTableFunctionRowIter) Close(ctx *sql.Context) error {
+	if itr.cells == nil {
+		return nil
+	}
+	return itr.cells.Close(ctx)
+}
@@ -301,6 +301,13 @@ func (ds *DiffSummaryTableFunction) RowIter(ctx *sql.Context, row sql.Row) (sql.
 		return NewDiffSummaryTableFunctionRowIter(summs), nil
 	}
 
+	// Tables outside of a sparse checkout are only summarized when asked for by name
+	sparse, err := dsess.DSessFromSess(ctx.Session).SparseCheckout(ctx, sqledb.RevisionQualifiedName())
+	if err != nil {
+		return nil, err
+	}
+	deltas = diff.FilterSparseTableDeltas(sparse, deltas)
+
 	var diffSummaries []*diff.TableDeltaSummary
 	for _, delta := range deltas {
 		summ, err := getSummaryForDelta(ctx, delta, sqledb, fromDetails, toDetails, false)
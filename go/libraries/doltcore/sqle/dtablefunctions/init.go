@@ -25,4 +25,5 @@ var DoltTableFunctions = []sql.TableFunction{
 	&SchemaDiffTableFunction{},
 	&ReflogTableFunction{},
 	&QueryDiffTableFunction{},
+	&BlameTableFunction{},
 }
@@ -0,0 +1,527 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"bytes"
+	"container/heap"
+	"context"
+	"encoding/hex"
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/resolve"
+	"github.com/dolthub/dolt/go/store/hash"
+	"github.com/dolthub/dolt/go/store/prolly"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/val"
+)
+
+// blameBatchSize is the number of rows blamed at a time. The history of the table is walked once for each batch, so
+// that the memory used to blame a table does not grow with the size of the table.
+const blameBatchSize = 4096
+
+// BlameCells returns an iterator of a row for every cell of the named table as of the commit given, ordered by row and
+// then by column. Each row holds the primary key of the cell's row, or its row hash for keyless tables, followed by the
+// name of the cell's column and the hash, date, committer, email and message of the commit that last changed the cell.
+// Cells are blamed in batches of rows as the iterator is read.
+func BlameCells(ctx *sql.Context, start *doltdb.Commit, tableName string) (sql.RowIter, error) {
+	tbl, sch, err := BlameTableAtCommit(ctx, start, tableName)
+	if err != nil {
+		return nil, err
+	}
+	if tbl == nil {
+		return nil, sql.ErrTableNotFound.New(tableName)
+	}
+
+	rows, err := blameRowData(ctx, tbl)
+	if err != nil {
+		return nil, err
+	}
+	iter, err := rows.IterAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	itr := &blameCellIter{
+		start:     start,
+		tableName: tableName,
+		keyless:   schema.IsKeyless(sch),
+		ns:        rows.NodeStore(),
+		rows:      iter,
+		commits:   make(map[hash.Hash]*blameCommit),
+	}
+	itr.keyDesc, _ = rows.Descriptors()
+	for _, col := range sch.GetAllCols().GetColumns() {
+		if col.Virtual {
+			continue
+		}
+		bc := blameColumn{col: col, keyIdx: -1}
+		if col.IsPartOfPK {
+			bc.keyIdx = sch.GetPKCols().TagToIdx[col.Tag]
+		}
+		itr.cols = append(itr.cols, bc)
+	}
+
+	if err = itr.nextKey(ctx); err != nil {
+		return nil, err
+	}
+	return itr, nil
+}
+
+// blameCommit holds the details of a commit that changed a cell
+type blameCommit struct {
+	row sql.Row
+}
+
+// blameColumn is a column being blamed, along with where its values are stored in rows of the blamed table.
+type blameColumn struct {
+	col schema.Column
+	// keyIdx is the position of the column in the key tuple, or -1 if the column is stored in the value tuple
+	keyIdx int
+}
+
+// blameRow tracks the commits blamed for each cell of a single row
+type blameRow struct {
+	key     val.Tuple
+	commits []*blameCommit
+}
+
+// blameCells holds the cells which have not been blamed yet, as the positions of their columns by the key of their row.
+type blameCells map[string][]int
+
+// blameCellIter blames the cells of a table in batches of rows, walking the history of the table for each batch.
+type blameCellIter struct {
+	start     *doltdb.Commit
+	tableName string
+	keyless   bool
+	keyDesc   val.TupleDesc
+	ns        tree.NodeStore
+	cols      []blameColumn
+
+	// rows iterates the rows of the table as of |start|, and next is the first key of the next batch, or nil once
+	// every row has been read
+	rows prolly.MapIter
+	next val.Tuple
+
+	// commits caches the details of each commit cells have been blamed on
+	commits map[hash.Hash]*blameCommit
+
+	batch []sql.Row
+	idx   int
+}
+
+var _ sql.RowIter = (*blameCellIter)(nil)
+
+// Next implements sql.RowIter
+func (itr *blameCellIter) Next(ctx *sql.Context) (sql.Row, error) {
+	for itr.idx >= len(itr.batch) {
+		if itr.next == nil {
+			return nil, io.EOF
+		}
+		if err := itr.blameBatch(ctx); err != nil {
+			return nil, err
+		}
+	}
+	row := itr.batch[itr.idx]
+	itr.idx++
+	return row, nil
+}
+
+// Close implements sql.RowIter
+func (itr *blameCellIter) Close(*sql.Context) error {
+	return nil
+}
+
+func (itr *blameCellIter) nextKey(ctx *sql.Context) error {
+	k, _, err := itr.rows.Next(ctx)
+	if err == io.EOF {
+		itr.next = nil
+		return nil
+	}
+	itr.next = k
+	return err
+}
+
+// blameBatch blames the cells of the next batch of rows, and makes their sql rows the current batch.
+func (itr *blameCellIter) blameBatch(ctx *sql.Context) error {
+	startKey := itr.next
+	var rows []*blameRow
+	cells := make(blameCells)
+	for len(rows) < blameBatchSize && itr.next != nil {
+		row := &blameRow{key: itr.next, commits: make([]*blameCommit, len(itr.cols))}
+		rows = append(rows, row)
+		cols := make([]int, len(itr.cols))
+		for i := range cols {
+			cols[i] = i
+		}
+		cells[string(row.key)] = cols
+		if err := itr.nextKey(ctx); err != nil {
+			return err
+		}
+	}
+
+	byKey := make(map[string]*blameRow, len(rows))
+	for _, row := range rows {
+		byKey[string(row.key)] = row
+	}
+	err := itr.blameHistory(ctx, cells, startKey, itr.next, func(blamed *blameCommit, cells blameCells) {
+		for k, cols := range cells {
+			for _, i := range cols {
+				byKey[k].commits[i] = blamed
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	itr.batch, itr.idx = itr.batch[:0], 0
+	for _, row := range rows {
+		if err = itr.appendSqlRows(ctx, row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// blameHistory attributes |cells|, whose rows have keys between |startKey| inclusive and |stopKey| exclusive, to the
+// commits that last changed them, calling |blame| with the cells blamed on each commit. Like the dolt_blame_<table>
+// view, every parent of a commit is considered. A cell is blamed on a commit when its value differs from every
+// parent's, because its row or column was added or changed in that commit, or because the table was created, renamed
+// or had its primary key changed. Otherwise its blame is passed on to the first parent holding the same value. Rows of
+// keyless tables are identified by their content, so every cell of a keyless row is blamed on the commit that added
+// that row or changed how many copies of it the table holds.
+func (itr *blameCellIter) blameHistory(ctx *sql.Context, cells blameCells, startKey, stopKey val.Tuple, blame func(*blameCommit, blameCells)) error {
+	// commits are visited from the highest to the lowest, so that the cells passed on to a commit by each of its
+	// children are collected before it is visited
+	queue := &blameQueue{queued: make(map[hash.Hash]*blameQueueItem)}
+	if err := queue.add(itr.start, cells); err != nil {
+		return err
+	}
+
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(*blameQueueItem)
+		delete(queue.queued, item.hash)
+
+		tbl, sch, err := BlameTableAtCommit(ctx, item.cm, itr.tableName)
+		if err != nil {
+			return err
+		}
+
+		remaining := item.cells
+		for i := 0; i < item.cm.NumParents() && len(remaining) > 0; i++ {
+			optCmt, err := item.cm.GetParent(ctx, i)
+			if err != nil {
+				return err
+			}
+			parent, ok := optCmt.ToCommit()
+			if !ok {
+				return doltdb.ErrGhostCommitEncountered
+			}
+
+			parentTbl, parentSch, err := BlameTableAtCommit(ctx, parent, itr.tableName)
+			if err != nil {
+				return err
+			}
+			if parentTbl == nil || schema.IsKeyless(parentSch) != itr.keyless ||
+				!schema.ArePrimaryKeySetsDiffable(tbl.Format(), parentSch, sch) {
+				continue
+			}
+
+			unchanged, err := itr.splitChanges(ctx, remaining, parentTbl, parentSch, tbl, sch, startKey, stopKey)
+			if err != nil {
+				return err
+			}
+			if len(unchanged) > 0 {
+				if err = queue.add(parent, unchanged); err != nil {
+					return err
+				}
+			}
+		}
+
+		if len(remaining) > 0 {
+			blamed, err := itr.blameCommit(ctx, item.cm, item.hash)
+			if err != nil {
+				return err
+			}
+			blame(blamed, remaining)
+		}
+	}
+
+	return nil
+}
+
+// splitChanges removes the cells that are the same in the parent and child tables given from |cells|, and returns
+// them. The cells left in |cells| were changed in the child.
+func (itr *blameCellIter) splitChanges(ctx *sql.Context, cells blameCells, parentTbl *doltdb.Table, parentSch schema.Schema, childTbl *doltdb.Table, childSch schema.Schema, startKey, stopKey val.Tuple) (blameCells, error) {
+	unchanged := make(blameCells)
+	parentHash, err := parentTbl.HashOf()
+	if err != nil {
+		return nil, err
+	}
+	childHash, err := childTbl.HashOf()
+	if err != nil {
+		return nil, err
+	}
+	if parentHash == childHash {
+		for k, cols := range cells {
+			unchanged[k] = cols
+			delete(cells, k)
+		}
+		return unchanged, nil
+	}
+
+	// For each blamed column, find where its value lives in the parent and child value tuples. Columns that are new
+	// or have a new type in the child were changed in every row.
+	parentIdx := make([]int, len(itr.cols))
+	childIdx := make([]int, len(itr.cols))
+	for i, bc := range itr.cols {
+		if bc.keyIdx >= 0 {
+			continue
+		}
+		parentCol, ok := parentSch.GetAllCols().GetByTag(bc.col.Tag)
+		childCol, _ := childSch.GetAllCols().GetByTag(bc.col.Tag)
+		if !ok || parentCol.Virtual || !parentCol.TypeInfo.Equals(childCol.TypeInfo) {
+			parentIdx[i] = -1
+			continue
+		}
+		parentIdx[i] = itr.valueIdx(parentSch, bc.col.Tag)
+		childIdx[i] = itr.valueIdx(childSch, bc.col.Tag)
+	}
+
+	// Cells are assumed unchanged until the diff shows otherwise
+	changed := make(blameCells)
+	for k, cols := range cells {
+		for _, i := range cols {
+			if parentIdx[i] < 0 {
+				changed[k] = append(changed[k], i)
+			} else {
+				unchanged[k] = append(unchanged[k], i)
+			}
+		}
+		delete(cells, k)
+	}
+
+	parentRows, err := blameRowData(ctx, parentTbl)
+	if err != nil {
+		return nil, err
+	}
+	childRows, err := blameRowData(ctx, childTbl)
+	if err != nil {
+		return nil, err
+	}
+
+	err = prolly.DiffMapsKeyRange(ctx, parentRows, childRows, startKey, stopKey, func(ctx context.Context, d tree.Diff) error {
+		cols, ok := unchanged[string(d.Key)]
+		if !ok {
+			return nil
+		}
+
+		var same []int
+		if d.Type == tree.ModifiedDiff && !itr.keyless {
+			from, to := val.Tuple(d.From), val.Tuple(d.To)
+			for _, i := range cols {
+				if itr.cols[i].keyIdx >= 0 || bytes.Equal(from.GetField(parentIdx[i]), to.GetField(childIdx[i])) {
+					same = append(same, i)
+				} else {
+					changed[string(d.Key)] = append(changed[string(d.Key)], i)
+				}
+			}
+		} else if d.Type == tree.AddedDiff || d.Type == tree.ModifiedDiff {
+			changed[string(d.Key)] = append(changed[string(d.Key)], cols...)
+		} else {
+			return nil
+		}
+
+		if len(same) > 0 {
+			unchanged[string(d.Key)] = same
+		} else {
+			delete(unchanged, string(d.Key))
+		}
+		if len(unchanged) == 0 {
+			return io.EOF
+		}
+		return nil
+	})
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	for k, cols := range changed {
+		cells[k] = cols
+	}
+	return unchanged, nil
+}
+
+// valueIdx returns the position of the column with the tag given in the value tuples of a table with the schema given.
+func (itr *blameCellIter) valueIdx(sch schema.Schema, tag uint64) int {
+	idx := 0
+	if itr.keyless {
+		// the first field of a keyless value tuple is the row's cardinality
+		idx++
+	}
+	for _, col := range sch.GetNonPKCols().GetColumns() {
+		if col.Tag == tag {
+			return idx
+		}
+		if !col.Virtual {
+			idx++
+		}
+	}
+	return -1
+}
+
+// blameCommit returns the details of the commit given, which has the hash given.
+func (itr *blameCellIter) blameCommit(ctx *sql.Context, cm *doltdb.Commit, h hash.Hash) (*blameCommit, error) {
+	if blamed, ok := itr.commits[h]; ok {
+		return blamed, nil
+	}
+	blamed, err := newBlameCommit(ctx, cm)
+	if err != nil {
+		return nil, err
+	}
+	itr.commits[h] = blamed
+	return blamed, nil
+}
+
+// appendSqlRows appends a row for every cell of the blamed row given to the current batch, ordered by column.
+func (itr *blameCellIter) appendSqlRows(ctx *sql.Context, row *blameRow) error {
+	var key sql.Row
+	if itr.keyless {
+		key = sql.Row{hex.EncodeToString(row.key.GetField(0))}
+	} else {
+		for i := 0; i < itr.keyDesc.Count(); i++ {
+			v, err := tree.GetField(ctx, itr.keyDesc, i, row.key, itr.ns)
+			if err != nil {
+				return err
+			}
+			key = append(key, v)
+		}
+	}
+
+	for i, bc := range itr.cols {
+		r := make(sql.Row, 0, len(key)+1+len(row.commits[i].row))
+		r = append(r, key...)
+		r = append(r, bc.col.Name)
+		r = append(r, row.commits[i].row...)
+		itr.batch = append(itr.batch, r)
+	}
+	return nil
+}
+
+// blameQueueItem is a commit waiting to have the cells passed on to it by its children blamed.
+type blameQueueItem struct {
+	cm     *doltdb.Commit
+	hash   hash.Hash
+	height uint64
+	cells  blameCells
+}
+
+// blameQueue is a heap of commits, ordered from the highest to the lowest.
+type blameQueue struct {
+	items  []*blameQueueItem
+	queued map[hash.Hash]*blameQueueItem
+}
+
+// add queues |cm| with |cells|, or adds |cells| to it if it is already queued.
+func (q *blameQueue) add(cm *doltdb.Commit, cells blameCells) error {
+	h, err := cm.HashOf()
+	if err != nil {
+		return err
+	}
+	if item, ok := q.queued[h]; ok {
+		for k, cols := range cells {
+			item.cells[k] = append(item.cells[k], cols...)
+		}
+		return nil
+	}
+
+	height, err := cm.Height()
+	if err != nil {
+		return err
+	}
+	item := &blameQueueItem{cm: cm, hash: h, height: height, cells: cells}
+	q.queued[h] = item
+	heap.Push(q, item)
+	return nil
+}
+
+func (q *blameQueue) Len() int {
+	return len(q.items)
+}
+
+func (q *blameQueue) Less(i, j int) bool {
+	return q.items[i].height > q.items[j].height
+}
+
+func (q *blameQueue) Swap(i, j int) {
+	q.items[i], q.items[j] = q.items[j], q.items[i]
+}
+
+func (q *blameQueue) Push(x interface{}) {
+	q.items = append(q.items, x.(*blameQueueItem))
+}
+
+func (q *blameQueue) Pop() interface{} {
+	item := q.items[len(q.items)-1]
+	q.items = q.items[:len(q.items)-1]
+	return item
+}
+
+// BlameTableAtCommit returns the table with the name given in the commit given, and its schema. Returns a nil table
+// and schema if the table does not exist in the commit.
+func BlameTableAtCommit(ctx *sql.Context, cm *doltdb.Commit, tableName string) (*doltdb.Table, schema.Schema, error) {
+	root, err := cm.GetRootValue(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, tbl, ok, err := resolve.Table(ctx, root, tableName)
+	if err != nil || !ok {
+		return nil, nil, err
+	}
+
+	sch, err := tbl.GetSchema(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tbl, sch, nil
+}
+
+func newBlameCommit(ctx *sql.Context, cm *doltdb.Commit) (*blameCommit, error) {
+	h, err := cm.HashOf()
+	if err != nil {
+		return nil, err
+	}
+	meta, err := cm.GetCommitMeta(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &blameCommit{row: sql.Row{h.String(), meta.Time(), meta.Name, meta.Email, meta.Description}}, nil
+}
+
+func blameRowData(ctx *sql.Context, tbl *doltdb.Table) (prolly.Map, error) {
+	idx, err := tbl.GetRowData(ctx)
+	if err != nil {
+		return prolly.Map{}, err
+	}
+	return durable.ProllyMapFromIndex(idx)
+}
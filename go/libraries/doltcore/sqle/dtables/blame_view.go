@@ -27,6 +27,9 @@ import (
 
 var errUnblameableTable = errors.New("unable to generate blame view for table without primary key")
 
+// BlameRowHashColumn is the name of the column that identifies a row of a keyless table in blame results.
+const BlameRowHashColumn = "row_hash"
+
 const (
 	// todo: force /*+ JOIN_ORDER(sd,ld) */ for testing consistency
 	viewExpressionTemplate = `
@@ -66,26 +69,31 @@ const (
 
 // NewBlameView returns a view expression for the DOLT_BLAME system view for the specified table.
 // The DOLT_BLAME system view is a view on the DOLT_DIFF system table that shows the latest commit
-// for each primary key in the specified table.
-func NewBlameView(ctx *sql.Context, tableName doltdb.TableName, root doltdb.RootValue) (string, error) {
+// for each primary key in the specified table. Returns false if the table is keyless, since keyless
+// tables are blamed by a KeylessBlameTable instead.
+func NewBlameView(ctx *sql.Context, tableName doltdb.TableName, root doltdb.RootValue) (string, bool, error) {
 	var table *doltdb.Table
 	var err error
 	table, tableName, err = getTableInsensitiveOrError(ctx, root, tableName)
 	if err != nil {
-		return "", err
+		return "", false, err
 	}
 
 	sch, err := table.GetSchema(ctx)
 	if err != nil {
-		return "", nil
+		return "", false, nil
+	}
+
+	if schema.IsKeyless(sch) {
+		return "", false, nil
 	}
 
 	blameViewExpression, err := createDoltBlameViewExpression(tableName.Name, sch.GetPKCols().GetColumns())
 	if err != nil {
-		return "", err
+		return "", false, err
 	}
 
-	return blameViewExpression, nil
+	return blameViewExpression, true, nil
 }
 
 // createDoltBlameViewExpression creates a view expression string to generate the DOLT_BLAME system
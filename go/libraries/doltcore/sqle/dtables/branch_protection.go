@@ -0,0 +1,328 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
+)
+
+const (
+	BranchProtectionTableName = "dolt_branch_protection"
+)
+
+// branchProtectionSchema is the schema for the "dolt_branch_protection" table. Protecting a branch without specifying
+// any rules denies force updates, as that is the most common reason to protect a branch.
+var branchProtectionSchema = sql.Schema{
+	&sql.Column{
+		Name:       "database",
+		Type:       types.MustCreateString(sqltypes.VarChar, 16383, sql.Collation_utf8mb4_0900_ai_ci),
+		Source:     BranchProtectionTableName,
+		PrimaryKey: true,
+	},
+	&sql.Column{
+		Name:       "branch",
+		Type:       types.MustCreateString(sqltypes.VarChar, 16383, sql.Collation_utf8mb4_0900_ai_ci),
+		Source:     BranchProtectionTableName,
+		PrimaryKey: true,
+	},
+	&sql.Column{
+		Name:    "fast_forward_only",
+		Type:    types.Boolean,
+		Source:  BranchProtectionTableName,
+		Default: literalColumnDefault(types.Boolean, int8(0)),
+	},
+	&sql.Column{
+		Name:    "deny_force_push",
+		Type:    types.Boolean,
+		Source:  BranchProtectionTableName,
+		Default: literalColumnDefault(types.Boolean, int8(1)),
+	},
+	&sql.Column{
+		Name:    "required_approvals",
+		Type:    types.Uint32,
+		Source:  BranchProtectionTableName,
+		Default: literalColumnDefault(types.Uint32, uint32(0)),
+	},
+	&sql.Column{
+		Name:     "required_workflow",
+		Type:     types.MustCreateString(sqltypes.VarChar, 2048, sql.Collation_utf8mb4_0900_ai_ci),
+		Source:   BranchProtectionTableName,
+		Nullable: true,
+	},
+}
+
+// literalColumnDefault returns a column default that always evaluates to the given literal value.
+func literalColumnDefault(typ sql.Type, val interface{}) *sql.ColumnDefaultValue {
+	def, err := sql.NewColumnDefaultValue(expression.NewLiteral(val, typ), typ, true, false, false)
+	if err != nil {
+		panic(err) // should never happen
+	}
+	return def
+}
+
+// BranchProtectionTable provides a layer over the branch_control.Protection structure, exposing it as a system table.
+type BranchProtectionTable struct {
+	*branch_control.Protection
+}
+
+var _ sql.Table = BranchProtectionTable{}
+var _ sql.InsertableTable = BranchProtectionTable{}
+var _ sql.ReplaceableTable = BranchProtectionTable{}
+var _ sql.UpdatableTable = BranchProtectionTable{}
+var _ sql.DeletableTable = BranchProtectionTable{}
+var _ sql.RowInserter = BranchProtectionTable{}
+var _ sql.RowReplacer = BranchProtectionTable{}
+var _ sql.RowUpdater = BranchProtectionTable{}
+var _ sql.RowDeleter = BranchProtectionTable{}
+
+// NewBranchProtectionTable returns a new BranchProtectionTable.
+func NewBranchProtectionTable(protection *branch_control.Protection) BranchProtectionTable {
+	return BranchProtectionTable{protection}
+}
+
+// Name implements the interface sql.Table.
+func (tbl BranchProtectionTable) Name() string {
+	return BranchProtectionTableName
+}
+
+// String implements the interface sql.Table.
+func (tbl BranchProtectionTable) String() string {
+	return BranchProtectionTableName
+}
+
+// Schema implements the interface sql.Table.
+func (tbl BranchProtectionTable) Schema() sql.Schema {
+	return branchProtectionSchema
+}
+
+// Collation implements the interface sql.Table.
+func (tbl BranchProtectionTable) Collation() sql.CollationID {
+	return sql.Collation_Default
+}
+
+// Partitions implements the interface sql.Table.
+func (tbl BranchProtectionTable) Partitions(context *sql.Context) (sql.PartitionIter, error) {
+	return index.SinglePartitionIterFromNomsMap(nil), nil
+}
+
+// PartitionRows implements the interface sql.Table.
+func (tbl BranchProtectionTable) PartitionRows(context *sql.Context, partition sql.Partition) (sql.RowIter, error) {
+	tbl.RWMutex.RLock()
+	defer tbl.RWMutex.RUnlock()
+
+	var rows []sql.Row
+	for _, value := range tbl.Values {
+		var workflow interface{}
+		if len(value.RequiredWorkflow) > 0 {
+			workflow = value.RequiredWorkflow
+		}
+		rows = append(rows, sql.Row{
+			value.Database,
+			value.Branch,
+			boolToInt8(value.FastForwardOnly),
+			boolToInt8(value.DenyForcePush),
+			value.RequiredApprovals,
+			workflow,
+		})
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// Inserter implements the interface sql.InsertableTable.
+func (tbl BranchProtectionTable) Inserter(context *sql.Context) sql.RowInserter {
+	return tbl
+}
+
+// Replacer implements the interface sql.ReplaceableTable.
+func (tbl BranchProtectionTable) Replacer(ctx *sql.Context) sql.RowReplacer {
+	return tbl
+}
+
+// Updater implements the interface sql.UpdatableTable.
+func (tbl BranchProtectionTable) Updater(ctx *sql.Context) sql.RowUpdater {
+	return tbl
+}
+
+// Deleter implements the interface sql.DeletableTable.
+func (tbl BranchProtectionTable) Deleter(context *sql.Context) sql.RowDeleter {
+	return tbl
+}
+
+// StatementBegin implements the interface sql.TableEditor.
+func (tbl BranchProtectionTable) StatementBegin(ctx *sql.Context) {}
+
+// DiscardChanges implements the interface sql.TableEditor.
+func (tbl BranchProtectionTable) DiscardChanges(ctx *sql.Context, errorEncountered error) error {
+	return nil
+}
+
+// StatementComplete implements the interface sql.TableEditor.
+func (tbl BranchProtectionTable) StatementComplete(ctx *sql.Context) error {
+	return nil
+}
+
+// Insert implements the interface sql.RowInserter.
+func (tbl BranchProtectionTable) Insert(ctx *sql.Context, row sql.Row) error {
+	tbl.RWMutex.Lock()
+	defer tbl.RWMutex.Unlock()
+
+	value, err := branchProtectionValueFromRow(row)
+	if err != nil {
+		return err
+	}
+	if err = tbl.checkAdmin(ctx, value); err != nil {
+		return err
+	}
+	return tbl.insert(value)
+}
+
+// Update implements the interface sql.RowUpdater.
+func (tbl BranchProtectionTable) Update(ctx *sql.Context, old sql.Row, new sql.Row) error {
+	tbl.RWMutex.Lock()
+	defer tbl.RWMutex.Unlock()
+
+	oldValue, err := branchProtectionValueFromRow(old)
+	if err != nil {
+		return err
+	}
+	newValue, err := branchProtectionValueFromRow(new)
+	if err != nil {
+		return err
+	}
+
+	// If we're not updating the same row, then we pre-emptively check for a row violation
+	if oldValue.Database != newValue.Database || oldValue.Branch != newValue.Branch {
+		if tblIndex := tbl.GetIndex(newValue.Database, newValue.Branch); tblIndex != -1 {
+			return newBranchProtectionUniqueKeyErr(newValue)
+		}
+	}
+	if err = tbl.checkAdmin(ctx, oldValue); err != nil {
+		return err
+	}
+	if err = tbl.checkAdmin(ctx, newValue); err != nil {
+		return err
+	}
+
+	if tblIndex := tbl.GetIndex(oldValue.Database, oldValue.Branch); tblIndex != -1 {
+		tbl.Protection.Delete(tblIndex)
+	}
+	return tbl.insert(newValue)
+}
+
+// Delete implements the interface sql.RowDeleter.
+func (tbl BranchProtectionTable) Delete(ctx *sql.Context, row sql.Row) error {
+	tbl.RWMutex.Lock()
+	defer tbl.RWMutex.Unlock()
+
+	value, err := branchProtectionValueFromRow(row)
+	if err != nil {
+		return err
+	}
+	if err = tbl.checkAdmin(ctx, value); err != nil {
+		return err
+	}
+
+	if tblIndex := tbl.GetIndex(value.Database, value.Branch); tblIndex != -1 {
+		tbl.Protection.Delete(tblIndex)
+	}
+	return nil
+}
+
+// Close implements the interface sql.Closer.
+func (tbl BranchProtectionTable) Close(context *sql.Context) error {
+	return branch_control.SaveData(context)
+}
+
+// checkAdmin returns an error if the user of the given context may not modify the rules of the given value, which
+// requires either the correct database privileges, or admin permissions on the branch expression.
+func (tbl BranchProtectionTable) checkAdmin(ctx *sql.Context, value branch_control.ProtectionValue) error {
+	// A nil session means we're not in the SQL context, so we allow the modification in such a case
+	branchAwareSession := branch_control.GetBranchAwareSession(ctx)
+	if branchAwareSession == nil || branch_control.HasDatabasePrivileges(branchAwareSession, value.Database) {
+		return nil
+	}
+	// tbl.Access() shares a lock with the protection table. No need to acquire its lock.
+	user := branchAwareSession.GetUser()
+	host := branchAwareSession.GetHost()
+	// As we've folded the branch expression, we can use it directly as though it were a normal branch name to
+	// determine if the user has permission to modify the rules.
+	_, modPerms := tbl.Access().Match(value.Database, value.Branch, user, host)
+	if modPerms&branch_control.Permissions_Admin != branch_control.Permissions_Admin {
+		return branch_control.ErrModifyingProtectionRow.New(user, host, value.Database, value.Branch)
+	}
+	return nil
+}
+
+// insert adds the given value to the table. Assumes that the expressions have already been folded.
+func (tbl BranchProtectionTable) insert(value branch_control.ProtectionValue) error {
+	// If we already have this in the table, then we return a duplicate PK error
+	if tblIndex := tbl.GetIndex(value.Database, value.Branch); tblIndex != -1 {
+		return newBranchProtectionUniqueKeyErr(value)
+	}
+	tbl.Protection.Insert(value)
+	return nil
+}
+
+// branchProtectionValueFromRow returns the folded and validated value represented by the given row.
+func branchProtectionValueFromRow(row sql.Row) (branch_control.ProtectionValue, error) {
+	value := branch_control.ProtectionValue{
+		Database: strings.ToLower(branch_control.FoldExpression(row[0].(string))),
+		Branch:   strings.ToLower(branch_control.FoldExpression(row[1].(string))),
+	}
+	if row[2] != nil {
+		value.FastForwardOnly = row[2].(int8) != 0
+	}
+	if row[3] != nil {
+		value.DenyForcePush = row[3].(int8) != 0
+	}
+	if row[4] != nil {
+		value.RequiredApprovals = row[4].(uint32)
+	}
+	if row[5] != nil {
+		value.RequiredWorkflow = row[5].(string)
+	}
+
+	// Verify that the lengths of each expression fit within an uint16
+	if len(value.Database) > math.MaxUint16 || len(value.Branch) > math.MaxUint16 {
+		return value, branch_control.ErrExpressionsTooLong.New(value.Database, value.Branch, "", "")
+	}
+	return value, nil
+}
+
+// newBranchProtectionUniqueKeyErr returns a duplicate primary key error for the given value.
+func newBranchProtectionUniqueKeyErr(value branch_control.ProtectionValue) error {
+	return sql.NewUniqueKeyErr(
+		fmt.Sprintf(`[%q, %q]`, value.Database, value.Branch),
+		true,
+		sql.Row{value.Database, value.Branch})
+}
+
+// boolToInt8 returns the representation of the given bool as used by the Boolean type.
+func boolToInt8(b bool) int8 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
+)
+
+// CIRunsTable is a read-only system table that shows the dolt_ci workflow runs which were triggered by branch
+// updates while running sql-server. The runs are recorded in the database, so they are shown after a restart and
+// outside of sql-server too.
+type CIRunsTable struct {
+	ddb       *doltdb.DoltDB
+	dbName    string
+	tableName string
+}
+
+var _ sql.Table = (*CIRunsTable)(nil)
+
+// NewCIRunsTable creates a CIRunsTable
+func NewCIRunsTable(_ *sql.Context, dbName, tableName string, ddb *doltdb.DoltDB) *CIRunsTable {
+	return &CIRunsTable{ddb: ddb, dbName: dbName, tableName: tableName}
+}
+
+// Name is a sql.Table interface function which returns the name of the table
+func (ct *CIRunsTable) Name() string {
+	return ct.tableName
+}
+
+// String is a sql.Table interface function which returns the name of the table
+func (ct *CIRunsTable) String() string {
+	return ct.tableName
+}
+
+// Schema is a sql.Table interface function that gets the sql.Schema of the ci runs system table.
+func (ct *CIRunsTable) Schema() sql.Schema {
+	return []*sql.Column{
+		{Name: "run_id", Type: types.Text, Source: ct.tableName, PrimaryKey: true, Nullable: false, DatabaseSource: ct.dbName},
+		{Name: "workflow", Type: types.Text, Source: ct.tableName, PrimaryKey: false, Nullable: false, DatabaseSource: ct.dbName},
+		{Name: "branch", Type: types.Text, Source: ct.tableName, PrimaryKey: false, Nullable: false, DatabaseSource: ct.dbName},
+		{Name: "commit_hash", Type: types.Text, Source: ct.tableName, PrimaryKey: false, Nullable: false, DatabaseSource: ct.dbName},
+		{Name: "status", Type: types.Text, Source: ct.tableName, PrimaryKey: false, Nullable: false, DatabaseSource: ct.dbName},
+		{Name: "failing_step", Type: types.Text, Source: ct.tableName, PrimaryKey: false, Nullable: true, DatabaseSource: ct.dbName},
+		{Name: "output", Type: types.LongText, Source: ct.tableName, PrimaryKey: false, Nullable: true, DatabaseSource: ct.dbName},
+		{Name: "started_at", Type: types.Datetime, Source: ct.tableName, PrimaryKey: false, Nullable: false, DatabaseSource: ct.dbName},
+		{Name: "finished_at", Type: types.Datetime, Source: ct.tableName, PrimaryKey: false, Nullable: false, DatabaseSource: ct.dbName},
+	}
+}
+
+// Collation implements the sql.Table interface.
+func (ct *CIRunsTable) Collation() sql.CollationID {
+	return sql.Collation_Default
+}
+
+// Partitions is a sql.Table interface function that returns a partition of the data. Currently the data is unpartitioned.
+func (ct *CIRunsTable) Partitions(*sql.Context) (sql.PartitionIter, error) {
+	return index.SinglePartitionIterFromNomsMap(nil), nil
+}
+
+// PartitionRows is a sql.Table interface function that gets a row iterator for a partition
+func (ct *CIRunsTable) PartitionRows(ctx *sql.Context, _ sql.Partition) (sql.RowIter, error) {
+	runs, err := ct.ddb.GetCIRuns(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ciRunsItr{runs: runs}, nil
+}
+
+type ciRunsItr struct {
+	runs doltdb.CIRuns
+	idx  int
+}
+
+var _ sql.RowIter = (*ciRunsItr)(nil)
+
+// Next retrieves the next row. It will return io.EOF if it's the last row.
+func (itr *ciRunsItr) Next(*sql.Context) (sql.Row, error) {
+	if itr.idx >= len(itr.runs) {
+		return nil, io.EOF
+	}
+	run := itr.runs[itr.idx]
+	itr.idx++
+
+	var failingStep interface{}
+	if run.FailingStep != "" {
+		failingStep = run.FailingStep
+	}
+	var output interface{}
+	if run.Output != "" {
+		output = run.Output
+	}
+
+	return sql.NewRow(run.RunId, run.Workflow, run.Branch, run.Commit, run.Status, failingStep, output, run.StartedAt, run.FinishedAt), nil
+}
+
+// Close closes the iterator.
+func (itr *ciRunsItr) Close(*sql.Context) error {
+	return nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
+)
+
+// KeylessBlameTable is a sql.Table implementation of the DOLT_BLAME system table of a keyless table. Tables with a
+// primary key are blamed by a view on their DOLT_DIFF system table instead, see NewBlameView. Keyless tables have no
+// stable row identity in the DOLT_DIFF system table, so their rows are identified by their hash and blamed by
+// walking the history of the table from |head|, which is the commit the table was resolved AS OF, if any.
+type KeylessBlameTable struct {
+	dbName    string
+	name      string
+	tableName string
+	head      *doltdb.Commit
+}
+
+var _ sql.Table = (*KeylessBlameTable)(nil)
+
+// NewKeylessBlameTable returns the DOLT_BLAME system table for the keyless table named |tableName|, as of |head|.
+func NewKeylessBlameTable(dbName, tableName string, head *doltdb.Commit) *KeylessBlameTable {
+	return &KeylessBlameTable{
+		dbName:    dbName,
+		name:      doltdb.DoltBlameViewPrefix + tableName,
+		tableName: tableName,
+		head:      head,
+	}
+}
+
+// Name implements sql.Table
+func (bt *KeylessBlameTable) Name() string {
+	return bt.name
+}
+
+// String implements sql.Table
+func (bt *KeylessBlameTable) String() string {
+	return bt.name
+}
+
+// Schema implements sql.Table
+func (bt *KeylessBlameTable) Schema() sql.Schema {
+	return sql.Schema{
+		{Name: BlameRowHashColumn, Type: types.Text, Source: bt.name, PrimaryKey: true, DatabaseSource: bt.dbName},
+		{Name: "commit", Type: types.Text, Source: bt.name, DatabaseSource: bt.dbName},
+		{Name: "commit_date", Type: types.DatetimeMaxPrecision, Source: bt.name, DatabaseSource: bt.dbName},
+		{Name: "committer", Type: types.Text, Source: bt.name, DatabaseSource: bt.dbName},
+		{Name: "email", Type: types.Text, Source: bt.name, DatabaseSource: bt.dbName},
+		{Name: "message", Type: types.Text, Source: bt.name, DatabaseSource: bt.dbName},
+	}
+}
+
+// Collation implements sql.Table
+func (bt *KeylessBlameTable) Collation() sql.CollationID {
+	return sql.Collation_Default
+}
+
+// Partitions implements sql.Table. The data is unpartitioned.
+func (bt *KeylessBlameTable) Partitions(*sql.Context) (sql.PartitionIter, error) {
+	return index.SinglePartitionIterFromNomsMap(nil), nil
+}
+
+// PartitionRows implements sql.Table. Rows are returned in order of their hash.
+func (bt *KeylessBlameTable) PartitionRows(ctx *sql.Context, _ sql.Partition) (sql.RowIter, error) {
+	cells, err := BlameCells(ctx, bt.head, bt.tableName)
+	if err != nil {
+		return nil, err
+	}
+	return &keylessBlameRowIter{cells: cells}, nil
+}
+
+// keylessBlameRowIter turns the blamed cells of a keyless table into a row for each of its rows. Every cell of a
+// keyless row is blamed on the same commit, so only the first cell of each row is kept. The row hash is followed by
+// the column name in each cell, which is dropped.
+type keylessBlameRowIter struct {
+	cells   sql.RowIter
+	lastKey interface{}
+}
+
+var _ sql.RowIter = (*keylessBlameRowIter)(nil)
+
+// Next implements sql.RowIter
+func (itr *keylessBlameRowIter) Next(ctx *sql.Context) (sql.Row, error) {
+	for {
+		cell, err := itr.cells.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if cell[0] == itr.lastKey {
+			continue
+		}
+		itr.lastKey = cell[0]
+		return append(sql.Row{cell[0]}, cell[2:]...), nil
+	}
+}
+
+// Close implements sql.RowIter
+func (itr *keylessBlameRowIter) Close(ctx *sql.Context) error {
+	return itr.cells.Close(ctx)
+}
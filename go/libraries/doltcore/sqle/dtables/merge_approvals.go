@@ -0,0 +1,280 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+const (
+	MergeApprovalsTableName = "dolt_merge_approvals"
+)
+
+// mergeApprovalsSchema is the schema for the "dolt_merge_approvals" table.
+var mergeApprovalsSchema = sql.Schema{
+	&sql.Column{
+		Name:       "database",
+		Type:       types.MustCreateString(sqltypes.VarChar, 16383, sql.Collation_utf8mb4_0900_ai_ci),
+		Source:     MergeApprovalsTableName,
+		PrimaryKey: true,
+	},
+	&sql.Column{
+		Name:       "branch",
+		Type:       types.MustCreateString(sqltypes.VarChar, 16383, sql.Collation_utf8mb4_0900_ai_ci),
+		Source:     MergeApprovalsTableName,
+		PrimaryKey: true,
+	},
+	&sql.Column{
+		Name:       "commit_hash",
+		Type:       types.MustCreateString(sqltypes.VarChar, 32, sql.Collation_utf8mb4_0900_ai_ci),
+		Source:     MergeApprovalsTableName,
+		PrimaryKey: true,
+	},
+	&sql.Column{
+		Name:       "user",
+		Type:       types.MustCreateString(sqltypes.VarChar, 16383, sql.Collation_utf8mb4_0900_bin),
+		Source:     MergeApprovalsTableName,
+		PrimaryKey: true,
+	},
+}
+
+// MergeApprovalsTable provides a layer over the branch_control.MergeApprovals structure, exposing it as a system table.
+type MergeApprovalsTable struct {
+	*branch_control.MergeApprovals
+}
+
+var _ sql.Table = MergeApprovalsTable{}
+var _ sql.InsertableTable = MergeApprovalsTable{}
+var _ sql.ReplaceableTable = MergeApprovalsTable{}
+var _ sql.UpdatableTable = MergeApprovalsTable{}
+var _ sql.DeletableTable = MergeApprovalsTable{}
+var _ sql.RowInserter = MergeApprovalsTable{}
+var _ sql.RowReplacer = MergeApprovalsTable{}
+var _ sql.RowUpdater = MergeApprovalsTable{}
+var _ sql.RowDeleter = MergeApprovalsTable{}
+
+// NewMergeApprovalsTable returns a new MergeApprovalsTable.
+func NewMergeApprovalsTable(approvals *branch_control.MergeApprovals) MergeApprovalsTable {
+	return MergeApprovalsTable{approvals}
+}
+
+// Name implements the interface sql.Table.
+func (tbl MergeApprovalsTable) Name() string {
+	return MergeApprovalsTableName
+}
+
+// String implements the interface sql.Table.
+func (tbl MergeApprovalsTable) String() string {
+	return MergeApprovalsTableName
+}
+
+// Schema implements the interface sql.Table.
+func (tbl MergeApprovalsTable) Schema() sql.Schema {
+	return mergeApprovalsSchema
+}
+
+// Collation implements the interface sql.Table.
+func (tbl MergeApprovalsTable) Collation() sql.CollationID {
+	return sql.Collation_Default
+}
+
+// Partitions implements the interface sql.Table.
+func (tbl MergeApprovalsTable) Partitions(context *sql.Context) (sql.PartitionIter, error) {
+	return index.SinglePartitionIterFromNomsMap(nil), nil
+}
+
+// PartitionRows implements the interface sql.Table.
+func (tbl MergeApprovalsTable) PartitionRows(context *sql.Context, partition sql.Partition) (sql.RowIter, error) {
+	tbl.RWMutex.RLock()
+	defer tbl.RWMutex.RUnlock()
+
+	var rows []sql.Row
+	for _, value := range tbl.Values {
+		rows = append(rows, sql.Row{
+			value.Database,
+			value.Branch,
+			value.Commit,
+			value.User,
+		})
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// Inserter implements the interface sql.InsertableTable.
+func (tbl MergeApprovalsTable) Inserter(context *sql.Context) sql.RowInserter {
+	return tbl
+}
+
+// Replacer implements the interface sql.ReplaceableTable.
+func (tbl MergeApprovalsTable) Replacer(ctx *sql.Context) sql.RowReplacer {
+	return tbl
+}
+
+// Updater implements the interface sql.UpdatableTable.
+func (tbl MergeApprovalsTable) Updater(ctx *sql.Context) sql.RowUpdater {
+	return tbl
+}
+
+// Deleter implements the interface sql.DeletableTable.
+func (tbl MergeApprovalsTable) Deleter(context *sql.Context) sql.RowDeleter {
+	return tbl
+}
+
+// StatementBegin implements the interface sql.TableEditor.
+func (tbl MergeApprovalsTable) StatementBegin(ctx *sql.Context) {}
+
+// DiscardChanges implements the interface sql.TableEditor.
+func (tbl MergeApprovalsTable) DiscardChanges(ctx *sql.Context, errorEncountered error) error {
+	return nil
+}
+
+// StatementComplete implements the interface sql.TableEditor.
+func (tbl MergeApprovalsTable) StatementComplete(ctx *sql.Context) error {
+	return nil
+}
+
+// Insert implements the interface sql.RowInserter.
+func (tbl MergeApprovalsTable) Insert(ctx *sql.Context, row sql.Row) error {
+	tbl.RWMutex.Lock()
+	defer tbl.RWMutex.Unlock()
+
+	value, err := mergeApprovalValueFromRow(row)
+	if err != nil {
+		return err
+	}
+	if err = tbl.checkApprover(ctx, value); err != nil {
+		return err
+	}
+	return tbl.insert(value)
+}
+
+// Update implements the interface sql.RowUpdater.
+func (tbl MergeApprovalsTable) Update(ctx *sql.Context, old sql.Row, new sql.Row) error {
+	tbl.RWMutex.Lock()
+	defer tbl.RWMutex.Unlock()
+
+	oldValue, err := mergeApprovalValueFromRow(old)
+	if err != nil {
+		return err
+	}
+	newValue, err := mergeApprovalValueFromRow(new)
+	if err != nil {
+		return err
+	}
+	if oldValue != newValue {
+		if tblIndex := tbl.GetIndex(newValue.Database, newValue.Branch, newValue.Commit, newValue.User); tblIndex != -1 {
+			return newMergeApprovalUniqueKeyErr(newValue)
+		}
+	}
+	if err = tbl.checkApprover(ctx, oldValue); err != nil {
+		return err
+	}
+	if err = tbl.checkApprover(ctx, newValue); err != nil {
+		return err
+	}
+
+	if tblIndex := tbl.GetIndex(oldValue.Database, oldValue.Branch, oldValue.Commit, oldValue.User); tblIndex != -1 {
+		tbl.MergeApprovals.Delete(tblIndex)
+	}
+	return tbl.insert(newValue)
+}
+
+// Delete implements the interface sql.RowDeleter.
+func (tbl MergeApprovalsTable) Delete(ctx *sql.Context, row sql.Row) error {
+	tbl.RWMutex.Lock()
+	defer tbl.RWMutex.Unlock()
+
+	value, err := mergeApprovalValueFromRow(row)
+	if err != nil {
+		return err
+	}
+	if err = tbl.checkApprover(ctx, value); err != nil {
+		return err
+	}
+
+	if tblIndex := tbl.GetIndex(value.Database, value.Branch, value.Commit, value.User); tblIndex != -1 {
+		tbl.MergeApprovals.Delete(tblIndex)
+	}
+	return nil
+}
+
+// Close implements the interface sql.Closer.
+func (tbl MergeApprovalsTable) Close(context *sql.Context) error {
+	return branch_control.SaveData(context)
+}
+
+// checkApprover returns an error if the user of the given context may not record (or withdraw) the given approval.
+// Users may only approve on their own behalf, and only for branches that they're able to write to. Users with the
+// correct database privileges, or admin permissions on the branch, may modify any approval.
+func (tbl MergeApprovalsTable) checkApprover(ctx *sql.Context, value branch_control.MergeApprovalValue) error {
+	// A nil session means we're not in the SQL context, so we allow the modification in such a case
+	branchAwareSession := branch_control.GetBranchAwareSession(ctx)
+	if branchAwareSession == nil || branch_control.HasDatabasePrivileges(branchAwareSession, value.Database) {
+		return nil
+	}
+	// tbl.Access() shares a lock with the approvals table. No need to acquire its lock.
+	user := branchAwareSession.GetUser()
+	host := branchAwareSession.GetHost()
+	_, perms := tbl.Access().Match(value.Database, value.Branch, user, host)
+	if perms&branch_control.Permissions_Admin == branch_control.Permissions_Admin {
+		return nil
+	}
+	if value.User == user && perms&branch_control.Permissions_Write == branch_control.Permissions_Write {
+		return nil
+	}
+	return branch_control.ErrModifyingApprovalRow.New(user, host, value.Database, value.Branch, value.Commit, value.User)
+}
+
+// insert adds the given value to the table.
+func (tbl MergeApprovalsTable) insert(value branch_control.MergeApprovalValue) error {
+	// If we already have this in the table, then we return a duplicate PK error
+	if tblIndex := tbl.GetIndex(value.Database, value.Branch, value.Commit, value.User); tblIndex != -1 {
+		return newMergeApprovalUniqueKeyErr(value)
+	}
+	tbl.MergeApprovals.Insert(value)
+	return nil
+}
+
+// mergeApprovalValueFromRow returns the normalized and validated value represented by the given row.
+func mergeApprovalValueFromRow(row sql.Row) (branch_control.MergeApprovalValue, error) {
+	// Database, Branch, and the commit hash are case-insensitive, while User is case-sensitive
+	value := branch_control.MergeApprovalValue{
+		Database: strings.ToLower(row[0].(string)),
+		Branch:   strings.ToLower(row[1].(string)),
+		Commit:   strings.ToLower(strings.TrimSpace(row[2].(string))),
+		User:     row[3].(string),
+	}
+	if !hash.IsValid(value.Commit) {
+		return value, fmt.Errorf("invalid commit hash `%s`", value.Commit)
+	}
+	return value, nil
+}
+
+// newMergeApprovalUniqueKeyErr returns a duplicate primary key error for the given value.
+func newMergeApprovalUniqueKeyErr(value branch_control.MergeApprovalValue) error {
+	return sql.NewUniqueKeyErr(
+		fmt.Sprintf(`[%q, %q, %q, %q]`, value.Database, value.Branch, value.Commit, value.User),
+		true,
+		sql.Row{value.Database, value.Branch, value.Commit, value.User})
+}
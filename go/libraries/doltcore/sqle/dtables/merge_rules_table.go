@@ -0,0 +1,310 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	sqlTypes "github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+var _ sql.Table = (*MergeRulesTable)(nil)
+var _ sql.UpdatableTable = (*MergeRulesTable)(nil)
+var _ sql.DeletableTable = (*MergeRulesTable)(nil)
+var _ sql.InsertableTable = (*MergeRulesTable)(nil)
+var _ sql.ReplaceableTable = (*MergeRulesTable)(nil)
+var _ sql.IndexAddressableTable = (*MergeRulesTable)(nil)
+
+// MergeRulesTable is the system table that declares how concurrent modifications of the same cell are resolved
+// during a merge, rather than being recorded as conflicts.
+type MergeRulesTable struct {
+	backingTable VersionableTable
+	schemaName   string
+}
+
+func (i *MergeRulesTable) Name() string {
+	return doltdb.MergeRulesTableName
+}
+
+func (i *MergeRulesTable) String() string {
+	return doltdb.MergeRulesTableName
+}
+
+// doltMergeRulesSchema returns the schema of the dolt_merge_rules system table.
+func doltMergeRulesSchema() sql.Schema {
+	return []*sql.Column{
+		{Name: "table_name", Type: sqlTypes.Text, Source: doltdb.MergeRulesTableName, PrimaryKey: true},
+		{Name: "column_name", Type: sqlTypes.Text, Source: doltdb.MergeRulesTableName, PrimaryKey: true},
+		{Name: "strategy", Type: sqlTypes.Text, Source: doltdb.MergeRulesTableName, PrimaryKey: false, Nullable: false},
+		{Name: "order_by_column", Type: sqlTypes.Text, Source: doltdb.MergeRulesTableName, PrimaryKey: false, Nullable: true},
+	}
+}
+
+// Schema is a sql.Table interface function that gets the sql.Schema of the dolt_merge_rules system table.
+func (i *MergeRulesTable) Schema() sql.Schema {
+	return doltMergeRulesSchema()
+}
+
+func (i *MergeRulesTable) Collation() sql.CollationID {
+	return sql.Collation_Default
+}
+
+// Partitions is a sql.Table interface function that returns a partition of the data.
+func (i *MergeRulesTable) Partitions(context *sql.Context) (sql.PartitionIter, error) {
+	if i.backingTable == nil {
+		// no backing table; return an empty iter.
+		return index.SinglePartitionIterFromNomsMap(nil), nil
+	}
+	return i.backingTable.Partitions(context)
+}
+
+func (i *MergeRulesTable) PartitionRows(context *sql.Context, partition sql.Partition) (sql.RowIter, error) {
+	if i.backingTable == nil {
+		// no backing table; return an empty iter.
+		return sql.RowsToRowIter(), nil
+	}
+
+	return i.backingTable.PartitionRows(context, partition)
+}
+
+// NewMergeRulesTable creates a MergeRulesTable
+func NewMergeRulesTable(_ *sql.Context, backingTable VersionableTable, schemaName string) sql.Table {
+	return &MergeRulesTable{backingTable: backingTable, schemaName: schemaName}
+}
+
+// NewEmptyMergeRulesTable creates a MergeRulesTable
+func NewEmptyMergeRulesTable(_ *sql.Context, schemaName string) sql.Table {
+	return &MergeRulesTable{schemaName: schemaName}
+}
+
+// Replacer returns a RowReplacer for this table. The RowReplacer will have Insert and optionally Delete called once
+// for each row, followed by a call to Close() when all rows have been processed.
+func (it *MergeRulesTable) Replacer(ctx *sql.Context) sql.RowReplacer {
+	return newMergeRulesWriter(it)
+}
+
+// Updater returns a RowUpdater for this table. The RowUpdater will have Update called once for each row to be
+// updated, followed by a call to Close() when all rows have been processed.
+func (it *MergeRulesTable) Updater(ctx *sql.Context) sql.RowUpdater {
+	return newMergeRulesWriter(it)
+}
+
+// Inserter returns an Inserter for this table. The Inserter will get one call to Insert() for each row to be
+// inserted, and will end with a call to Close() to finalize the insert operation.
+func (it *MergeRulesTable) Inserter(*sql.Context) sql.RowInserter {
+	return newMergeRulesWriter(it)
+}
+
+// Deleter returns a RowDeleter for this table. The RowDeleter will get one call to Delete for each row to be deleted,
+// and will end with a call to Close() to finalize the delete operation.
+func (it *MergeRulesTable) Deleter(*sql.Context) sql.RowDeleter {
+	return newMergeRulesWriter(it)
+}
+
+func (it *MergeRulesTable) LockedToRoot(ctx *sql.Context, root doltdb.RootValue) (sql.IndexAddressableTable, error) {
+	if it.backingTable == nil {
+		return it, nil
+	}
+	return it.backingTable.LockedToRoot(ctx, root)
+}
+
+// IndexedAccess implements IndexAddressableTable, but MergeRulesTable has no indexes.
+// Thus, this should never be called.
+func (it *MergeRulesTable) IndexedAccess(lookup sql.IndexLookup) sql.IndexedTable {
+	panic("Unreachable")
+}
+
+// GetIndexes implements IndexAddressableTable, but MergeRulesTable has no indexes.
+func (it *MergeRulesTable) GetIndexes(ctx *sql.Context) ([]sql.Index, error) {
+	return nil, nil
+}
+
+func (i *MergeRulesTable) PreciseMatch() bool {
+	return true
+}
+
+var _ sql.RowReplacer = (*mergeRulesWriter)(nil)
+var _ sql.RowUpdater = (*mergeRulesWriter)(nil)
+var _ sql.RowInserter = (*mergeRulesWriter)(nil)
+var _ sql.RowDeleter = (*mergeRulesWriter)(nil)
+
+type mergeRulesWriter struct {
+	it                      *MergeRulesTable
+	errDuringStatementBegin error
+	prevHash                *hash.Hash
+	tableWriter             dsess.TableWriter
+}
+
+func newMergeRulesWriter(it *MergeRulesTable) *mergeRulesWriter {
+	return &mergeRulesWriter{it, nil, nil, nil}
+}
+
+// Insert inserts the row given, returning an error if it cannot. Insert will be called once for each row to process
+// for the insert operation, which may involve many rows. After all rows in an operation have been processed, Close
+// is called.
+func (iw *mergeRulesWriter) Insert(ctx *sql.Context, r sql.Row) error {
+	if err := iw.errDuringStatementBegin; err != nil {
+		return err
+	}
+	if err := validateMergeRule(r); err != nil {
+		return err
+	}
+	return iw.tableWriter.Insert(ctx, r)
+}
+
+// Update the given row. Provides both the old and new rows.
+func (iw *mergeRulesWriter) Update(ctx *sql.Context, old sql.Row, new sql.Row) error {
+	if err := iw.errDuringStatementBegin; err != nil {
+		return err
+	}
+	if err := validateMergeRule(new); err != nil {
+		return err
+	}
+	return iw.tableWriter.Update(ctx, old, new)
+}
+
+// Delete deletes the given row. Returns ErrDeleteRowNotFound if the row was not found. Delete will be called once for
+// each row to process for the delete operation, which may involve many rows. After all rows have been processed,
+// Close is called.
+func (iw *mergeRulesWriter) Delete(ctx *sql.Context, r sql.Row) error {
+	if err := iw.errDuringStatementBegin; err != nil {
+		return err
+	}
+	return iw.tableWriter.Delete(ctx, r)
+}
+
+// validateMergeRule returns an error if the dolt_merge_rules row |r| is not a valid merge rule.
+func validateMergeRule(r sql.Row) error {
+	fields := make([]string, len(r))
+	for i, v := range r {
+		if v != nil {
+			fields[i] = fmt.Sprint(v)
+		}
+	}
+	_, err := doltdb.NewMergeRule(fields[0], fields[1], fields[2], fields[3])
+	return err
+}
+
+// StatementBegin is called before the first operation of a statement. Integrators should mark the state of the data
+// in some way that it may be returned to in the case of an error.
+func (iw *mergeRulesWriter) StatementBegin(ctx *sql.Context) {
+	dbName := ctx.GetCurrentDatabase()
+	dSess := dsess.DSessFromSess(ctx.Session)
+
+	// TODO: this needs to use a revision qualified name
+	roots, _ := dSess.GetRoots(ctx, dbName)
+	dbState, ok, err := dSess.LookupDbState(ctx, dbName)
+	if err != nil {
+		iw.errDuringStatementBegin = err
+		return
+	}
+	if !ok {
+		iw.errDuringStatementBegin = fmt.Errorf("no root value found in session")
+		return
+	}
+
+	prevHash, err := roots.Working.HashOf()
+	if err != nil {
+		iw.errDuringStatementBegin = err
+		return
+	}
+
+	iw.prevHash = &prevHash
+
+	tname := doltdb.TableName{Name: doltdb.MergeRulesTableName, Schema: iw.it.schemaName}
+	found, err := roots.Working.HasTable(ctx, tname)
+	if err != nil {
+		iw.errDuringStatementBegin = err
+		return
+	}
+
+	if !found {
+		sch := sql.NewPrimaryKeySchema(iw.it.Schema())
+		doltSch, err := sqlutil.ToDoltSchema(ctx, roots.Working, tname, sch, roots.Head, sql.Collation_Default)
+		if err != nil {
+			iw.errDuringStatementBegin = err
+			return
+		}
+
+		// underlying table doesn't exist. Record this, then create the table.
+		newRootValue, err := doltdb.CreateEmptyTable(ctx, roots.Working, tname, doltSch)
+
+		if err != nil {
+			iw.errDuringStatementBegin = err
+			return
+		}
+
+		if dbState.WorkingSet() == nil {
+			iw.errDuringStatementBegin = doltdb.ErrOperationNotSupportedInDetachedHead
+			return
+		}
+
+		// We use WriteSession.SetWorkingSet instead of DoltSession.SetWorkingRoot because we want to avoid modifying the root
+		// until the end of the transaction, but we still want the WriteSession to be able to find the newly
+		// created table.
+		if ws := dbState.WriteSession(); ws != nil {
+			err = ws.SetWorkingSet(ctx, dbState.WorkingSet().WithWorkingRoot(newRootValue))
+			if err != nil {
+				iw.errDuringStatementBegin = err
+				return
+			}
+		}
+
+		dSess.SetWorkingRoot(ctx, dbName, newRootValue)
+	}
+
+	if ws := dbState.WriteSession(); ws != nil {
+		tableWriter, err := ws.GetTableWriter(ctx, tname, dbName, dSess.SetWorkingRoot, false)
+		if err != nil {
+			iw.errDuringStatementBegin = err
+			return
+		}
+		iw.tableWriter = tableWriter
+		tableWriter.StatementBegin(ctx)
+	}
+}
+
+// DiscardChanges is called if a statement encounters an error, and all current changes since the statement beginning
+// should be discarded.
+func (iw *mergeRulesWriter) DiscardChanges(ctx *sql.Context, errorEncountered error) error {
+	if iw.tableWriter != nil {
+		return iw.tableWriter.DiscardChanges(ctx, errorEncountered)
+	}
+	return nil
+}
+
+// StatementComplete is called after the last operation of the statement, indicating that it has successfully completed.
+// The mark set in StatementBegin may be removed, and a new one should be created on the next StatementBegin.
+func (iw *mergeRulesWriter) StatementComplete(ctx *sql.Context) error {
+	if iw.tableWriter != nil {
+		return iw.tableWriter.StatementComplete(ctx)
+	}
+	return nil
+}
+
+// Close finalizes the delete operation, persisting the result.
+func (iw mergeRulesWriter) Close(ctx *sql.Context) error {
+	if iw.tableWriter != nil {
+		return iw.tableWriter.Close(ctx)
+	}
+	return nil
+}
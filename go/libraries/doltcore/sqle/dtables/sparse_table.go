@@ -0,0 +1,215 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
+)
+
+var errEmptySparsePattern = errors.New("invalid sparse checkout pattern: pattern cannot be empty")
+
+// SparseTable is a sql.Table implementation that implements a system table which holds the table name patterns of the
+// sparse checkout of the current working set. Unlike dolt_ignore, its contents are not versioned: they're stored in the
+// working set itself, and never committed or pushed.
+type SparseTable struct {
+	dbName    string
+	tableName string
+}
+
+var _ sql.Table = SparseTable{}
+var _ sql.InsertableTable = SparseTable{}
+var _ sql.ReplaceableTable = SparseTable{}
+var _ sql.UpdatableTable = SparseTable{}
+var _ sql.DeletableTable = SparseTable{}
+var _ sql.RowInserter = SparseTable{}
+var _ sql.RowReplacer = SparseTable{}
+var _ sql.RowUpdater = SparseTable{}
+var _ sql.RowDeleter = SparseTable{}
+
+// NewSparseTable creates a SparseTable
+func NewSparseTable(dbName, tableName string) sql.Table {
+	return SparseTable{dbName: dbName, tableName: tableName}
+}
+
+// Name implements the interface sql.Table.
+func (st SparseTable) Name() string {
+	return st.tableName
+}
+
+// String implements the interface sql.Table.
+func (st SparseTable) String() string {
+	return st.tableName
+}
+
+// Schema implements the interface sql.Table.
+func (st SparseTable) Schema() sql.Schema {
+	return sql.Schema{
+		&sql.Column{
+			Name:           "pattern",
+			Type:           types.MustCreateString(sqltypes.VarChar, 16383, sql.Collation_utf8mb4_0900_ai_ci),
+			Source:         st.tableName,
+			PrimaryKey:     true,
+			DatabaseSource: st.dbName,
+		},
+	}
+}
+
+// Collation implements the interface sql.Table.
+func (st SparseTable) Collation() sql.CollationID {
+	return sql.Collation_Default
+}
+
+// Partitions implements the interface sql.Table.
+func (st SparseTable) Partitions(*sql.Context) (sql.PartitionIter, error) {
+	return index.SinglePartitionIterFromNomsMap(nil), nil
+}
+
+// PartitionRows implements the interface sql.Table.
+func (st SparseTable) PartitionRows(ctx *sql.Context, _ sql.Partition) (sql.RowIter, error) {
+	sess := dsess.DSessFromSess(ctx.Session)
+	dbState, ok, err := sess.LookupDbState(ctx, st.dbName)
+	if err != nil {
+		return nil, err
+	}
+	if !ok || dbState.WorkingSet() == nil {
+		return sql.RowsToRowIter(), nil
+	}
+
+	var rows []sql.Row
+	for _, pattern := range dbState.WorkingSet().SparseTables() {
+		rows = append(rows, sql.Row{pattern})
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// Inserter implements the interface sql.InsertableTable.
+func (st SparseTable) Inserter(*sql.Context) sql.RowInserter {
+	return st
+}
+
+// Replacer implements the interface sql.ReplaceableTable.
+func (st SparseTable) Replacer(*sql.Context) sql.RowReplacer {
+	return st
+}
+
+// Updater implements the interface sql.UpdatableTable.
+func (st SparseTable) Updater(*sql.Context) sql.RowUpdater {
+	return st
+}
+
+// Deleter implements the interface sql.DeletableTable.
+func (st SparseTable) Deleter(*sql.Context) sql.RowDeleter {
+	return st
+}
+
+// StatementBegin implements the interface sql.TableEditor.
+func (st SparseTable) StatementBegin(*sql.Context) {}
+
+// DiscardChanges implements the interface sql.TableEditor.
+func (st SparseTable) DiscardChanges(*sql.Context, error) error {
+	return nil
+}
+
+// StatementComplete implements the interface sql.TableEditor.
+func (st SparseTable) StatementComplete(*sql.Context) error {
+	return nil
+}
+
+// Insert implements the interface sql.RowInserter.
+func (st SparseTable) Insert(ctx *sql.Context, row sql.Row) error {
+	return st.updatePatterns(ctx, func(patterns []string) ([]string, error) {
+		pattern := strings.TrimSpace(row[0].(string))
+		if len(pattern) == 0 {
+			return nil, errEmptySparsePattern
+		}
+		if indexOfSparsePattern(patterns, pattern) != -1 {
+			return nil, sql.NewUniqueKeyErr(fmt.Sprintf("[%q]", pattern), true, sql.Row{pattern})
+		}
+		return append(patterns, pattern), nil
+	})
+}
+
+// Update implements the interface sql.RowUpdater.
+func (st SparseTable) Update(ctx *sql.Context, old sql.Row, new sql.Row) error {
+	return st.updatePatterns(ctx, func(patterns []string) ([]string, error) {
+		oldPattern, newPattern := old[0].(string), strings.TrimSpace(new[0].(string))
+		if len(newPattern) == 0 {
+			return nil, errEmptySparsePattern
+		}
+		if idx := indexOfSparsePattern(patterns, newPattern); idx != -1 && !strings.EqualFold(oldPattern, newPattern) {
+			return nil, sql.NewUniqueKeyErr(fmt.Sprintf("[%q]", newPattern), true, sql.Row{newPattern})
+		}
+		if idx := indexOfSparsePattern(patterns, oldPattern); idx != -1 {
+			patterns[idx] = newPattern
+		}
+		return patterns, nil
+	})
+}
+
+// Delete implements the interface sql.RowDeleter.
+func (st SparseTable) Delete(ctx *sql.Context, row sql.Row) error {
+	return st.updatePatterns(ctx, func(patterns []string) ([]string, error) {
+		if idx := indexOfSparsePattern(patterns, row[0].(string)); idx != -1 {
+			patterns = append(patterns[:idx], patterns[idx+1:]...)
+		}
+		return patterns, nil
+	})
+}
+
+// Close implements the interface sql.Closer.
+func (st SparseTable) Close(*sql.Context) error {
+	return nil
+}
+
+// updatePatterns applies |update| to the sparse checkout patterns of the session's working set, and sets the working
+// set to the result.
+func (st SparseTable) updatePatterns(ctx *sql.Context, update func(patterns []string) ([]string, error)) error {
+	sess := dsess.DSessFromSess(ctx.Session)
+	ws, err := sess.WorkingSet(ctx, st.dbName)
+	if err != nil {
+		return err
+	}
+
+	patterns, err := update(append([]string{}, ws.SparseTables()...))
+	if err != nil {
+		return err
+	}
+	patterns, err = doltdb.NormalizeSparsePatterns(patterns)
+	if err != nil {
+		return err
+	}
+	return sess.SetWorkingSet(ctx, st.dbName, ws.WithSparseTables(patterns))
+}
+
+// indexOfSparsePattern returns the index of |pattern| in |patterns|, compared case-insensitively, or -1 if it is not
+// present.
+func indexOfSparsePattern(patterns []string, pattern string) int {
+	for i := range patterns {
+		if strings.EqualFold(patterns[i], pattern) {
+			return i
+		}
+	}
+	return -1
+}
@@ -132,6 +132,14 @@ func newStatusItr(ctx *sql.Context, st *StatusTable) (*StatusItr, error) {
 		return nil, err
 	}
 
+	// Tables outside of a sparse checkout are hidden
+	sparse, err := st.workingSet.SparseCheckout()
+	if err != nil {
+		return nil, err
+	}
+	stagedTables = diff.FilterSparseTableDeltas(sparse, stagedTables)
+	unstagedTables = diff.FilterSparseTableDeltas(sparse, unstagedTables)
+
 	// Some tables may differ only in column tags and/or recorded conflicts.
 	// We try to make such changes invisible to users and shouldn't display them for unstaged tables.
 	changedUnstagedTables := make([]diff.TableDelta, 0, len(unstagedTables))
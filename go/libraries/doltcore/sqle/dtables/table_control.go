@@ -0,0 +1,351 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
+)
+
+const (
+	TableControlTableName = "dolt_table_control"
+)
+
+// TableControlPermissionsStrings is a slice of strings representing the permissions that may be granted on a table. The
+// order matches the order of the equivalent branch_control.Permissions, which begin at Permissions_Write.
+var TableControlPermissionsStrings = []string{"write", "read"}
+
+// tableControlSchema is the schema for the "dolt_table_control" table.
+var tableControlSchema = sql.Schema{
+	&sql.Column{
+		Name:       "database",
+		Type:       types.MustCreateString(sqltypes.VarChar, 16383, sql.Collation_utf8mb4_0900_ai_ci),
+		Source:     TableControlTableName,
+		PrimaryKey: true,
+	},
+	&sql.Column{
+		Name:       "branch",
+		Type:       types.MustCreateString(sqltypes.VarChar, 16383, sql.Collation_utf8mb4_0900_ai_ci),
+		Source:     TableControlTableName,
+		PrimaryKey: true,
+	},
+	&sql.Column{
+		Name:       "user",
+		Type:       types.MustCreateString(sqltypes.VarChar, 16383, sql.Collation_utf8mb4_0900_bin),
+		Source:     TableControlTableName,
+		PrimaryKey: true,
+	},
+	&sql.Column{
+		Name:       "host",
+		Type:       types.MustCreateString(sqltypes.VarChar, 16383, sql.Collation_utf8mb4_0900_ai_ci),
+		Source:     TableControlTableName,
+		PrimaryKey: true,
+	},
+	&sql.Column{
+		Name:       "table",
+		Type:       types.MustCreateString(sqltypes.VarChar, 16383, sql.Collation_utf8mb4_0900_ai_ci),
+		Source:     TableControlTableName,
+		PrimaryKey: true,
+	},
+	&sql.Column{
+		Name:     "predicate",
+		Type:     types.LongText,
+		Source:   TableControlTableName,
+		Nullable: true,
+	},
+	&sql.Column{
+		Name:   "permissions",
+		Type:   types.MustCreateSetType(TableControlPermissionsStrings, sql.Collation_utf8mb4_0900_ai_ci),
+		Source: TableControlTableName,
+	},
+}
+
+// TableControlTable provides a layer over the branch_control.TableControl structure, exposing it as a system table.
+type TableControlTable struct {
+	*branch_control.TableControl
+}
+
+var _ sql.Table = TableControlTable{}
+var _ sql.InsertableTable = TableControlTable{}
+var _ sql.ReplaceableTable = TableControlTable{}
+var _ sql.UpdatableTable = TableControlTable{}
+var _ sql.DeletableTable = TableControlTable{}
+var _ sql.RowInserter = TableControlTable{}
+var _ sql.RowReplacer = TableControlTable{}
+var _ sql.RowUpdater = TableControlTable{}
+var _ sql.RowDeleter = TableControlTable{}
+
+// NewTableControlTable returns a new TableControlTable.
+func NewTableControlTable(tableControl *branch_control.TableControl) TableControlTable {
+	return TableControlTable{tableControl}
+}
+
+// Name implements the interface sql.Table.
+func (tbl TableControlTable) Name() string {
+	return TableControlTableName
+}
+
+// String implements the interface sql.Table.
+func (tbl TableControlTable) String() string {
+	return TableControlTableName
+}
+
+// Schema implements the interface sql.Table.
+func (tbl TableControlTable) Schema() sql.Schema {
+	return tableControlSchema
+}
+
+// Collation implements the interface sql.Table.
+func (tbl TableControlTable) Collation() sql.CollationID {
+	return sql.Collation_Default
+}
+
+// Partitions implements the interface sql.Table.
+func (tbl TableControlTable) Partitions(context *sql.Context) (sql.PartitionIter, error) {
+	return index.SinglePartitionIterFromNomsMap(nil), nil
+}
+
+// PartitionRows implements the interface sql.Table.
+func (tbl TableControlTable) PartitionRows(context *sql.Context, partition sql.Partition) (sql.RowIter, error) {
+	tbl.RWMutex.RLock()
+	defer tbl.RWMutex.RUnlock()
+
+	var rows []sql.Row
+	for _, value := range tbl.Values {
+		var predicate interface{}
+		if len(value.Predicate) > 0 {
+			predicate = value.Predicate
+		}
+		rows = append(rows, sql.Row{
+			value.Database,
+			value.Branch,
+			value.User,
+			value.Host,
+			value.Table,
+			predicate,
+			uint64(value.Permissions >> 1),
+		})
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// Inserter implements the interface sql.InsertableTable.
+func (tbl TableControlTable) Inserter(context *sql.Context) sql.RowInserter {
+	return tbl
+}
+
+// Replacer implements the interface sql.ReplaceableTable.
+func (tbl TableControlTable) Replacer(ctx *sql.Context) sql.RowReplacer {
+	return tbl
+}
+
+// Updater implements the interface sql.UpdatableTable.
+func (tbl TableControlTable) Updater(ctx *sql.Context) sql.RowUpdater {
+	return tbl
+}
+
+// Deleter implements the interface sql.DeletableTable.
+func (tbl TableControlTable) Deleter(context *sql.Context) sql.RowDeleter {
+	return tbl
+}
+
+// StatementBegin implements the interface sql.TableEditor.
+func (tbl TableControlTable) StatementBegin(ctx *sql.Context) {}
+
+// DiscardChanges implements the interface sql.TableEditor.
+func (tbl TableControlTable) DiscardChanges(ctx *sql.Context, errorEncountered error) error {
+	return nil
+}
+
+// StatementComplete implements the interface sql.TableEditor.
+func (tbl TableControlTable) StatementComplete(ctx *sql.Context) error {
+	return nil
+}
+
+// Insert implements the interface sql.RowInserter.
+func (tbl TableControlTable) Insert(ctx *sql.Context, row sql.Row) error {
+	tbl.RWMutex.Lock()
+	defer tbl.RWMutex.Unlock()
+
+	value, err := tableControlValueFromRow(row)
+	if err != nil {
+		return err
+	}
+
+	// A nil session means we're not in the SQL context, so we allow the insertion in such a case
+	if branchAwareSession := branch_control.GetBranchAwareSession(ctx); branchAwareSession != nil &&
+		// Having the correct database privileges also allows the insertion
+		!branch_control.HasDatabasePrivileges(branchAwareSession, value.Database) {
+
+		// tbl.Access() shares a lock with the table control table. No need to acquire its lock.
+
+		insertUser := branchAwareSession.GetUser()
+		insertHost := branchAwareSession.GetHost()
+		// As we've folded the branch expression, we can use it directly as though it were a normal branch name to
+		// determine if the user attempting the insertion has permission to perform the insertion.
+		_, modPerms := tbl.Access().Match(value.Database, value.Branch, insertUser, insertHost)
+		if modPerms&branch_control.Permissions_Admin != branch_control.Permissions_Admin {
+			return branch_control.ErrInsertingTableControlRow.New(insertUser, insertHost, value.Database, value.Branch, value.User, value.Host, value.Table)
+		}
+	}
+
+	return tbl.insert(value)
+}
+
+// Update implements the interface sql.RowUpdater.
+func (tbl TableControlTable) Update(ctx *sql.Context, old sql.Row, new sql.Row) error {
+	tbl.RWMutex.Lock()
+	defer tbl.RWMutex.Unlock()
+
+	oldValue, err := tableControlValueFromRow(old)
+	if err != nil {
+		return err
+	}
+	newValue, err := tableControlValueFromRow(new)
+	if err != nil {
+		return err
+	}
+
+	// If we're not updating the same row, then we pre-emptively check for a row violation
+	if oldValue.Database != newValue.Database || oldValue.Branch != newValue.Branch || oldValue.User != newValue.User ||
+		oldValue.Host != newValue.Host || oldValue.Table != newValue.Table {
+		if tblIndex := tbl.GetIndex(newValue.Database, newValue.Branch, newValue.User, newValue.Host, newValue.Table); tblIndex != -1 {
+			return newTableControlUniqueKeyErr(newValue)
+		}
+	}
+
+	// A nil session means we're not in the SQL context, so we'd allow the update in such a case
+	if branchAwareSession := branch_control.GetBranchAwareSession(ctx); branchAwareSession != nil {
+		// tbl.Access() shares a lock with the table control table. No need to acquire its lock.
+
+		insertUser := branchAwareSession.GetUser()
+		insertHost := branchAwareSession.GetHost()
+		if !branch_control.HasDatabasePrivileges(branchAwareSession, oldValue.Database) {
+			// As we've folded the branch expression, we can use it directly as though it were a normal branch name to
+			// determine if the user attempting the update has permission to perform the update on the old branch name.
+			_, modPerms := tbl.Access().Match(oldValue.Database, oldValue.Branch, insertUser, insertHost)
+			if modPerms&branch_control.Permissions_Admin != branch_control.Permissions_Admin {
+				return branch_control.ErrUpdatingRow.New(insertUser, insertHost, oldValue.Database, oldValue.Branch, oldValue.User, oldValue.Host)
+			}
+		}
+		if !branch_control.HasDatabasePrivileges(branchAwareSession, newValue.Database) {
+			// Similar to the block above, we check if the user has permission to use the new branch name
+			_, modPerms := tbl.Access().Match(newValue.Database, newValue.Branch, insertUser, insertHost)
+			if modPerms&branch_control.Permissions_Admin != branch_control.Permissions_Admin {
+				return branch_control.ErrUpdatingToRow.New(insertUser, insertHost, oldValue.Database, oldValue.Branch,
+					oldValue.User, oldValue.Host, newValue.Database, newValue.Branch)
+			}
+		}
+	}
+
+	if tblIndex := tbl.GetIndex(oldValue.Database, oldValue.Branch, oldValue.User, oldValue.Host, oldValue.Table); tblIndex != -1 {
+		tbl.TableControl.Delete(tblIndex)
+	}
+	return tbl.insert(newValue)
+}
+
+// Delete implements the interface sql.RowDeleter.
+func (tbl TableControlTable) Delete(ctx *sql.Context, row sql.Row) error {
+	tbl.RWMutex.Lock()
+	defer tbl.RWMutex.Unlock()
+
+	value, err := tableControlValueFromRow(row)
+	if err != nil {
+		return err
+	}
+
+	// A nil session means we're not in the SQL context, so we allow the deletion in such a case
+	if branchAwareSession := branch_control.GetBranchAwareSession(ctx); branchAwareSession != nil &&
+		// Having the correct database privileges also allows the deletion
+		!branch_control.HasDatabasePrivileges(branchAwareSession, value.Database) {
+
+		// tbl.Access() shares a lock with the table control table. No need to acquire its lock.
+
+		insertUser := branchAwareSession.GetUser()
+		insertHost := branchAwareSession.GetHost()
+		// As we've folded the branch expression, we can use it directly as though it were a normal branch name to
+		// determine if the user attempting the deletion has permission to perform the deletion.
+		_, modPerms := tbl.Access().Match(value.Database, value.Branch, insertUser, insertHost)
+		if modPerms&branch_control.Permissions_Admin != branch_control.Permissions_Admin {
+			return branch_control.ErrDeletingRow.New(insertUser, insertHost, value.Database, value.Branch, value.User, value.Host)
+		}
+	}
+
+	if tblIndex := tbl.GetIndex(value.Database, value.Branch, value.User, value.Host, value.Table); tblIndex != -1 {
+		tbl.TableControl.Delete(tblIndex)
+	}
+	return nil
+}
+
+// Close implements the interface sql.Closer.
+func (tbl TableControlTable) Close(context *sql.Context) error {
+	return branch_control.SaveData(context)
+}
+
+// insert adds the given value to the table. Assumes that the expressions have already been folded.
+func (tbl TableControlTable) insert(value branch_control.TableControlValue) error {
+	// If we already have this in the table, then we return a duplicate PK error
+	if tblIndex := tbl.GetIndex(value.Database, value.Branch, value.User, value.Host, value.Table); tblIndex != -1 {
+		return newTableControlUniqueKeyErr(value)
+	}
+	tbl.TableControl.Insert(value)
+	return nil
+}
+
+// tableControlValueFromRow returns the folded and validated value represented by the given row.
+func tableControlValueFromRow(row sql.Row) (branch_control.TableControlValue, error) {
+	// Database, Branch, Host, and Table are case-insensitive, while User is case-sensitive
+	value := branch_control.TableControlValue{
+		Database:    strings.ToLower(branch_control.FoldExpression(row[0].(string))),
+		Branch:      strings.ToLower(branch_control.FoldExpression(row[1].(string))),
+		User:        branch_control.FoldExpression(row[2].(string)),
+		Host:        strings.ToLower(branch_control.FoldExpression(row[3].(string))),
+		Table:       branch_control.FoldTableExpression(row[4].(string)),
+		Permissions: branch_control.Permissions(row[6].(uint64) << 1),
+	}
+	if row[5] != nil {
+		value.Predicate = strings.TrimSpace(row[5].(string))
+	}
+
+	// Verify that the lengths of each expression fit within an uint16
+	if len(value.Database) > math.MaxUint16 || len(value.Branch) > math.MaxUint16 || len(value.User) > math.MaxUint16 ||
+		len(value.Host) > math.MaxUint16 || len(value.Table) > math.MaxUint16 {
+		return value, branch_control.ErrExpressionsTooLong.New(value.Database, value.Branch, value.User, value.Host)
+	}
+	// The predicate is resolved against each table when it is written to, so we may only validate its syntax here
+	if len(value.Predicate) > 0 {
+		if _, err := sqlparser.Parse("SELECT * FROM dual WHERE " + value.Predicate); err != nil {
+			return value, fmt.Errorf("invalid predicate `%s`: %w", value.Predicate, err)
+		}
+	}
+	return value, nil
+}
+
+// newTableControlUniqueKeyErr returns a duplicate primary key error for the given value.
+func newTableControlUniqueKeyErr(value branch_control.TableControlValue) error {
+	return sql.NewUniqueKeyErr(
+		fmt.Sprintf(`[%q, %q, %q, %q, %q]`, value.Database, value.Branch, value.User, value.Host, value.Table),
+		true,
+		sql.Row{value.Database, value.Branch, value.User, value.Host, value.Table})
+}
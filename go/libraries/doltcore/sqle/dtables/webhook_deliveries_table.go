@@ -0,0 +1,127 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
+)
+
+// WebhookDeliveriesTable is a read-only system table that shows the webhook notifications sent for branch updates
+// while running sql-server, and whether they were delivered. The deliveries are recorded in the database, see
+// doltdb.DoltDB.RecordWebhookDeliveries.
+type WebhookDeliveriesTable struct {
+	ddb       *doltdb.DoltDB
+	dbName    string
+	tableName string
+}
+
+var _ sql.Table = (*WebhookDeliveriesTable)(nil)
+
+// NewWebhookDeliveriesTable creates a WebhookDeliveriesTable
+func NewWebhookDeliveriesTable(_ *sql.Context, dbName, tableName string, ddb *doltdb.DoltDB) *WebhookDeliveriesTable {
+	return &WebhookDeliveriesTable{ddb: ddb, dbName: dbName, tableName: tableName}
+}
+
+// Name is a sql.Table interface function which returns the name of the table
+func (wt *WebhookDeliveriesTable) Name() string {
+	return wt.tableName
+}
+
+// String is a sql.Table interface function which returns the name of the table
+func (wt *WebhookDeliveriesTable) String() string {
+	return wt.tableName
+}
+
+// Schema is a sql.Table interface function that gets the sql.Schema of the webhook deliveries system table.
+func (wt *WebhookDeliveriesTable) Schema() sql.Schema {
+	return []*sql.Column{
+		{Name: "delivery_id", Type: types.Text, Source: wt.tableName, PrimaryKey: true, Nullable: false, DatabaseSource: wt.dbName},
+		{Name: "webhook", Type: types.Text, Source: wt.tableName, PrimaryKey: false, Nullable: false, DatabaseSource: wt.dbName},
+		{Name: "branch", Type: types.Text, Source: wt.tableName, PrimaryKey: false, Nullable: false, DatabaseSource: wt.dbName},
+		{Name: "old_commit", Type: types.Text, Source: wt.tableName, PrimaryKey: false, Nullable: true, DatabaseSource: wt.dbName},
+		{Name: "new_commit", Type: types.Text, Source: wt.tableName, PrimaryKey: false, Nullable: true, DatabaseSource: wt.dbName},
+		{Name: "status", Type: types.Text, Source: wt.tableName, PrimaryKey: false, Nullable: false, DatabaseSource: wt.dbName},
+		{Name: "attempts", Type: types.Int32, Source: wt.tableName, PrimaryKey: false, Nullable: false, DatabaseSource: wt.dbName},
+		{Name: "response_code", Type: types.Int32, Source: wt.tableName, PrimaryKey: false, Nullable: true, DatabaseSource: wt.dbName},
+		{Name: "error", Type: types.LongText, Source: wt.tableName, PrimaryKey: false, Nullable: true, DatabaseSource: wt.dbName},
+		{Name: "created_at", Type: types.Datetime, Source: wt.tableName, PrimaryKey: false, Nullable: false, DatabaseSource: wt.dbName},
+		{Name: "updated_at", Type: types.Datetime, Source: wt.tableName, PrimaryKey: false, Nullable: false, DatabaseSource: wt.dbName},
+	}
+}
+
+// Collation implements the sql.Table interface.
+func (wt *WebhookDeliveriesTable) Collation() sql.CollationID {
+	return sql.Collation_Default
+}
+
+// Partitions is a sql.Table interface function that returns a partition of the data. Currently the data is unpartitioned.
+func (wt *WebhookDeliveriesTable) Partitions(*sql.Context) (sql.PartitionIter, error) {
+	return index.SinglePartitionIterFromNomsMap(nil), nil
+}
+
+// PartitionRows is a sql.Table interface function that gets a row iterator for a partition
+func (wt *WebhookDeliveriesTable) PartitionRows(ctx *sql.Context, _ sql.Partition) (sql.RowIter, error) {
+	deliveries, err := wt.ddb.GetWebhookDeliveries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &webhookDeliveriesItr{deliveries: deliveries}, nil
+}
+
+type webhookDeliveriesItr struct {
+	deliveries doltdb.WebhookDeliveries
+	idx        int
+}
+
+var _ sql.RowIter = (*webhookDeliveriesItr)(nil)
+
+// Next retrieves the next row. It will return io.EOF if it's the last row.
+func (itr *webhookDeliveriesItr) Next(*sql.Context) (sql.Row, error) {
+	if itr.idx >= len(itr.deliveries) {
+		return nil, io.EOF
+	}
+	d := itr.deliveries[itr.idx]
+	itr.idx++
+
+	var oldCommit interface{}
+	if d.OldCommit != "" {
+		oldCommit = d.OldCommit
+	}
+	var newCommit interface{}
+	if d.NewCommit != "" {
+		newCommit = d.NewCommit
+	}
+	var responseCode interface{}
+	if d.ResponseCode != 0 {
+		responseCode = int32(d.ResponseCode)
+	}
+	var errStr interface{}
+	if d.Error != "" {
+		errStr = d.Error
+	}
+
+	return sql.NewRow(d.DeliveryId, d.Webhook, d.Branch, oldCommit, newCommit, d.Status, int32(d.Attempts), responseCode, errStr, d.CreatedAt, d.UpdatedAt), nil
+}
+
+// Close closes the iterator.
+func (itr *webhookDeliveriesItr) Close(*sql.Context) error {
+	return nil
+}
@@ -27,6 +27,8 @@ import (
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
 )
 
 // BranchControlTest is used to define a test using the branch control system. The root account is used with any queries
@@ -45,6 +47,9 @@ type BranchControlTestAssertion struct {
 	Expected       []sql.Row
 	ExpectedErr    *errors.Kind
 	ExpectedErrStr string
+	// SkipResultsCheck runs the query without checking its results, which is useful for queries that return commit
+	// hashes.
+	SkipResultsCheck bool
 }
 
 // BranchControlBlockTest are tests for quickly verifying that a command is blocked before the appropriate entry is
@@ -1361,6 +1366,565 @@ var BranchControlTests = []BranchControlTest{
 			},
 		},
 	},
+	{
+		Name: "Table control restricts tables and rows",
+		SetUpScript: []string{
+			"DELETE FROM dolt_branch_control WHERE user = '%';",
+			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'root', 'localhost', 'admin'), ('%', '%', 'contractor', 'localhost', 'write');",
+			"CREATE USER contractor@localhost;",
+			"GRANT ALL ON *.* TO contractor@localhost;",
+			"REVOKE SUPER ON *.* FROM contractor@localhost;",
+			"CREATE TABLE owned (pk BIGINT PRIMARY KEY, owner VARCHAR(20), v BIGINT);",
+			"CREATE TABLE other (pk BIGINT PRIMARY KEY);",
+			"INSERT INTO owned VALUES (1, 'someone', 1), (2, 'contractor', 2);",
+			"INSERT INTO dolt_table_control VALUES ('%', '%', 'contractor', 'localhost', 'OWNED', 'owner = ''contractor''', 'write');",
+		},
+		Assertions: []BranchControlTestAssertion{
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "SELECT * FROM dolt_table_control;",
+				Expected: []sql.Row{
+					{"%", "%", "contractor", "localhost", "owned", "owner = 'contractor'", "write"},
+				},
+			},
+			{ // Tables without a matching entry may not be modified
+				User:        "contractor",
+				Host:        "localhost",
+				Query:       "INSERT INTO other VALUES (1);",
+				ExpectedErr: branch_control.ErrTableAccess,
+			},
+			{
+				User:  "contractor",
+				Host:  "localhost",
+				Query: "INSERT INTO owned VALUES (3, 'contractor', 3);",
+				Expected: []sql.Row{
+					{types.NewOkResult(1)},
+				},
+			},
+			{
+				User:        "contractor",
+				Host:        "localhost",
+				Query:       "INSERT INTO owned VALUES (4, 'someone', 4);",
+				ExpectedErr: branch_control.ErrTableRowAccess,
+			},
+			{
+				User:  "contractor",
+				Host:  "localhost",
+				Query: "UPDATE owned SET v = v + 10 WHERE owner = 'contractor';",
+				Expected: []sql.Row{
+					{types.OkResult{RowsAffected: 2, Info: plan.UpdateInfo{Matched: 2, Updated: 2}}},
+				},
+			},
+			{ // Rows that do not satisfy the predicate may not be updated
+				User:        "contractor",
+				Host:        "localhost",
+				Query:       "UPDATE owned SET v = 5 WHERE pk = 1;",
+				ExpectedErr: branch_control.ErrTableRowAccess,
+			},
+			{ // Rows may not be updated so that they no longer satisfy the predicate
+				User:        "contractor",
+				Host:        "localhost",
+				Query:       "UPDATE owned SET owner = 'someone' WHERE pk = 2;",
+				ExpectedErr: branch_control.ErrTableRowAccess,
+			},
+			{
+				User:        "contractor",
+				Host:        "localhost",
+				Query:       "DELETE FROM owned WHERE pk = 1;",
+				ExpectedErr: branch_control.ErrTableRowAccess,
+			},
+			{
+				User:        "contractor",
+				Host:        "localhost",
+				Query:       "REPLACE INTO owned VALUES (1, 'contractor', 1);",
+				ExpectedErr: branch_control.ErrTableReplaceAccess,
+			},
+			{
+				User:        "contractor",
+				Host:        "localhost",
+				Query:       "TRUNCATE TABLE owned;",
+				ExpectedErr: branch_control.ErrTableReplaceAccess,
+			},
+			{
+				User:  "contractor",
+				Host:  "localhost",
+				Query: "DELETE FROM owned WHERE pk = 3;",
+				Expected: []sql.Row{
+					{types.NewOkResult(1)},
+				},
+			},
+			{
+				User:        "contractor",
+				Host:        "localhost",
+				Query:       "INSERT INTO dolt_table_control VALUES ('%', '%', 'contractor', 'localhost', '%', NULL, 'write');",
+				ExpectedErr: branch_control.ErrInsertingTableControlRow,
+			},
+			{ // A broader entry grants access to all other tables, while the longer match still applies to "owned"
+				User:  "root",
+				Host:  "localhost",
+				Query: "INSERT INTO dolt_table_control VALUES ('%', '%', 'contractor', 'localhost', '%', NULL, 'write');",
+				Expected: []sql.Row{
+					{types.NewOkResult(1)},
+				},
+			},
+			{
+				User:  "contractor",
+				Host:  "localhost",
+				Query: "INSERT INTO other VALUES (1);",
+				Expected: []sql.Row{
+					{types.NewOkResult(1)},
+				},
+			},
+			{
+				User:        "contractor",
+				Host:        "localhost",
+				Query:       "DELETE FROM owned WHERE pk = 1;",
+				ExpectedErr: branch_control.ErrTableRowAccess,
+			},
+			{ // Read permissions on a table forbid writes
+				User:  "root",
+				Host:  "localhost",
+				Query: "INSERT INTO dolt_table_control VALUES ('%', '%', 'contractor', 'localhost', 'other', NULL, 'read');",
+				Expected: []sql.Row{
+					{types.NewOkResult(1)},
+				},
+			},
+			{
+				User:        "contractor",
+				Host:        "localhost",
+				Query:       "INSERT INTO other VALUES (2);",
+				ExpectedErr: branch_control.ErrTableAccess,
+			},
+			{ // Branch admins are not restricted
+				User:  "root",
+				Host:  "localhost",
+				Query: "INSERT INTO dolt_table_control VALUES ('%', '%', 'root', 'localhost', 'owned', 'owner = ''root''', 'write');",
+				Expected: []sql.Row{
+					{types.NewOkResult(1)},
+				},
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "DELETE FROM owned WHERE pk = 1;",
+				Expected: []sql.Row{
+					{types.NewOkResult(1)},
+				},
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "SELECT * FROM owned ORDER BY pk;",
+				Expected: []sql.Row{
+					{2, "contractor", 12},
+				},
+			},
+		},
+	},
+	{
+		Name: "Table control restricts schema changes and procedures that change whole tables",
+		SetUpScript: []string{
+			"DELETE FROM dolt_branch_control WHERE user = '%';",
+			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'root', 'localhost', 'admin'), ('%', '%', 'contractor', 'localhost', 'write');",
+			"CREATE USER contractor@localhost;",
+			"GRANT ALL ON *.* TO contractor@localhost;",
+			"REVOKE SUPER ON *.* FROM contractor@localhost;",
+			"CREATE TABLE owned (pk BIGINT PRIMARY KEY, owner VARCHAR(20), v BIGINT);",
+			"CREATE TABLE other (pk BIGINT PRIMARY KEY);",
+			"CREATE TABLE hidden (pk BIGINT PRIMARY KEY);",
+			"INSERT INTO owned VALUES (1, 'someone', 1), (2, 'contractor', 2);",
+			"CALL dolt_commit('-Am', 'initial tables');",
+			"CALL dolt_checkout('-b', 'feature');",
+			"UPDATE owned SET v = 10 WHERE pk = 1;",
+			"CALL dolt_commit('-am', 'update a row owned by someone else');",
+			"CALL dolt_checkout('-b', 'ours_feature', 'main');",
+			"UPDATE owned SET v = 30 WHERE pk = 1;",
+			"CALL dolt_commit('-am', 'update a row owned by someone else again');",
+			"CALL dolt_checkout('-b', 'other_feature', 'main');",
+			"INSERT INTO other VALUES (1);",
+			"CALL dolt_commit('-am', 'insert into other');",
+			"CALL dolt_checkout('main');",
+			"INSERT INTO dolt_table_control VALUES ('%', '%', 'contractor', 'localhost', 'owned', 'owner = ''contractor''', 'write'), ('%', '%', 'contractor', 'localhost', 'other', NULL, 'write');",
+		},
+		Assertions: []BranchControlTestAssertion{
+			{
+				User:        "contractor",
+				Host:        "localhost",
+				Query:       "ALTER TABLE owned ADD COLUMN v2 BIGINT;",
+				ExpectedErr: branch_control.ErrTableRestrictedAccess,
+			},
+			{
+				User:        "contractor",
+				Host:        "localhost",
+				Query:       "ALTER TABLE owned MODIFY COLUMN v VARCHAR(20);",
+				ExpectedErr: branch_control.ErrTableRestrictedAccess,
+			},
+			{
+				User:        "contractor",
+				Host:        "localhost",
+				Query:       "ALTER TABLE owned DROP COLUMN v;",
+				ExpectedErr: branch_control.ErrTableRestrictedAccess,
+			},
+			{
+				User:        "contractor",
+				Host:        "localhost",
+				Query:       "CREATE INDEX idx_v ON owned (v);",
+				ExpectedErr: branch_control.ErrTableRestrictedAccess,
+			},
+			{
+				User:        "contractor",
+				Host:        "localhost",
+				Query:       "DROP TABLE owned;",
+				ExpectedErr: branch_control.ErrTableRestrictedAccess,
+			},
+			{
+				User:        "contractor",
+				Host:        "localhost",
+				Query:       "RENAME TABLE owned TO renamed;",
+				ExpectedErr: branch_control.ErrTableRestrictedAccess,
+			},
+			{
+				User:        "contractor",
+				Host:        "localhost",
+				Query:       "ALTER TABLE owned RENAME TO renamed;",
+				ExpectedErr: branch_control.ErrTableRestrictedAccess,
+			},
+			{ // Renaming onto a restricted name is also a change to that table
+				User:        "contractor",
+				Host:        "localhost",
+				Query:       "RENAME TABLE other TO owned;",
+				ExpectedErr: branch_control.ErrTableRestrictedAccess,
+			},
+			{ // Tables without a matching entry may not be changed either
+				User:        "contractor",
+				Host:        "localhost",
+				Query:       "DROP TABLE hidden;",
+				ExpectedErr: branch_control.ErrTableAccess,
+			},
+			{
+				User:        "contractor",
+				Host:        "localhost",
+				Query:       "ALTER TABLE hidden ADD COLUMN v BIGINT;",
+				ExpectedErr: branch_control.ErrTableAccess,
+			},
+			{
+				User:        "contractor",
+				Host:        "localhost",
+				Query:       "CALL dolt_merge('feature');",
+				ExpectedErr: branch_control.ErrTableRestrictedAccess,
+			},
+			{
+				User:        "contractor",
+				Host:        "localhost",
+				Query:       "CALL dolt_merge('--no-ff', 'feature');",
+				ExpectedErr: branch_control.ErrTableRestrictedAccess,
+			},
+			{
+				User:        "contractor",
+				Host:        "localhost",
+				Query:       "CALL dolt_cherry_pick('feature');",
+				ExpectedErr: branch_control.ErrTableRestrictedAccess,
+			},
+			{
+				User:        "contractor",
+				Host:        "localhost",
+				Query:       "CALL dolt_checkout('feature', '--', 'owned');",
+				ExpectedErr: branch_control.ErrTableRestrictedAccess,
+			},
+			{
+				User:  "contractor",
+				Host:  "localhost",
+				Query: "UPDATE owned SET v = 20 WHERE pk = 2;",
+				Expected: []sql.Row{
+					{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}},
+				},
+			},
+			{
+				User:        "contractor",
+				Host:        "localhost",
+				Query:       "CALL dolt_checkout('--', 'owned');",
+				ExpectedErr: branch_control.ErrTableRestrictedAccess,
+			},
+			{
+				User:        "contractor",
+				Host:        "localhost",
+				Query:       "CALL dolt_checkout('.');",
+				ExpectedErr: branch_control.ErrTableRestrictedAccess,
+			},
+			{
+				User:        "contractor",
+				Host:        "localhost",
+				Query:       "CALL dolt_reset('--hard');",
+				ExpectedErr: branch_control.ErrTableRestrictedAccess,
+			},
+			{
+				User:             "contractor",
+				Host:             "localhost",
+				Query:            "CALL dolt_commit('-am', 'update an owned row');",
+				SkipResultsCheck: true,
+			},
+			{
+				User:        "contractor",
+				Host:        "localhost",
+				Query:       "CALL dolt_merge('feature', 'other_feature');",
+				ExpectedErr: branch_control.ErrTableRestrictedAccess,
+			},
+			{
+				User:        "contractor",
+				Host:        "localhost",
+				Query:       "CALL dolt_revert('HEAD');",
+				ExpectedErr: branch_control.ErrTableRestrictedAccess,
+			},
+			{ // Merges that only change tables without row restrictions are allowed
+				User:             "contractor",
+				Host:             "localhost",
+				Query:            "CALL dolt_merge('other_feature', '--no-ff', '-m', 'merge other_feature');",
+				SkipResultsCheck: true,
+			},
+			{
+				User:  "contractor",
+				Host:  "localhost",
+				Query: "SELECT * FROM other;",
+				Expected: []sql.Row{
+					{1},
+				},
+			},
+			{ // Branch admins are not restricted
+				User:             "root",
+				Host:             "localhost",
+				Query:            "CALL dolt_merge('feature', '-m', 'merge feature');",
+				SkipResultsCheck: true,
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "SELECT * FROM owned ORDER BY pk;",
+				Expected: []sql.Row{
+					{1, "someone", 10},
+					{2, "contractor", 20},
+				},
+			},
+			{ // Merges with the ours strategy do not change any tables
+				User:             "contractor",
+				Host:             "localhost",
+				Query:            "CALL dolt_merge('-s', 'ours', 'ours_feature');",
+				SkipResultsCheck: true,
+			},
+			{
+				User:  "contractor",
+				Host:  "localhost",
+				Query: "SELECT * FROM owned ORDER BY pk;",
+				Expected: []sql.Row{
+					{1, "someone", 10},
+					{2, "contractor", 20},
+				},
+			},
+		},
+	},
+	{
+		Name: "Protected branches enforce their merge and force update rules",
+		SetUpScript: []string{
+			"DELETE FROM dolt_branch_control WHERE user = '%';",
+			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'root', 'localhost', 'admin'), ('%', '%', 'dev', 'localhost', 'write'), ('%', '%', 'reviewer', 'localhost', 'write');",
+			"CREATE USER dev@localhost;",
+			"GRANT ALL ON *.* TO dev@localhost;",
+			"REVOKE SUPER ON *.* FROM dev@localhost;",
+			"CREATE USER reviewer@localhost;",
+			"GRANT ALL ON *.* TO reviewer@localhost;",
+			"REVOKE SUPER ON *.* FROM reviewer@localhost;",
+			"CREATE TABLE t (pk BIGINT PRIMARY KEY);",
+			"CALL DOLT_COMMIT('-Am', 'init');",
+			"CALL DOLT_BRANCH('feature');",
+			"CALL DOLT_CHECKOUT('feature');",
+			"INSERT INTO t VALUES (1);",
+			"CALL DOLT_COMMIT('-Am', 'feature');",
+			"CALL DOLT_BRANCH('tested');",
+			"CALL DOLT_CHECKOUT('main');",
+			"INSERT INTO dolt_branch_protection (`database`, branch, fast_forward_only, required_approvals) VALUES ('%', 'MAIN', true, 1);",
+			"INSERT INTO dolt_branch_protection (`database`, branch, deny_force_push, required_workflow) VALUES ('%', 'tested', false, 'checks');",
+		},
+		Assertions: []BranchControlTestAssertion{
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "SELECT * FROM dolt_branch_protection ORDER BY branch;",
+				Expected: []sql.Row{
+					{"%", "main", 1, 1, uint32(1), nil},
+					{"%", "tested", 0, 0, uint32(0), "checks"},
+				},
+			},
+			{
+				User:        "dev",
+				Host:        "localhost",
+				Query:       "INSERT INTO dolt_branch_protection (`database`, branch) VALUES ('%', 'feature');",
+				ExpectedErr: branch_control.ErrModifyingProtectionRow,
+			},
+			{
+				User:        "dev",
+				Host:        "localhost",
+				Query:       "DELETE FROM dolt_branch_protection;",
+				ExpectedErr: branch_control.ErrModifyingProtectionRow,
+			},
+			{
+				User:        "dev",
+				Host:        "localhost",
+				Query:       "CALL DOLT_BRANCH('-f', 'main', 'feature');",
+				ExpectedErr: branch_control.ErrProtectedForceUpdate,
+			},
+			{
+				User:        "dev",
+				Host:        "localhost",
+				Query:       "CALL DOLT_BRANCH('-c', '-f', 'feature', 'main');",
+				ExpectedErr: branch_control.ErrProtectedForceUpdate,
+			},
+			{
+				User:        "dev",
+				Host:        "localhost",
+				Query:       "CALL DOLT_MERGE('feature');",
+				ExpectedErr: branch_control.ErrProtectedApprovals,
+			},
+			{ // Users may only record approvals for themselves
+				User:        "dev",
+				Host:        "localhost",
+				Query:       "INSERT INTO dolt_merge_approvals VALUES ('mydb', 'main', HASHOF('feature'), 'reviewer');",
+				ExpectedErr: branch_control.ErrModifyingApprovalRow,
+			},
+			{
+				User:  "dev",
+				Host:  "localhost",
+				Query: "INSERT INTO dolt_merge_approvals VALUES ('mydb', 'main', HASHOF('feature'), 'dev');",
+				Expected: []sql.Row{
+					{types.NewOkResult(1)},
+				},
+			},
+			{ // Approving your own merge does not count
+				User:        "dev",
+				Host:        "localhost",
+				Query:       "CALL DOLT_MERGE('feature');",
+				ExpectedErr: branch_control.ErrProtectedApprovals,
+			},
+			{
+				User:  "reviewer",
+				Host:  "localhost",
+				Query: "INSERT INTO dolt_merge_approvals VALUES ('mydb', 'main', HASHOF('feature'), 'reviewer');",
+				Expected: []sql.Row{
+					{types.NewOkResult(1)},
+				},
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "SELECT branch, user FROM dolt_merge_approvals ORDER BY user;",
+				Expected: []sql.Row{
+					{"main", "dev"},
+					{"main", "reviewer"},
+				},
+			},
+			{
+				User:        "dev",
+				Host:        "localhost",
+				Query:       "CALL DOLT_MERGE('--no-ff', 'feature');",
+				ExpectedErr: branch_control.ErrProtectedFastForward,
+			},
+			{
+				User:             "dev",
+				Host:             "localhost",
+				Query:            "CALL DOLT_MERGE('feature');",
+				SkipResultsCheck: true,
+			},
+			{
+				User:  "dev",
+				Host:  "localhost",
+				Query: "SELECT * FROM t;",
+				Expected: []sql.Row{
+					{1},
+				},
+			},
+			{
+				User:        "dev",
+				Host:        "localhost",
+				Query:       "CALL DOLT_RESET('--hard', 'HEAD~1');",
+				ExpectedErr: branch_control.ErrProtectedForceUpdate,
+			},
+			{ // Resetting without moving the branch is allowed
+				User:  "dev",
+				Host:  "localhost",
+				Query: "CALL DOLT_RESET('--hard');",
+				Expected: []sql.Row{
+					{0},
+				},
+			},
+			{ // Protected branches only accept changes through merges
+				User:        "dev",
+				Host:        "localhost",
+				Query:       "CALL DOLT_COMMIT('--allow-empty', '-m', 'direct commit');",
+				ExpectedErr: branch_control.ErrProtectedDirectCommit,
+			},
+			{
+				User:        "dev",
+				Host:        "localhost",
+				Query:       "CALL DOLT_REVERT('HEAD');",
+				ExpectedErr: branch_control.ErrProtectedDirectCommit,
+			},
+			{
+				User:        "dev",
+				Host:        "localhost",
+				Query:       "CALL DOLT_CHERRY_PICK('tested');",
+				ExpectedErr: branch_control.ErrProtectedDirectCommit,
+			},
+			{
+				User:        "dev",
+				Host:        "localhost",
+				Query:       "CALL DOLT_BRANCH('-D', 'main');",
+				ExpectedErr: branch_control.ErrProtectedDelete,
+			},
+			{
+				User:        "dev",
+				Host:        "localhost",
+				Query:       "CALL DOLT_BRANCH('-m', '-f', 'main', 'renamed');",
+				ExpectedErr: branch_control.ErrProtectedDelete,
+			},
+			{
+				User:  "dev",
+				Host:  "localhost",
+				Query: "CALL DOLT_CHECKOUT('tested');",
+				Expected: []sql.Row{
+					{0, "Switched to branch 'tested'"},
+				},
+			},
+			{ // Force updates are allowed when the rules permit them
+				User:  "dev",
+				Host:  "localhost",
+				Query: "CALL DOLT_RESET('--hard', 'HEAD~1');",
+				Expected: []sql.Row{
+					{0},
+				},
+			},
+			{ // No dolt_ci workflow runs are recorded, so the required workflow has not passed
+				User:        "dev",
+				Host:        "localhost",
+				Query:       "CALL DOLT_MERGE('main');",
+				ExpectedErr: branch_control.ErrProtectedWorkflowFailed,
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "DELETE FROM dolt_branch_protection WHERE branch = 'main';",
+				Expected: []sql.Row{
+					{types.NewOkResult(1)},
+				},
+			},
+			{
+				User:  "dev",
+				Host:  "localhost",
+				Query: "CALL DOLT_BRANCH('-f', 'main', 'tested');",
+				Expected: []sql.Row{
+					{0},
+				},
+			},
+		},
+	},
 }
 
 func TestBranchControl(t *testing.T) {
@@ -1406,6 +1970,10 @@ func TestBranchControl(t *testing.T) {
 					t.Run(assertion.Query, func(t *testing.T) {
 						enginetest.AssertErrWithCtx(t, engine, harness, ctx, assertion.Query, nil, nil, assertion.ExpectedErrStr)
 					})
+				} else if assertion.SkipResultsCheck {
+					t.Run(assertion.Query, func(t *testing.T) {
+						enginetest.RunQueryWithContext(t, engine, harness, ctx, assertion.Query)
+					})
 				} else {
 					t.Run(assertion.Query, func(t *testing.T) {
 						enginetest.TestQueryWithContext(t, ctx, engine, harness, assertion.Query, assertion.Expected, nil, nil, nil)
@@ -1508,3 +2076,62 @@ func TestBranchControlBlocks(t *testing.T) {
 		})
 	}
 }
+
+// TestProtectedBranchRequiredWorkflow checks that merges into a branch which requires a workflow to pass are allowed
+// once a passing run of the workflow on the merged commit is recorded in the database.
+func TestProtectedBranchRequiredWorkflow(t *testing.T) {
+	harness := newDoltHarness(t)
+	defer harness.Close()
+	engine, err := harness.NewEngine(t)
+	require.NoError(t, err)
+	defer engine.Close()
+
+	ctx := enginetest.NewContext(harness)
+	ctx.NewCtxWithClient(sql.Client{
+		User:    "root",
+		Address: "localhost",
+	})
+	engine.EngineAnalyzer().Catalog.MySQLDb.AddRootAccount()
+	engine.EngineAnalyzer().Catalog.MySQLDb.SetPersister(&mysql_db.NoopPersister{})
+
+	for _, statement := range []string{
+		"CREATE USER dev@localhost;",
+		"GRANT ALL ON *.* TO dev@localhost;",
+		"REVOKE SUPER ON *.* FROM dev@localhost;",
+		"CREATE TABLE t (pk BIGINT PRIMARY KEY);",
+		"CALL DOLT_COMMIT('-Am', 'init');",
+		"CALL DOLT_CHECKOUT('-b', 'feature');",
+		"INSERT INTO t VALUES (1);",
+		"CALL DOLT_COMMIT('-Am', 'feature');",
+		"CALL DOLT_CHECKOUT('main');",
+		"INSERT INTO dolt_branch_protection (`database`, branch, required_workflow) VALUES ('%', 'main', 'checks');",
+	} {
+		enginetest.RunQueryWithContext(t, engine, harness, ctx, statement)
+	}
+
+	_, iter, _, err := engine.Query(ctx, "SELECT HASHOF('feature');")
+	require.NoError(t, err)
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(t, err)
+	featureHead := rows[0][0].(string)
+
+	db, ok := dsess.DSessFromSess(ctx.Session).Provider().BaseDatabase(ctx, "mydb")
+	require.True(t, ok)
+	ddb := db.DbData().Ddb
+
+	devCtx := ctx.NewCtxWithClient(sql.Client{
+		User:    "dev",
+		Address: "localhost",
+	})
+	enginetest.AssertErrWithCtx(t, engine, harness, devCtx, "CALL DOLT_MERGE('feature');", nil, branch_control.ErrProtectedWorkflowFailed)
+
+	// a failing run, or a passing run of another commit, does not satisfy the rule
+	require.NoError(t, ddb.AddCIRun(ctx, doltdb.CIRun{RunId: "1", Workflow: "checks", Branch: "feature", Commit: featureHead, Status: doltdb.CIRunStatusFailed}))
+	require.NoError(t, ddb.AddCIRun(ctx, doltdb.CIRun{RunId: "2", Workflow: "checks", Branch: "main", Commit: "0123456789abcdefghijklmnopqrstuv", Status: doltdb.CIRunStatusPassed}))
+	enginetest.AssertErrWithCtx(t, engine, harness, devCtx, "CALL DOLT_MERGE('feature');", nil, branch_control.ErrProtectedWorkflowFailed)
+
+	require.NoError(t, ddb.AddCIRun(ctx, doltdb.CIRun{RunId: "3", Workflow: "Checks", Branch: "feature", Commit: featureHead, Status: doltdb.CIRunStatusPassed}))
+	enginetest.TestQueryWithContext(t, devCtx, engine, harness, "SELECT status FROM dolt_ci_runs ORDER BY run_id;", []sql.Row{{"failed"}, {"passed"}, {"passed"}}, nil, nil, nil)
+	enginetest.RunQueryWithContext(t, engine, harness, devCtx, "CALL DOLT_MERGE('feature');")
+	enginetest.TestQueryWithContext(t, devCtx, engine, harness, "SELECT * FROM t;", []sql.Row{{1}}, nil, nil, nil)
+}
@@ -1217,6 +1217,11 @@ func TestDoltMerge(t *testing.T) {
 	RunDoltMergeTests(t, h)
 }
 
+func TestDoltMergeRules(t *testing.T) {
+	h := newDoltEnginetestHarness(t)
+	RunDoltMergeRulesTests(t, h)
+}
+
 func TestDoltMergePrepared(t *testing.T) {
 	h := newDoltEnginetestHarness(t)
 	RunDoltMergePreparedTests(t, h)
@@ -1536,6 +1541,16 @@ func TestSchemaDiffTableFunctionPrepared(t *testing.T) {
 	RunSchemaDiffTableFunctionTestsPrepared(t, harness)
 }
 
+func TestBlameTableFunction(t *testing.T) {
+	harness := newDoltEnginetestHarness(t)
+	RunBlameTableFunctionTests(t, harness)
+}
+
+func TestBlameTableFunctionPrepared(t *testing.T) {
+	harness := newDoltEnginetestHarness(t)
+	RunBlameTableFunctionTestsPrepared(t, harness)
+}
+
 func TestDoltDatabaseCollationDiffs(t *testing.T) {
 	harness := newDoltEnginetestHarness(t)
 	RunDoltDatabaseCollationDiffsTests(t, harness)
@@ -828,6 +828,19 @@ func RunDoltMergeTests(t *testing.T, h DoltEnginetestHarness) {
 	}
 }
 
+func RunDoltMergeRulesTests(t *testing.T, h DoltEnginetestHarness) {
+	if !types.IsFormat_DOLT(types.Format_Default) {
+		t.Skip()
+	}
+	for _, script := range MergeRulesScripts {
+		func() {
+			h := h.NewHarness(t)
+			defer h.Close()
+			enginetest.TestScript(t, h, script)
+		}()
+	}
+}
+
 func RunDoltMergePreparedTests(t *testing.T, h DoltEnginetestHarness) {
 	for _, script := range MergeScripts {
 		// harness can't reset effectively when there are new commits / branches created, so use a new harness for
@@ -1347,6 +1360,28 @@ func RunDoltDatabaseCollationDiffsTests(t *testing.T, harness DoltEnginetestHarn
 	}
 }
 
+func RunBlameTableFunctionTests(t *testing.T, harness DoltEnginetestHarness) {
+	for _, test := range BlameTableFunctionScriptTests {
+		t.Run(test.Name, func(t *testing.T) {
+			harness = harness.NewHarness(t)
+			defer harness.Close()
+			harness.Setup(setup.MydbData)
+			enginetest.TestScript(t, harness, test)
+		})
+	}
+}
+
+func RunBlameTableFunctionTestsPrepared(t *testing.T, harness DoltEnginetestHarness) {
+	for _, test := range BlameTableFunctionScriptTests {
+		t.Run(test.Name, func(t *testing.T) {
+			harness = harness.NewHarness(t)
+			defer harness.Close()
+			harness.Setup(setup.MydbData)
+			enginetest.TestScriptPrepared(t, harness, test)
+		})
+	}
+}
+
 func RunQueryDiffTests(t *testing.T, harness DoltEnginetestHarness) {
 	for _, test := range QueryDiffTableScriptTests {
 		t.Run(test.Name, func(t *testing.T) {
@@ -274,6 +274,7 @@ func (d *DoltHarness) NewEngine(t *testing.T) (enginetest.QueryEngine, error) {
 		}
 		e.Analyzer.ExecBuilder = rowexec.NewOverrideBuilder(kvexec.Builder{})
 		d.engine = e
+		doltProvider.SetQueryRunner(e.Query)
 
 		sqlCtx := enginetest.NewContext(d)
 		databases := pro.AllDatabases(sqlCtx)
@@ -476,6 +477,7 @@ func (d *DoltHarness) NewDatabases(names ...string) []sql.Database {
 	e := enginetest.NewEngineWithProvider(d.t, d, d.provider)
 	require.NoError(d.t, err)
 	d.engine = e
+	doltProvider.SetQueryRunner(e.Query)
 
 	for _, name := range names {
 		err := d.provider.CreateDatabase(enginetest.NewContext(d), name)
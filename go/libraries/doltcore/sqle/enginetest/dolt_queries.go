@@ -7743,3 +7743,260 @@ var DoltTempTableScripts = []queries.ScriptTest{
 		},
 	},
 }
+
+var BlameTableFunctionScriptTests = []queries.ScriptTest{
+	{
+		Name: "invalid arguments",
+		SetUpScript: []string{
+			"create table t (pk int primary key, c1 varchar(20));",
+			"call dolt_commit('-Am', 'creating table t');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:       "SELECT * from dolt_blame();",
+				ExpectedErr: sql.ErrInvalidArgumentNumber,
+			},
+			{
+				Query:       "SELECT * from dolt_blame('HEAD', 't', 'extra');",
+				ExpectedErr: sql.ErrInvalidArgumentNumber,
+			},
+			{
+				Query:       "SELECT * from dolt_blame(123);",
+				ExpectedErr: sql.ErrInvalidArgumentDetails,
+			},
+			{
+				Query:       "SELECT * from dolt_blame('doesnotexist');",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+			{
+				Query:          "SELECT * from dolt_blame('fake-branch', 't');",
+				ExpectedErrStr: "branch not found: fake-branch",
+			},
+		},
+	},
+	{
+		Name: "blames each column of each row",
+		SetUpScript: []string{
+			"create table t (pk int primary key, name varchar(20), price decimal(10,2));",
+			"insert into t values (1, 'one', 1.00), (2, 'two', 2.00);",
+			"call dolt_commit('-Am', 'creating table t');",
+			"update t set price = 9.99 where pk = 1;",
+			"call dolt_commit('-am', 'updating price of 1');",
+			"alter table t add column qty int;",
+			"call dolt_commit('-am', 'adding qty');",
+			"update t set name = 'TWO', qty = 5 where pk = 2;",
+			"insert into t values (3, 'three', 3.00, 3);",
+			"call dolt_commit('-am', 'updating 2 and adding 3');",
+			"update t set price = 0 where pk = 3;",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "SELECT pk, column_name, message FROM dolt_blame('t');",
+				Expected: []sql.Row{
+					{1, "pk", "creating table t"},
+					{1, "name", "creating table t"},
+					{1, "price", "updating price of 1"},
+					{1, "qty", "adding qty"},
+					{2, "pk", "creating table t"},
+					{2, "name", "updating 2 and adding 3"},
+					{2, "price", "creating table t"},
+					{2, "qty", "updating 2 and adding 3"},
+					{3, "pk", "updating 2 and adding 3"},
+					{3, "name", "updating 2 and adding 3"},
+					{3, "price", "updating 2 and adding 3"},
+					{3, "qty", "updating 2 and adding 3"},
+				},
+			},
+			{
+				Query: "SELECT pk, column_name, message FROM dolt_blame('HEAD~1', 't');",
+				Expected: []sql.Row{
+					{1, "pk", "creating table t"},
+					{1, "name", "creating table t"},
+					{1, "price", "updating price of 1"},
+					{1, "qty", "adding qty"},
+					{2, "pk", "creating table t"},
+					{2, "name", "creating table t"},
+					{2, "price", "creating table t"},
+					{2, "qty", "adding qty"},
+				},
+			},
+			{
+				Query:    "SELECT count(*) FROM dolt_blame('t') b JOIN dolt_log l ON b.commit = l.commit_hash AND b.committer = l.committer AND b.commit_date = l.date;",
+				Expected: []sql.Row{{12}},
+			},
+			{
+				Query: "SELECT pk, message FROM dolt_blame('t') WHERE column_name = 'price' AND message <> 'creating table t';",
+				Expected: []sql.Row{
+					{1, "updating price of 1"},
+					{3, "updating 2 and adding 3"},
+				},
+			},
+		},
+	},
+	{
+		Name: "blames cells through every parent of merge commits",
+		SetUpScript: []string{
+			"create table t (pk int primary key, c1 int, c2 int);",
+			"insert into t values (1, 1, 1), (2, 2, 2);",
+			"call dolt_commit('-Am', 'creating table t');",
+			"call dolt_checkout('-b', 'feature');",
+			"update t set c1 = 10 where pk = 1;",
+			"insert into t values (3, 3, 3);",
+			"call dolt_commit('-am', 'changes on feature');",
+			"call dolt_checkout('main');",
+			"update t set c2 = 20 where pk = 2;",
+			"call dolt_commit('-am', 'changes on main');",
+			"call dolt_merge('feature', '--no-ff', '-m', 'merging feature');",
+			"call dolt_checkout('-b', 'other', 'HEAD~1');",
+			"update t set c2 = 21 where pk = 2;",
+			"call dolt_commit('-am', 'changes on other');",
+			"call dolt_checkout('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "SELECT pk, column_name, message FROM dolt_blame('t');",
+				Expected: []sql.Row{
+					{1, "pk", "creating table t"},
+					{1, "c1", "changes on feature"},
+					{1, "c2", "creating table t"},
+					{2, "pk", "creating table t"},
+					{2, "c1", "creating table t"},
+					{2, "c2", "changes on main"},
+					{3, "pk", "changes on feature"},
+					{3, "c1", "changes on feature"},
+					{3, "c2", "changes on feature"},
+				},
+			},
+			{
+				Query:            "call dolt_merge('other', '--no-commit');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:            "update t set c1 = 30 where pk = 3;",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:            "call dolt_commit('-am', 'merging other');",
+				SkipResultsCheck: true,
+			},
+			{
+				// cells are blamed on the parent holding the same value, and on the merge when no parent does
+				Query: "SELECT pk, column_name, message FROM dolt_blame('t') WHERE pk > 1;",
+				Expected: []sql.Row{
+					{2, "pk", "creating table t"},
+					{2, "c1", "creating table t"},
+					{2, "c2", "changes on other"},
+					{3, "pk", "changes on feature"},
+					{3, "c1", "merging other"},
+					{3, "c2", "changes on feature"},
+				},
+			},
+		},
+	},
+	{
+		Name: "blames tables larger than a batch of rows",
+		SetUpScript: []string{
+			"create table d (n int primary key);",
+			"insert into d values (0), (1), (2), (3), (4), (5), (6), (7), (8), (9);",
+			"create table big (pk int primary key, c int);",
+			"insert into big select a.n * 1000 + b.n * 100 + c.n * 10 + e.n, 0 from d a, d b, d c, d e;",
+			"call dolt_commit('-Am', 'creating table big');",
+			"update big set c = 1 where pk in (0, 4095, 4096, 9999);",
+			"call dolt_commit('-am', 'updating big');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "SELECT count(*), count(distinct pk) FROM dolt_blame('big');",
+				Expected: []sql.Row{{20000, 10000}},
+			},
+			{
+				Query:    "SELECT pk, column_name FROM dolt_blame('big') WHERE message = 'updating big';",
+				Expected: []sql.Row{{0, "c"}, {4095, "c"}, {4096, "c"}, {9999, "c"}},
+			},
+		},
+	},
+	{
+		Name: "blames cells across primary key changes and table drops",
+		SetUpScript: []string{
+			"create table t (pk int primary key, c1 int);",
+			"insert into t values (1, 1);",
+			"call dolt_commit('-Am', 'creating table t');",
+			"alter table t drop primary key;",
+			"alter table t add primary key (pk, c1);",
+			"call dolt_commit('-am', 'changing primary key');",
+			"create table u (pk int primary key, c1 int);",
+			"insert into u values (1, 1);",
+			"call dolt_commit('-Am', 'creating table u');",
+			"drop table u;",
+			"call dolt_commit('-Am', 'dropping table u');",
+			"create table u (pk int primary key, c1 int);",
+			"insert into u values (1, 1);",
+			"call dolt_commit('-Am', 'recreating table u');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "SELECT pk, c1, column_name, message FROM dolt_blame('t');",
+				Expected: []sql.Row{
+					{1, 1, "pk", "changing primary key"},
+					{1, 1, "c1", "changing primary key"},
+				},
+			},
+			{
+				Query: "SELECT pk, column_name, message FROM dolt_blame('U');",
+				Expected: []sql.Row{
+					{1, "pk", "recreating table u"},
+					{1, "c1", "recreating table u"},
+				},
+			},
+		},
+	},
+	{
+		Name: "blames keyless tables by row hash",
+		SetUpScript: []string{
+			"create table k (a int, b varchar(10));",
+			"insert into k values (1, 'x'), (2, 'y');",
+			"call dolt_commit('-Am', 'creating table k');",
+			"insert into k values (3, 'z');",
+			"call dolt_commit('-am', 'adding z');",
+			"update k set b = 'Y' where a = 2;",
+			"call dolt_commit('-am', 'updating y');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "SELECT column_name, message FROM dolt_blame('k') ORDER BY message, column_name;",
+				Expected: []sql.Row{
+					{"a", "adding z"},
+					{"b", "adding z"},
+					{"a", "creating table k"},
+					{"b", "creating table k"},
+					{"a", "updating y"},
+					{"b", "updating y"},
+				},
+			},
+			{
+				Query:    "SELECT count(distinct row_hash), count(*) FROM dolt_blame('k');",
+				Expected: []sql.Row{{3, 6}},
+			},
+			{
+				Query:    "SELECT message FROM dolt_blame_k ORDER BY message;",
+				Expected: []sql.Row{{"adding z"}, {"creating table k"}, {"updating y"}},
+			},
+			{
+				Query:    "SELECT count(*) FROM dolt_blame_k b JOIN dolt_blame('k') f ON b.row_hash = f.row_hash AND b.commit = f.commit;",
+				Expected: []sql.Row{{6}},
+			},
+			{
+				Query:    "SELECT message FROM dolt_blame('HEAD~1', 'k') WHERE column_name = 'a' ORDER BY message;",
+				Expected: []sql.Row{{"adding z"}, {"creating table k"}, {"creating table k"}},
+			},
+			{
+				Query:    "SELECT message FROM dolt_blame_k AS OF 'HEAD~1' ORDER BY message;",
+				Expected: []sql.Row{{"adding z"}, {"creating table k"}, {"creating table k"}},
+			},
+			{
+				Query:    "SELECT count(*) FROM dolt_blame_k AS OF 'HEAD~2';",
+				Expected: []sql.Row{{2}},
+			},
+		},
+	},
+}
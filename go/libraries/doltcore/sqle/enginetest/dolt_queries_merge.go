@@ -2528,6 +2528,248 @@ var MergeScripts = []queries.ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "dolt_merge() with multiple branches",
+		SetUpScript: []string{
+			"create table t (pk int primary key, c int);",
+			"insert into t values (1, 1);",
+			"call dolt_commit('-Am', 'setup');",
+			"call dolt_checkout('-b', 'b1');",
+			"insert into t values (11, 1);",
+			"call dolt_commit('-am', 'b1');",
+			"call dolt_checkout('-b', 'b2', 'main');",
+			"insert into t values (12, 2);",
+			"call dolt_commit('-am', 'b2');",
+			"call dolt_checkout('-b', 'b3', 'b2');",
+			"update t set c = 20 where pk = 12;",
+			"call dolt_commit('-am', 'b3');",
+			"call dolt_checkout('-b', 'c1', 'main');",
+			"update t set c = 10 where pk = 1;",
+			"call dolt_commit('-am', 'c1');",
+			"call dolt_checkout('-b', 'c2', 'main');",
+			"update t set c = 20 where pk = 1;",
+			"call dolt_commit('-am', 'c2');",
+			"call dolt_checkout('main');",
+			"update t set c = 100 where pk = 1;",
+			"call dolt_commit('-am', 'main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "call dolt_merge('--no-commit', 'b1', 'b2');",
+				ExpectedErrStr: "error: flag '--no-commit' is not supported when merging multiple branches or using a merge strategy",
+			},
+			{
+				Query:          "call dolt_merge('b1', 'c1');",
+				ExpectedErrStr: "merging c1 produced conflicts or constraint violations; merges of multiple branches must be free of conflicts, merge the branches one at a time to resolve them",
+			},
+			{
+				Query:    "select message from dolt_log limit 1;",
+				Expected: []sql.Row{{"main"}},
+			},
+			{
+				Query:    "select * from dolt_status;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "call dolt_merge('b1', 'b2', 'b3');",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+			},
+			{
+				Query:    "select message from dolt_log limit 1;",
+				Expected: []sql.Row{{"Merge branches 'b1', 'b2' and 'b3' into main"}},
+			},
+			{
+				Query: "select parent_hash, parent_index from dolt_commit_ancestors where commit_hash = hashof('HEAD') order by parent_index;",
+				Expected: []sql.Row{
+					{doltCommit, 0},
+					{doltCommit, 1},
+					{doltCommit, 2},
+					{doltCommit, 3},
+				},
+			},
+			{
+				Query:    "select hashof('HEAD^2') = hashof('b1'), hashof('HEAD^3') = hashof('b2'), hashof('HEAD^4') = hashof('b3');",
+				Expected: []sql.Row{{true, true, true}},
+			},
+			{
+				Query:    "select * from t;",
+				Expected: []sql.Row{{1, 100}, {11, 1}, {12, 20}},
+			},
+			{
+				Query:    "call dolt_merge('b1', 'b2');",
+				Expected: []sql.Row{{"", 0, 0, "Everything up-to-date"}},
+			},
+		},
+	},
+	{
+		Name: "dolt_merge() with the ours strategy",
+		SetUpScript: []string{
+			"create table t (pk int primary key, c int);",
+			"insert into t values (1, 1);",
+			"call dolt_commit('-Am', 'setup');",
+			"call dolt_checkout('-b', 'b1');",
+			"insert into t values (11, 1);",
+			"call dolt_commit('-am', 'b1');",
+			"call dolt_checkout('-b', 'b2', 'main');",
+			"update t set c = 2 where pk = 1;",
+			"call dolt_commit('-am', 'b2');",
+			"call dolt_checkout('main');",
+			"update t set c = 100 where pk = 1;",
+			"call dolt_commit('-am', 'main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "call dolt_merge('-s', 'theirs', 'b1');",
+				ExpectedErrStr: "error: unsupported merge strategy 'theirs'; the only supported strategy is 'ours'",
+			},
+			{
+				Query:    "call dolt_merge('-s', 'ours', 'b1');",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+			},
+			{
+				Query:    "select message from dolt_log limit 1;",
+				Expected: []sql.Row{{"Merge branch 'b1' into main"}},
+			},
+			{
+				Query:    "select hashof('HEAD^2') = hashof('b1');",
+				Expected: []sql.Row{{true}},
+			},
+			{
+				Query:    "select * from t;",
+				Expected: []sql.Row{{1, 100}},
+			},
+			{
+				Query:    "call dolt_merge('--strategy', 'ours', '-m', 'supersede b1 and b2', 'b1', 'b2');",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+			},
+			{
+				Query:    "select message from dolt_log limit 1;",
+				Expected: []sql.Row{{"supersede b1 and b2"}},
+			},
+			{
+				Query:    "select count(*) from dolt_commit_ancestors where commit_hash = hashof('HEAD');",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "select * from t;",
+				Expected: []sql.Row{{1, 100}},
+			},
+			{
+				// The branch is merged now, so a regular merge doesn't take its changes either
+				Query:    "call dolt_merge('b2');",
+				Expected: []sql.Row{{"", 0, 0, "cannot fast forward from a to b. a is ahead of b already"}},
+			},
+		},
+	},
+}
+
+var MergeRulesScripts = []queries.ScriptTest{
+	{
+		Name: "merge rules resolve concurrent modifications",
+		SetUpScript: []string{
+			"create table t (pk int primary key, c int, mx int, mn int, o varchar(10), th varchar(10), tags set('a','b','c','d'), v varchar(10), updated_at datetime)",
+			"insert into t values (1, 10, 5, 5, 'base', 'base', 'a,b', 'base', '2020-01-01')",
+			"insert into dolt_merge_rules values ('t', 'c', 'sum', null), ('t', 'mx', 'max', null), ('T', 'MN', 'min', null), ('t', 'o', 'ours', null), ('t', 'th', 'theirs', null), ('t', 'tags', 'union', null), ('t', 'v', 'latest', 'updated_at'), ('t', 'updated_at', 'max', null)",
+			"call dolt_commit('-Am', 'base')",
+			"call dolt_branch('other')",
+			"update t set c = c + 3, mx = 7, mn = 3, o = 'left', th = 'left', tags = 'a,c', v = 'left', updated_at = '2021-01-01'",
+			"call dolt_commit('-am', 'left')",
+			"call dolt_checkout('other')",
+			"update t set c = c + 4, mx = 6, mn = 4, o = 'right', th = 'right', tags = 'b,d', v = 'right', updated_at = '2022-01-01'",
+			"call dolt_commit('-am', 'right')",
+			"call dolt_checkout('main')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_merge('other')",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+			},
+			{
+				Query:    "select pk, c, mx, mn, o, th, tags, v from t",
+				Expected: []sql.Row{{1, 17, 7, 3, "left", "right", "c,d", "right"}},
+			},
+		},
+	},
+	{
+		Name: "merge rules for all columns of a table",
+		SetUpScript: []string{
+			"create table t (pk int primary key, a varchar(10), b varchar(10))",
+			"insert into t values (1, 'base', 'base'), (2, 'base', 'base')",
+			"insert into dolt_merge_rules values ('t', '*', 'theirs', null), ('t', 'b', 'ours', null)",
+			"call dolt_commit('-Am', 'base')",
+			"call dolt_branch('other')",
+			"update t set a = 'left', b = 'left'",
+			"insert into t values (3, 'left', 'left')",
+			"call dolt_commit('-am', 'left')",
+			"call dolt_checkout('other')",
+			"update t set a = 'right', b = 'right'",
+			"insert into t values (3, 'right', 'right')",
+			"call dolt_commit('-am', 'right')",
+			"call dolt_checkout('main')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_merge('other')",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, "right", "left"}, {2, "right", "left"}, {3, "right", "left"}},
+			},
+		},
+	},
+	{
+		Name: "cells merge rules can't resolve are conflicts",
+		SetUpScript: []string{
+			"set autocommit = 0",
+			"create table t (pk int primary key, c int, v varchar(10), updated_at datetime, other int)",
+			"insert into t values (1, 1, 'base', '2020-01-01', 1), (2, 1, 'base', '2020-01-01', 1), (3, 1, 'base', '2020-01-01', 1)",
+			"insert into dolt_merge_rules values ('t', 'c', 'sum', null), ('t', 'v', 'latest', 'updated_at')",
+			"call dolt_commit('-Am', 'base')",
+			"call dolt_branch('other')",
+			"update t set c = 2, v = 'left', updated_at = '2021-01-01' where pk = 1",
+			"update t set c = null where pk = 2",
+			"update t set other = 2 where pk = 3",
+			"call dolt_commit('-am', 'left')",
+			"call dolt_checkout('other')",
+			"update t set c = 3, v = 'right', updated_at = '2021-01-01' where pk = 1",
+			"update t set c = 3 where pk = 2",
+			"update t set other = 3 where pk = 3",
+			"call dolt_commit('-am', 'right')",
+			"call dolt_checkout('main')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_merge('other')",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				Query:    "select our_pk from dolt_conflicts_t order by our_pk",
+				Expected: []sql.Row{{1}, {2}, {3}},
+			},
+		},
+	},
+	{
+		Name: "merge rules are validated",
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "insert into dolt_merge_rules values ('t', 'c', 'bogus', null)",
+				ExpectedErrStr: "invalid merge rule for t.c: unknown strategy 'bogus'; expected one of ours, theirs, max, min, sum, latest, union",
+			},
+			{
+				Query:          "insert into dolt_merge_rules values ('t', 'c', 'latest', null)",
+				ExpectedErrStr: "invalid merge rule for t.c: the latest strategy requires an order_by_column",
+			},
+			{
+				Query:          "insert into dolt_merge_rules values ('t', 'c', 'max', 'updated_at')",
+				ExpectedErrStr: "invalid merge rule for t.c: order_by_column is only used by the latest strategy",
+			},
+			{
+				Query:    "insert into dolt_merge_rules values ('t', 'c', 'MAX', null)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+		},
+	},
 }
 
 var KeylessMergeCVsAndConflictsScripts = []queries.ScriptTest{
@@ -3886,6 +4128,127 @@ var SchemaConflictScripts = []queries.ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "schema conflicts can be resolved with a merged schema",
+		SetUpScript: []string{
+			"set @@autocommit=0;",
+			"create table t (pk int primary key, c0 varchar(20), c1 int)",
+			"insert into t values (1, '1', 1), (2, '2', 2)",
+			"call dolt_commit('-Am', 'added table t')",
+			"call dolt_checkout('-b', 'other')",
+			"alter table t modify column c0 int",
+			"update t set c1 = 20 where pk = 2",
+			"insert into t values (3, 3, 3)",
+			"call dolt_commit('-am', 'altered t on branch other')",
+			"call dolt_checkout('main')",
+			"alter table t modify column c0 varchar(10)",
+			"alter table t add column c2 int default 0",
+			"insert into t values (4, 'four', 4, 4)",
+			"call dolt_commit('-am', 'altered t on branch main')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_merge('other')",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				Query:          "call dolt_conflicts_resolve('--ours', 't')",
+				ExpectedErrStr: "Unable to automatically resolve schema conflicts since data changes may not have been fully merged yet. To continue, resolve each table's schema conflict with the CREATE TABLE statement of its merged schema (dolt conflicts resolve --schema), or abort this merge (dolt merge --abort) then apply ALTER TABLE statements to one side of this merge to get the two schemas in sync with the desired schema, then rerun the merge. To track resolution of this limitation, follow https://github.com/dolthub/dolt/issues/6616",
+			},
+			{
+				Query:    "call dolt_conflicts_resolve('--schema', 'create table t (pk int primary key, c0 int, c1 int, c2 int default 0)')",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "select * from dolt_schema_conflicts",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "show create table t",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `pk` int NOT NULL,\n  `c0` int,\n  `c1` int,\n  `c2` int DEFAULT '0',\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 1, 1, 0}, {2, 2, 20, 0}, {3, 3, 3, 0}, {4, nil, 4, 4}},
+			},
+			{
+				// the value of c0 for row 4 can't be converted to an int, so the row is left as a conflict
+				Query:    "select base_pk, our_pk, our_c0, their_pk, their_c0 from dolt_conflicts_t",
+				Expected: []sql.Row{{nil, 4, nil, nil, nil}},
+			},
+			{
+				Query:    "update t set c0 = 4 where pk = 4",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
+			},
+			{
+				Query:    "delete from dolt_conflicts_t",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "call dolt_commit('-am', 'merged other')",
+				Expected: []sql.Row{{doltCommit}},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 1, 1, 0}, {2, 2, 20, 0}, {3, 3, 3, 0}, {4, 4, 4, 4}},
+			},
+			{
+				Query:    "select count(*) from dolt_commit_ancestors where commit_hash = hashof('HEAD')",
+				Expected: []sql.Row{{2}},
+			},
+		},
+	},
+	{
+		Name: "resolving schema conflicts with a merged schema errors",
+		SetUpScript: []string{
+			"set @@autocommit=0;",
+			"create table t (pk int primary key, c0 varchar(20))",
+			"create table u (pk int primary key)",
+			"call dolt_commit('-Am', 'added tables')",
+			"call dolt_checkout('-b', 'other')",
+			"alter table t modify column c0 int",
+			"call dolt_commit('-am', 'altered t on branch other')",
+			"call dolt_checkout('main')",
+			"alter table t modify column c0 datetime(6)",
+			"call dolt_commit('-am', 'altered t on branch main')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "call dolt_conflicts_resolve('--schema', 'create table t (pk int primary key, c0 int)')",
+				ExpectedErrStr: "no merge is in progress, there are no schema conflicts to resolve",
+			},
+			{
+				Query:    "call dolt_merge('other')",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				Query:          "call dolt_conflicts_resolve('--schema', 'create table t (pk int primary key, c0 int)', '--ours')",
+				ExpectedErrStr: "--schema can't be used with --ours or --theirs",
+			},
+			{
+				Query:          "call dolt_conflicts_resolve('--schema', 'create table t (pk int primary key, c0 int)', 't')",
+				ExpectedErrStr: "--schema resolves the table named in its CREATE TABLE statement and takes no tables",
+			},
+			{
+				Query:          "call dolt_conflicts_resolve('--schema', 'create table u (pk int primary key, c0 int)')",
+				ExpectedErrStr: "table u has no schema conflicts to resolve",
+			},
+			{
+				Query:          "call dolt_conflicts_resolve('--schema', 'create table v (pk int primary key)')",
+				ExpectedErrStr: "table not found",
+			},
+			{
+				Query:          "call dolt_conflicts_resolve('--schema', 'create table t (pk bigint primary key, c0 int)')",
+				ExpectedErrStr: "error: cannot merge because table t has different primary keys",
+			},
+			{
+				Query: "select * from dolt_status",
+				Expected: []sql.Row{
+					{"t", false, "schema conflict"},
+				},
+			},
+		},
+	},
 }
 
 // OldFormatMergeConflictsAndCVsScripts tests old format merge behavior
@@ -15,6 +15,9 @@
 package enginetest
 
 import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/enginetest"
 	"github.com/dolthub/go-mysql-server/enginetest/queries"
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/plan"
@@ -24,6 +27,26 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dprocedures"
 )
 
+// rebaseStoppedValidator validates the message returned by dolt_rebase when the rebase stops at a step of the rebase
+// plan with the action given, since the message includes the hash of the step's commit.
+type rebaseStoppedValidator struct {
+	action string
+}
+
+var _ enginetest.CustomValueValidator = &rebaseStoppedValidator{}
+
+func (v *rebaseStoppedValidator) Validate(val interface{}) (bool, error) {
+	message, ok := val.(string)
+	if !ok {
+		return false, nil
+	}
+	return strings.HasPrefix(message, dprocedures.RebaseStoppedMessage+v.action+" "), nil
+}
+
+func rebaseStoppedAt(action string) *rebaseStoppedValidator {
+	return &rebaseStoppedValidator{action: action}
+}
+
 var DoltRebaseScriptTests = []queries.ScriptTest{
 	{
 		Name:        "dolt_rebase errors: basic errors",
@@ -1547,6 +1570,366 @@ var DoltRebaseScriptTests = []queries.ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "dolt_rebase: edit, break and exec actions",
+		SetUpScript: []string{
+			"create table t (pk int primary key);",
+			"call dolt_commit('-Am', 'creating table t');",
+			"call dolt_branch('branch1');",
+
+			"insert into t values (0);",
+			"call dolt_commit('-am', 'inserting row 0');",
+
+			"call dolt_checkout('branch1');",
+			"insert into t values (1);",
+			"call dolt_commit('-am', 'inserting row 1');",
+			"insert into t values (2);",
+			"call dolt_commit('-am', 'inserting row 2');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "call dolt_rebase('-i', 'main');",
+				Expected: []sql.Row{{0, "interactive rebase started on branch dolt_rebase_branch1; " +
+					"adjust the rebase plan in the dolt_rebase table, then " +
+					"continue rebasing by calling dolt_rebase('--continue')"}},
+			},
+			{
+				Query: "update dolt_rebase set action='edit' where rebase_order=1;",
+				Expected: []sql.Row{{gmstypes.OkResult{
+					RowsAffected: 1,
+					InsertID:     0,
+					Info: plan.UpdateInfo{
+						Matched:  1,
+						Updated:  1,
+						Warnings: 0,
+					},
+				}}},
+			},
+			{
+				Query:    "insert into dolt_rebase values (1.5, 'break', '', ''), (2.5, 'exec', '', 'select * from t'), (3, 'exec', '', 'insert into t values (1)');",
+				Expected: []sql.Row{{gmstypes.NewOkResult(3)}},
+			},
+			{
+				Query:    "call dolt_rebase('--continue');",
+				Expected: []sql.Row{{0, rebaseStoppedAt("edit")}},
+			},
+			{
+				Query:    "select active_branch();",
+				Expected: []sql.Row{{"dolt_rebase_branch1"}},
+			},
+			{
+				Query:    "insert into t values (100);",
+				Expected: []sql.Row{{gmstypes.NewOkResult(1)}},
+			},
+			{
+				Query:    "call dolt_add('t');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "call dolt_rebase('--continue');",
+				Expected: []sql.Row{{0, "Stopped rebasing at break; continue rebasing by calling dolt_rebase('--continue')"}},
+			},
+			{
+				Query: "select message from dolt_log;",
+				Expected: []sql.Row{
+					{"inserting row 1"},
+					{"inserting row 0"},
+					{"creating table t"},
+					{"Initialize data repository"},
+				},
+			},
+			{
+				Query:    "select * from t;",
+				Expected: []sql.Row{{0}, {1}, {100}},
+			},
+			{
+				Query: "call dolt_rebase('--continue');",
+				ExpectedErrStr: dprocedures.ErrRebaseExecFailed.New("insert into t values (1)",
+					"duplicate primary key given: [1]").Error(),
+			},
+			{
+				Query:    "select active_branch();",
+				Expected: []sql.Row{{"dolt_rebase_branch1"}},
+			},
+			{
+				Query:    "call dolt_rebase('--continue');",
+				Expected: []sql.Row{{0, "Successfully rebased and updated refs/heads/branch1"}},
+			},
+			{
+				Query:    "select active_branch();",
+				Expected: []sql.Row{{"branch1"}},
+			},
+			{
+				Query: "select message from dolt_log;",
+				Expected: []sql.Row{
+					{"inserting row 2"},
+					{"inserting row 1"},
+					{"inserting row 0"},
+					{"creating table t"},
+					{"Initialize data repository"},
+				},
+			},
+			{
+				Query:    "select * from t as of 'HEAD~1';",
+				Expected: []sql.Row{{0}, {1}, {100}},
+			},
+		},
+	},
+	{
+		Name: "dolt_rebase errors: stopped rebases",
+		SetUpScript: []string{
+			"create table t (pk int primary key);",
+			"call dolt_commit('-Am', 'creating table t');",
+			"call dolt_branch('branch1');",
+
+			"insert into t values (0);",
+			"call dolt_commit('-am', 'inserting row 0');",
+
+			"call dolt_checkout('branch1');",
+			"insert into t values (1);",
+			"call dolt_commit('-am', 'inserting row 1');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "call dolt_rebase('-i', 'main');",
+				Expected: []sql.Row{{0, "interactive rebase started on branch dolt_rebase_branch1; " +
+					"adjust the rebase plan in the dolt_rebase table, then " +
+					"continue rebasing by calling dolt_rebase('--continue')"}},
+			},
+			{
+				Query:    "insert into dolt_rebase values (0.5, 'exec', '', '');",
+				Expected: []sql.Row{{gmstypes.NewOkResult(1)}},
+			},
+			{
+				Query:          "call dolt_rebase('--continue');",
+				ExpectedErrStr: rebase.ErrInvalidRebasePlanExecWithoutQuery.Error(),
+			},
+			{
+				Query:    "update dolt_rebase set action='break' where rebase_order=0.5;",
+				Expected: []sql.Row{{gmstypes.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
+			},
+			{
+				Query:    "call dolt_rebase('--continue');",
+				Expected: []sql.Row{{0, "Stopped rebasing at break; continue rebasing by calling dolt_rebase('--continue')"}},
+			},
+			{
+				Query:    "insert into t values (100);",
+				Expected: []sql.Row{{gmstypes.NewOkResult(1)}},
+			},
+			{
+				Query:    "call dolt_add('t');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:          "call dolt_rebase('--continue');",
+				ExpectedErrStr: dprocedures.ErrRebaseStagedChangesAtStop.New("break").Error(),
+			},
+			{
+				Query:    "call dolt_commit('-m', 'inserting row 100');",
+				Expected: []sql.Row{{doltCommit}},
+			},
+			{
+				Query:    "call dolt_rebase('--continue');",
+				Expected: []sql.Row{{0, "Successfully rebased and updated refs/heads/branch1"}},
+			},
+			{
+				Query: "select message from dolt_log;",
+				Expected: []sql.Row{
+					{"inserting row 1"},
+					{"inserting row 100"},
+					{"inserting row 0"},
+					{"creating table t"},
+					{"Initialize data repository"},
+				},
+			},
+		},
+	},
+	{
+		Name: "dolt_rebase: rebased commit becomes empty; --empty=stop",
+		SetUpScript: []string{
+			"create table t (pk int primary key);",
+			"call dolt_commit('-Am', 'creating table t');",
+			"call dolt_branch('branch1');",
+
+			"insert into t values (0);",
+			"call dolt_commit('-am', 'inserting row 0 on main');",
+
+			"call dolt_checkout('branch1');",
+			"insert into t values (0);",
+			"call dolt_commit('-am', 'inserting row 0 on branch1');",
+			"insert into t values (10);",
+			"call dolt_commit('-am', 'inserting row 10 on branch1');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "call dolt_rebase('-i', '--empty', 'pause', 'main');",
+				ExpectedErrStr: "unsupported option for the empty flag (pause); only 'keep', 'drop' or 'stop' are allowed",
+			},
+			{
+				Query: "call dolt_rebase('-i', '--empty', 'stop', 'main');",
+				Expected: []sql.Row{{0, "interactive rebase started on branch dolt_rebase_branch1; " +
+					"adjust the rebase plan in the dolt_rebase table, then " +
+					"continue rebasing by calling dolt_rebase('--continue')"}},
+			},
+			{
+				Query:    "call dolt_rebase('--continue');",
+				Expected: []sql.Row{{0, rebaseStoppedAt("pick")}},
+			},
+			{
+				Query:    "select active_branch();",
+				Expected: []sql.Row{{"dolt_rebase_branch1"}},
+			},
+			{
+				Query:    "select message from dolt_log limit 1;",
+				Expected: []sql.Row{{"inserting row 0 on main"}},
+			},
+			{
+				Query:    "insert into t values (5);",
+				Expected: []sql.Row{{gmstypes.NewOkResult(1)}},
+			},
+			{
+				Query:    "call dolt_add('t');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "call dolt_rebase('--continue');",
+				Expected: []sql.Row{{0, "Successfully rebased and updated refs/heads/branch1"}},
+			},
+			{
+				Query: "select message from dolt_log;",
+				Expected: []sql.Row{
+					{"inserting row 10 on branch1"},
+					{"inserting row 0 on branch1"},
+					{"inserting row 0 on main"},
+					{"creating table t"},
+					{"Initialize data repository"},
+				},
+			},
+			{
+				Query:    "select * from t as of 'HEAD~1';",
+				Expected: []sql.Row{{0}, {5}},
+			},
+		},
+	},
+	{
+		Name: "dolt_rebase: --onto",
+		SetUpScript: []string{
+			"create table t (pk int primary key);",
+			"call dolt_commit('-Am', 'creating table t');",
+			"call dolt_branch('feature1');",
+
+			"insert into t values (0);",
+			"call dolt_commit('-am', 'inserting row 0 on main');",
+
+			"call dolt_checkout('feature1');",
+			"insert into t values (1);",
+			"call dolt_commit('-am', 'inserting row 1 on feature1');",
+			"call dolt_checkout('-b', 'feature2');",
+			"insert into t values (2);",
+			"call dolt_commit('-am', 'inserting row 2 on feature2');",
+			"insert into t values (3);",
+			"call dolt_commit('-am', 'inserting row 3 on feature2');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "call dolt_rebase('-i', '--onto', 'doesnotexist', 'feature1');",
+				ExpectedErrStr: "branch not found: doesnotexist",
+			},
+			{
+				Query:    "select active_branch();",
+				Expected: []sql.Row{{"feature2"}},
+			},
+			{
+				Query: "call dolt_rebase('-i', '--onto', 'main', 'feature1');",
+				Expected: []sql.Row{{0, "interactive rebase started on branch dolt_rebase_feature2; " +
+					"adjust the rebase plan in the dolt_rebase table, then " +
+					"continue rebasing by calling dolt_rebase('--continue')"}},
+			},
+			{
+				Query: "select * from dolt_rebase order by rebase_order;",
+				Expected: []sql.Row{
+					{"1", "pick", doltCommit, "inserting row 2 on feature2"},
+					{"2", "pick", doltCommit, "inserting row 3 on feature2"},
+				},
+			},
+			{
+				Query:    "select message from dolt_log limit 1;",
+				Expected: []sql.Row{{"inserting row 0 on main"}},
+			},
+			{
+				Query:    "call dolt_rebase('--continue');",
+				Expected: []sql.Row{{0, "Successfully rebased and updated refs/heads/feature2"}},
+			},
+			{
+				Query: "select message from dolt_log;",
+				Expected: []sql.Row{
+					{"inserting row 3 on feature2"},
+					{"inserting row 2 on feature2"},
+					{"inserting row 0 on main"},
+					{"creating table t"},
+					{"Initialize data repository"},
+				},
+			},
+			{
+				Query:    "select * from t;",
+				Expected: []sql.Row{{0}, {2}, {3}},
+			},
+		},
+	},
+	{
+		Name: "dolt_rebase: --autosquash",
+		SetUpScript: []string{
+			"create table t (pk int primary key);",
+			"call dolt_commit('-Am', 'creating table t');",
+			"call dolt_checkout('-b', 'branch1');",
+			"insert into t values (1);",
+			"call dolt_commit('-am', 'inserting row 1');",
+			"insert into t values (2);",
+			"call dolt_commit('-am', 'inserting row 2');",
+			"insert into t values (10);",
+			"call dolt_commit('-am', 'fixup! inserting row 1');",
+			"insert into t values (20);",
+			"call dolt_commit('-am', 'squash! inserting row 2');",
+			"insert into t values (30);",
+			"call dolt_commit('-am', 'fixup! inserting row 3');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "call dolt_rebase('-i', '--autosquash', 'main');",
+				Expected: []sql.Row{{0, "interactive rebase started on branch dolt_rebase_branch1; " +
+					"adjust the rebase plan in the dolt_rebase table, then " +
+					"continue rebasing by calling dolt_rebase('--continue')"}},
+			},
+			{
+				Query: "select * from dolt_rebase order by rebase_order;",
+				Expected: []sql.Row{
+					{"1", "pick", doltCommit, "inserting row 1"},
+					{"2", "fixup", doltCommit, "fixup! inserting row 1"},
+					{"3", "pick", doltCommit, "inserting row 2"},
+					{"4", "squash", doltCommit, "squash! inserting row 2"},
+					{"5", "pick", doltCommit, "fixup! inserting row 3"},
+				},
+			},
+			{
+				Query:    "call dolt_rebase('--continue');",
+				Expected: []sql.Row{{0, "Successfully rebased and updated refs/heads/branch1"}},
+			},
+			{
+				Query: "select message from dolt_log;",
+				Expected: []sql.Row{
+					{"fixup! inserting row 3"},
+					{"inserting row 2\n\nsquash! inserting row 2"},
+					{"inserting row 1"},
+					{"creating table t"},
+					{"Initialize data repository"},
+				},
+			},
+			{
+				Query:    "select * from t as of 'HEAD~2';",
+				Expected: []sql.Row{{1}, {10}},
+			},
+		},
+	},
 	{
 		// Merge commits are skipped during a rebase
 		Name: "dolt_rebase: merge commits",
@@ -174,6 +174,28 @@ var DoltVerifyConstraintsTestScripts = []queries.ScriptTest{
 			},
 		},
 	},
+	{
+		Name:        "verify-constraints: FK violations: --output-only on a detached head",
+		SetUpScript: append(verifyConstraintsFkViolationsSetupScript, "CALL DOLT_TAG('v1');"),
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:            "USE `mydb/v1`;",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "CALL DOLT_VERIFY_CONSTRAINTS('--all', '--output-only', 'child3');",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "CALL DOLT_VERIFY_CONSTRAINTS('--all', '--output-only', 'parent3');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:          "CALL DOLT_VERIFY_CONSTRAINTS('--all', 'child3');",
+				ExpectedErrStr: "this operation is not supported while in a detached head state",
+			},
+		},
+	},
 	{
 		Name:        "verify-constraints: FK violations: --all no named tables",
 		SetUpScript: verifyConstraintsFkViolationsSetupScript,
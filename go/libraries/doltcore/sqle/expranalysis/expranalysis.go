@@ -71,6 +71,33 @@ func ResolveCheckExpression(ctx *sql.Context, tableName string, sch schema.Schem
 	return nil, fmt.Errorf("unable to find check expression")
 }
 
+// ResolvePredicateExpression returns a sql.Expression for the given predicate, resolved against the given table schema.
+// The returned expression may be evaluated against full rows of the table.
+func ResolvePredicateExpression(ctx *sql.Context, tableName string, sch schema.Schema, predicate string) (sql.Expression, error) {
+	const checkName = "dolt_predicate"
+	// The predicate is resolved by treating it as the only check constraint of the table
+	sch = sch.Copy()
+	for _, check := range sch.Checks().AllChecks() {
+		if err := sch.Checks().DropCheck(check.Name()); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := sch.Checks().AddCheck(checkName, predicate, true); err != nil {
+		return nil, err
+	}
+
+	ct, err := parseCreateTable(ctx, tableName, sch)
+	if err != nil {
+		return nil, err
+	}
+	for _, check := range ct.Checks() {
+		if check.Name == checkName {
+			return check.Expr, nil
+		}
+	}
+	return nil, fmt.Errorf("unable to resolve predicate `%s`", predicate)
+}
+
 func stripTableNamesFromExpression(expr sql.Expression) sql.Expression {
 	e, _, _ := transform.Expr(expr, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
 		if col, ok := e.(*expression.GetField); ok {
@@ -22,10 +22,13 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 	"google.golang.org/grpc"
 
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
 	"github.com/dolthub/dolt/go/libraries/doltcore/remotesrv"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
 	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/dolt/go/store/hash"
 )
 
 type remotesrvStore struct {
@@ -67,9 +70,91 @@ func (s remotesrvStore) Get(ctx context.Context, path, _ string) (remotesrv.Remo
 	if !ok {
 		return nil, remotesrv.ErrUnimplemented
 	}
+	if !s.createDBs {
+		// Replicas accept whatever their primary has committed, but pushes from clients must respect the branch
+		// protection rules of this server.
+		return protectedBranchStore{rss, s.ctxFactory, path, sdb.DbData().Ddb}, nil
+	}
 	return rss, nil
 }
 
+var branchRefFilter = map[ref.RefType]struct{}{ref.BranchRefType: {}}
+
+// protectedBranchStore is a remotesrv.RemoteSrvStore which rejects pushes that overwrite or delete a branch that does
+// not allow force updates. The pushing client checks its own rules, which may differ from ours, so this is where the
+// rules of the receiving database are enforced.
+type protectedBranchStore struct {
+	remotesrv.RemoteSrvStore
+	ctxFactory func(context.Context) (*sql.Context, error)
+	dbName     string
+	ddb        *doltdb.DoltDB
+}
+
+func (s protectedBranchStore) Commit(ctx context.Context, current, last hash.Hash) (bool, error) {
+	sqlCtx, err := s.ctxFactory(ctx)
+	if err != nil {
+		return false, err
+	}
+	if err = checkProtectedBranchUpdates(sqlCtx, s.dbName, s.ddb, last, current); err != nil {
+		return false, err
+	}
+	return s.RemoteSrvStore.Commit(ctx, current, last)
+}
+
+// checkProtectedBranchUpdates returns an error if moving the root of |ddb| from |last| to |current| would delete a
+// branch that does not allow force updates, or move its head to a commit that does not descend from its current head.
+func checkProtectedBranchUpdates(ctx *sql.Context, dbName string, ddb *doltdb.DoltDB, last, current hash.Hash) error {
+	if last.IsEmpty() {
+		return nil
+	}
+	newHeads := make(map[string]hash.Hash)
+	err := ddb.VisitRefsOfTypeByNomsRoot(ctx, branchRefFilter, current, func(r ref.DoltRef, addr hash.Hash) error {
+		newHeads[r.GetPath()] = addr
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx.SetCurrentDatabase(dbName)
+	return ddb.VisitRefsOfTypeByNomsRoot(ctx, branchRefFilter, last, func(r ref.DoltRef, oldAddr hash.Hash) error {
+		newAddr, ok := newHeads[r.GetPath()]
+		if ok && newAddr == oldAddr {
+			return nil
+		}
+		err := branch_control.CanForceUpdateBranch(ctx, r.GetPath())
+		if err == nil || !ok {
+			return err
+		}
+		// The branch may still move forward, as that doesn't overwrite any of its history
+		fastForward, ffErr := isFastForward(ctx, ddb, oldAddr, newAddr)
+		if ffErr != nil {
+			return ffErr
+		}
+		if fastForward {
+			return nil
+		}
+		return err
+	})
+}
+
+// isFastForward returns whether the commit at |newAddr| descends from the commit at |oldAddr|.
+func isFastForward(ctx context.Context, ddb *doltdb.DoltDB, oldAddr, newAddr hash.Hash) (bool, error) {
+	oldCommit, err := readCommit(ctx, ddb, oldAddr)
+	if err != nil {
+		return false, err
+	}
+	newCommit, err := readCommit(ctx, ddb, newAddr)
+	if err != nil {
+		return false, err
+	}
+	canFF, err := oldCommit.CanFastForwardTo(ctx, newCommit)
+	if err != nil && !errors.Is(err, doltdb.ErrIsAhead) {
+		return false, err
+	}
+	return canFF, nil
+}
+
 // In the SQL context, the database provider that we use to expose the
 // remotesapi interface can choose to either create a newly accessed database
 // on first access or to return NotFound. Currently we allow creation in the
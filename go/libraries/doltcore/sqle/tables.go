@@ -703,13 +703,54 @@ func (t *WritableDoltTable) Inserter(ctx *sql.Context) sql.RowInserter {
 	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
 		return sqlutil.NewStaticErrorEditor(err)
 	}
-	te, err := t.getTableEditor(ctx)
+	te, err := t.getTableControlEditor(ctx)
 	if err != nil {
 		return sqlutil.NewStaticErrorEditor(err)
 	}
 	return te
 }
 
+// getTableControlEditor returns the table editor for this table, which is restricted to the rows that the current user
+// may write according to the "dolt_table_control" table.
+func (t *WritableDoltTable) getTableControlEditor(ctx *sql.Context) (dsess.TableWriter, error) {
+	predicates, err := dsess.CheckTableAccessForDb(ctx, t.db, t.tableName)
+	if err != nil {
+		return nil, err
+	}
+	te, err := t.getTableEditor(ctx)
+	if err != nil || len(predicates) == 0 {
+		return te, err
+	}
+	return dsess.NewTableControlWriter(ctx, te, t.db, t.tableName, t.sch, predicates)
+}
+
+// checkAlterAccess returns an error if the current user may not alter this table. Altering a table requires write
+// access to its branch, and to every row of the table according to the "dolt_table_control" table.
+func (t *WritableDoltTable) checkAlterAccess(ctx *sql.Context) error {
+	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
+		return err
+	}
+	return dsess.CheckUnrestrictedTableAccessForDb(ctx, t.db, t.tableName)
+}
+
+// checkUnrestrictedTableAccess returns an error if the current user may not write every row of this table according
+// to the "dolt_table_control" table. Operations that replace or remove rows without reading them first require this.
+func (t *WritableDoltTable) checkUnrestrictedTableAccess(ctx *sql.Context) error {
+	predicates, err := dsess.CheckTableAccessForDb(ctx, t.db, t.tableName)
+	if err != nil {
+		return err
+	}
+	if len(predicates) > 0 {
+		var user, host string
+		if branchAwareSession := branch_control.GetBranchAwareSession(ctx); branchAwareSession != nil {
+			user, host = branchAwareSession.GetUser(), branchAwareSession.GetHost()
+		}
+		_, branch := dsess.SplitRevisionDbName(t.db.RevisionQualifiedName())
+		return branch_control.ErrTableReplaceAccess.New(user, host, t.tableName, branch)
+	}
+	return nil
+}
+
 func (t *WritableDoltTable) getTableEditor(ctx *sql.Context) (ed dsess.TableWriter, err error) {
 	ds := dsess.DSessFromSess(ctx.Session)
 
@@ -963,7 +1004,7 @@ func (t *WritableDoltTable) Deleter(ctx *sql.Context) sql.RowDeleter {
 	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
 		return sqlutil.NewStaticErrorEditor(err)
 	}
-	te, err := t.getTableEditor(ctx)
+	te, err := t.getTableControlEditor(ctx)
 	if err != nil {
 		return sqlutil.NewStaticErrorEditor(err)
 	}
@@ -975,6 +1016,9 @@ func (t *WritableDoltTable) Replacer(ctx *sql.Context) sql.RowReplacer {
 	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
 		return sqlutil.NewStaticErrorEditor(err)
 	}
+	if err := t.checkUnrestrictedTableAccess(ctx); err != nil {
+		return sqlutil.NewStaticErrorEditor(err)
+	}
 	te, err := t.getTableEditor(ctx)
 	if err != nil {
 		return sqlutil.NewStaticErrorEditor(err)
@@ -987,6 +1031,9 @@ func (t *WritableDoltTable) Truncate(ctx *sql.Context) (int, error) {
 	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
 		return 0, err
 	}
+	if err := t.checkUnrestrictedTableAccess(ctx); err != nil {
+		return 0, err
+	}
 	table, err := t.DoltTable.DoltTable(ctx)
 	if err != nil {
 		return 0, err
@@ -1129,7 +1176,7 @@ func (t *WritableDoltTable) Updater(ctx *sql.Context) sql.RowUpdater {
 	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
 		return sqlutil.NewStaticErrorEditor(err)
 	}
-	te, err := t.getTableEditor(ctx)
+	te, err := t.getTableControlEditor(ctx)
 	if err != nil {
 		return sqlutil.NewStaticErrorEditor(err)
 	}
@@ -1587,7 +1634,7 @@ func (t *AlterableDoltTable) WithProjections(colNames []string) sql.Table {
 
 // AddColumn implements sql.AlterableTable
 func (t *AlterableDoltTable) AddColumn(ctx *sql.Context, column *sql.Column, order *sql.ColumnOrder) error {
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
+	if err := t.checkAlterAccess(ctx); err != nil {
 		return err
 	}
 	root, err := t.getRoot(ctx)
@@ -1726,7 +1773,7 @@ func (t *AlterableDoltTable) RewriteInserter(
 	if _, isSet := os.LookupEnv(dconfig.EnvAssertNoTableRewrite); isSet {
 		return nil, fmt.Errorf("attempted to rewrite table but %s was set", dconfig.EnvAssertNoTableRewrite)
 	}
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
+	if err := t.checkAlterAccess(ctx); err != nil {
 		return nil, err
 	}
 	err := validateSchemaChange(t.Name(), oldSchema, newSchema, oldColumn, newColumn, idxCols)
@@ -2292,7 +2339,7 @@ func (t *AlterableDoltTable) dropColumnData(ctx *sql.Context, updatedTable *dolt
 // ModifyColumn implements sql.AlterableTable. ModifyColumn operations are only used for operations that change only
 // the schema of a table, not the data. For those operations, |RewriteInserter| is used.
 func (t *AlterableDoltTable) ModifyColumn(ctx *sql.Context, columnName string, column *sql.Column, order *sql.ColumnOrder) error {
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
+	if err := t.checkAlterAccess(ctx); err != nil {
 		return err
 	}
 	ws, err := t.db.GetWorkingSet(ctx)
@@ -2465,7 +2512,7 @@ func allocatePrefixLengths(idxCols []sql.IndexColumn) []uint16 {
 
 // CreateIndex implements sql.IndexAlterableTable
 func (t *AlterableDoltTable) CreateIndex(ctx *sql.Context, idx sql.IndexDef) error {
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
+	if err := t.checkAlterAccess(ctx); err != nil {
 		return err
 	}
 	if idx.Constraint != sql.IndexConstraint_None && idx.Constraint != sql.IndexConstraint_Unique && idx.Constraint != sql.IndexConstraint_Spatial && idx.Constraint != sql.IndexConstraint_Vector {
@@ -2483,7 +2530,7 @@ func (t *AlterableDoltTable) CreateIndex(ctx *sql.Context, idx sql.IndexDef) err
 
 // DropIndex implements sql.IndexAlterableTable
 func (t *AlterableDoltTable) DropIndex(ctx *sql.Context, indexName string) error {
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
+	if err := t.checkAlterAccess(ctx); err != nil {
 		return err
 	}
 	// We disallow removing internal dolt_ tables from SQL directly
@@ -2511,7 +2558,7 @@ func (t *AlterableDoltTable) DropIndex(ctx *sql.Context, indexName string) error
 
 // RenameIndex implements sql.IndexAlterableTable
 func (t *AlterableDoltTable) RenameIndex(ctx *sql.Context, fromIndexName string, toIndexName string) error {
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
+	if err := t.checkAlterAccess(ctx); err != nil {
 		return err
 	}
 	// RenameIndex will error if there is a name collision or an index does not exist
@@ -2555,7 +2602,7 @@ func (t *AlterableDoltTable) CreateFulltextIndex(ctx *sql.Context, idx sql.Index
 	if !types.IsFormat_DOLT(t.Format()) {
 		return fmt.Errorf("FULLTEXT is not supported on storage format %s. Run `dolt migrate` to upgrade to the latest storage format.", t.Format().VersionString())
 	}
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
+	if err := t.checkAlterAccess(ctx); err != nil {
 		return err
 	}
 	if !idx.IsFullText() {
@@ -2748,7 +2795,7 @@ func (t *WritableDoltTable) createForeignKey(
 
 // AddForeignKey implements sql.ForeignKeyTable
 func (t *AlterableDoltTable) AddForeignKey(ctx *sql.Context, sqlFk sql.ForeignKeyConstraint) error {
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
+	if err := t.checkAlterAccess(ctx); err != nil {
 		return err
 	}
 	// empty string foreign key names are replaced with a generated name elsewhere
@@ -2766,7 +2813,7 @@ func (t *AlterableDoltTable) AddForeignKey(ctx *sql.Context, sqlFk sql.ForeignKe
 	if err != nil {
 		return err
 	}
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
+	if err := t.checkAlterAccess(ctx); err != nil {
 		return err
 	}
 	// empty string foreign key names are replaced with a generated name elsewhere
@@ -2815,7 +2862,7 @@ func (t *AlterableDoltTable) AddForeignKey(ctx *sql.Context, sqlFk sql.ForeignKe
 
 // DropForeignKey implements sql.ForeignKeyTable
 func (t *AlterableDoltTable) DropForeignKey(ctx *sql.Context, fkName string) error {
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
+	if err := t.checkAlterAccess(ctx); err != nil {
 		return err
 	}
 	root, err := t.getRoot(ctx)
@@ -2849,7 +2896,7 @@ func (t *AlterableDoltTable) DropForeignKey(ctx *sql.Context, fkName string) err
 // an update statement (including a no-op write statement) has the side-effect of causing a schema change.
 // TODO: get rid of explicit IsResolved tracking
 func (t *WritableDoltTable) UpdateForeignKey(ctx *sql.Context, fkName string, sqlFk sql.ForeignKeyConstraint) error {
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
+	if err := t.checkAlterAccess(ctx); err != nil {
 		return err
 	}
 	root, err := t.getRoot(ctx)
@@ -3129,7 +3176,7 @@ func (t *WritableDoltTable) updateFromRoot(ctx *sql.Context, root doltdb.RootVal
 }
 
 func (t *AlterableDoltTable) CreateCheck(ctx *sql.Context, check *sql.CheckDefinition) error {
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
+	if err := t.checkAlterAccess(ctx); err != nil {
 		return err
 	}
 	root, err := t.getRoot(ctx)
@@ -3185,7 +3232,7 @@ func (t *AlterableDoltTable) CreateCheck(ctx *sql.Context, check *sql.CheckDefin
 }
 
 func (t *AlterableDoltTable) DropCheck(ctx *sql.Context, chName string) error {
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
+	if err := t.checkAlterAccess(ctx); err != nil {
 		return err
 	}
 	root, err := t.getRoot(ctx)
@@ -3236,7 +3283,7 @@ func (t *AlterableDoltTable) ModifyStoredCollation(ctx *sql.Context, collation s
 }
 
 func (t *AlterableDoltTable) ModifyDefaultCollation(ctx *sql.Context, collation sql.CollationID) error {
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
+	if err := t.checkAlterAccess(ctx); err != nil {
 		return err
 	}
 	root, err := t.getRoot(ctx)
@@ -0,0 +1,632 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+const (
+	// WebhookEventHeader is the request header naming the event a webhook payload describes.
+	WebhookEventHeader = "X-Dolt-Event"
+	// WebhookDeliveryHeader is the request header holding the unique id of a webhook delivery. Retries of a delivery
+	// have the same id.
+	WebhookDeliveryHeader = "X-Dolt-Delivery"
+	// WebhookSignatureHeader is the request header holding the HMAC-SHA256 signature of the payload, formatted as
+	// "sha256=<hex digest>". It is only sent for webhooks configured with a secret.
+	WebhookSignatureHeader = "X-Dolt-Signature-256"
+
+	webhookBranchUpdateEvent = "branch_update"
+	webhookUserAgent         = "dolt-webhook"
+
+	// webhookInitialBackoff is the delay before the first retry of a failed delivery. It doubles for each subsequent
+	// retry, up to webhookMaxBackoff.
+	webhookInitialBackoff = time.Second
+	webhookMaxBackoff     = 30 * time.Second
+
+	// webhookRecordQueueSize is the number of delivery status changes which can wait to be recorded in their
+	// databases. Changes are discarded from the dolt_webhook_deliveries system table, but still delivered, when it is
+	// full.
+	webhookRecordQueueSize = 1024
+)
+
+// WebhookPayload is the JSON body POSTed to a webhook when a branch head is updated. OldCommit is empty for newly
+// created branches and NewCommit is empty for deleted branches. Tables is only populated when both are set.
+type WebhookPayload struct {
+	DeliveryId string              `json:"delivery_id"`
+	Event      string              `json:"event"`
+	Database   string              `json:"database"`
+	Branch     string              `json:"branch"`
+	OldCommit  string              `json:"old_commit"`
+	NewCommit  string              `json:"new_commit"`
+	Committer  *WebhookCommitter   `json:"committer,omitempty"`
+	Tables     []WebhookTableStats `json:"tables"`
+}
+
+// WebhookCommitter describes the commit at the new head of the branch.
+type WebhookCommitter struct {
+	Name    string    `json:"name"`
+	Email   string    `json:"email"`
+	Date    time.Time `json:"date"`
+	Message string    `json:"message"`
+}
+
+// WebhookTableStats summarizes the changes to a single table between the old and new branch heads. Row counts are
+// omitted for tables whose primary key changed, since their rows cannot be diffed.
+type WebhookTableStats struct {
+	TableName     string `json:"table_name"`
+	RowsAdded     uint64 `json:"rows_added"`
+	RowsDeleted   uint64 `json:"rows_deleted"`
+	RowsModified  uint64 `json:"rows_modified"`
+	CellsModified uint64 `json:"cells_modified"`
+	SchemaChanged bool   `json:"schema_changed"`
+}
+
+// WebhookController notifies the configured webhooks of branch head updates in a running sql-server.
+//
+// A doltdb.CommitHook is installed on every database. The hook keeps track of the head of every branch, and when one
+// moves it creates a pending delivery and enqueues it for every matching webhook. Each webhook has a bounded queue and
+// a background thread, so a slow endpoint does not delay the others. The thread builds the payload, POSTs it, and
+// retries failed attempts with exponential backoff. Every change to the status of a delivery is recorded in its
+// database, where the dolt_webhook_deliveries system table reads it, by one more background thread, so that commit
+// hooks never write to the database.
+type WebhookController struct {
+	webhooks []*webhook
+	lgr      *logrus.Logger
+	recordCh chan webhookRecord
+
+	ctxF func(context.Context) (*sql.Context, error)
+}
+
+// webhookRecord is a change to the status of a delivery, waiting to be recorded in its database.
+type webhookRecord struct {
+	ddb      *doltdb.DoltDB
+	delivery doltdb.WebhookDelivery
+}
+
+// NewWebhookController returns a new WebhookController for |configs|.
+func NewWebhookController(lgr *logrus.Logger, configs []servercfg.WebhookConfig) *WebhookController {
+	webhooks := make([]*webhook, len(configs))
+	for i, cfg := range configs {
+		webhooks[i] = newWebhook(cfg)
+	}
+	return &WebhookController{
+		webhooks: webhooks,
+		lgr:      lgr,
+		recordCh: make(chan webhookRecord, webhookRecordQueueSize),
+	}
+}
+
+type webhook struct {
+	cfg     servercfg.WebhookConfig
+	client  *http.Client
+	workCh  chan webhookWork
+	backoff time.Duration
+}
+
+func newWebhook(cfg servercfg.WebhookConfig) *webhook {
+	return &webhook{
+		cfg:     cfg,
+		client:  &http.Client{Timeout: cfg.Timeout()},
+		workCh:  make(chan webhookWork, cfg.QueueSize()),
+		backoff: webhookInitialBackoff,
+	}
+}
+
+// matches returns true if this webhook should be notified of updates to |branch| in database |dbName|.
+func (w *webhook) matches(dbName, branch string) bool {
+	return matchesAnyOrEmpty(w.cfg.Databases(), dbName) && matchesAnyOrEmpty(w.cfg.Branches(), branch)
+}
+
+func matchesAnyOrEmpty(names []string, name string) bool {
+	if len(names) == 0 {
+		return true
+	}
+	for _, n := range names {
+		if strings.EqualFold(n, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// webhookWork is a branch head update waiting to be delivered to a webhook.
+type webhookWork struct {
+	delivery doltdb.WebhookDelivery
+	dbName   string
+	ddb      *doltdb.DoltDB
+	branch   string
+	old      hash.Hash
+	new      hash.Hash
+}
+
+// RunBackgroundThread starts a delivery thread for each webhook, and the thread which records their deliveries.
+func (c *WebhookController) RunBackgroundThread(threads *sql.BackgroundThreads, ctxF func(context.Context) (*sql.Context, error)) error {
+	c.ctxF = ctxF
+	err := threads.Add("webhook_record_thread", c.recordThread)
+	if err != nil {
+		return err
+	}
+	for i, w := range c.webhooks {
+		w := w
+		err := threads.Add(fmt.Sprintf("webhook_thread_%d", i), func(ctx context.Context) {
+			c.bgThread(ctx, w)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *WebhookController) bgThread(ctx context.Context, w *webhook) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case work := <-w.workCh:
+			c.doWork(ctx, w, work)
+		}
+	}
+}
+
+// ApplyCommitHooks is called during engine initialization to install the webhook commit hook on the original set of
+// databases.
+func (c *WebhookController) ApplyCommitHooks(ctx context.Context, mrEnv *env.MultiRepoEnv, dbs ...dsess.SqlDatabase) error {
+	for _, db := range dbs {
+		denv := mrEnv.GetEnv(db.Name())
+		if denv == nil {
+			continue
+		}
+		ddb := denv.DoltDB(ctx)
+		ddb.PrependCommitHooks(ctx, c.newCommitHook(ctx, db.Name(), ddb))
+	}
+	return nil
+}
+
+// InitDatabaseHook returns an InitDatabaseHook which installs the webhook commit hook on new databases.
+func (c *WebhookController) InitDatabaseHook() InitDatabaseHook {
+	return func(ctx *sql.Context, _ *DoltDatabaseProvider, name string, env *env.DoltEnv, _ dsess.SqlDatabase) error {
+		ddb := env.DoltDB(ctx)
+		ddb.PrependCommitHooks(ctx, c.newCommitHook(ctx, name, ddb))
+		return nil
+	}
+}
+
+func (c *WebhookController) newCommitHook(ctx context.Context, name string, ddb *doltdb.DoltDB) *webhookCommitHook {
+	heads := make(map[string]hash.Hash)
+	branches, err := ddb.GetBranchesWithHashes(ctx)
+	if err != nil {
+		c.lgr.Warnf("webhooks: could not read the branches of %s, the first update of each branch will not include an old commit: %v", name, err)
+	}
+	for _, b := range branches {
+		heads[b.Ref.GetPath()] = b.Hash
+	}
+
+	return &webhookCommitHook{c: c, name: name, ddb: ddb, heads: heads}
+}
+
+// enqueue records a pending delivery of a branch update for each matching webhook, and queues it for delivery.
+func (c *WebhookController) enqueue(dbName string, ddb *doltdb.DoltDB, branch string, old, new hash.Hash) {
+	for _, w := range c.webhooks {
+		if !w.matches(dbName, branch) {
+			continue
+		}
+
+		now := time.Now().UTC()
+		delivery := doltdb.WebhookDelivery{
+			DeliveryId: uuid.NewString(),
+			Webhook:    w.cfg.Name(),
+			Branch:     branch,
+			OldCommit:  hashStringOrEmpty(old),
+			NewCommit:  hashStringOrEmpty(new),
+			Status:     doltdb.WebhookDeliveryStatusPending,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		}
+		c.record(ddb, delivery)
+
+		select {
+		case w.workCh <- webhookWork{delivery: delivery, dbName: dbName, ddb: ddb, branch: branch, old: old, new: new}:
+		default:
+			delivery.Status = doltdb.WebhookDeliveryStatusDropped
+			delivery.Error = "webhook queue is full"
+			c.record(ddb, delivery)
+			c.lgr.Warnf("webhooks: queue for webhook %s is full, dropping update of %s branch %s", w.cfg.Name(), dbName, branch)
+		}
+	}
+}
+
+// record queues a change to the status of |delivery| to be recorded in |ddb|. It does not block, so that it is safe
+// to call from commit hooks.
+func (c *WebhookController) record(ddb *doltdb.DoltDB, delivery doltdb.WebhookDelivery) {
+	select {
+	case c.recordCh <- webhookRecord{ddb: ddb, delivery: delivery}:
+	default:
+		c.lgr.Warnf("webhooks: record queue is full, not recording the %s status of delivery %s", delivery.Status, delivery.DeliveryId)
+	}
+}
+
+func (c *WebhookController) recordThread(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case rec := <-c.recordCh:
+			c.recordDeliveries(ctx, rec)
+		}
+	}
+}
+
+// recordDeliveries records |first|, along with every other change waiting in the queue, in their databases. The
+// changes to each database are written together.
+func (c *WebhookController) recordDeliveries(ctx context.Context, first webhookRecord) {
+	var ddbs []*doltdb.DoltDB
+	byDdb := make(map[*doltdb.DoltDB][]doltdb.WebhookDelivery)
+	add := func(rec webhookRecord) {
+		if _, ok := byDdb[rec.ddb]; !ok {
+			ddbs = append(ddbs, rec.ddb)
+		}
+		byDdb[rec.ddb] = append(byDdb[rec.ddb], rec.delivery)
+	}
+	add(first)
+	for drained := false; !drained; {
+		select {
+		case rec := <-c.recordCh:
+			add(rec)
+		default:
+			drained = true
+		}
+	}
+
+	sqlCtx, err := c.ctxF(ctx)
+	if err != nil {
+		c.lgr.Warnf("webhooks: could not create session to record webhook deliveries: %v", err)
+		return
+	}
+	defer sql.SessionEnd(sqlCtx.Session)
+	sql.SessionCommandBegin(sqlCtx.Session)
+	defer sql.SessionCommandEnd(sqlCtx.Session)
+
+	for _, ddb := range ddbs {
+		if err = ddb.RecordWebhookDeliveries(sqlCtx, byDdb[ddb]...); err != nil {
+			c.lgr.Warnf("webhooks: could not record webhook deliveries: %v", err)
+		}
+	}
+}
+
+func (c *WebhookController) doWork(ctx context.Context, w *webhook, work webhookWork) {
+	delivery := work.delivery
+
+	body, err := c.buildPayload(ctx, work)
+	if err != nil {
+		delivery.Status = doltdb.WebhookDeliveryStatusFailed
+		delivery.Error = err.Error()
+		delivery.UpdatedAt = time.Now().UTC()
+		c.record(work.ddb, delivery)
+		c.lgr.Warnf("webhooks: could not build payload for webhook %s for %s branch %s: %v", w.cfg.Name(), work.dbName, work.branch, err)
+		return
+	}
+
+	backoff := w.backoff
+	for attempt := 0; attempt <= w.cfg.MaxRetries(); attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+			backoff = min(backoff*2, webhookMaxBackoff)
+		}
+
+		code, err := w.post(ctx, delivery.DeliveryId, body)
+		delivery.Attempts = attempt + 1
+		delivery.ResponseCode = code
+		delivery.UpdatedAt = time.Now().UTC()
+		if err == nil {
+			delivery.Status = doltdb.WebhookDeliveryStatusDelivered
+			delivery.Error = ""
+			c.record(work.ddb, delivery)
+			return
+		}
+
+		delivery.Error = err.Error()
+		c.record(work.ddb, delivery)
+	}
+
+	delivery.Status = doltdb.WebhookDeliveryStatusFailed
+	c.record(work.ddb, delivery)
+	c.lgr.Warnf("webhooks: failed to deliver update of %s branch %s to webhook %s after %d attempts: %s", work.dbName, work.branch, w.cfg.Name(), delivery.Attempts, delivery.Error)
+}
+
+// buildPayload returns the JSON encoded WebhookPayload for |work|. The commits are read in a session, so that they
+// are safe from concurrent garbage collection.
+func (c *WebhookController) buildPayload(ctx context.Context, work webhookWork) ([]byte, error) {
+	sqlCtx, err := c.ctxF(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer sql.SessionEnd(sqlCtx.Session)
+	sql.SessionCommandBegin(sqlCtx.Session)
+	defer sql.SessionCommandEnd(sqlCtx.Session)
+
+	payload, err := newWebhookPayload(sqlCtx, work)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(payload)
+}
+
+func newWebhookPayload(ctx context.Context, work webhookWork) (*WebhookPayload, error) {
+	payload := &WebhookPayload{
+		DeliveryId: work.delivery.DeliveryId,
+		Event:      webhookBranchUpdateEvent,
+		Database:   work.dbName,
+		Branch:     work.branch,
+		OldCommit:  hashStringOrEmpty(work.old),
+		NewCommit:  hashStringOrEmpty(work.new),
+		Tables:     []WebhookTableStats{},
+	}
+	if work.new.IsEmpty() {
+		return payload, nil
+	}
+
+	newCm, err := readCommit(ctx, work.ddb, work.new)
+	if err != nil {
+		return nil, err
+	}
+	meta, err := newCm.GetCommitMeta(ctx)
+	if err != nil {
+		return nil, err
+	}
+	payload.Committer = &WebhookCommitter{
+		Name:    meta.Name,
+		Email:   meta.Email,
+		Date:    meta.Time().UTC(),
+		Message: meta.Description,
+	}
+	if work.old.IsEmpty() {
+		return payload, nil
+	}
+
+	oldCm, err := readCommit(ctx, work.ddb, work.old)
+	if err != nil {
+		return nil, err
+	}
+	fromRoot, err := oldCm.GetRootValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	toRoot, err := newCm.GetRootValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	payload.Tables, err = webhookTableStats(ctx, fromRoot, toRoot)
+	if err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+func readCommit(ctx context.Context, ddb *doltdb.DoltDB, h hash.Hash) (*doltdb.Commit, error) {
+	optCmt, err := ddb.ReadCommit(ctx, h)
+	if err != nil {
+		return nil, err
+	}
+	cm, ok := optCmt.ToCommit()
+	if !ok {
+		return nil, doltdb.ErrGhostCommitEncountered
+	}
+	return cm, nil
+}
+
+// webhookTableStats returns the stats of every user table which changed between |fromRoot| and |toRoot|.
+func webhookTableStats(ctx context.Context, fromRoot, toRoot doltdb.RootValue) ([]WebhookTableStats, error) {
+	deltas, err := diff.GetTableDeltas(ctx, fromRoot, toRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]WebhookTableStats, 0, len(deltas))
+	for _, td := range deltas {
+		if doltdb.IsFullTextTable(td.CurName()) {
+			continue
+		}
+		changed, err := td.HasChanges()
+		if err != nil {
+			return nil, err
+		}
+		if !changed {
+			continue
+		}
+
+		schemaChanged, err := td.HasSchemaChanged(ctx)
+		if err != nil {
+			return nil, err
+		}
+		tableStats := WebhookTableStats{
+			TableName:     td.CurName(),
+			SchemaChanged: schemaChanged,
+		}
+
+		acc, err := accumulateDiffStat(ctx, td)
+		if errors.Is(err, diff.ErrPrimaryKeySetChanged) {
+			stats = append(stats, tableStats)
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		tableStats.RowsAdded = acc.Adds
+		tableStats.RowsDeleted = acc.Removes
+		tableStats.RowsModified = acc.Changes
+		tableStats.CellsModified = acc.CellChanges
+		stats = append(stats, tableStats)
+	}
+	return stats, nil
+}
+
+func accumulateDiffStat(ctx context.Context, td diff.TableDelta) (diff.DiffStatProgress, error) {
+	ch := make(chan diff.DiffStatProgress)
+	grp, ctx2 := errgroup.WithContext(ctx)
+	grp.Go(func() error {
+		defer close(ch)
+		return diff.StatForTableDelta(ctx2, ch, td)
+	})
+
+	acc := diff.DiffStatProgress{}
+	grp.Go(func() error {
+		for p := range ch {
+			acc.Adds += p.Adds
+			acc.Removes += p.Removes
+			acc.Changes += p.Changes
+			acc.CellChanges += p.CellChanges
+		}
+		return nil
+	})
+
+	err := grp.Wait()
+	return acc, err
+}
+
+// post sends |body| to the webhook, returning the response status code. A non-2xx response is an error.
+func (w *webhook) post(ctx context.Context, deliveryId string, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.cfg.URL(), bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", webhookUserAgent)
+	req.Header.Set(WebhookEventHeader, webhookBranchUpdateEvent)
+	req.Header.Set(WebhookDeliveryHeader, deliveryId)
+	if w.cfg.Secret() != "" {
+		req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(w.cfg.Secret(), body))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// drain the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected response status: %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// SignWebhookPayload returns the value of the WebhookSignatureHeader for |body| signed with |secret|. Receivers can
+// compute the same value to verify that a payload was sent by this server.
+func SignWebhookPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func hashStringOrEmpty(h hash.Hash) string {
+	if h.IsEmpty() {
+		return ""
+	}
+	return h.String()
+}
+
+// webhookCommitHook is the doltdb.CommitHook which enqueues branch head updates for the WebhookController.
+type webhookCommitHook struct {
+	c    *WebhookController
+	name string
+	ddb  *doltdb.DoltDB
+
+	mu    sync.Mutex
+	heads map[string]hash.Hash
+}
+
+var _ doltdb.CommitHook = (*webhookCommitHook)(nil)
+
+// Execute implements doltdb.CommitHook
+func (h *webhookCommitHook) Execute(ctx context.Context, ds datas.Dataset, db *doltdb.DoltDB) (func(context.Context) error, error) {
+	if !ref.IsRef(ds.ID()) {
+		return nil, nil
+	}
+	dref, err := ref.Parse(ds.ID())
+	if err != nil {
+		return nil, err
+	}
+	if dref.GetType() != ref.BranchRefType {
+		return nil, nil
+	}
+
+	// a deleted branch has no head address, and is reported with an empty new commit
+	branch := dref.GetPath()
+	newAddr, _ := ds.MaybeHeadAddr()
+
+	h.mu.Lock()
+	oldAddr := h.heads[branch]
+	if newAddr.IsEmpty() {
+		delete(h.heads, branch)
+	} else {
+		h.heads[branch] = newAddr
+	}
+	h.mu.Unlock()
+
+	if oldAddr == newAddr {
+		return nil, nil
+	}
+
+	h.c.enqueue(h.name, h.ddb, branch, oldAddr, newAddr)
+	return nil, nil
+}
+
+// HandleError implements doltdb.CommitHook
+func (h *webhookCommitHook) HandleError(ctx context.Context, err error) error {
+	return nil
+}
+
+// SetLogger implements doltdb.CommitHook
+func (h *webhookCommitHook) SetLogger(ctx context.Context, wr io.Writer) error {
+	return nil
+}
+
+// ExecuteForWorkingSets implements doltdb.CommitHook
+func (h *webhookCommitHook) ExecuteForWorkingSets() bool {
+	return false
+}
@@ -0,0 +1,306 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+type webhookRequest struct {
+	header  http.Header
+	payload WebhookPayload
+	body    []byte
+}
+
+// webhookTestServer is a local HTTP endpoint which records the webhook requests it receives, and responds to them
+// with the status codes in |statuses|, followed by 200 once they are exhausted.
+type webhookTestServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	statuses []int
+	requests []webhookRequest
+}
+
+func newWebhookTestServer(t *testing.T, statuses ...int) *webhookTestServer {
+	s := &webhookTestServer{statuses: statuses}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var payload WebhookPayload
+		require.NoError(t, json.Unmarshal(body, &payload))
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.requests = append(s.requests, webhookRequest{header: r.Header, payload: payload, body: body})
+		status := http.StatusOK
+		if len(s.statuses) > 0 {
+			status = s.statuses[0]
+			s.statuses = s.statuses[1:]
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *webhookTestServer) Requests() []webhookRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]webhookRequest(nil), s.requests...)
+}
+
+func webhookConfig(url string, mod func(cfg *servercfg.WebhookYAMLConfig)) servercfg.WebhookConfig {
+	cfg := servercfg.WebhookYAMLConfig{URL_: &url}
+	if mod != nil {
+		mod(&cfg)
+	}
+	return cfg
+}
+
+func TestWebhookController(t *testing.T) {
+	NewLogger := func() *logrus.Logger {
+		res := logrus.New()
+		res.SetOutput(new(bytes.Buffer))
+		return res
+	}
+	CtxFactory := func(ctx context.Context) (*sql.Context, error) {
+		return sql.NewContext(ctx, sql.WithSession(sql.NewBaseSession())), nil
+	}
+
+	// setup starts |controller| and installs its commit hook on a new database, returning the database's environment.
+	setup := func(t *testing.T, controller *WebhookController) *env.DoltEnv {
+		for _, w := range controller.webhooks {
+			w.backoff = time.Millisecond
+		}
+		bg := sql.NewBackgroundThreads()
+		t.Cleanup(func() { bg.Shutdown() })
+		require.NoError(t, controller.RunBackgroundThread(bg, CtxFactory))
+
+		dEnv := CreateTestEnvWithName("some_database")
+		ctx, err := CtxFactory(context.Background())
+		require.NoError(t, err)
+		require.NoError(t, controller.InitDatabaseHook()(ctx, nil, "some_database", dEnv, nil))
+		return dEnv
+	}
+
+	// commit creates a commit on |branch| of |dEnv| with |query| applied, returning the old and new head.
+	commit := func(t *testing.T, dEnv *env.DoltEnv, branch, query string) (hash.Hash, hash.Hash) {
+		ctx := context.Background()
+		ddb := dEnv.DoltDB(ctx)
+		head, err := ddb.ResolveCommitRef(ctx, ref.NewBranchRef(branch))
+		require.NoError(t, err)
+		oldHash, err := head.HashOf()
+		require.NoError(t, err)
+		root, err := head.GetRootValue(ctx)
+		require.NoError(t, err)
+		root, err = ExecuteSql(ctx, dEnv, root, query)
+		require.NoError(t, err)
+		_, valHash, err := ddb.WriteRootValue(ctx, root)
+		require.NoError(t, err)
+		meta, err := datas.NewCommitMeta("Bill Billerson", "bigbillieb@fake.horse", "webhook test")
+		require.NoError(t, err)
+		cm, err := ddb.Commit(ctx, valHash, ref.NewBranchRef(branch), meta)
+		require.NoError(t, err)
+		newHash, err := cm.HashOf()
+		require.NoError(t, err)
+		return oldHash, newHash
+	}
+
+	waitForDelivery := func(t *testing.T, dEnv *env.DoltEnv, status string) doltdb.WebhookDelivery {
+		var delivery doltdb.WebhookDelivery
+		require.Eventually(t, func() bool {
+			deliveries, err := dEnv.DoltDB(context.Background()).GetWebhookDeliveries(context.Background())
+			require.NoError(t, err)
+			if len(deliveries) == 0 {
+				return false
+			}
+			delivery = deliveries[len(deliveries)-1]
+			return delivery.Status == status
+		}, 5*time.Second, 10*time.Millisecond)
+		return delivery
+	}
+
+	t.Run("Delivered", func(t *testing.T) {
+		server := newWebhookTestServer(t)
+		controller := NewWebhookController(NewLogger(), []servercfg.WebhookConfig{webhookConfig(server.URL, func(cfg *servercfg.WebhookYAMLConfig) {
+			secret := "s3cr3t"
+			cfg.Secret_ = &secret
+		})})
+		dEnv := setup(t, controller)
+
+		oldHash, newHash := commit(t, dEnv, "main", "create table t (pk int primary key, c int);\ninsert into t values (1, 1), (2, 2);")
+		delivery := waitForDelivery(t, dEnv, doltdb.WebhookDeliveryStatusDelivered)
+		assert.Equal(t, 1, delivery.Attempts)
+		assert.Equal(t, http.StatusOK, delivery.ResponseCode)
+		assert.Equal(t, "main", delivery.Branch)
+		assert.Equal(t, oldHash.String(), delivery.OldCommit)
+		assert.Equal(t, newHash.String(), delivery.NewCommit)
+
+		requests := server.Requests()
+		require.Len(t, requests, 1)
+		req := requests[0]
+		assert.Equal(t, SignWebhookPayload("s3cr3t", req.body), req.header.Get(WebhookSignatureHeader))
+		assert.Equal(t, delivery.DeliveryId, req.header.Get(WebhookDeliveryHeader))
+		assert.Equal(t, "some_database", req.payload.Database)
+		assert.Equal(t, "main", req.payload.Branch)
+		assert.Equal(t, oldHash.String(), req.payload.OldCommit)
+		assert.Equal(t, newHash.String(), req.payload.NewCommit)
+		require.NotNil(t, req.payload.Committer)
+		assert.Equal(t, "Bill Billerson", req.payload.Committer.Name)
+		assert.Equal(t, "webhook test", req.payload.Committer.Message)
+		assert.Equal(t, []WebhookTableStats{{TableName: "t", RowsAdded: 2, SchemaChanged: true}}, req.payload.Tables)
+
+		_, newHash = commit(t, dEnv, "main", "insert into t values (1, 10), (3, 3) on duplicate key update c = values(c);")
+		require.Eventually(t, func() bool { return len(server.Requests()) == 2 }, 5*time.Second, 10*time.Millisecond)
+		req = server.Requests()[1]
+		assert.Equal(t, newHash.String(), req.payload.NewCommit)
+		assert.Equal(t, []WebhookTableStats{{TableName: "t", RowsAdded: 1, RowsModified: 1, CellsModified: 1}}, req.payload.Tables)
+	})
+	t.Run("Retried", func(t *testing.T) {
+		server := newWebhookTestServer(t, http.StatusInternalServerError, http.StatusBadGateway)
+		controller := NewWebhookController(NewLogger(), []servercfg.WebhookConfig{webhookConfig(server.URL, nil)})
+		dEnv := setup(t, controller)
+
+		commit(t, dEnv, "main", "create table t (pk int primary key);")
+		delivery := waitForDelivery(t, dEnv, doltdb.WebhookDeliveryStatusDelivered)
+		assert.Equal(t, 3, delivery.Attempts)
+		assert.Empty(t, delivery.Error)
+
+		requests := server.Requests()
+		require.Len(t, requests, 3)
+		assert.Empty(t, requests[0].header.Get(WebhookSignatureHeader))
+		assert.Equal(t, requests[0].header.Get(WebhookDeliveryHeader), requests[2].header.Get(WebhookDeliveryHeader))
+	})
+	t.Run("Failed", func(t *testing.T) {
+		server := newWebhookTestServer(t, http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError)
+		controller := NewWebhookController(NewLogger(), []servercfg.WebhookConfig{webhookConfig(server.URL, func(cfg *servercfg.WebhookYAMLConfig) {
+			retries := 1
+			cfg.MaxRetries_ = &retries
+		})})
+		dEnv := setup(t, controller)
+
+		commit(t, dEnv, "main", "create table t (pk int primary key);")
+		delivery := waitForDelivery(t, dEnv, doltdb.WebhookDeliveryStatusFailed)
+		assert.Equal(t, 2, delivery.Attempts)
+		assert.Equal(t, http.StatusInternalServerError, delivery.ResponseCode)
+		assert.Contains(t, delivery.Error, "500")
+		assert.Len(t, server.Requests(), 2)
+	})
+	t.Run("BranchFilter", func(t *testing.T) {
+		server := newWebhookTestServer(t)
+		controller := NewWebhookController(NewLogger(), []servercfg.WebhookConfig{webhookConfig(server.URL, func(cfg *servercfg.WebhookYAMLConfig) {
+			cfg.Branches_ = []string{"release"}
+		})})
+		dEnv := setup(t, controller)
+
+		commit(t, dEnv, "main", "create table t (pk int primary key);")
+		deliveries, err := dEnv.DoltDB(context.Background()).GetWebhookDeliveries(context.Background())
+		require.NoError(t, err)
+		assert.Empty(t, deliveries)
+	})
+	t.Run("QueueFull", func(t *testing.T) {
+		controller := NewWebhookController(NewLogger(), []servercfg.WebhookConfig{webhookConfig("http://localhost", func(cfg *servercfg.WebhookYAMLConfig) {
+			queueSize := 1
+			cfg.QueueSize_ = &queueSize
+		})})
+		controller.ctxF = CtxFactory
+		ddb := CreateTestEnvWithName("some_database").DoltDB(context.Background())
+
+		// the background threads are not running, so nothing is taken off the queues
+		controller.enqueue("some_database", ddb, "main", hash.Hash{}, hash.Of([]byte("first")))
+		controller.enqueue("some_database", ddb, "main", hash.Of([]byte("first")), hash.Of([]byte("second")))
+		controller.recordDeliveries(context.Background(), <-controller.recordCh)
+		deliveries, err := ddb.GetWebhookDeliveries(context.Background())
+		require.NoError(t, err)
+		require.Len(t, deliveries, 2)
+		assert.Equal(t, doltdb.WebhookDeliveryStatusPending, deliveries[0].Status)
+		assert.Equal(t, doltdb.WebhookDeliveryStatusDropped, deliveries[1].Status)
+	})
+}
+
+func TestWebhookCommitHook(t *testing.T) {
+	controller := NewWebhookController(logrus.New(), []servercfg.WebhookConfig{webhookConfig("http://localhost", nil)})
+	ctx := context.Background()
+	controller.ctxF = func(ctx context.Context) (*sql.Context, error) {
+		return sql.NewContext(ctx, sql.WithSession(sql.NewBaseSession())), nil
+	}
+	ddb := CreateTestEnvWithName("some_database").DoltDB(ctx)
+	hook := controller.newCommitHook(ctx, "some_database", ddb)
+
+	main, err := ddb.ResolveCommitRef(ctx, ref.NewBranchRef("main"))
+	require.NoError(t, err)
+	mainHash, err := main.HashOf()
+	require.NoError(t, err)
+	require.Equal(t, mainHash, hook.heads["main"])
+
+	// creating a branch is reported without an old commit
+	require.NoError(t, ddb.NewBranchAtCommit(ctx, ref.NewBranchRef("feature"), main, nil))
+	ds, err := doltdb.HackDatasDatabaseFromDoltDB(ddb).GetDataset(ctx, "refs/heads/feature")
+	require.NoError(t, err)
+	_, err = hook.Execute(ctx, ds, ddb)
+	require.NoError(t, err)
+
+	// executing the hook again for an unchanged head is not reported
+	_, err = hook.Execute(ctx, ds, ddb)
+	require.NoError(t, err)
+
+	// deleting a branch is reported without a new commit
+	require.NoError(t, ddb.DeleteBranch(ctx, ref.NewBranchRef("feature"), nil))
+	ds, err = doltdb.HackDatasDatabaseFromDoltDB(ddb).GetDataset(ctx, "refs/heads/feature")
+	require.NoError(t, err)
+	_, err = hook.Execute(ctx, ds, ddb)
+	require.NoError(t, err)
+
+	// tags are not reported
+	require.NoError(t, ddb.NewTagAtCommit(ctx, ref.NewTagRef("v1"), main, &datas.TagMeta{Name: "a", Email: "a@b.c"}))
+	ds, err = doltdb.HackDatasDatabaseFromDoltDB(ddb).GetDataset(ctx, "refs/tags/v1")
+	require.NoError(t, err)
+	_, err = hook.Execute(ctx, ds, ddb)
+	require.NoError(t, err)
+
+	// the hook does not write to the database, its deliveries are recorded by the controller
+	deliveries, err := ddb.GetWebhookDeliveries(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, deliveries)
+	controller.recordDeliveries(ctx, <-controller.recordCh)
+	deliveries, err = ddb.GetWebhookDeliveries(ctx)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	assert.Equal(t, "feature", deliveries[0].Branch)
+	assert.Empty(t, deliveries[0].OldCommit)
+	assert.Equal(t, mainHash.String(), deliveries[0].NewCommit)
+	assert.Equal(t, mainHash.String(), deliveries[1].OldCommit)
+	assert.Empty(t, deliveries[1].NewCommit)
+}
@@ -63,6 +63,14 @@ type SqlTableReader interface {
 	ReadSqlRow(ctx context.Context) (sql.Row, error)
 }
 
+// PrimaryKeySuggester is implemented by readers that can suggest a primary key for a table created from the rows they
+// read.
+type PrimaryKeySuggester interface {
+	// SuggestPrimaryKey returns the names of the columns whose values are unique and non-null in every row, or nil if
+	// there are no such columns.
+	SuggestPrimaryKey() []string
+}
+
 // PipeRows will read a row from given TableReader and write it to the provided RowWriter.  It will do this
 // for every row until the TableReader's ReadRow method returns io.EOF or encounters an error in either reading
 // or writing.  The caller will need to handle closing the tables as necessary. If contOnBadRow is true, errors reading
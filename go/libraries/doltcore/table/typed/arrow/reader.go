@@ -0,0 +1,226 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	gmstypes "github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/shopspring/decimal"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/row"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table"
+)
+
+const secondsPerDay = 24 * 60 * 60
+
+// ArrowReader implements SqlTableReader. It reads files in the Arrow IPC file format, which is also known as Feather
+// V2, and returns rows.
+type ArrowReader struct {
+	closer  io.Closer
+	fr      *ipc.FileReader
+	sch     schema.Schema
+	rec     arrow.Record
+	recIdx  int
+	rowIdx  int
+	numRows int
+}
+
+var _ table.SqlTableReader = (*ArrowReader)(nil)
+
+// OpenArrowReader opens a reader at a given path within local filesystem. The schema of the reader is derived from
+// the schema of the file and has no primary key.
+func OpenArrowReader(path string) (*ArrowReader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	rd, err := NewArrowReader(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return rd, nil
+}
+
+// ReadAtSeekCloser is the source of an ArrowReader.
+type ReadAtSeekCloser interface {
+	ipc.ReadAtSeeker
+	io.Closer
+}
+
+// NewArrowReader creates an ArrowReader which reads from |r|.
+func NewArrowReader(r ReadAtSeekCloser) (*ArrowReader, error) {
+	fr, err := ipc.NewFileReader(r, ipc.WithAllocator(memory.NewGoAllocator()))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read arrow file: %w", err)
+	}
+
+	sch, err := doltSchemaForArrowSchema(fr.Schema())
+	if err != nil {
+		fr.Close()
+		return nil, err
+	}
+
+	return &ArrowReader{closer: r, fr: fr, sch: sch, recIdx: -1}, nil
+}
+
+func (ar *ArrowReader) ReadRow(ctx context.Context) (row.Row, error) {
+	panic("deprecated")
+}
+
+func (ar *ArrowReader) ReadSqlRow(ctx context.Context) (sql.Row, error) {
+	for ar.rowIdx >= ar.numRows {
+		if ar.recIdx+1 >= ar.fr.NumRecords() {
+			return nil, io.EOF
+		}
+		ar.recIdx++
+
+		// the record is owned by the file reader, and is released on the next call to Record
+		rec, err := ar.fr.Record(ar.recIdx)
+		if err != nil {
+			return nil, err
+		}
+		ar.rec = rec
+		ar.rowIdx = 0
+		ar.numRows = int(rec.NumRows())
+	}
+
+	r := make(sql.Row, ar.rec.NumCols())
+	for i, col := range ar.rec.Columns() {
+		val, err := arrowValue(col, ar.rowIdx)
+		if err != nil {
+			return nil, fmt.Errorf("column %s: %w", ar.rec.ColumnName(i), err)
+		}
+		r[i] = val
+	}
+	ar.rowIdx++
+
+	return r, nil
+}
+
+func (ar *ArrowReader) GetSchema() schema.Schema {
+	return ar.sch
+}
+
+// Close should release resources being held
+func (ar *ArrowReader) Close(ctx context.Context) error {
+	err := ar.fr.Close()
+	if cerr := ar.closer.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// arrowValue returns the SQL value at index |i| of |arr|. Values which reference the memory of |arr| are copied, as
+// that memory is released when the next record batch is read.
+func arrowValue(arr arrow.Array, i int) (interface{}, error) {
+	if arr.IsNull(i) {
+		return nil, nil
+	}
+
+	switch arr := arr.(type) {
+	case *array.Boolean:
+		return arr.Value(i), nil
+	case *array.Int8:
+		return arr.Value(i), nil
+	case *array.Int16:
+		return arr.Value(i), nil
+	case *array.Int32:
+		return arr.Value(i), nil
+	case *array.Int64:
+		return arr.Value(i), nil
+	case *array.Uint8:
+		return arr.Value(i), nil
+	case *array.Uint16:
+		return arr.Value(i), nil
+	case *array.Uint32:
+		return arr.Value(i), nil
+	case *array.Uint64:
+		return arr.Value(i), nil
+	case *array.Float32:
+		return arr.Value(i), nil
+	case *array.Float64:
+		return arr.Value(i), nil
+	case *array.Decimal128:
+		scale := arr.DataType().(*arrow.Decimal128Type).Scale
+		return decimal.NewFromBigInt(arr.Value(i).BigInt(), -scale), nil
+	case *array.Date32:
+		return time.Unix(int64(arr.Value(i))*secondsPerDay, 0).UTC(), nil
+	case *array.Date64:
+		return time.UnixMilli(int64(arr.Value(i))).UTC(), nil
+	case *array.Timestamp:
+		unit := arr.DataType().(*arrow.TimestampType).Unit
+		return timeFromUnits(int64(arr.Value(i)), unit), nil
+	case *array.Time32:
+		unit := arr.DataType().(*arrow.Time32Type).Unit
+		return timespanFromUnits(int64(arr.Value(i)), unit), nil
+	case *array.Time64:
+		unit := arr.DataType().(*arrow.Time64Type).Unit
+		return timespanFromUnits(int64(arr.Value(i)), unit), nil
+	case *array.Duration:
+		unit := arr.DataType().(*arrow.DurationType).Unit
+		return timespanFromUnits(int64(arr.Value(i)), unit), nil
+	case *array.String:
+		return strings.Clone(arr.Value(i)), nil
+	case *array.Binary:
+		return bytes.Clone(arr.Value(i)), nil
+	case *array.FixedSizeBinary:
+		return bytes.Clone(arr.Value(i)), nil
+	default:
+		return nil, fmt.Errorf("unsupported arrow type: %s", arr.DataType())
+	}
+}
+
+// timeFromUnits returns the time |v| units after the UNIX epoch.
+func timeFromUnits(v int64, unit arrow.TimeUnit) time.Time {
+	switch unit {
+	case arrow.Second:
+		return time.Unix(v, 0).UTC()
+	case arrow.Millisecond:
+		return time.UnixMilli(v).UTC()
+	case arrow.Microsecond:
+		return time.UnixMicro(v).UTC()
+	default:
+		return time.Unix(0, v).UTC()
+	}
+}
+
+// timespanFromUnits returns a TIME value for a duration of |v| units.
+func timespanFromUnits(v int64, unit arrow.TimeUnit) gmstypes.Timespan {
+	switch unit {
+	case arrow.Second:
+		return gmstypes.Timespan(v * int64(time.Second/time.Microsecond))
+	case arrow.Millisecond:
+		return gmstypes.Timespan(v * int64(time.Millisecond/time.Microsecond))
+	case arrow.Microsecond:
+		return gmstypes.Timespan(v)
+	default:
+		return gmstypes.Timespan(v / int64(time.Microsecond))
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow
+
+import (
+	"fmt"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/dolthub/go-mysql-server/sql"
+	gmstypes "github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema/typeinfo"
+)
+
+// maxDecimal128Precision is the largest precision that fits in an Arrow decimal128. Decimal columns with a larger
+// precision are written as strings.
+const maxDecimal128Precision = 38
+
+// arrowTypeForSqlType maps |t| to the Arrow type used to store its values.
+func arrowTypeForSqlType(t sql.Type) (arrow.DataType, error) {
+	switch t.Type() {
+	case query.Type_INT8:
+		return arrow.PrimitiveTypes.Int8, nil
+	case query.Type_INT16, query.Type_YEAR:
+		return arrow.PrimitiveTypes.Int16, nil
+	case query.Type_INT24, query.Type_INT32:
+		return arrow.PrimitiveTypes.Int32, nil
+	case query.Type_INT64:
+		return arrow.PrimitiveTypes.Int64, nil
+	case query.Type_UINT8:
+		return arrow.PrimitiveTypes.Uint8, nil
+	case query.Type_UINT16:
+		return arrow.PrimitiveTypes.Uint16, nil
+	case query.Type_UINT24, query.Type_UINT32:
+		return arrow.PrimitiveTypes.Uint32, nil
+	case query.Type_UINT64, query.Type_BIT:
+		return arrow.PrimitiveTypes.Uint64, nil
+	case query.Type_FLOAT32:
+		return arrow.PrimitiveTypes.Float32, nil
+	case query.Type_FLOAT64:
+		return arrow.PrimitiveTypes.Float64, nil
+	case query.Type_DECIMAL:
+		dt := t.(sql.DecimalType)
+		if dt.Precision() > maxDecimal128Precision {
+			return arrow.BinaryTypes.String, nil
+		}
+		return &arrow.Decimal128Type{Precision: int32(dt.Precision()), Scale: int32(dt.Scale())}, nil
+	case query.Type_DATE:
+		return arrow.FixedWidthTypes.Date32, nil
+	case query.Type_DATETIME:
+		return &arrow.TimestampType{Unit: arrow.Microsecond}, nil
+	case query.Type_TIMESTAMP:
+		return &arrow.TimestampType{Unit: arrow.Microsecond, TimeZone: "UTC"}, nil
+	case query.Type_TIME:
+		return arrow.FixedWidthTypes.Duration_us, nil
+	case query.Type_CHAR, query.Type_VARCHAR, query.Type_TEXT, query.Type_JSON,
+		query.Type_ENUM, query.Type_SET, query.Type_GEOMETRY:
+		return arrow.BinaryTypes.String, nil
+	case query.Type_BINARY, query.Type_VARBINARY, query.Type_BLOB:
+		return arrow.BinaryTypes.Binary, nil
+	default:
+		return nil, fmt.Errorf("unsupported type: %v", t.Type())
+	}
+}
+
+// sqlTypeForArrowType maps the Arrow type |t| to the SQL type used for a column created from it.
+func sqlTypeForArrowType(t arrow.DataType) (sql.Type, error) {
+	switch t.ID() {
+	case arrow.BOOL:
+		return gmstypes.Boolean, nil
+	case arrow.INT8:
+		return gmstypes.Int8, nil
+	case arrow.INT16:
+		return gmstypes.Int16, nil
+	case arrow.INT32:
+		return gmstypes.Int32, nil
+	case arrow.INT64:
+		return gmstypes.Int64, nil
+	case arrow.UINT8:
+		return gmstypes.Uint8, nil
+	case arrow.UINT16:
+		return gmstypes.Uint16, nil
+	case arrow.UINT32:
+		return gmstypes.Uint32, nil
+	case arrow.UINT64:
+		return gmstypes.Uint64, nil
+	case arrow.FLOAT32:
+		return gmstypes.Float32, nil
+	case arrow.FLOAT64:
+		return gmstypes.Float64, nil
+	case arrow.DECIMAL128:
+		dt := t.(*arrow.Decimal128Type)
+		return gmstypes.CreateDecimalType(uint8(dt.Precision), uint8(dt.Scale))
+	case arrow.DATE32, arrow.DATE64:
+		return gmstypes.Date, nil
+	case arrow.TIMESTAMP:
+		if t.(*arrow.TimestampType).TimeZone != "" {
+			return gmstypes.TimestampMaxPrecision, nil
+		}
+		return gmstypes.DatetimeMaxPrecision, nil
+	case arrow.TIME32, arrow.TIME64, arrow.DURATION:
+		return gmstypes.Time, nil
+	case arrow.STRING:
+		return gmstypes.LongText, nil
+	case arrow.BINARY, arrow.FIXED_SIZE_BINARY:
+		return gmstypes.LongBlob, nil
+	default:
+		return nil, fmt.Errorf("unsupported arrow type: %s", t)
+	}
+}
+
+// arrowSchemaForSqlSchema returns the Arrow schema for |sch|.
+func arrowSchemaForSqlSchema(sch sql.Schema) (*arrow.Schema, error) {
+	fields := make([]arrow.Field, len(sch))
+	for i, col := range sch {
+		t, err := arrowTypeForSqlType(col.Type)
+		if err != nil {
+			return nil, fmt.Errorf("column %s: %w", col.Name, err)
+		}
+		fields[i] = arrow.Field{Name: col.Name, Type: t, Nullable: col.Nullable}
+	}
+	return arrow.NewSchema(fields, nil), nil
+}
+
+// doltSchemaForArrowSchema returns a keyless schema with a column for each field of |sch|.
+func doltSchemaForArrowSchema(sch *arrow.Schema) (schema.Schema, error) {
+	cols := make([]schema.Column, len(sch.Fields()))
+	for i, field := range sch.Fields() {
+		sqlType, err := sqlTypeForArrowType(field.Type)
+		if err != nil {
+			return nil, fmt.Errorf("column %s: %w", field.Name, err)
+		}
+		ti, err := typeinfo.FromSqlType(sqlType)
+		if err != nil {
+			return nil, err
+		}
+		var constraints []schema.ColConstraint
+		if !field.Nullable {
+			constraints = append(constraints, schema.NotNullConstraint{})
+		}
+		cols[i], err = schema.NewColumnWithTypeInfo(field.Name, uint64(i), ti, false, "", false, "", constraints...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return schema.SchemaFromCols(schema.NewColCollection(cols...))
+}
@@ -0,0 +1,260 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/decimal128"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	gmstypes "github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/shopspring/decimal"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table"
+)
+
+// recordBatchSize is the number of rows buffered before they are written as a record batch.
+const recordBatchSize = 64 * 1024
+
+// WriteSeekCloser is the destination of an ArrowRowWriter. The Arrow IPC file format writes a footer which
+// references the position of each record batch, so the destination must be seekable.
+type WriteSeekCloser interface {
+	io.WriteSeeker
+	io.Closer
+}
+
+// ArrowRowWriter writes rows to a file in the Arrow IPC file format, which is also known as Feather V2.
+type ArrowRowWriter struct {
+	fw      *ipc.FileWriter
+	builder *array.RecordBuilder
+	sch     sql.Schema
+	closer  io.Closer
+	rows    int
+}
+
+var _ table.SqlRowWriter = (*ArrowRowWriter)(nil)
+
+// NewArrowRowWriter creates a new ArrowRowWriter instance for the specified schema and writing to |w|.
+func NewArrowRowWriter(outSch sql.Schema, w WriteSeekCloser) (*ArrowRowWriter, error) {
+	arrowSch, err := arrowSchemaForSqlSchema(outSch)
+	if err != nil {
+		return nil, err
+	}
+
+	mem := memory.NewGoAllocator()
+	fw, err := ipc.NewFileWriter(w, ipc.WithSchema(arrowSch), ipc.WithAllocator(mem))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ArrowRowWriter{
+		fw:      fw,
+		builder: array.NewRecordBuilder(mem, arrowSch),
+		sch:     outSch,
+		closer:  w,
+	}, nil
+}
+
+// NewArrowRowWriterForFile creates a new ArrowRowWriter instance for the specified schema and writing to the
+// specified file name.
+func NewArrowRowWriterForFile(outSch schema.Schema, destName string) (*ArrowRowWriter, error) {
+	primaryKeySchema, err := sqlutil.FromDoltSchema("", "", outSch)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Create(destName)
+	if err != nil {
+		return nil, err
+	}
+
+	wr, err := NewArrowRowWriter(primaryKeySchema.Schema, f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return wr, nil
+}
+
+func (awr *ArrowRowWriter) WriteSqlRow(_ context.Context, r sql.Row) error {
+	for i, val := range r {
+		err := appendValue(awr.builder.Field(i), awr.sch[i].Type, val)
+		if err != nil {
+			return fmt.Errorf("column %s: %w", awr.sch[i].Name, err)
+		}
+	}
+
+	awr.rows++
+	if awr.rows >= recordBatchSize {
+		return awr.flush()
+	}
+	return nil
+}
+
+// flush writes the buffered rows as a record batch.
+func (awr *ArrowRowWriter) flush() error {
+	rec := awr.builder.NewRecord()
+	defer rec.Release()
+	awr.rows = 0
+	return awr.fw.Write(rec)
+}
+
+// Close should flush all writes, release resources being held
+func (awr *ArrowRowWriter) Close(_ context.Context) error {
+	defer awr.builder.Release()
+
+	if awr.rows > 0 {
+		if err := awr.flush(); err != nil {
+			return err
+		}
+	}
+
+	// Close writes the footer, but does not close the underlying writer
+	if err := awr.fw.Close(); err != nil {
+		return err
+	}
+
+	if awr.closer != nil {
+		return awr.closer.Close()
+	}
+
+	return nil
+}
+
+// appendValue appends |val|, a value of |sqlType|, to |b|.
+func appendValue(b array.Builder, sqlType sql.Type, val interface{}) error {
+	if val == nil {
+		b.AppendNull()
+		return nil
+	}
+
+	switch b := b.(type) {
+	case *array.StringBuilder:
+		s, err := sqlutil.SqlColToStr(sqlType, val)
+		if err != nil {
+			return err
+		}
+		b.Append(s)
+		return nil
+	case *array.BinaryBuilder:
+		switch v := val.(type) {
+		case []byte:
+			b.Append(v)
+		case string:
+			b.AppendString(v)
+		default:
+			return fmt.Errorf("unexpected value %v of type %T", val, val)
+		}
+		return nil
+	}
+
+	// normalize numeric and temporal values to the Go type used by |sqlType|
+	val, _, err := sqlType.Convert(val)
+	if err != nil {
+		return err
+	}
+
+	var ok bool
+	switch b := b.(type) {
+	case *array.Int8Builder:
+		var v int8
+		if v, ok = val.(int8); ok {
+			b.Append(v)
+		}
+	case *array.Int16Builder:
+		var v int16
+		if v, ok = val.(int16); ok {
+			b.Append(v)
+		}
+	case *array.Int32Builder:
+		var v int32
+		if v, ok = val.(int32); ok {
+			b.Append(v)
+		}
+	case *array.Int64Builder:
+		var v int64
+		if v, ok = val.(int64); ok {
+			b.Append(v)
+		}
+	case *array.Uint8Builder:
+		var v uint8
+		if v, ok = val.(uint8); ok {
+			b.Append(v)
+		}
+	case *array.Uint16Builder:
+		var v uint16
+		if v, ok = val.(uint16); ok {
+			b.Append(v)
+		}
+	case *array.Uint32Builder:
+		var v uint32
+		if v, ok = val.(uint32); ok {
+			b.Append(v)
+		}
+	case *array.Uint64Builder:
+		var v uint64
+		if v, ok = val.(uint64); ok {
+			b.Append(v)
+		}
+	case *array.Float32Builder:
+		var v float32
+		if v, ok = val.(float32); ok {
+			b.Append(v)
+		}
+	case *array.Float64Builder:
+		var v float64
+		if v, ok = val.(float64); ok {
+			b.Append(v)
+		}
+	case *array.Decimal128Builder:
+		var v decimal.Decimal
+		if v, ok = val.(decimal.Decimal); ok {
+			scale := int32(sqlType.(sql.DecimalType).Scale())
+			b.Append(decimal128.FromBigInt(v.Round(scale).Shift(scale).BigInt()))
+		}
+	case *array.Date32Builder:
+		var v time.Time
+		if v, ok = val.(time.Time); ok {
+			b.Append(arrow.Date32(v.Unix() / secondsPerDay))
+		}
+	case *array.TimestampBuilder:
+		var v time.Time
+		if v, ok = val.(time.Time); ok {
+			b.Append(arrow.Timestamp(v.UnixMicro()))
+		}
+	case *array.DurationBuilder:
+		var v gmstypes.Timespan
+		if v, ok = val.(gmstypes.Timespan); ok {
+			b.Append(arrow.Duration(v.AsTimeDuration().Microseconds()))
+		}
+	default:
+		return fmt.Errorf("unsupported arrow builder: %T", b)
+	}
+
+	if !ok {
+		return fmt.Errorf("unexpected value %v of type %T", val, val)
+	}
+	return nil
+}
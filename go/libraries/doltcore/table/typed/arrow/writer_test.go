@@ -0,0 +1,185 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	gmstypes "github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema/typeinfo"
+)
+
+func mustTypeInfo(t *testing.T, sqlType sql.Type) typeinfo.TypeInfo {
+	ti, err := typeinfo.FromSqlType(sqlType)
+	require.NoError(t, err)
+	return ti
+}
+
+func TestArrowRoundTrip(t *testing.T) {
+	cols := []struct {
+		name    string
+		sqlType sql.Type
+	}{
+		{"id", gmstypes.Int64},
+		{"small", gmstypes.Int16},
+		{"medium", gmstypes.Int24},
+		{"unsigned", gmstypes.Uint32},
+		{"flt", gmstypes.Float32},
+		{"dbl", gmstypes.Float64},
+		{"dec", gmstypes.MustCreateDecimalType(10, 2)},
+		{"wide_dec", gmstypes.MustCreateDecimalType(50, 5)},
+		{"dt", gmstypes.DatetimeMaxPrecision},
+		{"ts", gmstypes.TimestampMaxPrecision},
+		{"d", gmstypes.Date},
+		{"tm", gmstypes.Time},
+		{"name", gmstypes.MustCreateStringWithDefaults(query.Type_VARCHAR, 20)},
+		{"doc", gmstypes.JSON},
+		{"data", gmstypes.LongBlob},
+	}
+
+	var colColl []schema.Column
+	for i, c := range cols {
+		col, err := schema.NewColumnWithTypeInfo(c.name, uint64(i), mustTypeInfo(t, c.sqlType), i == 0, "", false, "")
+		require.NoError(t, err)
+		colColl = append(colColl, col)
+	}
+	sch, err := schema.SchemaFromCols(schema.NewColCollection(colColl...))
+	require.NoError(t, err)
+
+	dt := time.Date(2021, 3, 4, 5, 6, 7, 123456000, time.UTC)
+	rows := []sql.Row{
+		{
+			int64(1), int16(-2), int32(3), uint32(4), float32(1.5), 2.25,
+			decimal.RequireFromString("-123.45"), decimal.RequireFromString("12345678901234567890123456789012345678901.5"),
+			dt, dt, time.Date(1960, 1, 2, 0, 0, 0, 0, time.UTC), gmstypes.Timespan(-(25*time.Hour + time.Second).Microseconds()),
+			"one", gmstypes.MustJSON(`{"a": [1, 2]}`), []byte{0, 1, 2},
+		},
+		{int64(2), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil},
+	}
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "test.arrow")
+	wr, err := NewArrowRowWriterForFile(sch, path)
+	require.NoError(t, err)
+	for _, r := range rows {
+		require.NoError(t, wr.WriteSqlRow(ctx, r))
+	}
+	require.NoError(t, wr.Close(ctx))
+
+	rd, err := OpenArrowReader(path)
+	require.NoError(t, err)
+	defer rd.Close(ctx)
+
+	readSch := rd.GetSchema()
+	var readTypes []string
+	readSch.GetAllCols().Iter(func(tag uint64, col schema.Column) (stop bool, err error) {
+		readTypes = append(readTypes, col.Name+" "+col.TypeInfo.ToSqlType().String())
+		return false, nil
+	})
+	assert.Equal(t, []string{
+		"id bigint",
+		"small smallint",
+		"medium int",
+		"unsigned int unsigned",
+		"flt float",
+		"dbl double",
+		"dec decimal(10,2)",
+		"wide_dec longtext",
+		"dt datetime(6)",
+		"ts timestamp(6)",
+		"d date",
+		"tm time(6)",
+		"name longtext",
+		"doc longtext",
+		"data longblob",
+	}, readTypes)
+	assert.Empty(t, readSch.GetPKCols().GetColumns())
+
+	r, err := rd.ReadSqlRow(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, sql.Row{
+		int64(1), int16(-2), int32(3), uint32(4), float32(1.5), 2.25,
+		decimal.RequireFromString("-123.45"), "12345678901234567890123456789012345678901.5",
+		dt, dt, time.Date(1960, 1, 2, 0, 0, 0, 0, time.UTC), gmstypes.Timespan(-(25*time.Hour + time.Second).Microseconds()),
+		"one", `{"a": [1, 2]}`, []byte{0, 1, 2},
+	}, r)
+
+	r, err = rd.ReadSqlRow(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, sql.Row{int64(2), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil}, r)
+
+	_, err = rd.ReadSqlRow(ctx)
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestArrowReaderCompressed(t *testing.T) {
+	mem := memory.NewGoAllocator()
+	arrowSch := arrow.NewSchema([]arrow.Field{
+		{Name: "id", Type: arrow.PrimitiveTypes.Int32},
+		{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
+	}, nil)
+	b := array.NewRecordBuilder(mem, arrowSch)
+	defer b.Release()
+	b.Field(0).(*array.Int32Builder).AppendValues([]int32{1, 2}, nil)
+	b.Field(1).(*array.StringBuilder).AppendValues([]string{"a", ""}, []bool{true, false})
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	path := filepath.Join(t.TempDir(), "compressed.feather")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	fw, err := ipc.NewFileWriter(f, ipc.WithSchema(arrowSch), ipc.WithAllocator(mem), ipc.WithLZ4())
+	require.NoError(t, err)
+	require.NoError(t, fw.Write(rec))
+	require.NoError(t, fw.Close())
+	require.NoError(t, f.Close())
+
+	ctx := context.Background()
+	rd, err := OpenArrowReader(path)
+	require.NoError(t, err)
+	defer rd.Close(ctx)
+
+	idCol, ok := rd.GetSchema().GetAllCols().GetByName("id")
+	require.True(t, ok)
+	assert.Equal(t, "int", idCol.TypeInfo.ToSqlType().String())
+	assert.False(t, idCol.IsNullable())
+
+	var rows []sql.Row
+	for {
+		r, err := rd.ReadSqlRow(ctx)
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		rows = append(rows, r)
+	}
+	assert.Equal(t, []sql.Row{{int32(1), "a"}, {int32(2), nil}}, rows)
+}
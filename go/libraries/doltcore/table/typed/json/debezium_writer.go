@@ -0,0 +1,171 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package json
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+)
+
+// Debezium operation codes for row change events.
+const (
+	DebeziumOpCreate = "c"
+	DebeziumOpUpdate = "u"
+	DebeziumOpDelete = "d"
+)
+
+// Debezium table change types for schema change events.
+const (
+	DebeziumTableCreate = "CREATE"
+	DebeziumTableAlter  = "ALTER"
+	DebeziumTableDrop   = "DROP"
+)
+
+// DebeziumSource is the "source" block of a Debezium change event, which describes where a change was read from.
+// |Commit| and |Branch| are nil when the changes are not from a commit, or are not from the head of a branch.
+type DebeziumSource struct {
+	Version   string  `json:"version"`
+	Connector string  `json:"connector"`
+	Name      string  `json:"name"`
+	TsMs      int64   `json:"ts_ms"`
+	Snapshot  string  `json:"snapshot"`
+	Db        string  `json:"db"`
+	Table     string  `json:"table,omitempty"`
+	Commit    *string `json:"commit"`
+	Branch    *string `json:"branch"`
+}
+
+type debeziumRowEvent struct {
+	Before json.RawMessage `json:"before"`
+	After  json.RawMessage `json:"after"`
+	Op     string          `json:"op"`
+	Source DebeziumSource  `json:"source"`
+	TsMs   int64           `json:"ts_ms"`
+}
+
+type debeziumTableChange struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+type debeziumSchemaChangeEvent struct {
+	Source       DebeziumSource        `json:"source"`
+	DatabaseName string                `json:"databaseName"`
+	DDL          string                `json:"ddl"`
+	TableChanges []debeziumTableChange `json:"tableChanges"`
+	TsMs         int64                 `json:"ts_ms"`
+}
+
+// DebeziumWriter writes Debezium compatible change events as newline delimited JSON. Row changes are written as
+// change event envelopes with "before", "after", "op" and "source" fields, and schema changes are written in the
+// format of Debezium's schema change topic.
+type DebeziumWriter struct {
+	enc  *json.Encoder
+	tsMs int64
+}
+
+// NewDebeziumWriter returns a DebeziumWriter which writes events to |wr|.
+func NewDebeziumWriter(wr io.Writer) *DebeziumWriter {
+	enc := json.NewEncoder(wr)
+	enc.SetEscapeHTML(false)
+	return &DebeziumWriter{enc: enc, tsMs: time.Now().UnixMilli()}
+}
+
+// WriteSchemaChange writes a schema change event for the DDL statement given. |changeType| is one of the Debezium
+// table change types, or empty if the statement does not change a table.
+func (w *DebeziumWriter) WriteSchemaChange(source DebeziumSource, ddl string, changeType string) error {
+	tableChanges := []debeziumTableChange{}
+	if changeType != "" {
+		tableChanges = append(tableChanges, debeziumTableChange{
+			Type: changeType,
+			ID:   fmt.Sprintf("%s.%s", source.Db, source.Table),
+		})
+	}
+	return w.enc.Encode(debeziumSchemaChangeEvent{
+		Source:       source,
+		DatabaseName: source.Db,
+		DDL:          ddl,
+		TableChanges: tableChanges,
+		TsMs:         w.tsMs,
+	})
+}
+
+// NewRowDiffWriter returns a diff.SqlRowDiffWriter which writes a row change event for each row change written to it.
+// Rows are encoded using |outSch|, with NULL values included.
+func (w *DebeziumWriter) NewRowDiffWriter(source DebeziumSource, outSch schema.Schema) diff.SqlRowDiffWriter {
+	return &debeziumRowDiffWriter{w: w, source: source, sch: outSch}
+}
+
+type debeziumRowDiffWriter struct {
+	w      *DebeziumWriter
+	source DebeziumSource
+	sch    schema.Schema
+	// before holds the old row of a modified row until its new row is written
+	before json.RawMessage
+}
+
+var _ diff.SqlRowDiffWriter = (*debeziumRowDiffWriter)(nil)
+
+func (d *debeziumRowDiffWriter) WriteRow(ctx context.Context, row sql.Row, rowDiffType diff.ChangeType, colDiffTypes []diff.ChangeType) error {
+	rowData, err := jsonDataForSchema(d.sch, row, true)
+	if err != nil {
+		return err
+	}
+
+	switch rowDiffType {
+	case diff.ModifiedOld:
+		d.before = rowData
+		return nil
+	case diff.ModifiedNew:
+		if d.before == nil {
+			return fmt.Errorf("debezium format received a modified row without its old value")
+		}
+		before := d.before
+		d.before = nil
+		return d.writeEvent(before, rowData, DebeziumOpUpdate)
+	case diff.Added:
+		return d.writeEvent(nil, rowData, DebeziumOpCreate)
+	case diff.Removed:
+		return d.writeEvent(rowData, nil, DebeziumOpDelete)
+	default:
+		return fmt.Errorf("unexpected row diff type: %v", rowDiffType)
+	}
+}
+
+func (d *debeziumRowDiffWriter) writeEvent(before, after json.RawMessage, op string) error {
+	return d.w.enc.Encode(debeziumRowEvent{
+		Before: before,
+		After:  after,
+		Op:     op,
+		Source: d.source,
+		TsMs:   d.w.tsMs,
+	})
+}
+
+func (d *debeziumRowDiffWriter) WriteCombinedRow(ctx context.Context, oldRow, newRow sql.Row, mode diff.Mode) error {
+	return fmt.Errorf("debezium format is unable to output diffs for combined rows")
+}
+
+func (d *debeziumRowDiffWriter) Close(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package json
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema/typeinfo"
+	"github.com/dolthub/dolt/go/store/types"
+)
+
+func TestDebeziumWriter(t *testing.T) {
+	sch, err := schema.SchemaFromCols(schema.NewColCollection(
+		schema.NewColumn("id", 0, types.IntKind, true),
+		schema.Column{Name: "name", Tag: 1, Kind: types.StringKind, TypeInfo: typeinfo.StringDefaultType},
+	))
+	require.NoError(t, err)
+
+	commit := "abcdefghijklmnopqrstuvwxyz012345"
+	source := DebeziumSource{Connector: "dolt", Db: "db", Table: "t", Commit: &commit}
+	colDiffs := []diff.ChangeType{diff.None, diff.None}
+
+	var buf bytes.Buffer
+	ctx := context.Background()
+	w := NewDebeziumWriter(&buf)
+	require.NoError(t, w.WriteSchemaChange(source, "ALTER TABLE `t` ADD `name` varchar(16383);", DebeziumTableAlter))
+	rw := w.NewRowDiffWriter(source, sch)
+	require.NoError(t, rw.WriteRow(ctx, sql.Row{int64(1), "a<b"}, diff.Added, colDiffs))
+	require.NoError(t, rw.WriteRow(ctx, sql.Row{int64(2), "b"}, diff.ModifiedOld, colDiffs))
+	require.NoError(t, rw.WriteRow(ctx, sql.Row{int64(2), nil}, diff.ModifiedNew, colDiffs))
+	require.NoError(t, rw.WriteRow(ctx, sql.Row{int64(3), "c"}, diff.Removed, colDiffs))
+	require.Error(t, rw.WriteCombinedRow(ctx, sql.Row{int64(2), "b"}, sql.Row{int64(2), nil}, diff.ModeContext))
+	require.NoError(t, rw.Close(ctx))
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	require.Len(t, lines, 4)
+
+	var schemaChange map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &schemaChange))
+	assert.Equal(t, "db", schemaChange["databaseName"])
+	assert.Equal(t, "ALTER TABLE `t` ADD `name` varchar(16383);", schemaChange["ddl"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"type": "ALTER", "id": "db.t"}}, schemaChange["tableChanges"])
+
+	assert.True(t, strings.HasPrefix(lines[1], `{"before":null,"after":{"id":1,"name":"a<b"},"op":"c","source":{`), lines[1])
+	assert.True(t, strings.HasPrefix(lines[2], `{"before":{"id":2,"name":"b"},"after":{"id":2,"name":null},"op":"u","source":{`), lines[2])
+	assert.True(t, strings.HasPrefix(lines[3], `{"before":{"id":3,"name":"c"},"after":null,"op":"d","source":{`), lines[3])
+	assert.Contains(t, lines[3], `"db":"db","table":"t","commit":"abcdefghijklmnopqrstuvwxyz012345","branch":null}`)
+}
+
+func TestDebeziumWriterModifiedNewWithoutOld(t *testing.T) {
+	sch, err := schema.SchemaFromCols(schema.NewColCollection(schema.NewColumn("id", 0, types.IntKind, true)))
+	require.NoError(t, err)
+
+	w := NewDebeziumWriter(&bytes.Buffer{})
+	rw := w.NewRowDiffWriter(DebeziumSource{}, sch)
+	err = rw.WriteRow(context.Background(), sql.Row{int64(1)}, diff.ModifiedNew, []diff.ChangeType{diff.None})
+	require.Error(t, err)
+}
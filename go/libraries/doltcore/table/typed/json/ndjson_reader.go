@@ -0,0 +1,286 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package json
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/transform"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/row"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table/untyped"
+	"github.com/dolthub/dolt/go/libraries/utils/filesys"
+	"github.com/dolthub/dolt/go/store/types"
+)
+
+// NDJSONReader reads newline delimited JSON, where each row is a JSON object on its own line. Unlike JSONReader, rows
+// are decoded one at a time, so the file is never held in memory.
+type NDJSONReader struct {
+	nbf    *types.NomsBinFormat
+	closer io.Closer
+	dec    *json.Decoder
+	sch    schema.Schema
+	// typed is true when |sch| was supplied by the caller, in which case values are converted to the column types.
+	// Otherwise |sch| is derived from the keys in the file and values are returned as strings.
+	typed  bool
+	rowNum int
+}
+
+var _ table.SqlTableReader = (*NDJSONReader)(nil)
+
+// OpenNDJSONReader opens a reader at a given path within a given filesys. If |sch| is nil, the file is scanned once to
+// collect the keys of its objects, which become the untyped columns of the reader's schema.
+func OpenNDJSONReader(nbf *types.NomsBinFormat, path string, fs filesys.ReadableFS, sch schema.Schema) (*NDJSONReader, error) {
+	r, err := fs.OpenForRead(path)
+	if err != nil {
+		return nil, err
+	}
+	if sch != nil {
+		return newNDJSONReader(nbf, r, sch, true), nil
+	}
+
+	colNames, err := ndjsonColumnNames(r)
+	r.Close()
+	if err != nil {
+		return nil, err
+	}
+	if len(colNames) == 0 {
+		return nil, errors.New("unable to determine columns of ndjson file: file contains no keys")
+	}
+
+	_, sch = untyped.NewUntypedSchema(colNames...)
+
+	r, err = fs.OpenForRead(path)
+	if err != nil {
+		return nil, err
+	}
+	return newNDJSONReader(nbf, r, sch, false), nil
+}
+
+// NewNDJSONReader creates an NDJSONReader which reads from |r| and converts values to the types of |sch|.
+//
+// The bytes of the supplied reader are treated as UTF-8. If there is a UTF8,
+// UTF16LE or UTF16BE BOM at the first bytes read, then it is stripped and the
+// remaining contents of the reader are treated as that encoding.
+func NewNDJSONReader(nbf *types.NomsBinFormat, r io.ReadCloser, sch schema.Schema) (*NDJSONReader, error) {
+	if sch == nil {
+		return nil, errors.New("schema must be provided to NDJSONReader")
+	}
+	return newNDJSONReader(nbf, r, sch, true), nil
+}
+
+func newNDJSONReader(nbf *types.NomsBinFormat, r io.ReadCloser, sch schema.Schema, typed bool) *NDJSONReader {
+	return &NDJSONReader{
+		nbf:    nbf,
+		closer: r,
+		dec:    newNDJSONDecoder(r),
+		sch:    sch,
+		typed:  typed,
+	}
+}
+
+func newNDJSONDecoder(r io.Reader) *json.Decoder {
+	textReader := transform.NewReader(r, unicode.BOMOverride(unicode.UTF8.NewDecoder()))
+	dec := json.NewDecoder(bufio.NewReaderSize(textReader, ReadBufSize))
+	// numbers are kept as their literal text so that large integers and decimals are not rounded
+	dec.UseNumber()
+	return dec
+}
+
+// ndjsonColumnNames returns the keys of all the objects read from |r|, in the order they are first seen.
+func ndjsonColumnNames(r io.Reader) ([]string, error) {
+	dec := newNDJSONDecoder(r)
+	seen := make(map[string]struct{})
+	var names []string
+	for rowNum := 1; ; rowNum++ {
+		keys, _, err := readNDJSONObject(dec)
+		if err == io.EOF {
+			return names, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("row %d: %w", rowNum, err)
+		}
+
+		for _, k := range keys {
+			if _, ok := seen[k]; !ok {
+				seen[k] = struct{}{}
+				names = append(names, k)
+			}
+		}
+	}
+}
+
+// readNDJSONObject decodes the next JSON object from |dec|, returning its keys in the order they appear along with
+// its values. io.EOF is returned when there are no more objects.
+func readNDJSONObject(dec *json.Decoder) ([]string, map[string]interface{}, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, nil, err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return nil, nil, fmt.Errorf("expected a JSON object, found %v", tok)
+	}
+
+	var keys []string
+	vals := make(map[string]interface{})
+	for dec.More() {
+		tok, err = dec.Token()
+		if err != nil {
+			return nil, nil, err
+		}
+		key := tok.(string)
+
+		var val interface{}
+		if err = dec.Decode(&val); err != nil {
+			return nil, nil, err
+		}
+
+		if _, ok := vals[key]; !ok {
+			keys = append(keys, key)
+		}
+		vals[key] = val
+	}
+
+	// consume the closing brace
+	if _, err = dec.Token(); err != nil {
+		return nil, nil, err
+	}
+
+	return keys, vals, nil
+}
+
+// Close should release resources being held
+func (r *NDJSONReader) Close(ctx context.Context) error {
+	if r.closer != nil {
+		err := r.closer.Close()
+		r.closer = nil
+
+		return err
+	}
+	return errors.New("already closed")
+}
+
+// GetSchema gets the schema of the rows that this reader will return
+func (r *NDJSONReader) GetSchema() schema.Schema {
+	return r.sch
+}
+
+// ReadRow reads a row with the string representation of each value. It is used to infer the schema of the file.
+func (r *NDJSONReader) ReadRow(ctx context.Context) (row.Row, error) {
+	vals, err := r.next()
+	if err != nil {
+		return nil, err
+	}
+
+	allCols := r.sch.GetAllCols()
+	taggedVals := make(row.TaggedValues)
+	for k, v := range vals {
+		col, ok := allCols.GetByName(k)
+		if !ok {
+			return nil, table.NewBadRow(nil, fmt.Sprintf("row %d: column %s not found in schema", r.rowNum, k))
+		}
+
+		str, isNull, err := ndjsonValueString(v)
+		if err != nil {
+			return nil, table.NewBadRow(nil, fmt.Sprintf("row %d: %s", r.rowNum, err.Error()))
+		}
+		if !isNull {
+			taggedVals[col.Tag] = types.String(str)
+		}
+	}
+
+	return row.New(r.nbf, r.sch, taggedVals)
+}
+
+func (r *NDJSONReader) ReadSqlRow(ctx context.Context) (sql.Row, error) {
+	vals, err := r.next()
+	if err != nil {
+		return nil, err
+	}
+
+	allCols := r.sch.GetAllCols()
+	ret := make(sql.Row, allCols.Size())
+	for k, v := range vals {
+		col, ok := allCols.GetByName(k)
+		if !ok {
+			return nil, fmt.Errorf("row %d: column %s not found in schema", r.rowNum, k)
+		}
+
+		str, isNull, err := ndjsonValueString(v)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", r.rowNum, err)
+		}
+		if isNull {
+			continue
+		}
+
+		var val interface{} = str
+		if r.typed {
+			if b, isBool := v.(bool); isBool {
+				val = b
+			}
+			val, _, err = col.TypeInfo.ToSqlType().Convert(val)
+			if err != nil {
+				return nil, fmt.Errorf("row %d: column %s: %w", r.rowNum, k, err)
+			}
+		}
+
+		ret[allCols.TagToIdx[col.Tag]] = val
+	}
+
+	return ret, nil
+}
+
+// next decodes the next object in the file.
+func (r *NDJSONReader) next() (map[string]interface{}, error) {
+	r.rowNum++
+	_, vals, err := readNDJSONObject(r.dec)
+	if err == io.EOF {
+		return nil, io.EOF
+	} else if err != nil {
+		return nil, fmt.Errorf("row %d: %w", r.rowNum, err)
+	}
+	return vals, nil
+}
+
+// ndjsonValueString returns the string representation of the decoded JSON value |v|. Objects and arrays are
+// returned as JSON text.
+func ndjsonValueString(v interface{}) (string, bool, error) {
+	switch v := v.(type) {
+	case nil:
+		return "", true, nil
+	case string:
+		return v, false, nil
+	case json.Number:
+		return v.String(), false, nil
+	case bool:
+		return strconv.FormatBool(v), false, nil
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", false, err
+		}
+		return string(b), false, nil
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package json
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema/typeinfo"
+	"github.com/dolthub/dolt/go/libraries/utils/filesys"
+	"github.com/dolthub/dolt/go/libraries/utils/iohelp"
+	"github.com/dolthub/dolt/go/store/types"
+)
+
+func readAllSqlRows(t *testing.T, rd *NDJSONReader) []sql.Row {
+	var rows []sql.Row
+	for {
+		r, err := rd.ReadSqlRow(context.Background())
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		rows = append(rows, r)
+	}
+	return rows
+}
+
+func TestNDJSONReaderInfersColumns(t *testing.T) {
+	testJSON := `{"id": 0, "first name": "tim"}
+{"id": 1, "last name": "hendriks", "tags": ["a", {"b": 2}], "active": true}
+
+{"id": 9007199254740993, "first name": null, "score": 1.50}
+`
+
+	fs := filesys.EmptyInMemFS("/")
+	require.NoError(t, fs.WriteFile("file.jsonl", []byte(testJSON), os.ModePerm))
+
+	rd, err := OpenNDJSONReader(types.Format_Default, "file.jsonl", fs, nil)
+	require.NoError(t, err)
+	defer rd.Close(context.Background())
+
+	assert.Equal(t, []string{"id", "first name", "last name", "tags", "active", "score"}, rd.GetSchema().GetAllCols().GetColumnNames())
+
+	assert.Equal(t, []sql.Row{
+		{"0", "tim", nil, nil, nil, nil},
+		{"1", nil, "hendriks", `["a",{"b":2}]`, "true", nil},
+		{"9007199254740993", nil, nil, nil, nil, "1.50"},
+	}, readAllSqlRows(t, rd))
+}
+
+func TestNDJSONReaderReadRow(t *testing.T) {
+	fs := filesys.EmptyInMemFS("/")
+	require.NoError(t, fs.WriteFile("file.jsonl", []byte("{\"id\": 1, \"ok\": false}\n{\"id\": 2}\n"), os.ModePerm))
+
+	rd, err := OpenNDJSONReader(types.Format_Default, "file.jsonl", fs, nil)
+	require.NoError(t, err)
+	defer rd.Close(context.Background())
+
+	r, err := rd.ReadRow(context.Background())
+	require.NoError(t, err)
+	val, ok := r.GetColVal(1)
+	require.True(t, ok)
+	assert.Equal(t, types.String("false"), val)
+
+	r, err = rd.ReadRow(context.Background())
+	require.NoError(t, err)
+	_, ok = r.GetColVal(1)
+	assert.False(t, ok)
+
+	_, err = rd.ReadRow(context.Background())
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestNDJSONReaderWithSchema(t *testing.T) {
+	colColl := schema.NewColCollection(
+		schema.Column{Name: "id", Tag: 0, Kind: types.IntKind, IsPartOfPK: true, TypeInfo: typeinfo.Int64Type},
+		schema.Column{Name: "name", Tag: 1, Kind: types.StringKind, TypeInfo: typeinfo.StringDefaultType},
+		schema.Column{Name: "active", Tag: 2, Kind: types.IntKind, TypeInfo: typeinfo.Int8Type},
+	)
+	sch, err := schema.SchemaFromCols(colColl)
+	require.NoError(t, err)
+
+	testJSON := "{\"id\": 1, \"name\": \"one\", \"active\": true}\n{\"id\": 2, \"name\": null}\n"
+	rd, err := NewNDJSONReader(types.Format_Default, io.NopCloser(strings.NewReader(testJSON)), sch)
+	require.NoError(t, err)
+	defer rd.Close(context.Background())
+
+	assert.Equal(t, []sql.Row{
+		{int64(1), "one", int8(1)},
+		{int64(2), nil, nil},
+	}, readAllSqlRows(t, rd))
+
+	rd, err = NewNDJSONReader(types.Format_Default, io.NopCloser(strings.NewReader(`{"id": 1, "missing": 2}`)), sch)
+	require.NoError(t, err)
+	_, err = rd.ReadSqlRow(context.Background())
+	assert.ErrorContains(t, err, "row 1: column missing not found in schema")
+}
+
+func TestNDJSONReaderBadJson(t *testing.T) {
+	fs := filesys.EmptyInMemFS("/")
+	require.NoError(t, fs.WriteFile("array.jsonl", []byte("{\"id\": 1}\n[1, 2]\n"), os.ModePerm))
+	require.NoError(t, fs.WriteFile("empty.jsonl", []byte("{}\n"), os.ModePerm))
+	require.NoError(t, fs.WriteFile("truncated.jsonl", []byte("{\"id\": 1}\n{\"id\": "), os.ModePerm))
+
+	_, err := OpenNDJSONReader(types.Format_Default, "array.jsonl", fs, nil)
+	assert.ErrorContains(t, err, "row 2: expected a JSON object")
+
+	_, err = OpenNDJSONReader(types.Format_Default, "empty.jsonl", fs, nil)
+	assert.ErrorContains(t, err, "file contains no keys")
+
+	_, err = OpenNDJSONReader(types.Format_Default, "truncated.jsonl", fs, nil)
+	assert.Error(t, err)
+}
+
+func TestNDJSONWriter(t *testing.T) {
+	colColl := schema.NewColCollection(
+		schema.Column{Name: "id", Tag: 0, Kind: types.IntKind, IsPartOfPK: true, TypeInfo: typeinfo.Int64Type},
+		schema.Column{Name: "name", Tag: 1, Kind: types.StringKind, TypeInfo: typeinfo.StringDefaultType},
+	)
+	sch, err := schema.SchemaFromCols(colColl)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	wr, err := NewNDJSONWriter(iohelp.NopWrCloser(&buf), sch)
+	require.NoError(t, err)
+	require.NoError(t, wr.WriteSqlRow(context.Background(), sql.Row{int64(1), "one"}))
+	require.NoError(t, wr.WriteSqlRow(context.Background(), sql.Row{int64(2), nil}))
+	require.NoError(t, wr.Close(context.Background()))
+
+	assert.Equal(t, "{\"id\":1,\"name\":\"one\"}\n{\"id\":2}\n", buf.String())
+
+	rd, err := NewNDJSONReader(types.Format_Default, io.NopCloser(&buf), sch)
+	require.NoError(t, err)
+	defer rd.Close(context.Background())
+	assert.Equal(t, []sql.Row{{int64(1), "one"}, {int64(2), nil}}, readAllSqlRows(t, rd))
+}
@@ -71,6 +71,12 @@ func NewJSONSqlWriter(wr io.WriteCloser, sch sql.Schema) (*RowWriter, error) {
 	return w, nil
 }
 
+// NewNDJSONWriter returns a new writer that encodes each row as a JSON object on its own line, so that output can be
+// consumed one row at a time.
+func NewNDJSONWriter(wr io.WriteCloser, outSch schema.Schema) (*RowWriter, error) {
+	return NewJSONWriterWithHeader(wr, outSch, "", "\n", "\n")
+}
+
 func NewJSONWriterWithHeader(wr io.WriteCloser, outSch schema.Schema, header, footer, separator string) (*RowWriter, error) {
 	bwr := bufio.NewWriterSize(wr, WriteBufSize)
 	return &RowWriter{
@@ -101,7 +107,7 @@ func (j *RowWriter) WriteSqlRow(ctx context.Context, row sql.Row) error {
 	var jsonRowData []byte
 	if j.sch != nil {
 		var err error
-		jsonRowData, err = j.jsonDataForSchema(row)
+		jsonRowData, err = jsonDataForSchema(j.sch, row, false)
 		if err != nil {
 			return err
 		}
@@ -129,13 +135,17 @@ func (j *RowWriter) WriteSqlRow(ctx context.Context, row sql.Row) error {
 	return nil
 }
 
-// jsonDataForSchema returns a JSON representation of the given row, using the schema for serialization hints
-func (j *RowWriter) jsonDataForSchema(row sql.Row) ([]byte, error) {
-	allCols := j.sch.GetAllCols()
+// jsonDataForSchema returns a JSON representation of the given row, using the schema for serialization hints. NULL
+// values are omitted unless |includeNulls| is true.
+func jsonDataForSchema(sch schema.Schema, row sql.Row, includeNulls bool) ([]byte, error) {
+	allCols := sch.GetAllCols()
 	colValMap := make(map[string]interface{}, allCols.Size())
 	if err := allCols.Iter(func(tag uint64, col schema.Column) (stop bool, err error) {
 		val := row[allCols.TagToIdx[tag]]
 		if val == nil {
+			if includeNulls {
+				colValMap[col.Name] = nil
+			}
 			return false, nil
 		}
 
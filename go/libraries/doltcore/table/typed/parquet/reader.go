@@ -20,10 +20,8 @@ import (
 	"io"
 	"math/big"
 	"strings"
-	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
-	gmstypes "github.com/dolthub/go-mysql-server/sql/types"
 	"github.com/xitongsys/parquet-go-source/local"
 	"github.com/xitongsys/parquet-go/common"
 	"github.com/xitongsys/parquet-go/parquet"
@@ -53,13 +51,17 @@ type ParquetReader struct {
 	rLevels map[string][]int32
 	// dLevels are used for interpreting null values by indicating the deepest level in
 	// a nested field that's defined.
-	dLevels    map[string][]int32
+	dLevels map[string][]int32
+	// elements are the leaf schema elements read for each column, which describe how their values are encoded.
+	elements   map[string]*parquet.SchemaElement
 	columnName []string
 }
 
 var _ table.SqlTableReader = (*ParquetReader)(nil)
+var _ table.PrimaryKeySuggester = (*ParquetReader)(nil)
 
-// OpenParquetReader opens a reader at a given path within local filesystem.
+// OpenParquetReader opens a reader at a given path within local filesystem. If |sch| is nil, the schema is derived
+// from the metadata of the file.
 func OpenParquetReader(vrw types.ValueReadWriter, path string, sch schema.Schema) (*ParquetReader, error) {
 	fr, err := local.NewLocalFileReader(path)
 	if err != nil {
@@ -70,13 +72,21 @@ func OpenParquetReader(vrw types.ValueReadWriter, path string, sch schema.Schema
 }
 
 // NewParquetReader creates a ParquetReader from a given fileReader.
-// The ParquetFileInfo should describe the parquet file being read.
+// The ParquetFileInfo should describe the parquet file being read. If |sche| is nil, a keyless schema is derived from
+// the metadata of the file.
 func NewParquetReader(vrw types.ValueReadWriter, fr source.ParquetFile, sche schema.Schema) (*ParquetReader, error) {
 	pr, err := reader.NewParquetColumnReader(fr, 4)
 	if err != nil {
 		return nil, err
 	}
 
+	if sche == nil {
+		sche, err = doltSchemaForParquetSchema(pr)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read schema: %s", err.Error())
+		}
+	}
+
 	rootName := pr.SchemaHandler.GetRootExName()
 
 	columns := sche.GetAllCols().GetColumns()
@@ -87,6 +97,7 @@ func NewParquetReader(vrw types.ValueReadWriter, fr source.ParquetFile, sche sch
 	rLevels := make(map[string][]int32)
 	dLevels := make(map[string][]int32)
 	rowReadCounters := make(map[string]int)
+	elements := make(map[string]*parquet.SchemaElement)
 	var colName []string
 	for _, col := range columns {
 		pathName := common.ReformPathStr(fmt.Sprintf("%s.%s", rootName, col.Name))
@@ -109,6 +120,7 @@ func NewParquetReader(vrw types.ValueReadWriter, fr source.ParquetFile, sche sch
 			rLevels[col.Name] = rLevel
 		}
 		dLevels[col.Name] = dLevel
+		elements[col.Name] = pr.SchemaHandler.SchemaElements[pr.SchemaHandler.MapIndex[resolvedColumnName]]
 		rowReadCounters[col.Name] = 0
 		colName = append(colName, col.Name)
 	}
@@ -124,6 +136,7 @@ func NewParquetReader(vrw types.ValueReadWriter, fr source.ParquetFile, sche sch
 		fileData:        data,
 		rLevels:         rLevels,
 		dLevels:         dLevels,
+		elements:        elements,
 		columnName:      colName,
 	}, nil
 }
@@ -213,34 +226,28 @@ func (pr *ParquetReader) ReadSqlRow(ctx context.Context) (sql.Row, error) {
 
 	allCols := pr.sch.GetAllCols()
 	row := make(sql.Row, allCols.Size())
-	allCols.Iter(func(tag uint64, col schema.Column) (stop bool, err error) {
+	err := allCols.Iter(func(tag uint64, col schema.Column) (stop bool, err error) {
 		rowReadCounter := pr.rowReadCounters[col.Name]
-		readVal := func() interface{} {
+		elem := pr.elements[col.Name]
+		readVal := func() (interface{}, error) {
 			val := pr.fileData[col.Name][rowReadCounter]
 			rowReadCounter++
-			if val != nil {
-				switch col.TypeInfo.GetTypeIdentifier() {
-				case typeinfo.DatetimeTypeIdentifier:
-					val = time.UnixMicro(val.(int64))
-				case typeinfo.TimeTypeIdentifier:
-					val = gmstypes.Timespan(time.Duration(val.(int64)).Microseconds())
-				}
-			}
-
-			if col.Kind == types.DecimalKind {
-				prec, scale := col.TypeInfo.ToSqlType().(gmstypes.DecimalType_).Precision(), col.TypeInfo.ToSqlType().(gmstypes.DecimalType_).Scale()
-				val = DecimalByteArrayToString([]byte(val.(string)), int(prec), int(scale))
+			if val == nil {
+				return nil, nil
 			}
-			return val
+			return convertParquetValue(val, col, elem)
 		}
 		var val interface{}
 		rLevels, isRepeated := pr.rLevels[col.Name]
 		dLevels, _ := pr.dLevels[col.Name]
-		readVals := func() (val interface{}) {
+		readVals := func() (interface{}, error) {
 			var vals []interface{}
 			for {
 				dLevel := dLevels[rowReadCounter]
-				subVal := readVal()
+				subVal, err := readVal()
+				if err != nil {
+					return nil, err
+				}
 				if subVal == nil {
 					// dLevels tells us how to interpret this nil value:
 					// 0  -> the column value is NULL
@@ -249,9 +256,9 @@ func (pr *ParquetReader) ReadSqlRow(ctx context.Context) (sql.Row, error) {
 					// 3+ -> the column contains a non-empty value
 					switch dLevel {
 					case 0:
-						return nil
+						return nil, nil
 					case 1:
-						return []interface{}{}
+						return []interface{}{}, nil
 					}
 				}
 				vals = append(vals, subVal)
@@ -260,12 +267,15 @@ func (pr *ParquetReader) ReadSqlRow(ctx context.Context) (sql.Row, error) {
 					break
 				}
 			}
-			return vals
+			return vals, nil
 		}
 		if !isRepeated {
-			val = readVal()
+			val, err = readVal()
 		} else {
-			val = readVals()
+			val, err = readVals()
+		}
+		if err != nil {
+			return true, err
 		}
 
 		pr.rowReadCounters[col.Name] = rowReadCounter
@@ -273,12 +283,47 @@ func (pr *ParquetReader) ReadSqlRow(ctx context.Context) (sql.Row, error) {
 
 		return false, nil
 	})
+	if err != nil {
+		return nil, err
+	}
 
 	pr.rowsRead++
 
 	return row, nil
 }
 
+// SuggestPrimaryKey returns the first column whose values are non-null and distinct in every row of the file.
+// Repeated, floating point and JSON columns are never suggested.
+func (pr *ParquetReader) SuggestPrimaryKey() []string {
+	if pr.numRow == 0 {
+		return nil
+	}
+
+	for _, col := range pr.sch.GetAllCols().GetColumns() {
+		if _, isRepeated := pr.rLevels[col.Name]; isRepeated {
+			continue
+		}
+		switch col.TypeInfo.GetTypeIdentifier() {
+		case typeinfo.FloatTypeIdentifier, typeinfo.JSONTypeIdentifier:
+			continue
+		}
+
+		vals := pr.fileData[col.Name]
+		seen := make(map[interface{}]struct{}, len(vals))
+		for _, v := range vals {
+			if v == nil {
+				break
+			}
+			seen[v] = struct{}{}
+		}
+		if len(seen) == pr.numRow {
+			return []string{col.Name}
+		}
+	}
+
+	return nil
+}
+
 func (pr *ParquetReader) GetSchema() schema.Schema {
 	return pr.sch
 }
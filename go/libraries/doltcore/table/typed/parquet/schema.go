@@ -0,0 +1,354 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	gmstypes "github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/shopspring/decimal"
+	"github.com/xitongsys/parquet-go/common"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+	parquettypes "github.com/xitongsys/parquet-go/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema/typeinfo"
+	"github.com/dolthub/dolt/go/store/types"
+)
+
+// timeUnit is the resolution of an integer parquet time or timestamp value.
+type timeUnit int
+
+const (
+	unitMillis timeUnit = iota
+	unitMicros
+	unitNanos
+)
+
+// doltSchemaForParquetSchema returns a keyless schema with a column for each top level field of the parquet file
+// read by |pr|. Column types are derived from the logical and converted type annotations of each field, falling back
+// to its physical type.
+func doltSchemaForParquetSchema(pr *reader.ParquetReader) (schema.Schema, error) {
+	sh := pr.SchemaHandler
+	rootName := sh.GetRootExName()
+
+	var cols []schema.Column
+	// SchemaElements holds a depth first traversal of the schema tree, with the root at index 0
+	for i := 1; i < len(sh.SchemaElements); i = skipParquetSubtree(sh.SchemaElements, i) {
+		top := sh.SchemaElements[i]
+		name := sh.Infos[i].ExName
+
+		leafPath, found, isRepeated, err := resolveColumnPrefix(pr, common.ReformPathStr(fmt.Sprintf("%s.%s", rootName, name)))
+		if err != nil {
+			return nil, fmt.Errorf("column %s: %w", name, err)
+		}
+		if !found {
+			return nil, fmt.Errorf("column %s: nested fields with more than one leaf are not supported", name)
+		}
+		leaf := sh.SchemaElements[sh.MapIndex[leafPath]]
+
+		var sqlType sql.Type = gmstypes.JSON
+		if !isRepeated {
+			sqlType, err = sqlTypeForParquetElement(leaf)
+			if err != nil {
+				return nil, fmt.Errorf("column %s: %w", name, err)
+			}
+		}
+		ti, err := typeinfo.FromSqlType(sqlType)
+		if err != nil {
+			return nil, err
+		}
+
+		var constraints []schema.ColConstraint
+		if top.GetRepetitionType() == parquet.FieldRepetitionType_REQUIRED {
+			constraints = append(constraints, schema.NotNullConstraint{})
+		}
+		col, err := schema.NewColumnWithTypeInfo(name, uint64(len(cols)), ti, false, "", false, "", constraints...)
+		if err != nil {
+			return nil, err
+		}
+		cols = append(cols, col)
+	}
+
+	return schema.SchemaFromCols(schema.NewColCollection(cols...))
+}
+
+// skipParquetSubtree returns the index of the first element after the element at |i| and all of its descendants.
+func skipParquetSubtree(elems []*parquet.SchemaElement, i int) int {
+	remaining := 1
+	for remaining > 0 {
+		remaining += int(elems[i].GetNumChildren()) - 1
+		i++
+	}
+	return i
+}
+
+// sqlTypeForParquetElement returns the sql type used for values of the leaf |elem|.
+func sqlTypeForParquetElement(elem *parquet.SchemaElement) (sql.Type, error) {
+	if lt := elem.GetLogicalType(); lt != nil {
+		switch {
+		case lt.IsSetSTRING(), lt.IsSetENUM():
+			return gmstypes.LongText, nil
+		case lt.IsSetJSON():
+			return gmstypes.JSON, nil
+		case lt.IsSetBSON(), lt.IsSetUUID():
+			return gmstypes.LongBlob, nil
+		case lt.IsSetDATE():
+			return gmstypes.Date, nil
+		case lt.IsSetTIME():
+			return gmstypes.Time, nil
+		case lt.IsSetTIMESTAMP():
+			return gmstypes.DatetimeMaxPrecision, nil
+		case lt.IsSetDECIMAL():
+			return decimalType(lt.DECIMAL.Precision, lt.DECIMAL.Scale)
+		case lt.IsSetINTEGER():
+			return intType(lt.INTEGER.BitWidth, lt.INTEGER.IsSigned)
+		}
+	}
+
+	if elem.IsSetConvertedType() {
+		switch elem.GetConvertedType() {
+		case parquet.ConvertedType_UTF8, parquet.ConvertedType_ENUM:
+			return gmstypes.LongText, nil
+		case parquet.ConvertedType_JSON:
+			return gmstypes.JSON, nil
+		case parquet.ConvertedType_BSON, parquet.ConvertedType_INTERVAL:
+			return gmstypes.LongBlob, nil
+		case parquet.ConvertedType_DATE:
+			return gmstypes.Date, nil
+		case parquet.ConvertedType_TIME_MILLIS, parquet.ConvertedType_TIME_MICROS:
+			return gmstypes.Time, nil
+		case parquet.ConvertedType_TIMESTAMP_MILLIS, parquet.ConvertedType_TIMESTAMP_MICROS:
+			return gmstypes.DatetimeMaxPrecision, nil
+		case parquet.ConvertedType_DECIMAL:
+			return decimalType(elem.GetPrecision(), elem.GetScale())
+		case parquet.ConvertedType_INT_8:
+			return intType(8, true)
+		case parquet.ConvertedType_INT_16:
+			return intType(16, true)
+		case parquet.ConvertedType_INT_32:
+			return intType(32, true)
+		case parquet.ConvertedType_INT_64:
+			return intType(64, true)
+		case parquet.ConvertedType_UINT_8:
+			return intType(8, false)
+		case parquet.ConvertedType_UINT_16:
+			return intType(16, false)
+		case parquet.ConvertedType_UINT_32:
+			return intType(32, false)
+		case parquet.ConvertedType_UINT_64:
+			return intType(64, false)
+		}
+	}
+
+	switch elem.GetType() {
+	case parquet.Type_BOOLEAN:
+		return gmstypes.Boolean, nil
+	case parquet.Type_INT32:
+		return gmstypes.Int32, nil
+	case parquet.Type_INT64:
+		return gmstypes.Int64, nil
+	case parquet.Type_INT96:
+		return gmstypes.DatetimeMaxPrecision, nil
+	case parquet.Type_FLOAT:
+		return gmstypes.Float32, nil
+	case parquet.Type_DOUBLE:
+		return gmstypes.Float64, nil
+	case parquet.Type_BYTE_ARRAY, parquet.Type_FIXED_LEN_BYTE_ARRAY:
+		return gmstypes.LongBlob, nil
+	default:
+		return nil, fmt.Errorf("unsupported parquet type %s", elem.GetType())
+	}
+}
+
+// decimalType returns a decimal type for the given precision and scale, or longtext if the decimal is wider than
+// the widest decimal supported.
+func decimalType(precision, scale int32) (sql.Type, error) {
+	if precision > gmstypes.DecimalTypeMaxPrecision || scale > gmstypes.DecimalTypeMaxScale {
+		return gmstypes.LongText, nil
+	}
+	return gmstypes.CreateDecimalType(uint8(precision), uint8(scale))
+}
+
+func intType(bitWidth int8, signed bool) (sql.Type, error) {
+	switch bitWidth {
+	case 8:
+		if signed {
+			return gmstypes.Int8, nil
+		}
+		return gmstypes.Uint8, nil
+	case 16:
+		if signed {
+			return gmstypes.Int16, nil
+		}
+		return gmstypes.Uint16, nil
+	case 32:
+		if signed {
+			return gmstypes.Int32, nil
+		}
+		return gmstypes.Uint32, nil
+	case 64:
+		if signed {
+			return gmstypes.Int64, nil
+		}
+		return gmstypes.Uint64, nil
+	default:
+		return nil, fmt.Errorf("unsupported integer bit width %d", bitWidth)
+	}
+}
+
+// timestampUnit returns the resolution of the integer timestamp |elem|. Unannotated integers are read as
+// microseconds, which is how dolt writes datetime columns.
+func timestampUnit(elem *parquet.SchemaElement) timeUnit {
+	if lt := elem.GetLogicalType(); lt != nil && lt.IsSetTIMESTAMP() {
+		return logicalTimeUnit(lt.TIMESTAMP.Unit)
+	}
+	if elem.IsSetConvertedType() && elem.GetConvertedType() == parquet.ConvertedType_TIMESTAMP_MILLIS {
+		return unitMillis
+	}
+	return unitMicros
+}
+
+// timeOfDayUnit returns the resolution of the integer time |elem|. Unannotated integers are read as nanoseconds,
+// which is how dolt writes time columns.
+func timeOfDayUnit(elem *parquet.SchemaElement) timeUnit {
+	if lt := elem.GetLogicalType(); lt != nil && lt.IsSetTIME() {
+		return logicalTimeUnit(lt.TIME.Unit)
+	}
+	if elem.IsSetConvertedType() {
+		switch elem.GetConvertedType() {
+		case parquet.ConvertedType_TIME_MILLIS:
+			return unitMillis
+		case parquet.ConvertedType_TIME_MICROS:
+			return unitMicros
+		}
+	}
+	return unitNanos
+}
+
+func logicalTimeUnit(unit *parquet.TimeUnit) timeUnit {
+	switch {
+	case unit == nil:
+		return unitMicros
+	case unit.IsSetMILLIS():
+		return unitMillis
+	case unit.IsSetNANOS():
+		return unitNanos
+	default:
+		return unitMicros
+	}
+}
+
+func (u timeUnit) duration(v int64) time.Duration {
+	switch u {
+	case unitMillis:
+		return time.Duration(v) * time.Millisecond
+	case unitMicros:
+		return time.Duration(v) * time.Microsecond
+	default:
+		return time.Duration(v)
+	}
+}
+
+// convertParquetValue converts |val|, as read from the leaf |elem|, to a value that can be stored in |col|.
+func convertParquetValue(val interface{}, col schema.Column, elem *parquet.SchemaElement) (interface{}, error) {
+	switch col.TypeInfo.GetTypeIdentifier() {
+	case typeinfo.DatetimeTypeIdentifier:
+		switch v := val.(type) {
+		case int32:
+			// dates are stored as days since the unix epoch
+			return time.Unix(int64(v)*24*60*60, 0).UTC(), nil
+		case int64:
+			switch timestampUnit(elem) {
+			case unitMillis:
+				return time.UnixMilli(v), nil
+			case unitNanos:
+				return time.Unix(0, v), nil
+			default:
+				return time.UnixMicro(v), nil
+			}
+		case string:
+			if elem.GetType() == parquet.Type_INT96 {
+				return parquettypes.INT96ToTime(v), nil
+			}
+		}
+	case typeinfo.TimeTypeIdentifier:
+		switch v := val.(type) {
+		case int32:
+			return gmstypes.Timespan(unitMillis.duration(int64(v)).Microseconds()), nil
+		case int64:
+			return gmstypes.Timespan(timeOfDayUnit(elem).duration(v).Microseconds()), nil
+		}
+	}
+
+	if col.Kind == types.DecimalKind || isParquetDecimal(elem) {
+		prec, scale := decimalPrecisionAndScale(col, elem)
+		switch v := val.(type) {
+		case string:
+			if elem.GetType() == parquet.Type_BYTE_ARRAY || elem.GetType() == parquet.Type_FIXED_LEN_BYTE_ARRAY {
+				if len(v) == 0 {
+					return nil, fmt.Errorf("column %s: invalid empty decimal value", col.Name)
+				}
+				return DecimalByteArrayToString([]byte(v), prec, scale), nil
+			}
+		case int32:
+			if isParquetDecimal(elem) {
+				return decimal.New(int64(v), int32(-scale)).String(), nil
+			}
+		case int64:
+			if isParquetDecimal(elem) {
+				return decimal.New(v, int32(-scale)).String(), nil
+			}
+		}
+	}
+
+	switch col.Kind {
+	case types.UintKind:
+		// unsigned values are stored in signed physical types and wrap around for the upper half of their range
+		switch v := val.(type) {
+		case int32:
+			return uint32(v), nil
+		case int64:
+			return uint64(v), nil
+		}
+	}
+
+	return val, nil
+}
+
+func isParquetDecimal(elem *parquet.SchemaElement) bool {
+	if lt := elem.GetLogicalType(); lt != nil && lt.IsSetDECIMAL() {
+		return true
+	}
+	return elem.IsSetConvertedType() && elem.GetConvertedType() == parquet.ConvertedType_DECIMAL
+}
+
+// decimalPrecisionAndScale returns the precision and scale of the unscaled integers stored in |elem|, falling back to
+// those of |col| when the file does not describe them.
+func decimalPrecisionAndScale(col schema.Column, elem *parquet.SchemaElement) (int, int) {
+	if lt := elem.GetLogicalType(); lt != nil && lt.IsSetDECIMAL() {
+		return int(lt.DECIMAL.Precision), int(lt.DECIMAL.Scale)
+	}
+	if isParquetDecimal(elem) && elem.IsSetScale() {
+		return int(elem.GetPrecision()), int(elem.GetScale())
+	}
+	if dt, ok := col.TypeInfo.ToSqlType().(gmstypes.DecimalType_); ok {
+		return int(dt.Precision()), int(dt.Scale())
+	}
+	return 0, 0
+}
@@ -0,0 +1,138 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"context"
+	"io"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	gmstypes "github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/writer"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+)
+
+type annotatedRecord struct {
+	Id    int32    `parquet:"name=id, type=INT32"`
+	D     int32    `parquet:"name=d, type=INT32, convertedtype=DATE"`
+	Ts    int64    `parquet:"name=ts, type=INT64, convertedtype=TIMESTAMP_MILLIS"`
+	Tm    int32    `parquet:"name=tm, type=INT32, convertedtype=TIME_MILLIS"`
+	Dec   int32    `parquet:"name=dec, type=INT32, convertedtype=DECIMAL, scale=2, precision=9"`
+	U     int64    `parquet:"name=u, type=INT64, convertedtype=UINT_64"`
+	Flag  bool     `parquet:"name=flag, type=BOOLEAN"`
+	Score float64  `parquet:"name=score, type=DOUBLE"`
+	Name  *string  `parquet:"name=name, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	Tags  []string `parquet:"name=tags, type=LIST, valuetype=BYTE_ARRAY, valueconvertedtype=UTF8"`
+}
+
+func readAllSqlRows(t *testing.T, rd *ParquetReader) []sql.Row {
+	var rows []sql.Row
+	for {
+		r, err := rd.ReadSqlRow(context.Background())
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		rows = append(rows, r)
+	}
+	return rows
+}
+
+func columnTypes(sch schema.Schema) []string {
+	var colTypes []string
+	for _, col := range sch.GetAllCols().GetColumns() {
+		colTypes = append(colTypes, col.Name+" "+col.TypeInfo.ToSqlType().String())
+	}
+	return colTypes
+}
+
+func TestReaderDerivesSchemaFromMetadata(t *testing.T) {
+	filePath := path.Join(t.TempDir(), "annotated.parquet")
+	fw, err := local.NewLocalFileWriter(filePath)
+	require.NoError(t, err)
+	pw, err := writer.NewParquetWriter(fw, new(annotatedRecord), 1)
+	require.NoError(t, err)
+
+	name := "one"
+	require.NoError(t, pw.Write(annotatedRecord{Id: 1, D: 18000, Ts: 1600000000123, Tm: 3723004, Dec: -12345, U: -1, Flag: true, Score: 1.5, Name: &name, Tags: []string{"a", "b"}}))
+	require.NoError(t, pw.Write(annotatedRecord{Id: 2, Score: 1.5}))
+	require.NoError(t, pw.WriteStop())
+	require.NoError(t, fw.Close())
+
+	rd, err := OpenParquetReader(nil, filePath, nil)
+	require.NoError(t, err)
+	defer rd.Close(context.Background())
+
+	sch := rd.GetSchema()
+	assert.Equal(t, []string{
+		"id int",
+		"d date",
+		"ts datetime(6)",
+		"tm time(6)",
+		"dec decimal(9,2)",
+		"u bigint unsigned",
+		"flag tinyint(1)",
+		"score double",
+		"name longtext",
+		"tags json",
+	}, columnTypes(sch))
+	assert.Empty(t, sch.GetPKCols().GetColumns())
+
+	idCol, _ := sch.GetAllCols().GetByName("id")
+	assert.False(t, idCol.IsNullable())
+	nameCol, _ := sch.GetAllCols().GetByName("name")
+	assert.True(t, nameCol.IsNullable())
+
+	assert.Equal(t, []string{"id"}, rd.SuggestPrimaryKey())
+
+	rows := readAllSqlRows(t, rd)
+	require.Len(t, rows, 2)
+	assert.Equal(t, time.Date(2019, 4, 14, 0, 0, 0, 0, time.UTC), rows[0][1])
+	assert.Equal(t, time.UnixMilli(1600000000123), rows[0][2])
+	assert.Equal(t, gmstypes.Timespan((time.Hour + 2*time.Minute + 3*time.Second + 4*time.Millisecond).Microseconds()), rows[0][3])
+	assert.Equal(t, "-123.45", rows[0][4])
+	assert.Equal(t, uint64(18446744073709551615), rows[0][5])
+	assert.Equal(t, true, rows[0][6])
+	assert.Equal(t, "one", rows[0][8])
+	assert.Equal(t, []interface{}{"a", "b"}, rows[0][9])
+	assert.Nil(t, rows[1][8])
+}
+
+func TestReaderDerivesSchemaOfWrittenFile(t *testing.T) {
+	filePath := path.Join(t.TempDir(), "written.parquet")
+	pWr, err := NewParquetRowWriterForFile(rowSch, filePath)
+	require.NoError(t, err)
+	writeToParquet(pWr, getSampleRows(), t)
+
+	rd, err := OpenParquetReader(nil, filePath, nil)
+	require.NoError(t, err)
+	defer rd.Close(context.Background())
+
+	assert.Equal(t, []string{"name longtext", "age bigint unsigned", "title longtext"}, columnTypes(rd.GetSchema()))
+	assert.Equal(t, []string{"name"}, rd.SuggestPrimaryKey())
+	assert.Equal(t, []sql.Row{
+		{"Bill Billerson", uint64(32), "Senior Dufus"},
+		{"Rob Robertson", uint64(25), "Dufus"},
+		{"John Johnson", uint64(21), ""},
+		{"Andy Anderson", uint64(27), nil},
+	}, readAllSqlRows(t, rd))
+}
@@ -17,7 +17,9 @@ package xlsx
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/tealeg/xlsx"
@@ -115,8 +117,7 @@ func getXlsxRows(data *xlsx.File, tblName string) ([][][]string, error) {
 			for i := 0; i < len(sheet.Rows); i++ {
 				var rowVals []string
 				for j := 0; j < len(sheet.Rows[i].Cells); j++ {
-
-					rowVals = append(rowVals, sheet.Rows[i].Cells[j].Value)
+					rowVals = append(rowVals, cellValue(sheet.Rows[i].Cells[j], data.Date1904))
 				}
 				rows = append(rows, rowVals)
 			}
@@ -127,3 +128,31 @@ func getXlsxRows(data *xlsx.File, tblName string) ([][][]string, error) {
 	}
 	return nil, ErrTableNameMatchSheetName
 }
+
+// cellValue returns the text of |cell|. Boolean cells are returned as true or false, and cells formatted as dates or
+// times are returned in the format MySQL uses for them, rather than as the serial numbers excel stores, so that their
+// types can be inferred.
+func cellValue(cell *xlsx.Cell, date1904 bool) string {
+	switch cell.Type() {
+	case xlsx.CellTypeBool:
+		return strconv.FormatBool(cell.Bool())
+	case xlsx.CellTypeNumeric, xlsx.CellTypeDate:
+		if !cell.IsTime() {
+			break
+		}
+		serial, err := cell.Float()
+		if err != nil {
+			break
+		}
+		t := xlsx.TimeFromExcelTime(serial, date1904).Round(time.Second)
+		switch {
+		case serial < 1:
+			return t.Format("15:04:05")
+		case t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0:
+			return t.Format("2006-01-02")
+		default:
+			return t.Format("2006-01-02 15:04:05")
+		}
+	}
+	return cell.Value
+}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/row"
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/untyped"
 	"github.com/dolthub/dolt/go/libraries/utils/filesys"
 	"github.com/dolthub/dolt/go/store/types"
@@ -41,6 +42,8 @@ type XLSXReader struct {
 	vrw    types.ValueReadWriter
 }
 
+var _ table.PrimaryKeySuggester = (*XLSXReader)(nil)
+
 func OpenXLSXReaderFromBinary(ctx context.Context, vrw types.ValueReadWriter, r io.ReadCloser, info *XLSXFileInfo) (*XLSXReader, error) {
 	br := bufio.NewReaderSize(r, ReadBufSize)
 
@@ -176,3 +179,26 @@ func (xlsxr *XLSXReader) ReadSqlRow(ctx context.Context) (sql.Row, error) {
 
 	return outRow, nil
 }
+
+// SuggestPrimaryKey returns the first column of the sheet with a non-empty and distinct cell in every row.
+func (xlsxr *XLSXReader) SuggestPrimaryKey() []string {
+	if len(xlsxr.rows) == 0 {
+		return nil
+	}
+
+	allCols := xlsxr.sch.GetAllCols()
+	for idx, col := range allCols.GetColumns() {
+		seen := make(map[interface{}]struct{}, len(xlsxr.rows))
+		for _, r := range xlsxr.rows {
+			if r[idx] == nil || r[idx] == "" {
+				break
+			}
+			seen[r[idx]] = struct{}{}
+		}
+		if len(seen) == len(xlsxr.rows) {
+			return []string{col.Name}
+		}
+	}
+
+	return nil
+}
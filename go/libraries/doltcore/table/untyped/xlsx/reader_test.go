@@ -0,0 +1,109 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xlsx
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tealeg/xlsx"
+
+	"github.com/dolthub/dolt/go/libraries/utils/filesys"
+	"github.com/dolthub/dolt/go/store/types"
+)
+
+func writeTestWorkbook(t *testing.T, sheetName string, rows [][]func(*xlsx.Cell)) string {
+	f := xlsx.NewFile()
+	sheet, err := f.AddSheet(sheetName)
+	require.NoError(t, err)
+	for _, r := range rows {
+		xlRow := sheet.AddRow()
+		for _, setCell := range r {
+			setCell(xlRow.AddCell())
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), "test.xlsx")
+	require.NoError(t, f.Save(path))
+	return path
+}
+
+func str(s string) func(*xlsx.Cell) {
+	return func(c *xlsx.Cell) { c.SetString(s) }
+}
+
+func TestReaderFormatsTypedCells(t *testing.T) {
+	hired := time.Date(2019, 4, 14, 0, 0, 0, 0, time.UTC)
+	badge := time.Date(2019, 4, 14, 8, 30, 15, 0, time.UTC)
+	path := writeTestWorkbook(t, "people", [][]func(*xlsx.Cell){
+		{str("id"), str("team"), str("active"), str("hired"), str("badge")},
+		{
+			func(c *xlsx.Cell) { c.SetInt(1) },
+			str("a"),
+			func(c *xlsx.Cell) { c.SetBool(true) },
+			func(c *xlsx.Cell) { c.SetDate(hired) },
+			func(c *xlsx.Cell) { c.SetDateTime(badge) },
+		},
+		{
+			func(c *xlsx.Cell) { c.SetInt(2) },
+			str("a"),
+			func(c *xlsx.Cell) { c.SetBool(false) },
+			func(c *xlsx.Cell) { c.SetDate(hired.AddDate(0, 0, 1)) },
+			func(c *xlsx.Cell) { c.SetDateTime(badge) },
+		},
+	})
+
+	ctx := context.Background()
+	rd, err := OpenXLSXReader(ctx, types.NewMemoryValueStore(), path, filesys.LocalFS, NewXLSXInfo("people"))
+	require.NoError(t, err)
+	defer rd.Close(ctx)
+
+	var rows []sql.Row
+	for {
+		r, err := rd.ReadSqlRow(ctx)
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		rows = append(rows, r)
+	}
+	assert.Equal(t, []sql.Row{
+		{"1", "a", "true", "2019-04-14", "2019-04-14 08:30:15"},
+		{"2", "a", "false", "2019-04-15", "2019-04-14 08:30:15"},
+	}, rows)
+
+	assert.Equal(t, []string{"id"}, rd.SuggestPrimaryKey())
+}
+
+func TestReaderSuggestsNoPrimaryKey(t *testing.T) {
+	path := writeTestWorkbook(t, "dupes", [][]func(*xlsx.Cell){
+		{str("a"), str("b")},
+		{str("1"), str("x")},
+		{str("1"), str("")},
+	})
+
+	ctx := context.Background()
+	rd, err := OpenXLSXReader(ctx, types.NewMemoryValueStore(), path, filesys.LocalFS, NewXLSXInfo("dupes"))
+	require.NoError(t, err)
+	defer rd.Close(ctx)
+
+	assert.Nil(t, rd.SuggestPrimaryKey())
+}
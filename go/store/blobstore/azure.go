@@ -0,0 +1,225 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blockblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/sas"
+)
+
+// azureUploadBlockSize is the size of the blocks blobs are streamed in. A block blob has at most 50,000 blocks, so
+// blobs of up to 390GiB can be uploaded.
+const azureUploadBlockSize = 8 * 1024 * 1024
+
+// AzureBlobstore provides an Azure Blob Storage implementation of the Blobstore interface. Blobs are stored as block
+// blobs in a single container, their versions are their ETags, and CheckAndPut uses conditional writes.
+type AzureBlobstore struct {
+	client *container.Client
+	prefix string
+}
+
+var _ Blobstore = &AzureBlobstore{}
+
+// NewAzureBlobstore creates a new instance of an AzureBlobstore storing blobs under |prefix| in the container of
+// |client|.
+func NewAzureBlobstore(client *container.Client, prefix string) *AzureBlobstore {
+	return &AzureBlobstore{client: client, prefix: normalizePrefix(prefix)}
+}
+
+// Path returns the host, container and prefix of the blobstore
+func (bs *AzureBlobstore) Path() string {
+	u, err := url.Parse(bs.client.URL())
+	if err != nil {
+		return path.Join(bs.client.URL(), bs.prefix)
+	}
+	return path.Join(u.Host, u.Path, bs.prefix)
+}
+
+// Exists returns true if a blob exists for the given key, and false if it does not.
+func (bs *AzureBlobstore) Exists(ctx context.Context, key string) (bool, error) {
+	_, err := bs.blobClient(key).GetProperties(ctx, nil)
+	if bloberror.HasCode(err, bloberror.BlobNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Get retrieves an io.reader for the portion of a blob specified by br along with its version
+func (bs *AzureBlobstore) Get(ctx context.Context, key string, br BlobRange) (io.ReadCloser, string, error) {
+	client := bs.blobClient(key)
+	opts := &blob.DownloadStreamOptions{}
+
+	if !br.isAllRange() {
+		if br.offset < 0 {
+			// Azure can not read ranges relative to the end of a blob
+			props, err := client.GetProperties(ctx, nil)
+			if bloberror.HasCode(err, bloberror.BlobNotFound) {
+				return nil, "", NotFound{bs.blobURL(key)}
+			} else if err != nil {
+				return nil, "", err
+			}
+			var size int64
+			if props.ContentLength != nil {
+				size = *props.ContentLength
+			}
+			br = br.positiveRange(size)
+			if br.length == 0 {
+				return io.NopCloser(bytes.NewReader(nil)), derefETag(props.ETag), nil
+			}
+			opts.AccessConditions = &blob.AccessConditions{
+				ModifiedAccessConditions: &blob.ModifiedAccessConditions{IfMatch: props.ETag},
+			}
+		}
+		opts.Range = blob.HTTPRange{Offset: br.offset, Count: br.length}
+	}
+
+	resp, err := client.DownloadStream(ctx, opts)
+	if bloberror.HasCode(err, bloberror.BlobNotFound) {
+		return nil, "", NotFound{bs.blobURL(key)}
+	} else if err != nil {
+		return nil, "", err
+	}
+	return resp.Body, derefETag(resp.ETag), nil
+}
+
+// Put sets the blob and the version for a key. The blob is streamed to Azure in blocks.
+func (bs *AzureBlobstore) Put(ctx context.Context, key string, totalSize int64, reader io.Reader) (string, error) {
+	return bs.put(ctx, key, reader, nil)
+}
+
+// CheckAndPut will check the current version of a blob against an expectedVersion, and if the versions match it will
+// update the data and version associated with the key
+func (bs *AzureBlobstore) CheckAndPut(ctx context.Context, expectedVersion, key string, totalSize int64, reader io.Reader) (string, error) {
+	cond := &blob.ModifiedAccessConditions{}
+	if expectedVersion == "" {
+		cond.IfNoneMatch = to.Ptr(azcore.ETagAny)
+	} else {
+		cond.IfMatch = to.Ptr(azcore.ETag(expectedVersion))
+	}
+
+	ver, err := bs.put(ctx, key, reader, &blob.AccessConditions{ModifiedAccessConditions: cond})
+	if bloberror.HasCode(err, bloberror.ConditionNotMet, bloberror.BlobAlreadyExists, bloberror.BlobNotFound) {
+		return "", CheckAndPutError{Key: key, ExpectedVersion: expectedVersion, ActualVersion: "unknown"}
+	}
+	return ver, err
+}
+
+// Concatenate creates a new blob named |key| by concatenating |sources|. The sources are copied within Azure into
+// blocks of the new blob with StageBlockFromURL, and the blocks are then committed.
+func (bs *AzureBlobstore) Concatenate(ctx context.Context, key string, sources []string) (string, error) {
+	client := bs.blobClient(key)
+	var blockIDs []string
+	for _, src := range sources {
+		srcClient := bs.blobClient(src)
+		props, err := srcClient.GetProperties(ctx, nil)
+		if bloberror.HasCode(err, bloberror.BlobNotFound) {
+			return "", NotFound{bs.blobURL(src)}
+		} else if err != nil {
+			return "", err
+		}
+		srcURL, err := sourceURL(srcClient)
+		if err != nil {
+			return "", err
+		}
+
+		var size int64
+		if props.ContentLength != nil {
+			size = *props.ContentLength
+		}
+		for off := int64(0); off < size; off += blockblob.MaxStageBlockBytes {
+			id := azureBlockID(len(blockIDs))
+			_, err = client.StageBlockFromURL(ctx, id, srcURL, &blockblob.StageBlockFromURLOptions{
+				Range: blob.HTTPRange{Offset: off, Count: min(size-off, blockblob.MaxStageBlockBytes)},
+				SourceModifiedAccessConditions: &blob.SourceModifiedAccessConditions{
+					SourceIfMatch: props.ETag,
+				},
+			})
+			if err != nil {
+				return "", err
+			}
+			blockIDs = append(blockIDs, id)
+		}
+	}
+
+	if len(blockIDs) > blockblob.MaxBlocks {
+		return "", fmt.Errorf("cannot concatenate %d sources into %s, a block blob is limited to %d blocks", len(sources), bs.blobURL(key), blockblob.MaxBlocks)
+	}
+	resp, err := client.CommitBlockList(ctx, blockIDs, nil)
+	if err != nil {
+		return "", err
+	}
+	return derefETag(resp.ETag), nil
+}
+
+// sourceURL returns the url the service reads |client|'s blob from when it is copied. Clients authorized with a
+// shared key sign a short lived SAS for the copy, and the urls of other clients carry their SAS token already.
+func sourceURL(client *blockblob.Client) (string, error) {
+	u, err := client.GetSASURL(sas.BlobPermissions{Read: true}, time.Now().Add(time.Hour), nil)
+	if errors.Is(err, bloberror.MissingSharedKeyCredential) {
+		return client.URL(), nil
+	}
+	return u, err
+}
+
+// azureBlockID returns the id of the |i|th block of a concatenated blob. The ids of the blocks of a blob must all
+// have the same length.
+func azureBlockID(i int) string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%08d", i)))
+}
+
+func (bs *AzureBlobstore) put(ctx context.Context, key string, reader io.Reader, cond *blob.AccessConditions) (string, error) {
+	resp, err := bs.blobClient(key).UploadStream(ctx, reader, &blockblob.UploadStreamOptions{
+		BlockSize:        azureUploadBlockSize,
+		AccessConditions: cond,
+	})
+	if err != nil {
+		return "", err
+	}
+	return derefETag(resp.ETag), nil
+}
+
+func (bs *AzureBlobstore) blobClient(key string) *blockblob.Client {
+	return bs.client.NewBlockBlobClient(bs.absKey(key))
+}
+
+func (bs *AzureBlobstore) blobURL(key string) string {
+	return "azure://" + path.Join(bs.Path(), key)
+}
+
+func (bs *AzureBlobstore) absKey(key string) string {
+	return path.Join(bs.prefix, key)
+}
+
+func derefETag(etag *azcore.ETag) string {
+	if etag == nil {
+		return ""
+	}
+	return string(*etag)
+}
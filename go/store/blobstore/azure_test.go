@@ -0,0 +1,282 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAzure is an in memory implementation of the parts of the Azure Blob Storage REST API used by AzureBlobstore. It
+// is used as the transport of azblob clients, and supports ranged reads, conditional writes, and block blobs staged
+// from request bodies or copied from other blobs.
+type fakeAzure struct {
+	mu     sync.Mutex
+	data   map[string][]byte
+	blocks map[string]map[string][]byte
+	copies int
+}
+
+var _ policy.Transporter = &fakeAzure{}
+
+func newFakeAzure() *fakeAzure {
+	return &fakeAzure{data: make(map[string][]byte), blocks: make(map[string]map[string][]byte)}
+}
+
+// newFakeAzureContainerClient returns a client for the container |name| of the account served by |f|.
+func newFakeAzureContainerClient(f *fakeAzure, name string) *container.Client {
+	client, err := container.NewClientWithNoCredential("https://account.blob.core.windows.net/"+name, &container.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: f,
+			Retry:     policy.RetryOptions{MaxRetries: -1},
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+func (f *fakeAzure) Do(req *http.Request) (*http.Response, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := req.URL.Path
+	data, ok := f.data[key]
+	etag := aws.ToString(fakeETag(data))
+	if match := req.Header.Get("If-Match"); match != "" && (!ok || match != etag) {
+		return fakeAzureResponse(req, http.StatusPreconditionFailed, "ConditionNotMet", nil, nil), nil
+	}
+
+	switch req.Method {
+	case http.MethodHead, http.MethodGet:
+		if !ok {
+			return fakeAzureResponse(req, http.StatusNotFound, "BlobNotFound", nil, nil), nil
+		}
+		hdr := http.Header{"Etag": {etag}}
+		status := http.StatusOK
+		// the azblob clients set x-ms-* headers without canonicalizing their names
+		if rng := req.Header["x-ms-range"]; len(rng) > 0 {
+			start, end, _ := strings.Cut(strings.TrimPrefix(rng[0], "bytes="), "-")
+			size := int64(len(data))
+			s, _ := strconv.ParseInt(start, 10, 64)
+			e := size - 1
+			if end != "" {
+				e, _ = strconv.ParseInt(end, 10, 64)
+			}
+			if s >= size {
+				return fakeAzureResponse(req, http.StatusRequestedRangeNotSatisfiable, "InvalidRange", nil, nil), nil
+			}
+			e = min(e, size-1)
+			hdr.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", s, e, size))
+			data = data[s : e+1]
+			status = http.StatusPartialContent
+		}
+		if req.Method == http.MethodHead {
+			hdr.Set("Content-Length", strconv.Itoa(len(data)))
+			return fakeAzureResponse(req, status, "", hdr, nil), nil
+		}
+		return fakeAzureResponse(req, status, "", hdr, data), nil
+
+	case http.MethodPut:
+		switch req.URL.Query().Get("comp") {
+		case "block":
+			return f.stageBlock(req, key)
+		case "blocklist":
+			return f.commitBlockList(req, key, ok)
+		}
+		if req.Header.Get("If-None-Match") == "*" && ok {
+			return fakeAzureResponse(req, http.StatusConflict, "BlobAlreadyExists", nil, nil), nil
+		}
+		// empty blobs are uploaded without a body
+		body := []byte{}
+		if req.Body != nil {
+			var err error
+			if body, err = io.ReadAll(req.Body); err != nil {
+				return nil, err
+			}
+		}
+		f.data[key] = body
+		hdr := http.Header{"Etag": {aws.ToString(fakeETag(body))}}
+		return fakeAzureResponse(req, http.StatusCreated, "", hdr, nil), nil
+
+	default:
+		return fakeAzureResponse(req, http.StatusMethodNotAllowed, "UnsupportedHttpVerb", nil, nil), nil
+	}
+}
+
+// stageBlock stages a block of the blob |key|, either from the request body or from a range of the blob named by the
+// x-ms-copy-source header.
+func (f *fakeAzure) stageBlock(req *http.Request, key string) (*http.Response, error) {
+	var data []byte
+	if src := req.Header["x-ms-copy-source"]; len(src) > 0 {
+		u, err := url.Parse(src[0])
+		if err != nil {
+			return nil, err
+		}
+		var ok bool
+		data, ok = f.data[u.Path]
+		if !ok {
+			return fakeAzureResponse(req, http.StatusNotFound, "CannotVerifyCopySource", nil, nil), nil
+		}
+		if match := req.Header["x-ms-source-if-match"]; len(match) > 0 && match[0] != aws.ToString(fakeETag(data)) {
+			return fakeAzureResponse(req, http.StatusPreconditionFailed, "SourceConditionNotMet", nil, nil), nil
+		}
+		if rng := req.Header["x-ms-source-range"]; len(rng) > 0 {
+			var start, end int64
+			if _, err = fmt.Sscanf(rng[0], "bytes=%d-%d", &start, &end); err != nil {
+				return nil, err
+			}
+			data = data[start : end+1]
+		}
+		f.copies++
+	} else {
+		var err error
+		if data, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+	}
+
+	if f.blocks[key] == nil {
+		f.blocks[key] = make(map[string][]byte)
+	}
+	f.blocks[key][req.URL.Query().Get("blockid")] = data
+	return fakeAzureResponse(req, http.StatusCreated, "", nil, nil), nil
+}
+
+// commitBlockList replaces the blob |key| with the concatenation of the blocks listed in the request body.
+func (f *fakeAzure) commitBlockList(req *http.Request, key string, exists bool) (*http.Response, error) {
+	if req.Header.Get("If-None-Match") == "*" && exists {
+		return fakeAzureResponse(req, http.StatusConflict, "BlobAlreadyExists", nil, nil), nil
+	}
+	var list struct {
+		IDs []string `xml:",any"`
+	}
+	if err := xml.NewDecoder(req.Body).Decode(&list); err != nil {
+		return nil, err
+	}
+
+	data := []byte{}
+	for _, id := range list.IDs {
+		block, ok := f.blocks[key][id]
+		if !ok {
+			return fakeAzureResponse(req, http.StatusBadRequest, "InvalidBlockList", nil, nil), nil
+		}
+		data = append(data, block...)
+	}
+	delete(f.blocks, key)
+	f.data[key] = data
+	hdr := http.Header{"Etag": {aws.ToString(fakeETag(data))}}
+	return fakeAzureResponse(req, http.StatusCreated, "", hdr, nil), nil
+}
+
+func fakeAzureResponse(req *http.Request, status int, errCode string, hdr http.Header, body []byte) *http.Response {
+	if hdr == nil {
+		hdr = http.Header{}
+	}
+	if errCode != "" {
+		hdr.Set("x-ms-error-code", errCode)
+	}
+	if hdr.Get("Content-Length") == "" {
+		hdr.Set("Content-Length", strconv.Itoa(len(body)))
+	}
+	return &http.Response{
+		StatusCode:    status,
+		Status:        http.StatusText(status),
+		Header:        hdr,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+func TestAzureBlobstorePrefix(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeAzure()
+	bs := NewAzureBlobstore(newFakeAzureContainerClient(client, "container"), "/db/")
+	assert.Equal(t, "account.blob.core.windows.net/container/db", bs.Path())
+
+	ver, err := PutBytes(ctx, bs, "manifest", []byte("contents"))
+	require.NoError(t, err)
+	assert.Contains(t, client.data, "/container/db/manifest")
+
+	exists, err := bs.Exists(ctx, "manifest")
+	require.NoError(t, err)
+	assert.True(t, exists)
+	exists, err = bs.Exists(ctx, "missing")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	_, err = CheckAndPutBytes(ctx, bs, "", "manifest", []byte("other"))
+	assert.True(t, IsCheckAndPutError(err))
+	_, err = CheckAndPutBytes(ctx, bs, ver, "manifest", []byte("other"))
+	require.NoError(t, err)
+
+	_, _, err = GetBytes(ctx, bs, "missing", NewBlobRange(-4, 2))
+	assert.True(t, IsNotFoundError(err))
+	_, _, err = GetBytes(ctx, bs, "missing", AllRange)
+	assert.True(t, IsNotFoundError(err))
+}
+
+func TestAzureBlobstoreConcatenate(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeAzure()
+	bs := NewAzureBlobstore(newFakeAzureContainerClient(client, "container"), "db")
+
+	// blobs larger than a block are uploaded in several blocks
+	sizes := []int{azureUploadBlockSize + 1, 0, 1 << 10, 3 << 20}
+	var keys []string
+	var expected []byte
+	for i, size := range sizes {
+		data := randBytes(size)
+		key := fmt.Sprintf("src%d", i)
+		ver, err := bs.Put(ctx, key, int64(size), bytes.NewReader(data))
+		require.NoError(t, err)
+		actual, actualVer, err := GetBytes(ctx, bs, key, AllRange)
+		require.NoError(t, err)
+		assert.Equal(t, ver, actualVer)
+		assert.True(t, bytes.Equal(data, actual))
+		keys = append(keys, key)
+		expected = append(expected, data...)
+	}
+
+	ver, err := bs.Concatenate(ctx, "dest", keys)
+	require.NoError(t, err)
+	// the empty source has no blocks to copy
+	assert.Equal(t, 3, client.copies)
+
+	actual, actualVer, err := GetBytes(ctx, bs, "dest", AllRange)
+	require.NoError(t, err)
+	assert.Equal(t, ver, actualVer)
+	assert.True(t, bytes.Equal(expected, actual))
+
+	_, err = bs.Concatenate(ctx, "missing", []string{"src0", "nope"})
+	assert.True(t, IsNotFoundError(err))
+}
@@ -113,9 +113,31 @@ func appendLocalTest(tests []BlobstoreTest) []BlobstoreTest {
 	return append(tests, BlobstoreTest{"local", NewLocalBlobstore(dir), 10, 20})
 }
 
+func appendEncryptedTest(tests []BlobstoreTest) []BlobstoreTest {
+	ebs, err := NewEncryptedBlobstore(context.Background(), NewInMemoryBlobstore(""), randBytes(EncryptionKeyLen))
+
+	if err != nil {
+		panic("Could not create encrypted blobstore")
+	}
+
+	return append(tests, BlobstoreTest{"encrypted", ebs, 10, 20})
+}
+
+func appendS3Test(tests []BlobstoreTest) []BlobstoreTest {
+	return append(tests, BlobstoreTest{"s3", NewS3Blobstore(newFakeS3(), "bucket", uuid.New().String()+"/"), 10, 20})
+}
+
+func appendAzureTest(tests []BlobstoreTest) []BlobstoreTest {
+	client := newFakeAzureContainerClient(newFakeAzure(), "container")
+	return append(tests, BlobstoreTest{"azure", NewAzureBlobstore(client, uuid.New().String()+"/"), 10, 20})
+}
+
 func newBlobStoreTests() []BlobstoreTest {
 	var tests []BlobstoreTest
 	tests = append(tests, BlobstoreTest{"inmem", NewInMemoryBlobstore(""), 10, 20})
+	tests = appendEncryptedTest(tests)
+	tests = appendS3Test(tests)
+	tests = appendAzureTest(tests)
 	tests = appendLocalTest(tests)
 	tests = appendGCSTest(tests)
 	tests = appendOCITest(tests)
@@ -0,0 +1,624 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+// EncryptionKeyLen is the length, in bytes, of the keys used to encrypt blobs.
+const EncryptionKeyLen = 32
+
+const (
+	// encryptionKeysKey is the key of the blob holding the data keys of an EncryptedBlobstore, each encrypted with the
+	// key encryption key. It is the only blob of an EncryptedBlobstore stored unencrypted.
+	encryptionKeysKey = "encryption_keys"
+
+	encryptedBlobMagic = "DOLTENC1"
+	// encryptedHeaderLen is the length of the header of an encrypted blob: its magic, the id of the data key it was
+	// encrypted with, and its unencrypted size.
+	encryptedHeaderLen = len(encryptedBlobMagic) + 4 + 8
+	// encryptedSegmentSize is the size of the segments blobs are encrypted in. Each segment is encrypted separately, so
+	// that ranges of a blob can be read without reading the whole blob.
+	encryptedSegmentSize = 64 * 1024
+	gcmNonceLen          = 12
+	gcmTagLen            = 16
+	encryptedSegmentLen  = gcmNonceLen + encryptedSegmentSize + gcmTagLen
+)
+
+// ErrWrongEncryptionKey is returned when opening an EncryptedBlobstore with a key encryption key other than the one
+// its data keys were encrypted with.
+var ErrWrongEncryptionKey = errors.New("the encryption key does not match the key this store is encrypted with")
+
+// ReadEncryptionKeyFile reads a key encryption key from the file at |path|, which must contain a base64 encoded
+// 256-bit key, e.g. as created by `openssl rand -base64 32`.
+func ReadEncryptionKeyFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read encryption key file: %w", err)
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil || len(key) != EncryptionKeyLen {
+		return nil, fmt.Errorf("encryption key file '%s' must contain a base64 encoded %d-bit key", path, EncryptionKeyLen*8)
+	}
+	return key, nil
+}
+
+// encryptionKeys is the contents of the encryptionKeysKey blob.
+type encryptionKeys struct {
+	// Current is the id of the data key new blobs are encrypted with
+	Current uint32       `json:"current"`
+	Keys    []wrappedKey `json:"keys"`
+}
+
+// wrappedKey is a data key encrypted with the key encryption key.
+type wrappedKey struct {
+	ID  uint32 `json:"id"`
+	Key []byte `json:"key"`
+}
+
+// EncryptedBlobstore is a Blobstore which encrypts the blobs it writes to another Blobstore, and decrypts the blobs it
+// reads from it. Blobs are encrypted with AES-256-GCM using a data key generated for the store. Data keys are stored in
+// the underlying Blobstore, encrypted with a key encryption key which is never stored with the data. Rotating the keys
+// adds a new data key for blobs written afterward, while blobs written earlier remain readable with the data key they
+// were written with.
+type EncryptedBlobstore struct {
+	bs  Blobstore
+	kek cipher.AEAD
+
+	mu      sync.RWMutex
+	keys    map[uint32]cipher.AEAD
+	current uint32
+
+	// headers caches the headers of blobs read by range. Only blobs which are written once, like table files, are
+	// read by range.
+	headers sync.Map
+}
+
+var _ Blobstore = &EncryptedBlobstore{}
+
+// NewEncryptedBlobstore returns an EncryptedBlobstore which stores its blobs in |bs|, and whose data keys are encrypted
+// with |kek|. If |bs| has no data keys yet, one is created.
+func NewEncryptedBlobstore(ctx context.Context, bs Blobstore, kek []byte) (*EncryptedBlobstore, error) {
+	kekAEAD, err := newGCM(kek)
+	if err != nil {
+		return nil, err
+	}
+
+	ebs := &EncryptedBlobstore{bs: bs, kek: kekAEAD}
+	for {
+		keys, ver, err := readEncryptionKeys(ctx, bs)
+		if err != nil {
+			return nil, err
+		}
+		if ver != "" {
+			return ebs, ebs.setKeys(keys)
+		}
+
+		// this is a new store; create its first data key
+		keys, err = addDataKey(kekAEAD, encryptionKeys{})
+		if err != nil {
+			return nil, err
+		}
+		_, err = writeEncryptionKeys(ctx, bs, "", keys)
+		if IsCheckAndPutError(err) {
+			// another client created the keys first
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// RotateEncryptionKeys adds a new data key to the EncryptedBlobstore stored in |bs|, which is used to encrypt every
+// blob written afterward, and re-encrypts all of its data keys with |newKEK|. |kek| must be the key encryption key the
+// data keys are currently encrypted with. |newKEK| may be the same as |kek| to only add a new data key.
+func RotateEncryptionKeys(ctx context.Context, bs Blobstore, kek, newKEK []byte) error {
+	kekAEAD, err := newGCM(kek)
+	if err != nil {
+		return err
+	}
+	newKEKAEAD, err := newGCM(newKEK)
+	if err != nil {
+		return err
+	}
+
+	for {
+		keys, ver, err := readEncryptionKeys(ctx, bs)
+		if err != nil {
+			return err
+		}
+		if ver == "" {
+			return errors.New("this store is not encrypted")
+		}
+
+		rewrapped := encryptionKeys{Current: keys.Current}
+		for _, wk := range keys.Keys {
+			dataKey, err := unwrapKey(kekAEAD, wk)
+			if err != nil {
+				return err
+			}
+			wk, err = wrapKey(newKEKAEAD, wk.ID, dataKey)
+			if err != nil {
+				return err
+			}
+			rewrapped.Keys = append(rewrapped.Keys, wk)
+		}
+		rewrapped, err = addDataKey(newKEKAEAD, rewrapped)
+		if err != nil {
+			return err
+		}
+
+		_, err = writeEncryptionKeys(ctx, bs, ver, rewrapped)
+		if !IsCheckAndPutError(err) {
+			return err
+		}
+	}
+}
+
+func readEncryptionKeys(ctx context.Context, bs Blobstore) (encryptionKeys, string, error) {
+	data, ver, err := GetBytes(ctx, bs, encryptionKeysKey, AllRange)
+	if IsNotFoundError(err) {
+		return encryptionKeys{}, "", nil
+	} else if err != nil {
+		return encryptionKeys{}, "", err
+	}
+
+	var keys encryptionKeys
+	if err = json.Unmarshal(data, &keys); err != nil {
+		return encryptionKeys{}, "", fmt.Errorf("unable to read encryption keys: %w", err)
+	}
+	return keys, ver, nil
+}
+
+func writeEncryptionKeys(ctx context.Context, bs Blobstore, expectedVersion string, keys encryptionKeys) (string, error) {
+	data, err := json.Marshal(keys)
+	if err != nil {
+		return "", err
+	}
+	return bs.CheckAndPut(ctx, expectedVersion, encryptionKeysKey, int64(len(data)), bytes.NewReader(data))
+}
+
+// addDataKey generates a new data key, adds it to |keys| encrypted with |kek|, and makes it the current key.
+func addDataKey(kek cipher.AEAD, keys encryptionKeys) (encryptionKeys, error) {
+	var id uint32
+	for _, wk := range keys.Keys {
+		id = max(id, wk.ID)
+	}
+	id++
+
+	dataKey := make([]byte, EncryptionKeyLen)
+	if _, err := rand.Read(dataKey); err != nil {
+		return encryptionKeys{}, err
+	}
+	wk, err := wrapKey(kek, id, dataKey)
+	if err != nil {
+		return encryptionKeys{}, err
+	}
+
+	keys.Keys = append(keys.Keys, wk)
+	keys.Current = id
+	return keys, nil
+}
+
+func wrapKey(kek cipher.AEAD, id uint32, dataKey []byte) (wrappedKey, error) {
+	nonce := make([]byte, gcmNonceLen)
+	if _, err := rand.Read(nonce); err != nil {
+		return wrappedKey{}, err
+	}
+	return wrappedKey{ID: id, Key: kek.Seal(nonce, nonce, dataKey, keyAAD(id))}, nil
+}
+
+func unwrapKey(kek cipher.AEAD, wk wrappedKey) ([]byte, error) {
+	if len(wk.Key) < gcmNonceLen {
+		return nil, fmt.Errorf("encryption key %d is corrupt", wk.ID)
+	}
+	dataKey, err := kek.Open(nil, wk.Key[:gcmNonceLen], wk.Key[gcmNonceLen:], keyAAD(wk.ID))
+	if err != nil {
+		return nil, ErrWrongEncryptionKey
+	}
+	return dataKey, nil
+}
+
+func keyAAD(id uint32) []byte {
+	return binary.BigEndian.AppendUint32([]byte("dolt data key "), id)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	if len(key) != EncryptionKeyLen {
+		return nil, fmt.Errorf("encryption keys must be %d bytes", EncryptionKeyLen)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func (ebs *EncryptedBlobstore) setKeys(keys encryptionKeys) error {
+	aeads := make(map[uint32]cipher.AEAD, len(keys.Keys))
+	for _, wk := range keys.Keys {
+		dataKey, err := unwrapKey(ebs.kek, wk)
+		if err != nil {
+			return err
+		}
+		if aeads[wk.ID], err = newGCM(dataKey); err != nil {
+			return err
+		}
+	}
+	if _, ok := aeads[keys.Current]; !ok {
+		return fmt.Errorf("current encryption key %d not found", keys.Current)
+	}
+
+	ebs.mu.Lock()
+	defer ebs.mu.Unlock()
+	ebs.keys, ebs.current = aeads, keys.Current
+	return nil
+}
+
+// dataKey returns the data key with the id |id|, reloading the data keys of the store if it has been rotated since
+// they were loaded.
+func (ebs *EncryptedBlobstore) dataKey(ctx context.Context, id uint32) (cipher.AEAD, error) {
+	ebs.mu.RLock()
+	aead, ok := ebs.keys[id]
+	ebs.mu.RUnlock()
+	if ok {
+		return aead, nil
+	}
+
+	keys, _, err := readEncryptionKeys(ctx, ebs.bs)
+	if err != nil {
+		return nil, err
+	}
+	if err = ebs.setKeys(keys); err != nil {
+		return nil, err
+	}
+
+	ebs.mu.RLock()
+	defer ebs.mu.RUnlock()
+	if aead, ok = ebs.keys[id]; !ok {
+		return nil, fmt.Errorf("encryption key %d not found", id)
+	}
+	return aead, nil
+}
+
+func (ebs *EncryptedBlobstore) currentDataKey() (uint32, cipher.AEAD) {
+	ebs.mu.RLock()
+	defer ebs.mu.RUnlock()
+	return ebs.current, ebs.keys[ebs.current]
+}
+
+// Path implements Blobstore.
+func (ebs *EncryptedBlobstore) Path() string {
+	return ebs.bs.Path()
+}
+
+// Exists implements Blobstore.
+func (ebs *EncryptedBlobstore) Exists(ctx context.Context, key string) (bool, error) {
+	return ebs.bs.Exists(ctx, key)
+}
+
+// Get implements Blobstore.
+func (ebs *EncryptedBlobstore) Get(ctx context.Context, key string, br BlobRange) (io.ReadCloser, string, error) {
+	if br.isAllRange() {
+		rc, ver, err := ebs.bs.Get(ctx, key, AllRange)
+		if err != nil {
+			return nil, "", err
+		}
+		dr, err := ebs.newDecryptingReader(ctx, key, rc)
+		if err != nil {
+			rc.Close()
+			return nil, "", err
+		}
+		return dr, ver, nil
+	}
+
+	hdr, err := ebs.readHeader(ctx, key)
+	if err != nil {
+		return nil, "", err
+	}
+	aead, err := ebs.dataKey(ctx, hdr.keyID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	br = br.positiveRange(hdr.size)
+	if br.length == 0 {
+		return io.NopCloser(bytes.NewReader(nil)), "", nil
+	}
+	first, last := br.offset/encryptedSegmentSize, (br.offset+br.length-1)/encryptedSegmentSize
+	start := int64(encryptedHeaderLen) + first*encryptedSegmentLen
+	end := int64(encryptedHeaderLen) + last*encryptedSegmentLen + gcmNonceLen + hdr.segmentSize(last) + gcmTagLen
+
+	data, ver, err := GetBytes(ctx, ebs.bs, key, NewBlobRange(start, end-start))
+	if err != nil {
+		return nil, "", err
+	}
+
+	plaintext := make([]byte, 0, (last-first+1)*encryptedSegmentSize)
+	for seg := first; seg <= last; seg++ {
+		n := gcmNonceLen + hdr.segmentSize(seg) + gcmTagLen
+		if int64(len(data)) < n {
+			return nil, "", fmt.Errorf("encrypted blob %s is truncated", key)
+		}
+		plaintext, err = hdr.openSegment(aead, plaintext, seg, data[:n])
+		if err != nil {
+			return nil, "", fmt.Errorf("unable to decrypt blob %s: %w", key, err)
+		}
+		data = data[n:]
+	}
+
+	skip := br.offset - first*encryptedSegmentSize
+	return io.NopCloser(bytes.NewReader(plaintext[skip : skip+br.length])), ver, nil
+}
+
+// Put implements Blobstore.
+func (ebs *EncryptedBlobstore) Put(ctx context.Context, key string, totalSize int64, reader io.Reader) (string, error) {
+	ebs.headers.Delete(key)
+	er, encryptedSize, err := ebs.newEncryptingReader(key, totalSize, reader)
+	if err != nil {
+		return "", err
+	}
+	return ebs.bs.Put(ctx, key, encryptedSize, er)
+}
+
+// CheckAndPut implements Blobstore.
+func (ebs *EncryptedBlobstore) CheckAndPut(ctx context.Context, expectedVersion, key string, totalSize int64, reader io.Reader) (string, error) {
+	ebs.headers.Delete(key)
+	er, encryptedSize, err := ebs.newEncryptingReader(key, totalSize, reader)
+	if err != nil {
+		return "", err
+	}
+	return ebs.bs.CheckAndPut(ctx, expectedVersion, key, encryptedSize, er)
+}
+
+// Concatenate implements Blobstore. Encrypted blobs cannot be concatenated by the underlying Blobstore, so the sources
+// are read, decrypted and written again.
+func (ebs *EncryptedBlobstore) Concatenate(ctx context.Context, key string, sources []string) (string, error) {
+	var totalSize int64
+	for _, src := range sources {
+		hdr, err := ebs.readHeader(ctx, src)
+		if err != nil {
+			return "", err
+		}
+		totalSize += hdr.size
+	}
+
+	readers := make([]io.Reader, len(sources))
+	for i, src := range sources {
+		rc, _, err := ebs.Get(ctx, src, AllRange)
+		if err != nil {
+			return "", err
+		}
+		defer rc.Close()
+		readers[i] = rc
+	}
+
+	return ebs.Put(ctx, key, totalSize, io.MultiReader(readers...))
+}
+
+// encryptedHeader is the header of an encrypted blob, which is authenticated as part of every segment of the blob. The
+// key of the blob is not stored in the header, but is authenticated along with it.
+type encryptedHeader struct {
+	raw   []byte
+	key   string
+	keyID uint32
+	size  int64
+}
+
+func parseEncryptedHeader(key string, raw []byte) (encryptedHeader, error) {
+	if len(raw) < encryptedHeaderLen || string(raw[:len(encryptedBlobMagic)]) != encryptedBlobMagic {
+		return encryptedHeader{}, fmt.Errorf("blob %s is not encrypted", key)
+	}
+	raw = raw[:encryptedHeaderLen]
+	return encryptedHeader{
+		raw:   raw,
+		key:   key,
+		keyID: binary.BigEndian.Uint32(raw[len(encryptedBlobMagic):]),
+		size:  int64(binary.BigEndian.Uint64(raw[len(encryptedBlobMagic)+4:])),
+	}, nil
+}
+
+func newEncryptedHeader(key string, keyID uint32, size int64) encryptedHeader {
+	raw := make([]byte, 0, encryptedHeaderLen)
+	raw = append(raw, encryptedBlobMagic...)
+	raw = binary.BigEndian.AppendUint32(raw, keyID)
+	raw = binary.BigEndian.AppendUint64(raw, uint64(size))
+	return encryptedHeader{raw: raw, key: key, keyID: keyID, size: size}
+}
+
+// numSegments returns the number of segments of the blob.
+func (hdr encryptedHeader) numSegments() int64 {
+	return (hdr.size + encryptedSegmentSize - 1) / encryptedSegmentSize
+}
+
+// segmentSize returns the unencrypted size of the segment |seg| of the blob.
+func (hdr encryptedHeader) segmentSize(seg int64) int64 {
+	return min(encryptedSegmentSize, hdr.size-seg*encryptedSegmentSize)
+}
+
+// encryptedSize returns the size of the encrypted blob.
+func (hdr encryptedHeader) encryptedSize() int64 {
+	return int64(encryptedHeaderLen) + hdr.numSegments()*(gcmNonceLen+gcmTagLen) + hdr.size
+}
+
+// segmentAAD binds each segment to its position in the blob, to the header and to the key of the blob, so that segments
+// cannot be reordered, truncated or moved between blobs, and a blob cannot be copied over another one.
+func (hdr encryptedHeader) segmentAAD(seg int64) []byte {
+	aad := make([]byte, 0, len(hdr.raw)+len(hdr.key)+8)
+	aad = append(append(aad, hdr.raw...), hdr.key...)
+	return binary.BigEndian.AppendUint64(aad, uint64(seg))
+}
+
+func (hdr encryptedHeader) sealSegment(aead cipher.AEAD, dst []byte, seg int64, plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, gcmNonceLen)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	dst = append(dst, nonce...)
+	return aead.Seal(dst, nonce, plaintext, hdr.segmentAAD(seg)), nil
+}
+
+func (hdr encryptedHeader) openSegment(aead cipher.AEAD, dst []byte, seg int64, sealed []byte) ([]byte, error) {
+	return aead.Open(dst, sealed[:gcmNonceLen], sealed[gcmNonceLen:], hdr.segmentAAD(seg))
+}
+
+func (ebs *EncryptedBlobstore) readHeader(ctx context.Context, key string) (encryptedHeader, error) {
+	if hdr, ok := ebs.headers.Load(key); ok {
+		return hdr.(encryptedHeader), nil
+	}
+	raw, _, err := GetBytes(ctx, ebs.bs, key, NewBlobRange(0, int64(encryptedHeaderLen)))
+	if err != nil {
+		return encryptedHeader{}, err
+	}
+	hdr, err := parseEncryptedHeader(key, raw)
+	if err != nil {
+		return encryptedHeader{}, err
+	}
+	ebs.headers.Store(key, hdr)
+	return hdr, nil
+}
+
+// encryptingReader encrypts the |size| bytes read from |rd| one segment at a time.
+type encryptingReader struct {
+	rd   io.Reader
+	hdr  encryptedHeader
+	aead cipher.AEAD
+	seg  int64
+	in   []byte
+	out  []byte
+	buf  []byte
+}
+
+func (ebs *EncryptedBlobstore) newEncryptingReader(key string, size int64, rd io.Reader) (*encryptingReader, int64, error) {
+	if size < 0 {
+		return nil, 0, errors.New("encrypted blobs must have a known size")
+	}
+	keyID, aead := ebs.currentDataKey()
+	hdr := newEncryptedHeader(key, keyID, size)
+	return &encryptingReader{
+		rd:   rd,
+		hdr:  hdr,
+		aead: aead,
+		in:   make([]byte, encryptedSegmentSize),
+		out:  make([]byte, 0, encryptedSegmentLen),
+		buf:  append([]byte(nil), hdr.raw...),
+	}, hdr.encryptedSize(), nil
+}
+
+func (er *encryptingReader) Read(p []byte) (int, error) {
+	for len(er.buf) == 0 {
+		if er.seg >= er.hdr.numSegments() {
+			return 0, io.EOF
+		}
+		in := er.in[:er.hdr.segmentSize(er.seg)]
+		if _, err := io.ReadFull(er.rd, in); err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				return 0, fmt.Errorf("blob is shorter than its size of %d bytes", er.hdr.size)
+			}
+			return 0, err
+		}
+		var err error
+		er.buf, err = er.hdr.sealSegment(er.aead, er.out[:0], er.seg, in)
+		if err != nil {
+			return 0, err
+		}
+		er.seg++
+	}
+
+	n := copy(p, er.buf)
+	er.buf = er.buf[n:]
+	return n, nil
+}
+
+// decryptingReader decrypts an encrypted blob read from |rc| one segment at a time.
+type decryptingReader struct {
+	rc   io.ReadCloser
+	key  string
+	hdr  encryptedHeader
+	aead cipher.AEAD
+	seg  int64
+	in   []byte
+	out  []byte
+	buf  []byte
+}
+
+func (ebs *EncryptedBlobstore) newDecryptingReader(ctx context.Context, key string, rc io.ReadCloser) (*decryptingReader, error) {
+	raw := make([]byte, encryptedHeaderLen)
+	if _, err := io.ReadFull(rc, raw); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, fmt.Errorf("blob %s is not encrypted", key)
+		}
+		return nil, err
+	}
+	hdr, err := parseEncryptedHeader(key, raw)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := ebs.dataKey(ctx, hdr.keyID)
+	if err != nil {
+		return nil, err
+	}
+	return &decryptingReader{
+		rc:   rc,
+		key:  key,
+		hdr:  hdr,
+		aead: aead,
+		in:   make([]byte, encryptedSegmentLen),
+		out:  make([]byte, 0, encryptedSegmentSize),
+	}, nil
+}
+
+func (dr *decryptingReader) Read(p []byte) (int, error) {
+	for len(dr.buf) == 0 {
+		if dr.seg >= dr.hdr.numSegments() {
+			return 0, io.EOF
+		}
+		in := dr.in[:gcmNonceLen+dr.hdr.segmentSize(dr.seg)+gcmTagLen]
+		if _, err := io.ReadFull(dr.rc, in); err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				return 0, fmt.Errorf("encrypted blob %s is truncated", dr.key)
+			}
+			return 0, err
+		}
+		var err error
+		dr.buf, err = dr.hdr.openSegment(dr.aead, dr.out[:0], dr.seg, in)
+		if err != nil {
+			return 0, fmt.Errorf("unable to decrypt blob %s: %w", dr.key, err)
+		}
+		dr.seg++
+	}
+
+	n := copy(p, dr.buf)
+	dr.buf = dr.buf[n:]
+	return n, nil
+}
+
+func (dr *decryptingReader) Close() error {
+	return dr.rc.Close()
+}
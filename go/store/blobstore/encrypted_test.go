@@ -0,0 +1,181 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptedBlobstoreRanges(t *testing.T) {
+	ctx := context.Background()
+	ebs, err := NewEncryptedBlobstore(ctx, NewInMemoryBlobstore(""), randBytes(EncryptionKeyLen))
+	require.NoError(t, err)
+
+	// several segments, the last of which is partial
+	data := randBytes(3*encryptedSegmentSize + 100)
+	_, err = PutBytes(ctx, ebs, key, data)
+	require.NoError(t, err)
+
+	size := int64(len(data))
+	tests := []struct {
+		name     string
+		br       BlobRange
+		expected []byte
+	}{
+		{"all", AllRange, data},
+		{"within a segment", NewBlobRange(10, 100), data[10:110]},
+		{"across segments", NewBlobRange(encryptedSegmentSize-10, encryptedSegmentSize+20), data[encryptedSegmentSize-10 : 2*encryptedSegmentSize+10]},
+		{"to the end", NewBlobRange(encryptedSegmentSize, 0), data[encryptedSegmentSize:]},
+		{"tail", NewBlobRange(-200, 0), data[size-200:]},
+		{"from the end", NewBlobRange(-200, 50), data[size-200 : size-150]},
+		{"past the end", NewBlobRange(size-10, 100), data[size-10:]},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, _, err := GetBytes(ctx, ebs, key, test.br)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+
+	// the underlying blob does not contain the plaintext
+	raw, _, err := GetBytes(ctx, ebs.bs, key, AllRange)
+	require.NoError(t, err)
+	assert.False(t, bytes.Contains(raw, data[:64]))
+	assert.Equal(t, newEncryptedHeader(key, 1, size).encryptedSize(), int64(len(raw)))
+
+	// an empty blob
+	_, err = PutBytes(ctx, ebs, "empty", nil)
+	require.NoError(t, err)
+	actual, _, err := GetBytes(ctx, ebs, "empty", AllRange)
+	require.NoError(t, err)
+	assert.Empty(t, actual)
+}
+
+func TestEncryptedBlobstoreKeys(t *testing.T) {
+	ctx := context.Background()
+	bs := NewInMemoryBlobstore("")
+	kek := randBytes(EncryptionKeyLen)
+	ebs, err := NewEncryptedBlobstore(ctx, bs, kek)
+	require.NoError(t, err)
+	data := randBytes(1024)
+	_, err = PutBytes(ctx, ebs, key, data)
+	require.NoError(t, err)
+
+	_, err = NewEncryptedBlobstore(ctx, bs, randBytes(EncryptionKeyLen))
+	assert.ErrorIs(t, err, ErrWrongEncryptionKey)
+
+	// rotating adds a new data key and re-encrypts the existing ones with the new key encryption key
+	newKEK := randBytes(EncryptionKeyLen)
+	require.NoError(t, RotateEncryptionKeys(ctx, bs, kek, newKEK))
+	assert.ErrorIs(t, RotateEncryptionKeys(ctx, bs, kek, newKEK), ErrWrongEncryptionKey)
+	_, err = NewEncryptedBlobstore(ctx, bs, kek)
+	assert.ErrorIs(t, err, ErrWrongEncryptionKey)
+
+	rotated, err := NewEncryptedBlobstore(ctx, bs, newKEK)
+	require.NoError(t, err)
+	actual, _, err := GetBytes(ctx, rotated, key, AllRange)
+	require.NoError(t, err)
+	assert.Equal(t, data, actual)
+
+	_, err = PutBytes(ctx, rotated, "rotated", data)
+	require.NoError(t, err)
+	hdr, err := rotated.readHeader(ctx, "rotated")
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), hdr.keyID)
+
+	// an open store picks up data keys added after it was opened
+	require.NoError(t, RotateEncryptionKeys(ctx, bs, newKEK, newKEK))
+	other, err := NewEncryptedBlobstore(ctx, bs, newKEK)
+	require.NoError(t, err)
+	_, err = PutBytes(ctx, other, "rotated again", data)
+	require.NoError(t, err)
+	actual, _, err = GetBytes(ctx, rotated, "rotated again", NewBlobRange(0, 10))
+	require.NoError(t, err)
+	assert.Equal(t, data[:10], actual)
+}
+
+func TestEncryptedBlobstoreTampering(t *testing.T) {
+	ctx := context.Background()
+	bs := NewInMemoryBlobstore("")
+	ebs, err := NewEncryptedBlobstore(ctx, bs, randBytes(EncryptionKeyLen))
+	require.NoError(t, err)
+
+	_, err = PutBytes(ctx, bs, "plain", randBytes(100))
+	require.NoError(t, err)
+	_, _, err = GetBytes(ctx, ebs, "plain", AllRange)
+	assert.ErrorContains(t, err, "is not encrypted")
+
+	_, err = PutBytes(ctx, ebs, key, randBytes(2*encryptedSegmentSize))
+	require.NoError(t, err)
+	raw, _, err := GetBytes(ctx, bs, key, AllRange)
+	require.NoError(t, err)
+
+	flipped := append([]byte(nil), raw...)
+	flipped[len(flipped)-1] ^= 1
+	_, err = PutBytes(ctx, bs, "flipped", flipped)
+	require.NoError(t, err)
+	_, _, err = GetBytes(ctx, ebs, "flipped", AllRange)
+	assert.ErrorContains(t, err, "unable to decrypt blob flipped")
+	_, _, err = GetBytes(ctx, ebs, "flipped", NewBlobRange(-10, 0))
+	assert.ErrorContains(t, err, "unable to decrypt blob flipped")
+
+	// swapping two segments is detected
+	hdrLen, segLen := encryptedHeaderLen, encryptedSegmentLen
+	swapped := append([]byte(nil), raw[:hdrLen]...)
+	swapped = append(swapped, raw[hdrLen+segLen:]...)
+	swapped = append(swapped, raw[hdrLen:hdrLen+segLen]...)
+	_, err = PutBytes(ctx, bs, "swapped", swapped)
+	require.NoError(t, err)
+	_, _, err = GetBytes(ctx, ebs, "swapped", AllRange)
+	assert.ErrorContains(t, err, "unable to decrypt blob swapped")
+
+	// copying a blob over another one is detected
+	_, err = PutBytes(ctx, bs, "copied", raw)
+	require.NoError(t, err)
+	_, _, err = GetBytes(ctx, ebs, "copied", AllRange)
+	assert.ErrorContains(t, err, "unable to decrypt blob copied")
+
+	_, err = PutBytes(ctx, bs, key, raw[:len(raw)-100])
+	require.NoError(t, err)
+	_, _, err = GetBytes(ctx, ebs, key, AllRange)
+	assert.ErrorContains(t, err, "is truncated")
+}
+
+func TestReadEncryptionKeyFile(t *testing.T) {
+	dir := t.TempDir()
+	key := randBytes(EncryptionKeyLen)
+	path := filepath.Join(dir, "key")
+	require.NoError(t, os.WriteFile(path, []byte(base64.StdEncoding.EncodeToString(key)+"\n"), 0600))
+	actual, err := ReadEncryptionKeyFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, key, actual)
+
+	short := filepath.Join(dir, "short")
+	require.NoError(t, os.WriteFile(short, []byte(base64.StdEncoding.EncodeToString(key[:16])), 0600))
+	_, err = ReadEncryptionKeyFile(short)
+	assert.ErrorContains(t, err, "must contain a base64 encoded 256-bit key")
+
+	_, err = ReadEncryptionKeyFile(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,372 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	s3manager "github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// S3API is the subset of the S3 client used by S3Blobstore.
+type S3API interface {
+	GetObject(context.Context, *s3.GetObjectInput, ...func(*s3.Options)) (*s3.GetObjectOutput, error)
+	PutObject(context.Context, *s3.PutObjectInput, ...func(*s3.Options)) (*s3.PutObjectOutput, error)
+	HeadObject(context.Context, *s3.HeadObjectInput, ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
+	CreateMultipartUpload(context.Context, *s3.CreateMultipartUploadInput, ...func(*s3.Options)) (*s3.CreateMultipartUploadOutput, error)
+	UploadPart(context.Context, *s3.UploadPartInput, ...func(*s3.Options)) (*s3.UploadPartOutput, error)
+	UploadPartCopy(context.Context, *s3.UploadPartCopyInput, ...func(*s3.Options)) (*s3.UploadPartCopyOutput, error)
+	CompleteMultipartUpload(context.Context, *s3.CompleteMultipartUploadInput, ...func(*s3.Options)) (*s3.CompleteMultipartUploadOutput, error)
+	AbortMultipartUpload(context.Context, *s3.AbortMultipartUploadInput, ...func(*s3.Options)) (*s3.AbortMultipartUploadOutput, error)
+}
+
+var _ s3manager.UploadAPIClient = (S3API)(nil)
+
+var _ S3API = (*s3.Client)(nil)
+
+const (
+	// minS3PartSize is the minimum size of every part of a multipart upload but the last
+	minS3PartSize = 5 * 1024 * 1024
+	// maxS3CopyPartSize is the maximum size of a part copied from another object
+	maxS3CopyPartSize = 5 * 1024 * 1024 * 1024
+	// maxS3Parts is the maximum number of parts of a multipart upload
+	maxS3Parts = 10000
+)
+
+// S3Blobstore provides an S3 implementation of the Blobstore interface. The versions of blobs are their ETags, and
+// CheckAndPut uses S3 conditional writes.
+type S3Blobstore struct {
+	client S3API
+	bucket string
+	prefix string
+}
+
+var _ Blobstore = &S3Blobstore{}
+
+// NewS3Blobstore creates a new instance of a S3Blobstore
+func NewS3Blobstore(client S3API, bucket, prefix string) *S3Blobstore {
+	return &S3Blobstore{client: client, bucket: bucket, prefix: normalizePrefix(prefix)}
+}
+
+// Path returns the bucket and prefix of the blobstore
+func (bs *S3Blobstore) Path() string {
+	return path.Join(bs.bucket, bs.prefix)
+}
+
+// Exists returns true if a blob exists for the given key, and false if it does not.
+func (bs *S3Blobstore) Exists(ctx context.Context, key string) (bool, error) {
+	_, err := bs.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bs.bucket),
+		Key:    aws.String(bs.absKey(key)),
+	})
+	if isS3NotFoundErr(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Get retrieves an io.reader for the portion of a blob specified by br along with its version
+func (bs *S3Blobstore) Get(ctx context.Context, key string, br BlobRange) (io.ReadCloser, string, error) {
+	absKey := bs.absKey(key)
+	input := &s3.GetObjectInput{
+		Bucket: aws.String(bs.bucket),
+		Key:    aws.String(absKey),
+	}
+
+	if !br.isAllRange() {
+		if br.offset < 0 && br.length != 0 {
+			// S3 can only read ranges relative to the end of an object when they extend to its end
+			head, err := bs.client.HeadObject(ctx, &s3.HeadObjectInput{
+				Bucket: aws.String(bs.bucket),
+				Key:    aws.String(absKey),
+			})
+			if isS3NotFoundErr(err) {
+				return nil, "", NotFound{"s3://" + path.Join(bs.bucket, absKey)}
+			} else if err != nil {
+				return nil, "", err
+			}
+			br = br.positiveRange(aws.ToInt64(head.ContentLength))
+			if br.length == 0 {
+				return io.NopCloser(bytes.NewReader(nil)), aws.ToString(head.ETag), nil
+			}
+			input.IfMatch = head.ETag
+		}
+		input.Range = aws.String(s3RangeHeader(br))
+	}
+
+	out, err := bs.client.GetObject(ctx, input)
+	if isS3NotFoundErr(err) {
+		return nil, "", NotFound{"s3://" + path.Join(bs.bucket, absKey)}
+	} else if err != nil {
+		return nil, "", err
+	}
+	return out.Body, aws.ToString(out.ETag), nil
+}
+
+func s3RangeHeader(br BlobRange) string {
+	if br.offset < 0 {
+		return fmt.Sprintf("bytes=%d", br.offset)
+	} else if br.length == 0 {
+		return fmt.Sprintf("bytes=%d-", br.offset)
+	}
+	return fmt.Sprintf("bytes=%d-%d", br.offset, br.offset+br.length-1)
+}
+
+// Put sets the blob and the version for a key. The blob is streamed to S3, using a multipart upload when it is
+// larger than a single part.
+func (bs *S3Blobstore) Put(ctx context.Context, key string, totalSize int64, reader io.Reader) (string, error) {
+	out, err := s3manager.NewUploader(bs.client).Upload(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(bs.bucket),
+		Key:    aws.String(bs.absKey(key)),
+		Body:   reader,
+	})
+	if err != nil {
+		return "", err
+	}
+	return aws.ToString(out.ETag), nil
+}
+
+// CheckAndPut will check the current version of a blob against an expectedVersion, and if the versions match it will
+// update the data and version associated with the key. The blob is written with a single conditional PutObject, so it
+// is read into memory first; CheckAndPut is only used for manifests, which are small.
+func (bs *S3Blobstore) CheckAndPut(ctx context.Context, expectedVersion, key string, totalSize int64, reader io.Reader) (string, error) {
+	// the request body must be seekable to be signed
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+
+	input := &s3.PutObjectInput{
+		Bucket:        aws.String(bs.bucket),
+		Key:           aws.String(bs.absKey(key)),
+		Body:          bytes.NewReader(data),
+		ContentLength: aws.Int64(int64(len(data))),
+	}
+	if expectedVersion == "" {
+		input.IfNoneMatch = aws.String("*")
+	} else {
+		input.IfMatch = aws.String(expectedVersion)
+	}
+
+	out, err := bs.client.PutObject(ctx, input)
+	// S3 responds to a conditional write of an object which does not exist with not found
+	if isS3PreconditionErr(err) || isS3NotFoundErr(err) {
+		return "", CheckAndPutError{Key: key, ExpectedVersion: expectedVersion, ActualVersion: "unknown"}
+	} else if err != nil {
+		return "", err
+	}
+	return aws.ToString(out.ETag), nil
+}
+
+// Concatenate creates a new blob named |key| by concatenating |sources| with a multipart upload. Sources of at least
+// 5MiB are copied within S3 with UploadPartCopy. Every part but the last must be at least 5MiB, so smaller sources
+// are read and uploaded together with their neighbours, buffering at most a few parts in memory.
+func (bs *S3Blobstore) Concatenate(ctx context.Context, key string, sources []string) (string, error) {
+	absKey := bs.absKey(key)
+	res, err := bs.client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket: aws.String(bs.bucket),
+		Key:    aws.String(absKey),
+	})
+	if err != nil {
+		return "", err
+	}
+	uploadID := res.UploadId
+
+	ver, err := bs.concatenateParts(ctx, absKey, uploadID, sources)
+	if err != nil {
+		// the upload is abandoned, the original error is the one worth reporting
+		_, _ = bs.client.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+			Bucket:   aws.String(bs.bucket),
+			Key:      aws.String(absKey),
+			UploadId: uploadID,
+		})
+		return "", err
+	}
+	return ver, nil
+}
+
+func (bs *S3Blobstore) concatenateParts(ctx context.Context, absKey string, uploadID *string, sources []string) (string, error) {
+	var parts []s3types.CompletedPart
+	var buf []byte
+
+	uploadBuf := func() error {
+		part, err := bs.uploadPart(ctx, absKey, uploadID, int32(len(parts)+1), buf)
+		if err != nil {
+			return err
+		}
+		parts = append(parts, part)
+		buf = nil
+		return nil
+	}
+
+	for _, src := range sources {
+		size, err := bs.size(ctx, src)
+		if err != nil {
+			return "", err
+		}
+
+		var off int64
+		if len(buf) > 0 {
+			fill := minS3PartSize - int64(len(buf))
+			if size-fill < minS3PartSize {
+				// what would be left to copy is too small to be a part, so the whole source is buffered
+				fill = size
+			}
+			buf, err = bs.appendRange(ctx, buf, src, NewBlobRange(0, fill))
+			if err != nil {
+				return "", err
+			}
+			off = fill
+			if len(buf) >= minS3PartSize {
+				if err = uploadBuf(); err != nil {
+					return "", err
+				}
+			}
+		}
+
+		if rem := size - off; rem > 0 && rem < minS3PartSize {
+			buf, err = bs.appendRange(ctx, buf, src, NewBlobRange(off, rem))
+			if err != nil {
+				return "", err
+			}
+			if len(buf) >= minS3PartSize {
+				if err = uploadBuf(); err != nil {
+					return "", err
+				}
+			}
+		} else if rem > 0 {
+			// split the remainder into equal parts no larger than S3 can copy
+			n := (rem + maxS3CopyPartSize - 1) / maxS3CopyPartSize
+			for i := int64(0); i < n; i++ {
+				start := off + rem*i/n
+				end := off + rem*(i+1)/n
+				part, err := bs.uploadPartCopy(ctx, absKey, uploadID, int32(len(parts)+1), src, start, end)
+				if err != nil {
+					return "", err
+				}
+				parts = append(parts, part)
+			}
+		}
+	}
+
+	if len(buf) > 0 || len(parts) == 0 {
+		if err := uploadBuf(); err != nil {
+			return "", err
+		}
+	}
+
+	if len(parts) > maxS3Parts {
+		return "", fmt.Errorf("cannot concatenate %d sources into %s, a multipart upload is limited to %d parts", len(sources), absKey, maxS3Parts)
+	}
+
+	out, err := bs.client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(bs.bucket),
+		Key:             aws.String(absKey),
+		UploadId:        uploadID,
+		MultipartUpload: &s3types.CompletedMultipartUpload{Parts: parts},
+	})
+	if err != nil {
+		return "", err
+	}
+	return aws.ToString(out.ETag), nil
+}
+
+func (bs *S3Blobstore) size(ctx context.Context, key string) (int64, error) {
+	absKey := bs.absKey(key)
+	head, err := bs.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bs.bucket),
+		Key:    aws.String(absKey),
+	})
+	if isS3NotFoundErr(err) {
+		return 0, NotFound{"s3://" + path.Join(bs.bucket, absKey)}
+	} else if err != nil {
+		return 0, err
+	}
+	return aws.ToInt64(head.ContentLength), nil
+}
+
+func (bs *S3Blobstore) appendRange(ctx context.Context, buf []byte, key string, br BlobRange) ([]byte, error) {
+	rc, _, err := bs.Get(ctx, key, br)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	w := bytes.NewBuffer(buf)
+	if _, err = io.Copy(w, rc); err != nil {
+		return nil, err
+	}
+	return w.Bytes(), nil
+}
+
+func (bs *S3Blobstore) uploadPart(ctx context.Context, absKey string, uploadID *string, partNum int32, data []byte) (s3types.CompletedPart, error) {
+	res, err := bs.client.UploadPart(ctx, &s3.UploadPartInput{
+		Bucket:        aws.String(bs.bucket),
+		Key:           aws.String(absKey),
+		PartNumber:    aws.Int32(partNum),
+		UploadId:      uploadID,
+		Body:          bytes.NewReader(data),
+		ContentLength: aws.Int64(int64(len(data))),
+	})
+	if err != nil {
+		return s3types.CompletedPart{}, err
+	}
+	return s3types.CompletedPart{ETag: res.ETag, PartNumber: aws.Int32(partNum)}, nil
+}
+
+func (bs *S3Blobstore) uploadPartCopy(ctx context.Context, absKey string, uploadID *string, partNum int32, src string, start, end int64) (s3types.CompletedPart, error) {
+	res, err := bs.client.UploadPartCopy(ctx, &s3.UploadPartCopyInput{
+		Bucket:          aws.String(bs.bucket),
+		Key:             aws.String(absKey),
+		CopySource:      aws.String(url.PathEscape(bs.bucket + "/" + bs.absKey(src))),
+		CopySourceRange: aws.String(fmt.Sprintf("bytes=%d-%d", start, end-1)),
+		PartNumber:      aws.Int32(partNum),
+		UploadId:        uploadID,
+	})
+	if err != nil {
+		return s3types.CompletedPart{}, err
+	}
+	return s3types.CompletedPart{ETag: res.CopyPartResult.ETag, PartNumber: aws.Int32(partNum)}, nil
+}
+
+func (bs *S3Blobstore) absKey(key string) string {
+	return path.Join(bs.prefix, key)
+}
+
+func isS3NotFoundErr(err error) bool {
+	var nsk *s3types.NoSuchKey
+	var nf *s3types.NotFound
+	return errors.As(err, &nsk) || errors.As(err, &nf)
+}
+
+// isS3PreconditionErr returns true if a conditional write failed because the object changed, or because of a
+// concurrent conditional write of the same object.
+func isS3PreconditionErr(err error) bool {
+	var respErr interface{ HTTPStatusCode() int }
+	if !errors.As(err, &respErr) {
+		return false
+	}
+	code := respErr.HTTPStatusCode()
+	return code == http.StatusPreconditionFailed || code == http.StatusConflict
+}
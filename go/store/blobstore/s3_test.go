@@ -0,0 +1,271 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeS3 is an in memory implementation of S3API which supports ranged reads, conditional writes and multipart
+// uploads.
+type fakeS3 struct {
+	mu      sync.Mutex
+	data    map[string][]byte
+	uploads map[string]map[int32][]byte
+	copies  int
+}
+
+var _ S3API = &fakeS3{}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{data: make(map[string][]byte), uploads: make(map[string]map[int32][]byte)}
+}
+
+type fakeS3StatusErr int
+
+func (e fakeS3StatusErr) Error() string {
+	return fmt.Sprintf("http response error StatusCode: %d", int(e))
+}
+
+func (e fakeS3StatusErr) HTTPStatusCode() int {
+	return int(e)
+}
+
+func fakeETag(data []byte) *string {
+	sum := md5.Sum(data)
+	return aws.String(`"` + hex.EncodeToString(sum[:]) + `"`)
+}
+
+func (f *fakeS3) GetObject(_ context.Context, input *s3.GetObjectInput, _ ...func(*s3.Options)) (*s3.GetObjectOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	data, ok := f.data[aws.ToString(input.Key)]
+	if !ok {
+		return nil, &s3types.NoSuchKey{}
+	}
+	etag := fakeETag(data)
+	if input.IfMatch != nil && aws.ToString(input.IfMatch) != aws.ToString(etag) {
+		return nil, fakeS3StatusErr(http.StatusPreconditionFailed)
+	}
+
+	if input.Range != nil {
+		rng := strings.TrimPrefix(aws.ToString(input.Range), "bytes=")
+		start, end, _ := strings.Cut(rng, "-")
+		size := int64(len(data))
+		if start == "" {
+			n, _ := strconv.ParseInt(end, 10, 64)
+			data = data[max(0, size-n):]
+		} else {
+			s, _ := strconv.ParseInt(start, 10, 64)
+			e := size - 1
+			if end != "" {
+				e, _ = strconv.ParseInt(end, 10, 64)
+			}
+			data = data[min(s, size):min(e+1, size)]
+		}
+	}
+	return &s3.GetObjectOutput{Body: io.NopCloser(bytes.NewReader(data)), ETag: etag}, nil
+}
+
+func (f *fakeS3) PutObject(_ context.Context, input *s3.PutObjectInput, _ ...func(*s3.Options)) (*s3.PutObjectOutput, error) {
+	data, err := io.ReadAll(input.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	key := aws.ToString(input.Key)
+	existing, ok := f.data[key]
+	if input.IfNoneMatch != nil && ok {
+		return nil, fakeS3StatusErr(http.StatusPreconditionFailed)
+	}
+	if input.IfMatch != nil {
+		if !ok {
+			return nil, &s3types.NoSuchKey{}
+		} else if aws.ToString(input.IfMatch) != aws.ToString(fakeETag(existing)) {
+			return nil, fakeS3StatusErr(http.StatusPreconditionFailed)
+		}
+	}
+	f.data[key] = data
+	return &s3.PutObjectOutput{ETag: fakeETag(data)}, nil
+}
+
+func (f *fakeS3) HeadObject(_ context.Context, input *s3.HeadObjectInput, _ ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	data, ok := f.data[aws.ToString(input.Key)]
+	if !ok {
+		return nil, &s3types.NotFound{}
+	}
+	return &s3.HeadObjectOutput{ContentLength: aws.Int64(int64(len(data))), ETag: fakeETag(data)}, nil
+}
+
+func (f *fakeS3) CreateMultipartUpload(_ context.Context, input *s3.CreateMultipartUploadInput, _ ...func(*s3.Options)) (*s3.CreateMultipartUploadOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	id := strconv.Itoa(len(f.uploads))
+	f.uploads[id] = make(map[int32][]byte)
+	return &s3.CreateMultipartUploadOutput{Bucket: input.Bucket, Key: input.Key, UploadId: aws.String(id)}, nil
+}
+
+func (f *fakeS3) UploadPart(_ context.Context, input *s3.UploadPartInput, _ ...func(*s3.Options)) (*s3.UploadPartOutput, error) {
+	data, err := io.ReadAll(input.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	parts, ok := f.uploads[aws.ToString(input.UploadId)]
+	if !ok {
+		return nil, &s3types.NoSuchUpload{}
+	}
+	parts[aws.ToInt32(input.PartNumber)] = data
+	return &s3.UploadPartOutput{ETag: fakeETag(data)}, nil
+}
+
+func (f *fakeS3) UploadPartCopy(_ context.Context, input *s3.UploadPartCopyInput, _ ...func(*s3.Options)) (*s3.UploadPartCopyOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	parts, ok := f.uploads[aws.ToString(input.UploadId)]
+	if !ok {
+		return nil, &s3types.NoSuchUpload{}
+	}
+	src, err := url.PathUnescape(aws.ToString(input.CopySource))
+	if err != nil {
+		return nil, err
+	}
+	_, src, _ = strings.Cut(src, "/")
+	data, ok := f.data[src]
+	if !ok {
+		return nil, &s3types.NoSuchKey{}
+	}
+	var start, end int64
+	_, err = fmt.Sscanf(aws.ToString(input.CopySourceRange), "bytes=%d-%d", &start, &end)
+	if err != nil {
+		return nil, err
+	}
+	data = data[start : end+1]
+	parts[aws.ToInt32(input.PartNumber)] = data
+	f.copies++
+	return &s3.UploadPartCopyOutput{CopyPartResult: &s3types.CopyPartResult{ETag: fakeETag(data)}}, nil
+}
+
+func (f *fakeS3) CompleteMultipartUpload(_ context.Context, input *s3.CompleteMultipartUploadInput, _ ...func(*s3.Options)) (*s3.CompleteMultipartUploadOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	parts, ok := f.uploads[aws.ToString(input.UploadId)]
+	if !ok {
+		return nil, &s3types.NoSuchUpload{}
+	}
+	var data []byte
+	completed := input.MultipartUpload.Parts
+	for i, p := range completed {
+		part, ok := parts[aws.ToInt32(p.PartNumber)]
+		if !ok || aws.ToString(p.ETag) != aws.ToString(fakeETag(part)) {
+			return nil, &s3types.NoSuchUpload{}
+		} else if i < len(completed)-1 && len(part) < minS3PartSize {
+			return nil, fmt.Errorf("EntityTooSmall: part %d is %d bytes", aws.ToInt32(p.PartNumber), len(part))
+		}
+		data = append(data, part...)
+	}
+	delete(f.uploads, aws.ToString(input.UploadId))
+	f.data[aws.ToString(input.Key)] = data
+	return &s3.CompleteMultipartUploadOutput{ETag: fakeETag(data)}, nil
+}
+
+func (f *fakeS3) AbortMultipartUpload(_ context.Context, input *s3.AbortMultipartUploadInput, _ ...func(*s3.Options)) (*s3.AbortMultipartUploadOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.uploads, aws.ToString(input.UploadId))
+	return &s3.AbortMultipartUploadOutput{}, nil
+}
+
+func TestS3BlobstorePrefix(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeS3()
+	bs := NewS3Blobstore(client, "bucket", "/db/")
+	assert.Equal(t, "bucket/db", bs.Path())
+
+	ver, err := PutBytes(ctx, bs, "manifest", []byte("contents"))
+	require.NoError(t, err)
+	assert.Contains(t, client.data, "db/manifest")
+
+	exists, err := bs.Exists(ctx, "manifest")
+	require.NoError(t, err)
+	assert.True(t, exists)
+	exists, err = bs.Exists(ctx, "missing")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	_, err = CheckAndPutBytes(ctx, bs, "", "manifest", []byte("other"))
+	assert.True(t, IsCheckAndPutError(err))
+	_, err = CheckAndPutBytes(ctx, bs, ver, "manifest", []byte("other"))
+	require.NoError(t, err)
+
+	_, _, err = GetBytes(ctx, bs, "missing", NewBlobRange(-4, 2))
+	assert.True(t, IsNotFoundError(err))
+}
+
+func TestS3BlobstoreMultipart(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeS3()
+	bs := NewS3Blobstore(client, "bucket", "db")
+
+	// sources of at least 5MiB are copied, smaller ones are uploaded together with their neighbours
+	sizes := []int{3 << 20, 6 << 20, 1 << 10, 7 << 20, 2 << 20, 12 << 20, 0, 1 << 20}
+	var keys []string
+	var expected []byte
+	for i, size := range sizes {
+		data := randBytes(size)
+		key := fmt.Sprintf("src%d", i)
+		_, err := bs.Put(ctx, key, int64(size), bytes.NewReader(data))
+		require.NoError(t, err)
+		keys = append(keys, key)
+		expected = append(expected, data...)
+	}
+
+	ver, err := bs.Concatenate(ctx, "dest", keys)
+	require.NoError(t, err)
+	assert.Greater(t, client.copies, 0)
+	assert.Empty(t, client.uploads)
+
+	actual, actualVer, err := GetBytes(ctx, bs, "dest", AllRange)
+	require.NoError(t, err)
+	assert.Equal(t, ver, actualVer)
+	assert.True(t, bytes.Equal(expected, actual))
+
+	_, err = bs.Concatenate(ctx, "missing", []string{"src0", "nope"})
+	assert.True(t, IsNotFoundError(err))
+	assert.Empty(t, client.uploads)
+}
@@ -385,14 +385,19 @@ func (db *database) doSetHead(ctx context.Context, ds Dataset, addr hash.Hash, w
 					return prolly.AddressMap{}, err
 				}
 
-				if _, ok := targetCmt.(types.SerialMessage); ok {
+				if sm, ok := targetCmt.(types.SerialMessage); ok {
+					msg, err := serial.TryGetRootAsWorkingSet(sm, serial.MessagePrefixSz)
+					if err != nil {
+						return prolly.AddressMap{}, err
+					}
+
 					cmtRtHsh, err := GetCommitRootHash(newVal)
 					if err != nil {
 						return prolly.AddressMap{}, err
 					}
 
 					// TODO - construct new meta instance rather than using the default
-					updateWS := workingset_flatbuffer(cmtRtHsh, &cmtRtHsh, nil, nil, nil)
+					updateWS := workingset_flatbuffer(cmtRtHsh, &cmtRtHsh, nil, nil, workingSetSparseTables(msg), nil)
 					ref, err := db.WriteValue(ctx, types.SerialMessage(updateWS))
 					if err != nil {
 						return prolly.AddressMap{}, err
@@ -540,7 +545,7 @@ func (db *database) doFastForward(ctx context.Context, ds Dataset, newHeadAddr h
 						}
 
 						// TODO - construct new meta instance rather than using the default
-						updateWS := workingset_flatbuffer(cmtRtHsh, &cmtRtHsh, nil, nil, nil)
+						updateWS := workingset_flatbuffer(cmtRtHsh, &cmtRtHsh, nil, nil, workingSetSparseTables(msg), nil)
 						ref, err := db.WriteValue(ctx, types.SerialMessage(updateWS))
 						if err != nil {
 							return prolly.AddressMap{}, err
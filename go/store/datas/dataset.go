@@ -154,11 +154,12 @@ func validateDatasetIdComponent(refname string) (int, error) {
 }
 
 type WorkingSetHead struct {
-	Meta        *WorkingSetMeta
-	WorkingAddr hash.Hash
-	StagedAddr  *hash.Hash
-	MergeState  *MergeState
-	RebaseState *RebaseState
+	Meta         *WorkingSetMeta
+	WorkingAddr  hash.Hash
+	StagedAddr   *hash.Hash
+	MergeState   *MergeState
+	RebaseState  *RebaseState
+	SparseTables []string
 }
 
 type RebaseState struct {
@@ -169,6 +170,7 @@ type RebaseState struct {
 	emptyCommitHandling        uint8
 	lastAttemptedStep          float32
 	rebasingStarted            bool
+	stopReason                 uint8
 }
 
 func (rs *RebaseState) PreRebaseWorkingAddr() hash.Hash {
@@ -198,6 +200,10 @@ func (rs *RebaseState) RebasingStarted(_ context.Context) bool {
 	return rs.rebasingStarted
 }
 
+func (rs *RebaseState) StopReason(_ context.Context) uint8 {
+	return rs.stopReason
+}
+
 func (rs *RebaseState) CommitBecomesEmptyHandling(_ context.Context) uint8 {
 	return rs.commitBecomesEmptyHandling
 }
@@ -458,9 +464,12 @@ func (h serialWorkingSetHead) HeadWorkingSet() (*WorkingSetHead, error) {
 			rebaseState.EmptyCommitHandling(),
 			rebaseState.LastAttemptedStep(),
 			rebaseState.RebasingStarted(),
+			rebaseState.StopReason(),
 		)
 	}
 
+	ret.SparseTables = workingSetSparseTables(h.msg)
+
 	return &ret, nil
 }
 
@@ -16,6 +16,7 @@ package datas
 
 import (
 	"context"
+	"errors"
 
 	flatbuffers "github.com/dolthub/flatbuffers/v23/go"
 
@@ -87,6 +88,8 @@ type WorkingSetSpec struct {
 	StagedRoot  types.Ref
 	MergeState  *MergeState
 	RebaseState *RebaseState
+	// SparseTables holds the table name patterns of a sparse checkout, if any
+	SparseTables []string
 }
 
 // newWorkingSet creates a new working set object.
@@ -115,7 +118,7 @@ func newWorkingSet(ctx context.Context, db *database, workingSetSpec WorkingSetS
 
 	if db.Format().UsesFlatbuffers() {
 		stagedAddr := stagedRef.TargetHash()
-		data := workingset_flatbuffer(workingRef.TargetHash(), &stagedAddr, mergeState, rebaseState, meta)
+		data := workingset_flatbuffer(workingRef.TargetHash(), &stagedAddr, mergeState, rebaseState, workingSetSpec.SparseTables, meta)
 
 		r, err := db.WriteValue(ctx, types.SerialMessage(data))
 		if err != nil {
@@ -130,6 +133,10 @@ func newWorkingSet(ctx context.Context, db *database, workingSetSpec WorkingSetS
 		return ref.TargetHash(), ref, nil
 	}
 
+	if len(workingSetSpec.SparseTables) > 0 {
+		return hash.Hash{}, types.Ref{}, errors.New("sparse checkouts are not supported by the __LD_1__ storage format")
+	}
+
 	metaSt, err := meta.toNomsStruct(workingRef.Format())
 	if err != nil {
 		return hash.Hash{}, types.Ref{}, err
@@ -163,10 +170,10 @@ func newWorkingSet(ctx context.Context, db *database, workingSetSpec WorkingSetS
 }
 
 // workingset_flatbuffer creates a flatbuffer message for working set metadata.
-func workingset_flatbuffer(working hash.Hash, staged *hash.Hash, mergeState *MergeState, rebaseState *RebaseState, meta *WorkingSetMeta) serial.Message {
+func workingset_flatbuffer(working hash.Hash, staged *hash.Hash, mergeState *MergeState, rebaseState *RebaseState, sparseTables []string, meta *WorkingSetMeta) serial.Message {
 	builder := flatbuffers.NewBuilder(1024)
 	workingoff := builder.CreateByteVector(working[:])
-	var stagedOff, mergeStateOff, rebaseStateOffset flatbuffers.UOffsetT
+	var stagedOff, mergeStateOff, rebaseStateOffset, sparseTablesOff flatbuffers.UOffsetT
 	if staged != nil {
 		stagedOff = builder.CreateByteVector((*staged)[:])
 	}
@@ -196,9 +203,14 @@ func workingset_flatbuffer(working hash.Hash, staged *hash.Hash, mergeState *Mer
 		serial.RebaseStateAddEmptyCommitHandling(builder, rebaseState.emptyCommitHandling)
 		serial.RebaseStateAddLastAttemptedStep(builder, rebaseState.lastAttemptedStep)
 		serial.RebaseStateAddRebasingStarted(builder, rebaseState.rebasingStarted)
+		serial.RebaseStateAddStopReason(builder, rebaseState.stopReason)
 		rebaseStateOffset = serial.RebaseStateEnd(builder)
 	}
 
+	if len(sparseTables) > 0 {
+		sparseTablesOff = SerializeStringVector(builder, sparseTables)
+	}
+
 	var nameOff, emailOff, descOff flatbuffers.UOffsetT
 	if meta != nil {
 		nameOff = builder.CreateString(meta.Name)
@@ -217,6 +229,9 @@ func workingset_flatbuffer(working hash.Hash, staged *hash.Hash, mergeState *Mer
 	if rebaseStateOffset != 0 {
 		serial.WorkingSetAddRebaseState(builder, rebaseStateOffset)
 	}
+	if sparseTablesOff != 0 {
+		serial.WorkingSetAddSparseTables(builder, sparseTablesOff)
+	}
 
 	if meta != nil {
 		serial.WorkingSetAddName(builder, nameOff)
@@ -227,6 +242,18 @@ func workingset_flatbuffer(working hash.Hash, staged *hash.Hash, mergeState *Mer
 	return serial.FinishMessage(builder, serial.WorkingSetEnd(builder), []byte(serial.WorkingSetFileID))
 }
 
+// workingSetSparseTables returns the sparse checkout table patterns stored in |msg|.
+func workingSetSparseTables(msg *serial.WorkingSet) []string {
+	if msg.SparseTablesLength() == 0 {
+		return nil
+	}
+	sparseTables := make([]string, msg.SparseTablesLength())
+	for i := range sparseTables {
+		sparseTables[i] = string(msg.SparseTables(i))
+	}
+	return sparseTables
+}
+
 func NewMergeState(
 	ctx context.Context,
 	vrw types.ValueReadWriter,
@@ -264,7 +291,7 @@ func NewMergeState(
 	}
 }
 
-func NewRebaseState(preRebaseWorkingRoot hash.Hash, commitAddr hash.Hash, branch string, commitBecomesEmptyHandling uint8, emptyCommitHandling uint8, lastAttemptedStep float32, rebasingStarted bool) *RebaseState {
+func NewRebaseState(preRebaseWorkingRoot hash.Hash, commitAddr hash.Hash, branch string, commitBecomesEmptyHandling uint8, emptyCommitHandling uint8, lastAttemptedStep float32, rebasingStarted bool, stopReason uint8) *RebaseState {
 	return &RebaseState{
 		preRebaseWorkingAddr:       &preRebaseWorkingRoot,
 		ontoCommitAddr:             &commitAddr,
@@ -273,6 +300,7 @@ func NewRebaseState(preRebaseWorkingRoot hash.Hash, commitAddr hash.Hash, branch
 		emptyCommitHandling:        emptyCommitHandling,
 		lastAttemptedStep:          lastAttemptedStep,
 		rebasingStarted:            rebasingStarted,
+		stopReason:                 stopReason,
 	}
 }
 
@@ -44,6 +44,7 @@ func UnArchive(ctx context.Context, cs chunks.ChunkStore, smd StorageMetadata, p
 	if gs, ok := cs.(*GenerationalNBS); ok {
 		outPath, _ := gs.oldGen.Path()
 		oldgen := gs.oldGen.tables.upstream
+		enc := fileEncryptionOf(gs.oldGen)
 
 		swapMap := make(map[hash.Hash]hash.Hash)
 
@@ -84,7 +85,14 @@ func UnArchive(ctx context.Context, cs chunks.ChunkStore, smd StorageMetadata, p
 					if err != nil {
 						return err
 					}
-					err = classicTable.FlushToFile(filepath.Join(outPath, id))
+					if enc != nil {
+						err = writeLocalFile(filepath.Join(outPath, id), enc, classicTable.Flush)
+						if err == nil {
+							err = classicTable.Remove()
+						}
+					} else {
+						err = classicTable.FlushToFile(filepath.Join(outPath, id))
+					}
 					if err != nil {
 						return err
 					}
@@ -121,6 +129,7 @@ func BuildArchive(ctx context.Context, cs chunks.ChunkStore, dagGroups *ChunkRel
 	if gs, ok := cs.(*GenerationalNBS); ok {
 		outPath, _ := gs.oldGen.Path()
 		oldgen := gs.oldGen.tables.upstream
+		enc := fileEncryptionOf(gs.oldGen)
 
 		swapMap := make(map[hash.Hash]hash.Hash)
 
@@ -138,7 +147,7 @@ func BuildArchive(ctx context.Context, cs chunks.ChunkStore, dagGroups *ChunkRel
 
 			archivePath := ""
 			archiveName := hash.Hash{}
-			archivePath, archiveName, err = convertTableFileToArchive(ctx, ogcs, idx, dagGroups, outPath, progress, &stats)
+			archivePath, archiveName, err = convertTableFileToArchive(ctx, ogcs, idx, dagGroups, outPath, enc, progress, &stats)
 			if err != nil {
 				return err
 			}
@@ -150,7 +159,7 @@ func BuildArchive(ctx context.Context, cs chunks.ChunkStore, dagGroups *ChunkRel
 			}
 			archiveSize := fileInfo.Size()
 
-			err = verifyAllChunks(ctx, idx, archivePath, progress, &stats)
+			err = verifyAllChunks(ctx, idx, archivePath, enc, progress, &stats)
 			if err != nil {
 				return err
 			}
@@ -191,6 +200,7 @@ func convertTableFileToArchive(
 	idx tableIndex,
 	dagGroups *ChunkRelations,
 	archivePath string,
+	enc *fileEncryption,
 	progress chan interface{},
 	stats *Stats,
 ) (string, hash.Hash, error) {
@@ -237,6 +247,7 @@ func convertTableFileToArchive(
 	if err != nil {
 		return "", hash.Hash{}, err
 	}
+	arcW.enc = enc
 	var defaultDictByteSpanId uint32
 	defaultDictByteSpanId, err = arcW.writeByteSpan(cmpBuff)
 	if err != nil {
@@ -267,6 +278,18 @@ func convertTableFileToArchive(
 	return arcW.finalPath, name, err
 }
 
+// fileEncryptionOf returns the fileEncryption of the local store |nbs|, or nil if it is not encrypted.
+func fileEncryptionOf(nbs *NomsBlockStore) *fileEncryption {
+	switch p := nbs.p.(type) {
+	case *fsTablePersister:
+		return p.enc
+	case *ChunkJournal:
+		return p.persister.enc
+	default:
+		return nil
+	}
+}
+
 func indexFinalize(arcW *archiveWriter, originTableFile hash.Hash) error {
 	err := arcW.finalizeByteSpans()
 	if err != nil {
@@ -449,8 +472,8 @@ func gatherAllChunks(ctx context.Context, cs chunkSource, idx tableIndex, stats
 	return chkCache, defaultSamples, nil
 }
 
-func verifyAllChunks(ctx context.Context, idx tableIndex, archiveFile string, progress chan interface{}, stats *Stats) error {
-	fra, err := newFileReaderAt(archiveFile)
+func verifyAllChunks(ctx context.Context, idx tableIndex, archiveFile string, enc *fileEncryption, progress chan interface{}, stats *Stats) error {
+	fra, err := newFileReaderAt(archiveFile, enc)
 	if err != nil {
 		return err
 	}
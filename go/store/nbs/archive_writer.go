@@ -70,6 +70,8 @@ type archiveWriter struct {
 	workflowStage    stage
 	finalPath        string
 	chunkDataLength  uint64
+	// enc encrypts the archive when it's flushed to disk, if it is not nil
+	enc *fileEncryption
 }
 
 /*
@@ -443,6 +445,21 @@ func (aw *archiveWriter) flushToFile(fullPath string) error {
 	}
 
 	aw.finalPath = fullPath
+	if aw.enc != nil {
+		// the archive is built in an unencrypted temp file, which is removed once it's encrypted into place
+		err := writeLocalFile(fullPath, aw.enc, aw.output.Flush)
+		if err != nil {
+			return err
+		}
+		if bs, ok := aw.md5Summer.backingSink.(*BufferedFileByteSink); ok {
+			err = os.Remove(bs.path)
+			if err != nil {
+				return err
+			}
+		}
+		aw.workflowStage = stageDone
+		return nil
+	}
 	err := aw.output.FlushToFile(fullPath)
 	if err != nil {
 		return err
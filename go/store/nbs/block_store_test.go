@@ -64,6 +64,27 @@ func TestBlobstoreSuite(t *testing.T) {
 	suite.Run(t, &BlockStoreSuite{factory: fn})
 }
 
+func TestDynamoBlobstoreSuite(t *testing.T) {
+	kek := make([]byte, blobstore.EncryptionKeyLen)
+	_, err := rand.Read(kek)
+	require.NoError(t, err)
+	ddbs := make(map[string]*fakeDDB)
+	fn := func(ctx context.Context, dir string) (*NomsBlockStore, error) {
+		// the fake DynamoDB table only accepts manifests of this format
+		nbf := constants.FormatLD1String
+		qp := NewUnlimitedMemQuotaProvider()
+		if _, ok := ddbs[dir]; !ok {
+			ddbs[dir] = makeFakeDDB(t)
+		}
+		bs, err := blobstore.NewEncryptedBlobstore(ctx, blobstore.NewLocalBlobstore(dir), kek)
+		if err != nil {
+			return nil, err
+		}
+		return NewDynamoBSStore(ctx, nbf, table, db, ddbs[dir], bs, testMemTableSize, qp)
+	}
+	suite.Run(t, &BlockStoreSuite{factory: fn})
+}
+
 type BlockStoreSuite struct {
 	suite.Suite
 	dir        string
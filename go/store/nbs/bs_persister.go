@@ -209,7 +209,7 @@ func (bsp *blobstorePersister) CopyTableFile(ctx context.Context, r io.Reader, n
 		if _, err := bsp.bs.Put(ctx, name+tableRecordsExt, off, lr); err != nil {
 			return err
 		}
-		if _, err := bsp.bs.Put(ctx, name+tableTailExt, int64(fileSz), r); err != nil {
+		if _, err := bsp.bs.Put(ctx, name+tableTailExt, int64(fileSz)-off, r); err != nil {
 			return err
 		}
 	} else {
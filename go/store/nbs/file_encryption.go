@@ -0,0 +1,765 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nbs
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/dconfig"
+	"github.com/dolthub/dolt/go/libraries/utils/file"
+	"github.com/dolthub/dolt/go/store/blobstore"
+	"github.com/dolthub/dolt/go/store/hash"
+	"github.com/dolthub/dolt/go/store/util/tempfiles"
+)
+
+// Local stores can be encrypted at rest. The table files, archives and chunk journal of an encrypted store are written
+// in the encrypted file format below, with AES-256-GCM under a key supplied by DOLT_ENCRYPTION_KEY or
+// DOLT_ENCRYPTION_KEY_FILE. A store is encrypted if its directory holds an encryption marker file, which is an
+// encrypted file without any data, and is used to check the key supplied. Manifests and the journal index are not
+// encrypted, as they only hold the names of table files, and the addresses and offsets of chunks.
+//
+// An encrypted file begins with a header:
+//
+//	magic (8 bytes) | file id (16 bytes) | nonce (12 bytes) | tag (16 bytes)
+//
+// The nonce and tag seal an empty plaintext with the file id as additional data, so that a wrong key is detected when
+// the file is opened. The header is followed by frames:
+//
+//	plaintext length (uint32) | nonce (12 bytes) | ciphertext | tag (16 bytes)
+//
+// Each frame is sealed with the file id and the offset of its plaintext as additional data, so frames can't be moved
+// within a file or between files. Frames hold at most 64KiB, so that a range of a file can be read by decrypting only
+// the frames it overlaps. Data is only ever appended to an encrypted file as new frames. When the chunk journal is
+// opened, a frame which fails to authenticate ends it, as a torn write at the end of a plaintext journal would.
+
+const (
+	encryptedFileMagic = "DOLTNBE1"
+	encryptedFileIDLen = 16
+	gcmNonceLen        = 12
+	gcmTagLen          = 16
+
+	encryptedFileHeaderLen  = len(encryptedFileMagic) + encryptedFileIDLen + gcmNonceLen + gcmTagLen
+	encryptedFrameHeaderLen = uint32Size + gcmNonceLen
+	encryptedFrameMaxLen    = 64 * 1024
+
+	// encryptionMarkerFileName is the name of the file which marks a store directory as encrypted.
+	encryptionMarkerFileName = "encryption"
+
+	oldGenDirName = "oldgen"
+)
+
+// statsStoreDir is the directory of the statistics store of a database, .dolt/stats/.dolt/noms, relative to the
+// database's store in .dolt/noms. The statistics store is encrypted along with the database's store.
+var statsStoreDir = filepath.Join("..", "stats", ".dolt", "noms")
+
+// fileEncryption encrypts and decrypts the files of an encrypted local store.
+type fileEncryption struct {
+	aead cipher.AEAD
+}
+
+func newFileEncryption(key []byte) (*fileEncryption, error) {
+	if len(key) != blobstore.EncryptionKeyLen {
+		return nil, fmt.Errorf("encryption keys must be %d bytes", blobstore.EncryptionKeyLen)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &fileEncryption{aead: aead}, nil
+}
+
+// ReadEncryptionKey returns the key of encrypted local stores given by the DOLT_ENCRYPTION_KEY environment variable,
+// which holds a base64 encoded 256-bit key, or by the key file named by DOLT_ENCRYPTION_KEY_FILE. It returns nil if
+// neither is set.
+func ReadEncryptionKey() ([]byte, error) {
+	if encoded := os.Getenv(dconfig.EnvEncryptionKey); encoded != "" {
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+		if err != nil || len(key) != blobstore.EncryptionKeyLen {
+			return nil, fmt.Errorf("%s must hold a base64 encoded %d-bit key", dconfig.EnvEncryptionKey, blobstore.EncryptionKeyLen*8)
+		}
+		return key, nil
+	}
+	if path := os.Getenv(dconfig.EnvEncryptionKeyFile); path != "" {
+		return blobstore.ReadEncryptionKeyFile(path)
+	}
+	return nil, nil
+}
+
+// IsEncryptedStore returns whether the local store in |dir| is encrypted.
+func IsEncryptedStore(dir string) (bool, error) {
+	_, ok, err := findEncryptionMarker(dir)
+	return ok, err
+}
+
+// findEncryptionMarker returns the path of the encryption marker of the store in |dir|, and whether it exists. The
+// oldgen store of a database is encrypted along with its newgen store, in the parent directory, and the statistics
+// store of a database is encrypted along with the database's store.
+func findEncryptionMarker(dir string) (string, bool, error) {
+	path := filepath.Join(dir, encryptionMarkerFileName)
+	candidates := []string{path}
+	if filepath.Base(dir) == oldGenDirName {
+		dir = filepath.Dir(dir)
+		candidates = append(candidates, filepath.Join(dir, encryptionMarkerFileName))
+	}
+	if dbDir, ok := statsStoreDatabaseDir(dir); ok {
+		candidates = append(candidates, filepath.Join(dbDir, encryptionMarkerFileName))
+	}
+	for _, candidate := range candidates {
+		if ok, err := fileExists(candidate); ok || err != nil {
+			return candidate, ok, err
+		}
+	}
+	return path, false, nil
+}
+
+// statsStoreDatabaseDir returns the directory of the database's store if |dir| is the directory of its statistics
+// store, and whether it is.
+func statsStoreDatabaseDir(dir string) (string, bool) {
+	dbDir := filepath.Join(dir, "..", "..", "..", filepath.Base(dir))
+	return dbDir, filepath.Join(dbDir, statsStoreDir) == filepath.Clean(dir)
+}
+
+// loadFileEncryption returns the fileEncryption for the store in |dir|, or nil if the store is not encrypted. It
+// returns an error if the store is encrypted and no key, or the wrong key, is supplied.
+func loadFileEncryption(dir string) (*fileEncryption, error) {
+	marker, ok, err := findEncryptionMarker(dir)
+	if err != nil || !ok {
+		return nil, err
+	}
+	key, err := ReadEncryptionKey()
+	if err != nil {
+		return nil, err
+	} else if key == nil {
+		return nil, errMissingEncryptionKey(dir)
+	}
+	enc, err := newFileEncryption(key)
+	if err != nil {
+		return nil, err
+	}
+	if err = enc.checkMarker(marker); err != nil {
+		return nil, err
+	}
+	return enc, nil
+}
+
+// ErrMissingEncryptionKey is returned when an encrypted store is opened without an encryption key.
+var ErrMissingEncryptionKey = fmt.Errorf("the database is encrypted; set %s or %s to its encryption key", dconfig.EnvEncryptionKey, dconfig.EnvEncryptionKeyFile)
+
+func errMissingEncryptionKey(path string) error {
+	return fmt.Errorf("unable to open %s: %w", path, ErrMissingEncryptionKey)
+}
+
+// writeMarker marks the store in |dir| as encrypted with |enc|.
+func (enc *fileEncryption) writeMarker(dir string) error {
+	hdr, _, err := enc.newHeader()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, encryptionMarkerFileName), hdr, 0644)
+}
+
+// checkMarker returns blobstore.ErrWrongEncryptionKey if the encryption marker at |path| was not written with |enc|.
+func (enc *fileEncryption) checkMarker(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = enc.readHeader(f, filepath.Dir(path))
+	return err
+}
+
+func (enc *fileEncryption) newHeader() (hdr []byte, id []byte, err error) {
+	hdr = make([]byte, len(encryptedFileMagic)+encryptedFileIDLen+gcmNonceLen, encryptedFileHeaderLen)
+	copy(hdr, encryptedFileMagic)
+	if _, err = rand.Read(hdr[len(encryptedFileMagic):]); err != nil {
+		return nil, nil, err
+	}
+	id = hdr[len(encryptedFileMagic) : len(encryptedFileMagic)+encryptedFileIDLen]
+	nonce := hdr[len(encryptedFileMagic)+encryptedFileIDLen:]
+	hdr = enc.aead.Seal(hdr, nonce, nil, id)
+	return hdr, id, nil
+}
+
+// readHeader reads the header of the encrypted file |r|, and returns its file id.
+func (enc *fileEncryption) readHeader(r io.ReaderAt, name string) ([]byte, error) {
+	hdr := make([]byte, encryptedFileHeaderLen)
+	if _, err := r.ReadAt(hdr, 0); errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%s is not encrypted", name)
+	} else if err != nil {
+		return nil, err
+	}
+	if string(hdr[:len(encryptedFileMagic)]) != encryptedFileMagic {
+		return nil, fmt.Errorf("%s is not encrypted", name)
+	}
+	id := hdr[len(encryptedFileMagic) : len(encryptedFileMagic)+encryptedFileIDLen]
+	nonce := hdr[len(encryptedFileMagic)+encryptedFileIDLen : len(encryptedFileMagic)+encryptedFileIDLen+gcmNonceLen]
+	if _, err := enc.aead.Open(nil, nonce, hdr[len(hdr)-gcmTagLen:], id); err != nil {
+		return nil, fmt.Errorf("unable to open %s: %w", name, blobstore.ErrWrongEncryptionKey)
+	}
+	return id, nil
+}
+
+// appendFrame appends a frame sealing |pt|, which is at offset |off| of the plaintext of the file |id|, to |dst|.
+func (enc *fileEncryption) appendFrame(dst, id []byte, off int64, pt []byte) ([]byte, error) {
+	var hdr [encryptedFrameHeaderLen]byte
+	binary.BigEndian.PutUint32(hdr[:], uint32(len(pt)))
+	if _, err := rand.Read(hdr[uint32Size:]); err != nil {
+		return nil, err
+	}
+	dst = append(dst, hdr[:]...)
+	return enc.aead.Seal(dst, hdr[uint32Size:], pt, frameAAD(id, off)), nil
+}
+
+func frameAAD(id []byte, off int64) []byte {
+	aad := make([]byte, len(id)+8)
+	copy(aad, id)
+	binary.BigEndian.PutUint64(aad[len(id):], uint64(off))
+	return aad
+}
+
+func encryptedFrameLen(ptLen uint32) int64 {
+	return int64(encryptedFrameHeaderLen) + int64(ptLen) + gcmTagLen
+}
+
+// isEncryptedFile returns whether |r| begins with the magic of an encrypted file.
+func isEncryptedFile(r io.ReaderAt) (bool, error) {
+	magic := make([]byte, len(encryptedFileMagic))
+	if _, err := r.ReadAt(magic, 0); errors.Is(err, io.EOF) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return string(magic) == encryptedFileMagic, nil
+}
+
+// newWriter returns a writer which writes the data written to it to |w| as an encrypted file. If |enc| is nil, the
+// data is written to |w| unencrypted. The returned writer must be closed to write any buffered data to |w|, but does
+// not close |w|.
+func (enc *fileEncryption) newWriter(w io.Writer) (io.WriteCloser, error) {
+	if enc == nil {
+		return nopWriteCloser{w}, nil
+	}
+	hdr, id, err := enc.newHeader()
+	if err != nil {
+		return nil, err
+	}
+	if _, err = w.Write(hdr); err != nil {
+		return nil, err
+	}
+	return &encryptingWriter{w: w, enc: enc, id: id, buf: make([]byte, 0, encryptedFrameMaxLen)}, nil
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
+
+type encryptingWriter struct {
+	w   io.Writer
+	enc *fileEncryption
+	id  []byte
+	off int64
+	buf []byte
+	out []byte
+}
+
+func (ew *encryptingWriter) Write(p []byte) (int, error) {
+	n := 0
+	for len(p) > 0 {
+		c := copy(ew.buf[len(ew.buf):cap(ew.buf)], p)
+		ew.buf = ew.buf[:len(ew.buf)+c]
+		p, n = p[c:], n+c
+		if len(ew.buf) == cap(ew.buf) {
+			if err := ew.flush(); err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+func (ew *encryptingWriter) flush() (err error) {
+	if len(ew.buf) == 0 {
+		return nil
+	}
+	if ew.out, err = ew.enc.appendFrame(ew.out[:0], ew.id, ew.off, ew.buf); err != nil {
+		return err
+	}
+	if _, err = ew.w.Write(ew.out); err != nil {
+		return err
+	}
+	ew.off += int64(len(ew.buf))
+	ew.buf = ew.buf[:0]
+	return nil
+}
+
+func (ew *encryptingWriter) Close() error {
+	return ew.flush()
+}
+
+// writeLocalFile creates the file at |path| with the data |write| writes to it, encrypting it if |enc| is not nil.
+func writeLocalFile(path string, enc *fileEncryption, write func(io.Writer) error) (err error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+	}()
+	w, err := enc.newWriter(f)
+	if err != nil {
+		return err
+	}
+	if err = write(w); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+// localFile is a table file, archive or chunk journal opened for reading. An encrypted file is decrypted as it is read.
+type localFile interface {
+	io.ReaderAt
+	io.Closer
+}
+
+// openLocalFile opens the file at |path| for reading, and returns it along with the size of its data. If the file is
+// encrypted, it is decrypted with |enc|.
+func openLocalFile(path string, enc *fileEncryption) (localFile, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, 0, err
+	}
+	encrypted, err := isEncryptedFile(f)
+	if err != nil {
+		f.Close()
+		return nil, 0, err
+	}
+	if encrypted {
+		ef, err := openEncryptedFile(f, enc, false)
+		if err != nil {
+			f.Close()
+			return nil, 0, err
+		}
+		return ef, ef.Size(), nil
+	}
+
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, 0, err
+	}
+	return f, fi.Size(), nil
+}
+
+// reopenLocalFile opens a new reader of the file |f| was opened from, with a lifecycle independent from |f|.
+func reopenLocalFile(f localFile) (localFile, error) {
+	switch f := f.(type) {
+	case *encryptedFile:
+		return f.reopen()
+	case *os.File:
+		return os.Open(f.Name())
+	default:
+		return nil, fmt.Errorf("unexpected local file type %T", f)
+	}
+}
+
+// encryptedFile reads and appends to an encrypted file.
+type encryptedFile struct {
+	f   *os.File
+	enc *fileEncryption
+	id  []byte
+
+	mu     sync.Mutex
+	frames []encryptedFrame
+	// size is the size of the file's plaintext
+	size int64
+	// end is the offset in |f| of the end of its last frame
+	end int64
+	// truncated is true once anything in |f| after |end| has been truncated
+	truncated bool
+
+	cached   int
+	cachedPt []byte
+	ct       []byte
+}
+
+type encryptedFrame struct {
+	// off is the offset of the frame's plaintext in the file's plaintext
+	off int64
+	// pos is the offset of the frame in the file
+	pos    int64
+	length uint32
+}
+
+var _ localFile = &encryptedFile{}
+
+// createEncryptedFile writes the header of an encrypted file to the empty file |f|.
+func createEncryptedFile(f *os.File, enc *fileEncryption) (*encryptedFile, error) {
+	hdr, id, err := enc.newHeader()
+	if err != nil {
+		return nil, err
+	}
+	if _, err = f.WriteAt(hdr, 0); err != nil {
+		return nil, err
+	}
+	if err = f.Sync(); err != nil {
+		return nil, err
+	}
+	return &encryptedFile{f: f, enc: enc, id: id, end: int64(len(hdr)), truncated: true, cached: -1}, nil
+}
+
+// openEncryptedFile opens the encrypted file |f|. If |tornTail| is true, the file ends at the first frame which is
+// incomplete or fails to authenticate. Otherwise, the frames of |f| are authenticated as they are read.
+func openEncryptedFile(f *os.File, enc *fileEncryption, tornTail bool) (*encryptedFile, error) {
+	if enc == nil {
+		return nil, errMissingEncryptionKey(f.Name())
+	}
+	id, err := enc.readHeader(f, f.Name())
+	if err != nil {
+		return nil, err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	ef := &encryptedFile{f: f, enc: enc, id: id, end: int64(encryptedFileHeaderLen), cached: -1}
+
+	var hdr [encryptedFrameHeaderLen]byte
+	for ef.end < fi.Size() {
+		valid := fi.Size()-ef.end >= encryptedFrameLen(0)
+		if valid {
+			if _, err = f.ReadAt(hdr[:], ef.end); err != nil {
+				return nil, err
+			}
+			l := binary.BigEndian.Uint32(hdr[:])
+			valid = l > 0 && l <= encryptedFrameMaxLen && ef.end+encryptedFrameLen(l) <= fi.Size()
+			if valid {
+				ef.frames = append(ef.frames, encryptedFrame{off: ef.size, pos: ef.end, length: l})
+			}
+		}
+		if valid && tornTail {
+			_, err = ef.frame(len(ef.frames) - 1)
+			if valid = err == nil; !valid {
+				ef.frames = ef.frames[:len(ef.frames)-1]
+			}
+		}
+
+		if !valid && tornTail {
+			break
+		} else if !valid {
+			return nil, fmt.Errorf("encrypted file %s is corrupt at offset %d", f.Name(), ef.end)
+		}
+		fr := ef.frames[len(ef.frames)-1]
+		ef.size += int64(fr.length)
+		ef.end += encryptedFrameLen(fr.length)
+	}
+	return ef, nil
+}
+
+// frame returns the plaintext of the frame |i|. The plaintext is only valid until the next call to frame.
+func (ef *encryptedFile) frame(i int) ([]byte, error) {
+	if ef.cached == i {
+		return ef.cachedPt, nil
+	}
+	fr := ef.frames[i]
+	sz := encryptedFrameLen(fr.length)
+	if int64(cap(ef.ct)) < sz {
+		ef.ct = make([]byte, sz)
+	}
+	ct := ef.ct[:sz]
+	if _, err := ef.f.ReadAt(ct, fr.pos); err != nil {
+		return nil, err
+	}
+	ef.cached = -1
+	pt, err := ef.enc.aead.Open(ef.cachedPt[:0], ct[uint32Size:encryptedFrameHeaderLen], ct[encryptedFrameHeaderLen:], frameAAD(ef.id, fr.off))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt %s at offset %d: %w", ef.f.Name(), fr.off, err)
+	}
+	ef.cached, ef.cachedPt = i, pt
+	return pt, nil
+}
+
+// findFrame returns the index of the frame holding the plaintext at |off|.
+func (ef *encryptedFile) findFrame(off int64) int {
+	return sort.Search(len(ef.frames), func(i int) bool {
+		return ef.frames[i].off+int64(ef.frames[i].length) > off
+	})
+}
+
+// Size returns the size of the file's plaintext.
+func (ef *encryptedFile) Size() int64 {
+	ef.mu.Lock()
+	defer ef.mu.Unlock()
+	return ef.size
+}
+
+func (ef *encryptedFile) ReadAt(p []byte, off int64) (n int, err error) {
+	ef.mu.Lock()
+	defer ef.mu.Unlock()
+	if off < 0 {
+		return 0, fmt.Errorf("negative offset %d", off)
+	}
+	for i := ef.findFrame(off); n < len(p) && i < len(ef.frames); i++ {
+		pt, err := ef.frame(i)
+		if err != nil {
+			return n, err
+		}
+		n += copy(p[n:], pt[off+int64(n)-ef.frames[i].off:])
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// WriteAt writes |p| at offset |off| of the file's plaintext. Any of the file after |off| is truncated, so |p| is
+// always the end of the file.
+func (ef *encryptedFile) WriteAt(p []byte, off int64) (int, error) {
+	ef.mu.Lock()
+	defer ef.mu.Unlock()
+	if off > ef.size {
+		return 0, fmt.Errorf("cannot write at offset %d of %s, past its end at %d", off, ef.f.Name(), ef.size)
+	}
+
+	data := p
+	if off < ef.size {
+		// rewrite the frame holding |off| with the plaintext before |off| followed by |p|
+		i := ef.findFrame(off)
+		fr := ef.frames[i]
+		if off > fr.off {
+			pt, err := ef.frame(i)
+			if err != nil {
+				return 0, err
+			}
+			data = append(append([]byte(nil), pt[:off-fr.off]...), p...)
+		}
+		// copied so that clones of this file are unaffected
+		ef.frames = append([]encryptedFrame(nil), ef.frames[:i]...)
+		ef.size, ef.end, ef.truncated, ef.cached = fr.off, fr.pos, false, -1
+	}
+	if !ef.truncated {
+		if err := ef.f.Truncate(ef.end); err != nil {
+			return 0, err
+		}
+		ef.truncated = true
+	}
+
+	var buf []byte
+	var frames []encryptedFrame
+	pos, sz := ef.end, ef.size
+	for len(data) > 0 {
+		l := min(len(data), encryptedFrameMaxLen)
+		var err error
+		if buf, err = ef.enc.appendFrame(buf, ef.id, sz, data[:l]); err != nil {
+			return 0, err
+		}
+		frames = append(frames, encryptedFrame{off: sz, pos: pos, length: uint32(l)})
+		pos, sz, data = pos+encryptedFrameLen(uint32(l)), sz+int64(l), data[l:]
+	}
+	if _, err := ef.f.WriteAt(buf, ef.end); err != nil {
+		return 0, err
+	}
+	ef.frames = append(ef.frames, frames...)
+	ef.size, ef.end = sz, pos
+	return len(p), nil
+}
+
+func (ef *encryptedFile) Sync() error {
+	return ef.f.Sync()
+}
+
+func (ef *encryptedFile) Close() error {
+	return ef.f.Close()
+}
+
+// reopen opens a new reader of the data currently in the file, with a lifecycle independent from |ef|.
+func (ef *encryptedFile) reopen() (*encryptedFile, error) {
+	f, err := os.Open(ef.f.Name())
+	if err != nil {
+		return nil, err
+	}
+	ef.mu.Lock()
+	defer ef.mu.Unlock()
+	return &encryptedFile{
+		f:         f,
+		enc:       ef.enc,
+		id:        ef.id,
+		frames:    ef.frames[:len(ef.frames):len(ef.frames)],
+		size:      ef.size,
+		end:       ef.end,
+		truncated: true,
+		cached:    -1,
+	}, nil
+}
+
+// EncryptLocalStore encrypts the local store in |dir|, its oldgen store and its statistics store, with |key|. The
+// stores' table files, archives and chunk journals are rewritten encrypted, and the files written to them afterward are
+// encrypted. The stores must not be open. If a previous call was interrupted, calling EncryptLocalStore again completes it.
+func EncryptLocalStore(ctx context.Context, dir string, key []byte) error {
+	enc, err := newFileEncryption(key)
+	if err != nil {
+		return err
+	}
+	marker, ok, err := findEncryptionMarker(dir)
+	if err != nil {
+		return err
+	} else if ok {
+		if err = enc.checkMarker(marker); err != nil {
+			return err
+		}
+	} else if err = enc.writeMarker(dir); err != nil {
+		return err
+	}
+	return convertLocalStore(ctx, dir, enc, enc)
+}
+
+// DecryptLocalStore decrypts the local store in |dir|, its oldgen store and its statistics store, which are encrypted
+// with |key|. The store's table files, archives and chunk journal are rewritten unencrypted, and the files written to
+// it afterward are not encrypted. The store must not be open.
+func DecryptLocalStore(ctx context.Context, dir string, key []byte) error {
+	enc, err := newFileEncryption(key)
+	if err != nil {
+		return err
+	}
+	marker, ok, err := findEncryptionMarker(dir)
+	if err != nil {
+		return err
+	} else if ok {
+		if err = enc.checkMarker(marker); err != nil {
+			return err
+		}
+	}
+	if err = convertLocalStore(ctx, dir, enc, nil); err != nil {
+		return err
+	}
+	// the marker is removed last, so that an interrupted call leaves the store encrypted
+	if ok {
+		return os.Remove(marker)
+	}
+	return nil
+}
+
+// convertLocalStore rewrites the files of the store in |dir|, its oldgen store and its statistics store which are not
+// encrypted with |to|. Encrypted files are decrypted with |from|.
+func convertLocalStore(ctx context.Context, dir string, from, to *fileEncryption) error {
+	statsDir := filepath.Join(dir, statsStoreDir)
+	for _, d := range []string{dir, filepath.Join(dir, oldGenDirName), statsDir, filepath.Join(statsDir, oldGenDirName)} {
+		entries, err := os.ReadDir(d)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			name := strings.TrimSuffix(e.Name(), ArchiveFileSuffix)
+			if _, ok := hash.MaybeParse(name); !ok || e.IsDir() {
+				continue // not a table file, archive or chunk journal
+			}
+			if err = convertLocalFile(ctx, filepath.Join(d, e.Name()), from, to); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func convertLocalFile(ctx context.Context, path string, from, to *fileEncryption) (err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	encrypted, err := isEncryptedFile(f)
+	if err != nil {
+		return err
+	} else if encrypted == (to != nil) {
+		return nil
+	}
+
+	isJournal := filepath.Base(path) == chunkJournalAddr
+	var src io.ReaderAt = f
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	sz := fi.Size()
+	if encrypted {
+		ef, err := openEncryptedFile(f, from, isJournal)
+		if err != nil {
+			return err
+		}
+		src, sz = ef, ef.Size()
+	}
+	if isJournal {
+		// only the journal's valid records are copied, and not any zero fill or torn record following them
+		sz, err = processJournalRecords(ctx, io.NewSectionReader(src, 0, sz), 0, func(int64, journalRec) error {
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	temp, err := tempfiles.MovableTempFileProvider.NewFile(filepath.Dir(path), tempTablePrefix)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			temp.Close()
+			os.Remove(temp.Name())
+		}
+	}()
+	w, err := to.newWriter(temp)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(w, io.NewSectionReader(src, 0, sz)); err != nil {
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+	if err = temp.Sync(); err != nil {
+		return err
+	}
+	if err = temp.Close(); err != nil {
+		return err
+	}
+	return file.Rename(temp.Name(), path)
+}
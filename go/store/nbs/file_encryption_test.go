@@ -0,0 +1,359 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nbs
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/dconfig"
+	"github.com/dolthub/dolt/go/store/blobstore"
+	"github.com/dolthub/dolt/go/store/chunks"
+	"github.com/dolthub/dolt/go/store/constants"
+	"github.com/dolthub/dolt/go/store/hash"
+	"github.com/dolthub/dolt/go/store/types"
+)
+
+// setTestEncryptionKey sets a random key as the key of encrypted local stores for the duration of the test.
+func setTestEncryptionKey(t *testing.T) []byte {
+	key := make([]byte, blobstore.EncryptionKeyLen)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	t.Setenv(dconfig.EnvEncryptionKey, base64.StdEncoding.EncodeToString(key))
+	return key
+}
+
+func mustFileEncryption(t *testing.T, key []byte) *fileEncryption {
+	enc, err := newFileEncryption(key)
+	require.NoError(t, err)
+	return enc
+}
+
+func TestEncryptedLocalStoreSuite(t *testing.T) {
+	key := setTestEncryptionKey(t)
+	fn := func(ctx context.Context, dir string) (*NomsBlockStore, error) {
+		if err := mustFileEncryption(t, key).writeMarker(dir); err != nil {
+			return nil, err
+		}
+		return NewLocalStore(ctx, constants.FormatDefaultString, dir, testMemTableSize, NewUnlimitedMemQuotaProvider())
+	}
+	suite.Run(t, &BlockStoreSuite{factory: fn})
+}
+
+func TestEncryptedChunkJournalBlockStoreSuite(t *testing.T) {
+	cacheOnce.Do(makeGlobalCaches)
+	key := setTestEncryptionKey(t)
+	fn := func(ctx context.Context, dir string) (*NomsBlockStore, error) {
+		if err := mustFileEncryption(t, key).writeMarker(dir); err != nil {
+			return nil, err
+		}
+		return NewLocalJournalingStore(ctx, types.Format_Default.VersionString(), dir, NewUnlimitedMemQuotaProvider())
+	}
+	suite.Run(t, &BlockStoreSuite{
+		factory:        fn,
+		skipInterloper: true,
+	})
+}
+
+func TestEncryptedFileReadAt(t *testing.T) {
+	enc := mustFileEncryption(t, setTestEncryptionKey(t))
+	path := filepath.Join(t.TempDir(), "table")
+	data := make([]byte, 3*encryptedFrameMaxLen+100)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+	require.NoError(t, writeLocalFile(path, enc, func(w io.Writer) error {
+		_, err := w.Write(data)
+		return err
+	}))
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.False(t, bytes.Contains(raw, data[:64]))
+
+	f, sz, err := openLocalFile(path, enc)
+	require.NoError(t, err)
+	defer f.Close()
+	assert.Equal(t, int64(len(data)), sz)
+
+	buf := make([]byte, encryptedFrameMaxLen+20)
+	n, err := f.ReadAt(buf, encryptedFrameMaxLen-10)
+	require.NoError(t, err)
+	assert.Equal(t, data[encryptedFrameMaxLen-10:2*encryptedFrameMaxLen+10], buf[:n])
+
+	n, err = f.ReadAt(buf, sz-10)
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, data[sz-10:], buf[:n])
+
+	clone, err := reopenLocalFile(f)
+	require.NoError(t, err)
+	defer clone.Close()
+	n, err = clone.ReadAt(buf[:100], 0)
+	require.NoError(t, err)
+	assert.Equal(t, data[:100], buf[:n])
+
+	// a wrong or missing key is detected when the file is opened
+	_, _, err = openLocalFile(path, mustFileEncryption(t, make([]byte, blobstore.EncryptionKeyLen)))
+	assert.ErrorIs(t, err, blobstore.ErrWrongEncryptionKey)
+	_, _, err = openLocalFile(path, nil)
+	assert.ErrorContains(t, err, "is encrypted")
+
+	// unencrypted files are read as they are
+	plain := filepath.Join(t.TempDir(), "plain")
+	require.NoError(t, os.WriteFile(plain, data, 0644))
+	f, sz, err = openLocalFile(plain, enc)
+	require.NoError(t, err)
+	defer f.Close()
+	assert.Equal(t, int64(len(data)), sz)
+
+	// tampering is detected when the tampered frame is read
+	raw[len(raw)-1] ^= 1
+	require.NoError(t, os.WriteFile(path, raw, 0644))
+	f, _, err = openLocalFile(path, enc)
+	require.NoError(t, err)
+	defer f.Close()
+	_, err = f.ReadAt(buf[:10], 0)
+	assert.NoError(t, err)
+	_, err = f.ReadAt(buf[:10], sz-10)
+	assert.ErrorContains(t, err, "unable to decrypt")
+}
+
+func TestEncryptedFileWriteAt(t *testing.T) {
+	enc := mustFileEncryption(t, setTestEncryptionKey(t))
+	path := filepath.Join(t.TempDir(), "journal")
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0666)
+	require.NoError(t, err)
+	ef, err := createEncryptedFile(f, enc)
+	require.NoError(t, err)
+
+	var expected []byte
+	for i := 0; i < 10; i++ {
+		b := bytes.Repeat([]byte{byte(i)}, i*encryptedFrameMaxLen/3+1)
+		_, err = ef.WriteAt(b, int64(len(expected)))
+		require.NoError(t, err)
+		expected = append(expected, b...)
+	}
+	_, err = ef.WriteAt([]byte("past the end"), int64(len(expected))+1)
+	assert.Error(t, err)
+
+	// writing before the end truncates the file
+	expected = append(expected[:len(expected)-100], "the end"...)
+	_, err = ef.WriteAt([]byte("the end"), int64(len(expected)-7))
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(expected)), ef.Size())
+	actual := make([]byte, len(expected))
+	_, err = ef.ReadAt(actual, 0)
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+	end := ef.end
+	require.NoError(t, ef.Close())
+
+	// a torn write at the end of the file is ignored when it is reopened, and overwritten by the next write
+	f, err = os.OpenFile(path, os.O_RDWR, 0666)
+	require.NoError(t, err)
+	_, err = f.WriteAt(bytes.Repeat([]byte{0xff}, 100), end)
+	require.NoError(t, err)
+	ef, err = openEncryptedFile(f, enc, true)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(expected)), ef.Size())
+	_, err = ef.WriteAt([]byte("more"), ef.Size())
+	require.NoError(t, err)
+	expected = append(expected, "more"...)
+	require.NoError(t, ef.Close())
+
+	f, err = os.Open(path)
+	require.NoError(t, err)
+	ef, err = openEncryptedFile(f, enc, false)
+	require.NoError(t, err)
+	defer ef.Close()
+	actual = make([]byte, len(expected))
+	_, err = ef.ReadAt(actual, 0)
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestEncryptDecryptLocalStore(t *testing.T) {
+	cacheOnce.Do(makeGlobalCaches)
+	ctx := context.Background()
+	dir := t.TempDir()
+	oldgen := filepath.Join(dir, oldGenDirName)
+	require.NoError(t, os.Mkdir(oldgen, 0755))
+	nbf := types.Format_Default.VersionString()
+
+	// the newgen store has a chunk journal, and the oldgen store has table files
+	openStores := func() (*NomsBlockStore, *NomsBlockStore, error) {
+		newGen, err := NewLocalJournalingStore(ctx, nbf, dir, NewUnlimitedMemQuotaProvider())
+		if err != nil {
+			return nil, nil, err
+		}
+		oldGen, err := NewLocalStore(ctx, nbf, oldgen, testMemTableSize, NewUnlimitedMemQuotaProvider())
+		if err != nil {
+			newGen.Close()
+			return nil, nil, err
+		}
+		return newGen, oldGen, nil
+	}
+	var written []chunks.Chunk
+	putChunks := func(stores ...*NomsBlockStore) {
+		for _, store := range stores {
+			var c chunks.Chunk
+			for i := 0; i < 5; i++ {
+				c = chunks.NewChunk([]byte(fmt.Sprintf("sensitive data %d", len(written))))
+				require.NoError(t, store.Put(ctx, c, noopGetAddrs))
+				written = append(written, c)
+			}
+			root, err := store.Root(ctx)
+			require.NoError(t, err)
+			ok, err := store.Commit(ctx, c.Hash(), root)
+			require.NoError(t, err)
+			require.True(t, ok)
+		}
+	}
+	checkChunks := func(stores ...*NomsBlockStore) {
+		for _, c := range written {
+			found := false
+			for _, store := range stores {
+				actual, err := store.Get(ctx, c.Hash())
+				require.NoError(t, err)
+				if !actual.IsEmpty() {
+					assert.Equal(t, c.Data(), actual.Data())
+					found = true
+				}
+			}
+			assert.True(t, found)
+		}
+	}
+
+	newGen, oldGen, err := openStores()
+	require.NoError(t, err)
+	putChunks(newGen, oldGen)
+	require.NoError(t, newGen.Close())
+	require.NoError(t, oldGen.Close())
+
+	key := setTestEncryptionKey(t)
+	require.NoError(t, EncryptLocalStore(ctx, dir, key))
+	ok, err := IsEncryptedStore(oldgen)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assertStoreFiles(t, dir, true)
+	assertStoreFiles(t, oldgen, true)
+
+	newGen, oldGen, err = openStores()
+	require.NoError(t, err)
+	checkChunks(newGen, oldGen)
+	putChunks(newGen, oldGen)
+	require.NoError(t, newGen.Close())
+	require.NoError(t, oldGen.Close())
+	assertStoreFiles(t, dir, true)
+	assertStoreFiles(t, oldgen, true)
+
+	// the stores can't be opened without the key
+	t.Setenv(dconfig.EnvEncryptionKey, "")
+	_, _, err = openStores()
+	assert.ErrorContains(t, err, "is encrypted")
+	assert.ErrorIs(t, DecryptLocalStore(ctx, dir, make([]byte, blobstore.EncryptionKeyLen)), blobstore.ErrWrongEncryptionKey)
+
+	require.NoError(t, DecryptLocalStore(ctx, dir, key))
+	ok, err = IsEncryptedStore(dir)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assertStoreFiles(t, dir, false)
+	assertStoreFiles(t, oldgen, false)
+
+	newGen, oldGen, err = openStores()
+	require.NoError(t, err)
+	defer newGen.Close()
+	defer oldGen.Close()
+	checkChunks(newGen, oldGen)
+}
+
+func TestEncryptLocalStoreStatistics(t *testing.T) {
+	cacheOnce.Do(makeGlobalCaches)
+	ctx := context.Background()
+	root := t.TempDir()
+	dir := filepath.Join(root, ".dolt", "noms")
+	statsDir := filepath.Join(root, ".dolt", "stats", ".dolt", "noms")
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	require.NoError(t, os.MkdirAll(statsDir, 0755))
+	nbf := types.Format_Default.VersionString()
+
+	c := chunks.NewChunk([]byte("sensitive data in statistics"))
+	writeStats := func() {
+		store, err := NewLocalJournalingStore(ctx, nbf, statsDir, NewUnlimitedMemQuotaProvider())
+		require.NoError(t, err)
+		require.NoError(t, store.Put(ctx, c, noopGetAddrs))
+		root, err := store.Root(ctx)
+		require.NoError(t, err)
+		ok, err := store.Commit(ctx, c.Hash(), root)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.NoError(t, store.Close())
+	}
+	readStats := func() {
+		store, err := NewLocalJournalingStore(ctx, nbf, statsDir, NewUnlimitedMemQuotaProvider())
+		require.NoError(t, err)
+		defer store.Close()
+		actual, err := store.Get(ctx, c.Hash())
+		require.NoError(t, err)
+		assert.Equal(t, c.Data(), actual.Data())
+	}
+	writeStats()
+
+	key := setTestEncryptionKey(t)
+	require.NoError(t, EncryptLocalStore(ctx, dir, key))
+	ok, err := IsEncryptedStore(statsDir)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assertStoreFiles(t, statsDir, true)
+	readStats()
+
+	// a statistics store created after the database was encrypted is encrypted too
+	require.NoError(t, os.RemoveAll(statsDir))
+	require.NoError(t, os.MkdirAll(statsDir, 0755))
+	writeStats()
+	assertStoreFiles(t, statsDir, true)
+
+	require.NoError(t, DecryptLocalStore(ctx, dir, key))
+	assertStoreFiles(t, statsDir, false)
+	readStats()
+}
+
+// assertStoreFiles asserts that the table files and chunk journal in |dir| are encrypted, or not.
+func assertStoreFiles(t *testing.T, dir string, encrypted bool) {
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	cnt := 0
+	for _, e := range entries {
+		if _, ok := hash.MaybeParse(e.Name()); !ok {
+			continue
+		}
+		raw, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		require.NoError(t, err)
+		assert.Equal(t, encrypted, bytes.HasPrefix(raw, []byte(encryptedFileMagic)), e.Name())
+		assert.Equal(t, !encrypted, bytes.Contains(raw, []byte("sensitive data")), e.Name())
+		cnt++
+	}
+	assert.Greater(t, cnt, 0)
+}
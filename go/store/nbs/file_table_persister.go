@@ -42,13 +42,16 @@ import (
 
 const tempTablePrefix = "nbs_table_"
 
-func newFSTablePersister(dir string, q MemoryQuotaProvider) tablePersister {
-	return &fsTablePersister{dir, q, sync.Mutex{}, nil, make(map[string]struct{})}
+// newFSTablePersister returns a tablePersister for the table files in |dir|. If |enc| is not nil, the table files it
+// writes are encrypted with it.
+func newFSTablePersister(dir string, q MemoryQuotaProvider, enc *fileEncryption) tablePersister {
+	return &fsTablePersister{dir, q, enc, sync.Mutex{}, nil, make(map[string]struct{})}
 }
 
 type fsTablePersister struct {
 	dir string
 	q   MemoryQuotaProvider
+	enc *fileEncryption
 
 	// Protects the following two maps.
 	removeMu sync.Mutex
@@ -69,7 +72,7 @@ var _ tablePersister = &fsTablePersister{}
 var _ tableFilePersister = &fsTablePersister{}
 
 func (ftp *fsTablePersister) Open(ctx context.Context, name hash.Hash, chunkCount uint32, stats *Stats) (chunkSource, error) {
-	return newFileTableReader(ctx, ftp.dir, name, chunkCount, ftp.q, stats)
+	return newFileTableReader(ctx, ftp.dir, name, chunkCount, ftp.q, ftp.enc, stats)
 }
 
 func (ftp *fsTablePersister) Exists(ctx context.Context, name string, chunkCount uint32, stats *Stats) (bool, error) {
@@ -138,7 +141,15 @@ func (ftp *fsTablePersister) CopyTableFile(ctx context.Context, r io.Reader, fil
 			}
 		}()
 
-		_, err = io.Copy(temp, r)
+		w, err := ftp.enc.newWriter(temp)
+		if err != nil {
+			return "", cleanup, err
+		}
+		_, err = io.Copy(w, r)
+		if err != nil {
+			return "", cleanup, err
+		}
+		err = w.Close()
 		if err != nil {
 			return "", cleanup, err
 		}
@@ -165,6 +176,10 @@ func (ftp *fsTablePersister) CopyTableFile(ctx context.Context, r io.Reader, fil
 }
 
 func (ftp *fsTablePersister) TryMoveCmpChunkTableWriter(ctx context.Context, filename string, w *CmpChunkTableWriter) error {
+	if ftp.enc != nil {
+		// the table file is written unencrypted, so it's copied with CopyTableFile instead
+		return errors.New("cannot move a table file into an encrypted store")
+	}
 	path := filepath.Join(ftp.dir, filename)
 	ftp.removeMu.Lock()
 	if ftp.toKeep != nil {
@@ -203,7 +218,16 @@ func (ftp *fsTablePersister) persistTable(ctx context.Context, name hash.Hash, d
 			}
 		}()
 
-		_, ferr = io.Copy(temp, bytes.NewReader(data))
+		var w io.WriteCloser
+		w, ferr = ftp.enc.newWriter(temp)
+		if ferr != nil {
+			return "", cleanup, ferr
+		}
+		_, ferr = io.Copy(w, bytes.NewReader(data))
+		if ferr != nil {
+			return "", cleanup, ferr
+		}
+		ferr = w.Close()
 		if ferr != nil {
 			return "", cleanup, ferr
 		}
@@ -269,6 +293,12 @@ func (ftp *fsTablePersister) ConjoinAll(ctx context.Context, sources chunkSource
 			}
 		}()
 
+		var w io.WriteCloser
+		w, ferr = ftp.enc.newWriter(temp)
+		if ferr != nil {
+			return "", cleanup, ferr
+		}
+
 		for _, sws := range plan.sources.sws {
 			var r io.ReadCloser
 			r, _, ferr = sws.source.reader(ctx)
@@ -276,7 +306,7 @@ func (ftp *fsTablePersister) ConjoinAll(ctx context.Context, sources chunkSource
 				return "", cleanup, ferr
 			}
 
-			n, ferr := io.CopyN(temp, r, int64(sws.dataLen))
+			n, ferr := io.CopyN(w, r, int64(sws.dataLen))
 			if ferr != nil {
 				r.Close()
 				return "", cleanup, ferr
@@ -293,8 +323,13 @@ func (ftp *fsTablePersister) ConjoinAll(ctx context.Context, sources chunkSource
 			}
 		}
 
-		_, ferr = temp.Write(plan.mergedIndex)
+		_, ferr = w.Write(plan.mergedIndex)
+
+		if ferr != nil {
+			return "", cleanup, ferr
+		}
 
+		ferr = w.Close()
 		if ferr != nil {
 			return "", cleanup, ferr
 		}
@@ -76,42 +76,40 @@ func archiveFileExists(ctx context.Context, dir string, name string) (bool, erro
 	return err == nil, err
 }
 
-func newFileTableReader(ctx context.Context, dir string, h hash.Hash, chunkCount uint32, q MemoryQuotaProvider, stats *Stats) (cs chunkSource, err error) {
+func newFileTableReader(ctx context.Context, dir string, h hash.Hash, chunkCount uint32, q MemoryQuotaProvider, enc *fileEncryption, stats *Stats) (cs chunkSource, err error) {
 	// we either have a table file or an archive file
 	tfExists, err := tableFileExists(ctx, dir, h)
 	if err != nil {
 		return nil, err
 	} else if tfExists {
-		return nomsFileTableReader(ctx, filepath.Join(dir, h.String()), h, chunkCount, q)
+		return nomsFileTableReader(ctx, filepath.Join(dir, h.String()), h, chunkCount, q, enc)
 	}
 
 	afExists, err := archiveFileExists(ctx, dir, h.String())
 	if err != nil {
 		return nil, err
 	} else if afExists {
-		return newArchiveChunkSource(ctx, dir, h, chunkCount, q, stats)
+		return newArchiveChunkSource(ctx, dir, h, chunkCount, q, enc, stats)
 	}
 	return nil, fmt.Errorf("error opening table file: %w: %s/%s", ErrTableFileNotFound, dir, h.String())
 }
 
-func newFileReaderAt(path string) (*fileReaderAt, error) {
-	f, err := os.Open(path)
+// newFileReaderAt opens the table file or archive at |path|, decrypting it with |enc| if it is encrypted.
+func newFileReaderAt(path string, enc *fileEncryption) (*fileReaderAt, error) {
+	f, sz, err := openLocalFile(path, enc)
 	if err != nil {
 		return nil, err
 	}
-	fi, err := f.Stat()
-	if err != nil {
-		return nil, err
-	}
-	if fi.Size() < 0 {
+	if sz < 0 {
 		// Size returns the number of bytes for regular files and is system dependent for others (Some of which can be negative).
-		return nil, fmt.Errorf("%s has invalid size: %d", path, fi.Size())
+		f.Close()
+		return nil, fmt.Errorf("%s has invalid size: %d", path, sz)
 	}
-	return &fileReaderAt{f, path, fi.Size()}, nil
+	return &fileReaderAt{f, path, sz}, nil
 }
 
-func nomsFileTableReader(ctx context.Context, path string, h hash.Hash, chunkCount uint32, q MemoryQuotaProvider) (cs chunkSource, err error) {
-	fra, err := newFileReaderAt(path)
+func nomsFileTableReader(ctx context.Context, path string, h hash.Hash, chunkCount uint32, q MemoryQuotaProvider, enc *fileEncryption) (cs chunkSource, err error) {
+	fra, err := newFileReaderAt(path, enc)
 	if err != nil {
 		return nil, err
 	}
@@ -184,13 +182,13 @@ func (ftr *fileTableReader) clone() (chunkSource, error) {
 }
 
 type fileReaderAt struct {
-	f    *os.File
+	f    localFile
 	path string
 	sz   int64
 }
 
 func (fra *fileReaderAt) clone() (tableReaderAt, error) {
-	f, err := os.Open(fra.path)
+	f, err := reopenLocalFile(fra.f)
 	if err != nil {
 		return nil, err
 	}
@@ -206,7 +204,16 @@ func (fra *fileReaderAt) Close() error {
 }
 
 func (fra *fileReaderAt) Reader(ctx context.Context) (io.ReadCloser, error) {
-	return os.Open(fra.path)
+	f, err := reopenLocalFile(fra.f)
+	if err != nil {
+		return nil, err
+	}
+	return localFileReader{io.NewSectionReader(f, 0, fra.sz), f}, nil
+}
+
+type localFileReader struct {
+	*io.SectionReader
+	io.Closer
 }
 
 func (fra *fileReaderAt) ReadAtWithStats(ctx context.Context, p []byte, off int64, stats *Stats) (n int, err error) {
@@ -218,11 +225,12 @@ func (fra *fileReaderAt) ReadAtWithStats(ctx context.Context, p []byte, off int6
 	return fra.f.ReadAt(p, off)
 }
 
-func newTableFileMetadata(path string, chunkCount uint32) (*TableFileMetadata, error) {
-	fra, err := newFileReaderAt(path)
+func newTableFileMetadata(path string, chunkCount uint32, enc *fileEncryption) (*TableFileMetadata, error) {
+	fra, err := newFileReaderAt(path, enc)
 	if err != nil {
 		return nil, err
 	}
+	defer fra.Close()
 
 	idxSz := int64(indexSize(chunkCount) + footerSize)
 	indexOffset := fra.sz - idxSz
@@ -105,7 +105,12 @@ func (g GhostBlockStore) getManyCompressed(ctx context.Context, hashes hash.Hash
 
 func (g *GhostBlockStore) PersistGhostHashes(ctx context.Context, hashes hash.HashSet) error {
 	if hashes.Size() == 0 {
-		return fmt.Errorf("runtime error. PersistGhostHashes called with empty hash set")
+		// No ghosts remain, so there's nothing to record. This happens when the last ghost of a partial clone is fetched.
+		if err := os.Remove(g.ghostObjectsFile); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		g.skippedRefs = &hash.HashSet{}
+		return nil
 	}
 
 	f, err := os.OpenFile(g.ghostObjectsFile, os.O_TRUNC|os.O_CREATE|os.O_WRONLY, 0644)
@@ -128,6 +133,11 @@ func (g *GhostBlockStore) PersistGhostHashes(ctx context.Context, hashes hash.Ha
 	return nil
 }
 
+// GhostHashes returns a copy of the set of hashes which are currently persisted as ghosts.
+func (g GhostBlockStore) GhostHashes() hash.HashSet {
+	return g.skippedRefs.Copy()
+}
+
 func (g GhostBlockStore) Has(ctx context.Context, h hash.Hash) (bool, error) {
 	if g.skippedRefs.Has(h) {
 		return true, nil
@@ -83,4 +83,16 @@ func TestGhostBlockStore(t *testing.T) {
 		require.True(t, got[0].IsGhost())
 		require.Equal(t, ghost, got[0].Hash())
 	})
+	t.Run("PersistEmpty", func(t *testing.T) {
+		require.NoError(t, bs.PersistGhostHashes(ctx, hash.NewHashSet()))
+		h, err := bs.Has(ctx, ghost)
+		require.NoError(t, err)
+		require.False(t, h)
+
+		reopened, err := NewGhostBlockStore(path)
+		require.NoError(t, err)
+		h, err = reopened.Has(ctx, ghost)
+		require.NoError(t, err)
+		require.False(t, h)
+	})
 }
@@ -143,7 +143,7 @@ func (j *ChunkJournal) bootstrapJournalWriter(ctx context.Context) (err error) {
 	}
 
 	if !ok { // create new journal file
-		j.wr, err = createJournalWriter(ctx, j.path)
+		j.wr, err = createJournalWriter(ctx, j.path, j.persister.enc)
 		if err != nil {
 			return err
 		}
@@ -168,7 +168,7 @@ func (j *ChunkJournal) bootstrapJournalWriter(ctx context.Context) (err error) {
 		return
 	}
 
-	j.wr, ok, err = openJournalWriter(ctx, j.path)
+	j.wr, ok, err = openJournalWriter(ctx, j.path, j.persister.enc)
 	if err != nil {
 		return err
 	} else if !ok {
@@ -245,6 +245,28 @@ func (j *ChunkJournal) IterateRoots(f func(root string, timestamp *time.Time) er
 	})
 }
 
+// RootAsOf reads every root hash record in the journal file and returns the last root written at or before |asOf|,
+// along with the time it was written. Unlike IterateRoots, which only sees the most recent roots kept in memory for
+// the reflog, this covers the whole journal. Root records written by older versions of Dolt have no timestamp and are
+// skipped. If no root was written at or before |asOf|, |ok| is false.
+func (j *ChunkJournal) RootAsOf(ctx context.Context, asOf time.Time) (root hash.Hash, timestamp time.Time, ok bool, err error) {
+	if j.wr == nil {
+		// no journal file has been written yet
+		return hash.Hash{}, time.Time{}, false, nil
+	}
+	err = j.wr.processRootRecords(ctx, func(rec journalRec) error {
+		if rec.timestamp.IsZero() || rec.timestamp.After(asOf) {
+			return nil
+		}
+		root, timestamp, ok = rec.address, rec.timestamp, true
+		return nil
+	})
+	if err != nil {
+		return hash.Hash{}, time.Time{}, false, err
+	}
+	return root, timestamp, ok, nil
+}
+
 // Persist implements tablePersister.
 func (j *ChunkJournal) Persist(ctx context.Context, mt *memTable, haver chunkReader, keeper keeperF, stats *Stats) (chunkSource, gcBehavior, error) {
 	if j.backing.readOnly() {
@@ -19,6 +19,7 @@ import (
 	"math/rand"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -26,6 +27,7 @@ import (
 
 	"github.com/dolthub/dolt/go/libraries/utils/file"
 	"github.com/dolthub/dolt/go/store/chunks"
+	"github.com/dolthub/dolt/go/store/hash"
 	"github.com/dolthub/dolt/go/store/types"
 )
 
@@ -38,7 +40,7 @@ func makeTestChunkJournal(t *testing.T) *ChunkJournal {
 	m, err := newJournalManifest(ctx, dir)
 	require.NoError(t, err)
 	q := NewUnlimitedMemQuotaProvider()
-	p := newFSTablePersister(dir, q)
+	p := newFSTablePersister(dir, q, nil)
 	nbf := types.Format_Default.VersionString()
 	j, err := newChunkJournal(ctx, nbf, dir, m, p.(*fsTablePersister))
 	require.NoError(t, err)
@@ -126,6 +128,55 @@ func TestReadRecordRanges(t *testing.T) {
 	}
 }
 
+func TestChunkJournalRootAsOf(t *testing.T) {
+	ctx := context.Background()
+	j := makeTestChunkJournal(t)
+	_, _, ok, err := j.RootAsOf(ctx, time.Now())
+	require.NoError(t, err)
+	assert.False(t, ok)
+	mt, _ := randomMemTable(16)
+	_, _, err = j.Persist(ctx, mt, emptyChunkSource{}, nil, &Stats{})
+	require.NoError(t, err)
+
+	// write root hash records at known timestamps 100, 200, and 300
+	var now uint64
+	journalRecordTimestampGenerator = func() uint64 {
+		return now
+	}
+	t.Cleanup(func() {
+		journalRecordTimestampGenerator = func() uint64 {
+			return uint64(time.Now().Unix())
+		}
+	})
+	roots := make([]hash.Hash, 3)
+	for i := range roots {
+		now = uint64(i+1) * 100
+		roots[i] = hash.Of(randBuf(32))
+		require.NoError(t, j.wr.commitRootHash(ctx, roots[i]))
+	}
+
+	tests := []struct {
+		asOf int64
+		root hash.Hash
+		ok   bool
+	}{
+		{50, hash.Hash{}, false},
+		{100, roots[0], true},
+		{150, roots[0], true},
+		{299, roots[1], true},
+		{1000, roots[2], true},
+	}
+	for _, test := range tests {
+		root, timestamp, ok, err := j.RootAsOf(ctx, time.Unix(test.asOf, 0))
+		require.NoError(t, err)
+		assert.Equal(t, test.ok, ok)
+		assert.Equal(t, test.root, root)
+		if ok {
+			assert.False(t, timestamp.After(time.Unix(test.asOf, 0)))
+		}
+	}
+}
+
 func randBuf(n int) (b []byte) {
 	b = make([]byte, n)
 	rand.Read(b)
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"hash/crc32"
 	"io"
+	"math"
 	"os"
 	"path/filepath"
 	"runtime/trace"
@@ -83,7 +84,17 @@ func fileExists(path string) (bool, error) {
 	return true, nil
 }
 
-func openJournalWriter(ctx context.Context, path string) (wr *journalWriter, exists bool, err error) {
+// journalFile is the file a journalWriter appends records to and reads them from. It is an *os.File, or an
+// *encryptedFile if the journal is encrypted.
+type journalFile interface {
+	io.ReaderAt
+	io.WriterAt
+	Sync() error
+	Close() error
+}
+
+// openJournalWriter opens the journal file at |path|. If the journal file is encrypted, it is decrypted with |enc|.
+func openJournalWriter(ctx context.Context, path string, enc *fileEncryption) (wr *journalWriter, exists bool, err error) {
 	var f *os.File
 	if path, err = filepath.Abs(path); err != nil {
 		return nil, false, err
@@ -101,14 +112,26 @@ func openJournalWriter(ctx context.Context, path string) (wr *journalWriter, exi
 		return nil, true, err
 	}
 
+	var journal journalFile = f
+	if encrypted, err := isEncryptedFile(f); err != nil {
+		f.Close()
+		return nil, true, err
+	} else if encrypted {
+		if journal, err = openEncryptedFile(f, enc, true); err != nil {
+			f.Close()
+			return nil, true, err
+		}
+	}
+
 	return &journalWriter{
 		buf:     make([]byte, 0, journalWriterBuffSize),
-		journal: f,
+		journal: journal,
 		path:    path,
 	}, true, nil
 }
 
-func createJournalWriter(ctx context.Context, path string) (wr *journalWriter, err error) {
+// createJournalWriter creates a new journal file at |path|. If |enc| is not nil, the journal file is encrypted with it.
+func createJournalWriter(ctx context.Context, path string, enc *fileEncryption) (wr *journalWriter, err error) {
 	var f *os.File
 	if path, err = filepath.Abs(path); err != nil {
 		return nil, err
@@ -127,6 +150,19 @@ func createJournalWriter(ctx context.Context, path string) (wr *journalWriter, e
 	if f, err = os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0666); err != nil {
 		return nil, err
 	}
+	if enc != nil {
+		// an encrypted journal is not zero filled, as zeros would be encrypted as records
+		ef, err := createEncryptedFile(f, enc)
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		return &journalWriter{
+			buf:     make([]byte, 0, journalWriterBuffSize),
+			journal: ef,
+			path:    path,
+		}, nil
+	}
 	const batch = 1024 * 1024
 	b := make([]byte, batch)
 	for i := 0; i < chunkJournalFileSize; i += batch {
@@ -161,7 +197,7 @@ func deleteJournalAndIndexFiles(ctx context.Context, path string) (err error) {
 type journalWriter struct {
 	buf []byte
 
-	journal *os.File
+	journal journalFile
 	// off indicates the last position that has been written to the journal buffer
 	off     int64
 	indexed int64
@@ -304,7 +340,7 @@ func (wr *journalWriter) bootstrapJournal(ctx context.Context, reflogRingBuffer
 	// process the non-indexed portion of the journal starting at |wr.indexed|,
 	// at minimum the non-indexed portion will include a root hash record.
 	// Index lookups are added to the ongoing batch to re-synchronize.
-	wr.off, err = processJournalRecords(ctx, wr.journal, wr.indexed, func(o int64, r journalRec) error {
+	wr.off, err = processJournalRecords(ctx, io.NewSectionReader(wr.journal, 0, math.MaxInt64), wr.indexed, func(o int64, r journalRec) error {
 		switch r.kind {
 		case chunkJournalRecKind:
 			rng := Range{
@@ -607,18 +643,52 @@ func (wr *journalWriter) snapshot(ctx context.Context) (io.ReadCloser, int64, er
 	}
 	// open a new file descriptor with an
 	// independent lifecycle from |wr.file|
-	f, err := os.Open(wr.path)
+	f, err := wr.reopen()
 	if err != nil {
 		return nil, 0, err
 	}
 	return journalWriterSnapshot{
-		io.LimitReader(f, wr.off),
+		io.NewSectionReader(f, 0, wr.off),
 		func() error {
 			return f.Close()
 		},
 	}, wr.off, nil
 }
 
+// processRootRecords calls |cb| on each root hash record of the journal file, in the order they were written.
+func (wr *journalWriter) processRootRecords(ctx context.Context, cb func(rec journalRec) error) error {
+	wr.lock.Lock()
+	if err := wr.flush(ctx); err != nil {
+		wr.lock.Unlock()
+		return err
+	}
+	// open a new file descriptor with an
+	// independent lifecycle from |wr.file|
+	f, err := wr.reopen()
+	sz := wr.off
+	wr.lock.Unlock()
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = processJournalRecords(ctx, io.NewSectionReader(f, 0, sz), 0, func(_ int64, rec journalRec) error {
+		if rec.kind != rootHashJournalRecKind {
+			return nil
+		}
+		return cb(rec)
+	})
+	return err
+}
+
+// reopen opens a new reader of the journal file.
+func (wr *journalWriter) reopen() (localFile, error) {
+	if ef, ok := wr.journal.(*encryptedFile); ok {
+		return ef.reopen()
+	}
+	return os.Open(wr.path)
+}
+
 func (wr *journalWriter) offset() int64 {
 	return wr.off + int64(len(wr.buf))
 }
@@ -165,12 +165,17 @@ func GetStorageMetadata(ctx context.Context, path string, stats *Stats) (Storage
 		return StorageMetadata{}, err
 	}
 
+	enc, err := loadFileEncryption(newGen)
+	if err != nil {
+		return StorageMetadata{}, err
+	}
+
 	var artifacts []StorageArtifact
 
 	// for each table in the manifest, get the table spec
 	for i := 0; i < manifest.NumTableSpecs(); i++ {
 		tableSpecInfo := manifest.GetTableSpecInfo(i)
-		artifact, err := buildArtifact(ctx, tableSpecInfo, newGen, stats)
+		artifact, err := buildArtifact(ctx, tableSpecInfo, newGen, enc, stats)
 		if err != nil {
 			return StorageMetadata{}, err
 		}
@@ -197,7 +202,7 @@ func GetStorageMetadata(ctx context.Context, path string, stats *Stats) (Storage
 	for i := 0; i < manifest.NumTableSpecs(); i++ {
 		tableSpecInfo := manifest.GetTableSpecInfo(i)
 
-		artifact, err := buildArtifact(ctx, tableSpecInfo, oldgen, stats)
+		artifact, err := buildArtifact(ctx, tableSpecInfo, oldgen, enc, stats)
 		if err != nil {
 			return StorageMetadata{}, err
 		}
@@ -207,7 +212,7 @@ func GetStorageMetadata(ctx context.Context, path string, stats *Stats) (Storage
 	return StorageMetadata{path, artifacts}, nil
 }
 
-func buildArtifact(ctx context.Context, info TableSpecInfo, genPath string, stats *Stats) (StorageArtifact, error) {
+func buildArtifact(ctx context.Context, info TableSpecInfo, genPath string, enc *fileEncryption, stats *Stats) (StorageArtifact, error) {
 	tfName := info.GetName()
 
 	archive := false
@@ -228,7 +233,7 @@ func buildArtifact(ctx context.Context, info TableSpecInfo, genPath string, stat
 	}
 
 	if !archive {
-		tblMeta, err := newTableFileMetadata(fullPath, info.GetChunkCount())
+		tblMeta, err := newTableFileMetadata(fullPath, info.GetChunkCount(), enc)
 		if err != nil {
 			return StorageArtifact{}, err
 		}
@@ -240,7 +245,7 @@ func buildArtifact(ctx context.Context, info TableSpecInfo, genPath string, stat
 			tblMetadata: tblMeta,
 		}, nil
 	} else {
-		fra, err := newFileReaderAt(fullPath)
+		fra, err := newFileReaderAt(fullPath, enc)
 		if err != nil {
 			return StorageArtifact{}, err
 		}
@@ -518,6 +518,17 @@ func NewAWSStore(ctx context.Context, nbfVerStr string, table, ns, bucket string
 	return newNomsBlockStore(ctx, nbfVerStr, mm, p, q, inlineConjoiner{defaultMaxTables}, memTableSize)
 }
 
+// NewDynamoBSStore returns an nbs implementation whose table files are stored in |bs|, and whose manifest is stored in
+// the DynamoDB table |table| the same way as NewAWSStore's. This allows the table files of an AWS database to be
+// stored in a Blobstore that changes their contents, such as a blobstore.EncryptedBlobstore.
+func NewDynamoBSStore(ctx context.Context, nbfVerStr string, table, ns string, ddb DynamoDBAPIV2, bs blobstore.Blobstore, memTableSize uint64, q MemoryQuotaProvider) (*NomsBlockStore, error) {
+	cacheOnce.Do(makeGlobalCaches)
+
+	mm := makeManifestManager(newDynamoManifest(table, ns, ddb))
+	p := &blobstorePersister{bs, s3BlockSize, q}
+	return newNomsBlockStore(ctx, nbfVerStr, mm, p, q, inlineConjoiner{defaultMaxTables}, memTableSize)
+}
+
 // NewGCSStore returns an nbs implementation backed by a GCSBlobstore
 func NewGCSStore(ctx context.Context, nbfVerStr string, bucketName, path string, gcs *storage.Client, memTableSize uint64, q MemoryQuotaProvider) (*NomsBlockStore, error) {
 	cacheOnce.Do(makeGlobalCaches)
@@ -578,7 +589,11 @@ func newLocalStore(ctx context.Context, nbfVerStr string, dir string, memTableSi
 	if err != nil {
 		return nil, err
 	}
-	p := newFSTablePersister(dir, q)
+	enc, err := loadFileEncryption(dir)
+	if err != nil {
+		return nil, err
+	}
+	p := newFSTablePersister(dir, q, enc)
 	c := conjoinStrategy(inlineConjoiner{maxTables})
 
 	return newNomsBlockStore(ctx, nbfVerStr, makeManifestManager(m), p, q, c, memTableSize)
@@ -594,7 +609,11 @@ func NewLocalJournalingStore(ctx context.Context, nbfVers, dir string, q MemoryQ
 	if err != nil {
 		return nil, err
 	}
-	p := newFSTablePersister(dir, q)
+	enc, err := loadFileEncryption(dir)
+	if err != nil {
+		return nil, err
+	}
+	p := newFSTablePersister(dir, q, enc)
 
 	journal, err := newChunkJournal(ctx, nbfVers, dir, m, p.(*fsTablePersister))
 	if err != nil {
@@ -68,6 +68,12 @@ func NewRef(v Value, nbf *NomsBinFormat) (Ref, error) {
 	return constructRef(nbf, h, t, mch+1)
 }
 
+// NewGhostRef returns a Ref to the ghost value |g|. Ghost values are not present in the local chunk store, so they
+// can only be referenced in formats whose values are all SerialMessages, which is assumed to be the case.
+func NewGhostRef(g GhostValue, nbf *NomsBinFormat) (Ref, error) {
+	return constructRef(nbf, g.hash, PrimitiveTypeMap[SerialMessageKind], SerialMessageRefHeight+1)
+}
+
 // ToRefOfValue returns a new Ref that points to the same target as |r|, but
 // with the type 'Ref<Value>'.
 func ToRefOfValue(r Ref, nbf *NomsBinFormat) (Ref, error) {
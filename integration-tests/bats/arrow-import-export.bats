@@ -0,0 +1,175 @@
+#!/usr/bin/env bats
+load $BATS_TEST_DIRNAME/helper/common.bash
+
+setup() {
+    setup_common
+
+    dolt sql <<SQL
+CREATE TABLE test (
+  pk BIGINT NOT NULL,
+  i TINYINT,
+  u INT UNSIGNED,
+  f DOUBLE,
+  d DECIMAL(10,3),
+  dt DATETIME(6),
+  ts TIMESTAMP,
+  dd DATE,
+  tm TIME,
+  c VARCHAR(20),
+  j JSON,
+  b BLOB,
+  PRIMARY KEY (pk)
+);
+INSERT INTO test VALUES
+  (1, -1, 4294967295, 1.5, -12.345, '2020-01-02 03:04:05.123456', '2021-01-01 00:00:00', '1900-05-06', '-838:59:59', 'one', '{"a": 1}', 0x0102),
+  (2, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL);
+SQL
+    dolt commit -Am "create table test"
+}
+
+teardown() {
+    assert_feature_version
+    teardown_common
+}
+
+@test "arrow-import-export: round trip types through an arrow file" {
+    run dolt table export test test.arrow
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Successfully exported data." ]] || false
+    [ -f test.arrow ]
+
+    run dolt table import -c --pk pk imported test.arrow
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Import completed successfully." ]] || false
+
+    run dolt schema show imported
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ '`pk` bigint NOT NULL' ]] || false
+    [[ "$output" =~ '`i` tinyint' ]] || false
+    [[ "$output" =~ '`u` int unsigned' ]] || false
+    [[ "$output" =~ '`d` decimal(10,3)' ]] || false
+    [[ "$output" =~ '`dt` datetime(6)' ]] || false
+    [[ "$output" =~ '`ts` timestamp(6)' ]] || false
+    [[ "$output" =~ '`dd` date' ]] || false
+    [[ "$output" =~ '`tm` time(6)' ]] || false
+    [[ "$output" =~ '`c` longtext' ]] || false
+    [[ "$output" =~ '`b` longblob' ]] || false
+    [[ "$output" =~ 'PRIMARY KEY (`pk`)' ]] || false
+
+    run dolt sql -r csv -q "select pk, i, u, f, d, dt, ts, dd, tm, c, j, hex(b) from imported order by pk"
+    [ "$status" -eq 0 ]
+    [ "${lines[1]}" = '1,-1,4294967295,1.5,-12.345,2020-01-02 03:04:05.123456,2021-01-01 00:00:00,1900-05-06,-838:59:59,one,"{""a"":1}",0102' ]
+    [ "${lines[2]}" = '2,,,,,,,,,,,' ]
+}
+
+@test "arrow-import-export: import updates an existing table" {
+    dolt table export test test.arrow
+    dolt sql -q "delete from test where pk = 2; update test set c = 'changed' where pk = 1"
+
+    run dolt table import -u test test.arrow
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Rows Processed: 2, Additions: 1, Modifications: 1, Had No Effect: 0" ]] || false
+
+    run dolt diff --stat
+    [ "$output" = "" ]
+}
+
+@test "arrow-import-export: feather extension and file type" {
+    run dolt table export test test.feather
+    [ "$status" -eq 0 ]
+    [ -f test.feather ]
+
+    cp test.feather test.dat
+    run dolt table import -c --pk pk from_feather test.feather
+    [ "$status" -eq 0 ]
+    run dolt table import -c --pk pk --file-type arrow from_dat test.dat
+    [ "$status" -eq 0 ]
+
+    run dolt sql -r csv -q "select count(*) from from_feather join from_dat using (pk)"
+    [ "$status" -eq 0 ]
+    [ "${lines[1]}" = "2" ]
+}
+
+@test "arrow-import-export: import without a primary key creates a keyless table" {
+    dolt table export test test.arrow
+
+    run dolt table import -c keyless test.arrow
+    [ "$status" -eq 0 ]
+
+    run dolt schema show keyless
+    [ "$status" -eq 0 ]
+    [[ ! "$output" =~ "PRIMARY KEY" ]] || false
+
+    run dolt table import -c --pk missing missing test.arrow
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "provided primary key not found" ]] || false
+}
+
+@test "arrow-import-export: dump to arrow and feather files" {
+    dolt sql -q "create table other (id int primary key, name varchar(10)); insert into other values (1, 'a');"
+    dolt commit -Am "create table other"
+
+    run dolt dump -r arrow
+    [ "$status" -eq 0 ]
+    [ -f doltdump/test.arrow ]
+    [ -f doltdump/other.arrow ]
+
+    run dolt dump -r feather -d feathers
+    [ "$status" -eq 0 ]
+    [ -f feathers/test.feather ]
+    [ -f feathers/other.feather ]
+
+    run dolt table import -r other feathers/other.feather
+    [ "$status" -eq 0 ]
+    run dolt diff --stat
+    [ "$output" = "" ]
+}
+
+@test "arrow-import-export: pyarrow reads exported types" {
+    dolt table export test test.arrow
+
+    echo "import pyarrow.feather as feather
+table = feather.read_table('test.arrow')
+for field in table.schema:
+    print(field.name, field.type)
+print(table.column('d')[0], table.column('c')[0], table.column('j')[0])
+" > read_arrow.py
+    run python3 read_arrow.py
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "pk int64" ]] || false
+    [[ "$output" =~ "u uint32" ]] || false
+    [[ "$output" =~ "d decimal128(10, 3)" ]] || false
+    [[ "$output" =~ "dt timestamp[us]" ]] || false
+    [[ "$output" =~ "ts timestamp[us, tz=UTC]" ]] || false
+    [[ "$output" =~ "dd date32[day]" ]] || false
+    [[ "$output" =~ "tm duration[us]" ]] || false
+    [[ "$output" =~ "j string" ]] || false
+    [[ "$output" =~ "b binary" ]] || false
+    [[ "$output" =~ '-12.345 one {"a":1}' ]] || false
+}
+
+@test "arrow-import-export: import a compressed file written by pyarrow" {
+    echo "import pyarrow as pa
+import pyarrow.feather as feather
+table = pa.table({'id': pa.array([1, 2], pa.int32()), 'name': ['a', None]})
+feather.write_feather(table, 'pyarrow.feather', compression='lz4')
+" > write_arrow.py
+    run python3 write_arrow.py
+    [ "$status" -eq 0 ]
+
+    run dolt table import -c --pk id from_pyarrow pyarrow.feather
+    [ "$status" -eq 0 ]
+
+    run dolt sql -r csv -q "select * from from_pyarrow order by id"
+    [ "$status" -eq 0 ]
+    [ "${lines[1]}" = "1,a" ]
+    [ "${lines[2]}" = "2," ]
+}
+
+@test "arrow-import-export: bad arrow file import errors" {
+    echo "not an arrow file" > bad.arrow
+
+    run dolt table import -c bad bad.arrow
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "unable to read arrow file" ]] || false
+}
@@ -317,3 +317,89 @@ teardown() {
     run dolt backup sync-url file://../bac1
     [ "$status" -ne 0 ]
 }
+
+@test "backup: sync records incremental backups which verify" {
+    cd repo1
+    dolt backup add bac1 file://../bac1
+    dolt backup sync bac1
+    # syncing again with no changes does not record a backup
+    dolt backup sync bac1
+    dolt sql -q "insert into t1 values (1), (2)"
+    dolt commit -am "rows"
+    dolt backup sync bac1
+
+    run dolt backup verify bac1
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 3 ]
+    [[ "${lines[0]}" =~ ^1 ]] || false
+    [[ "${lines[1]}" =~ ^2 ]] || false
+    [[ "$output" =~ "table files added" ]] || false
+    [[ "$output" =~ "backup 'bac1' verified" ]] || false
+}
+
+@test "backup: prune keeps the most recent backups" {
+    cd repo1
+    dolt backup add bac1 file://../bac1
+    dolt backup sync bac1
+    dolt sql -q "insert into t1 values (1)"
+    dolt commit -am "one"
+    dolt backup sync bac1
+    dolt sql -q "insert into t1 values (2)"
+    dolt commit -am "two"
+    dolt backup sync bac1
+
+    run dolt backup prune --keep-last 2 bac1
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "pruned 1 backups from 'bac1'" ]] || false
+
+    run dolt backup verify bac1
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 3 ]
+    [[ "${lines[0]}" =~ ^2 ]] || false
+    [[ ! "${lines[0]}" =~ " 0 table files added" ]] || false
+    [[ "${lines[1]}" =~ ^3 ]] || false
+    [[ "${lines[1]}" =~ " 0 table files added" ]] || false
+
+    run dolt backup prune --keep-last 0 bac1
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "at least one backup must be kept" ]] || false
+
+    run dolt backup prune bac1
+    [ "$status" -eq 1 ]
+
+    cd ..
+    dolt backup restore file://./bac1 repo2
+    cd repo2
+    run dolt sql -q "select count(*) from t1" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "2" ]] || false
+}
+
+@test "backup: prune requires a backup on the local filesystem" {
+    mkdir bac1
+    cd repo1
+    dolt backup add bac1 localbs://../bac1
+    dolt backup sync bac1
+    dolt sql -q "insert into t1 values (1)"
+    dolt commit -am "one"
+    dolt backup sync bac1
+
+    run dolt backup prune --keep-last 1 bac1
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "only supported for backups on the local filesystem" ]] || false
+
+    run dolt backup verify bac1
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 3 ]
+}
+
+@test "backup: verify and prune unknown backup" {
+    cd repo1
+    run dolt backup verify bac1
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "unknown backup: 'bac1'" ]] || false
+
+    run dolt backup prune --keep-last 1 bac1
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "unknown backup: 'bac1'" ]] || false
+}
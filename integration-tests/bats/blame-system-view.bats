@@ -134,13 +134,25 @@ SQL
     [[ ! "$output" =~ "Richard Tracy" ]] || false
 }
 
-@test "blame-system-view: correct error message for table with no primary key" {
+@test "blame-system-view: view works for table with no primary key" {
     dolt sql -q "create table no_pks (a int, b text, c datetime);"
     dolt sql -q "insert into no_pks values (1, 'one', null), (2, 'two', NOW());"
+    dolt commit -Am "add no_pks"
+    dolt sql -q "update no_pks set b = 'TWO' where a = 2;"
+    dolt commit -am "update no_pks"
 
-    run dolt sql -q "select * from dolt_blame_no_pks;"
-    [ "$status" -eq 1 ]
-    [[ "$output" =~ "unable to generate blame view" ]] || false
+    run dolt sql -r csv -q "select row_hash, message from dolt_blame_no_pks order by message;"
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 3 ]
+    [[ "${lines[0]}" = "row_hash,message" ]] || false
+    [[ "${lines[1]}" =~ ^[0-9a-f]{32},add\ no_pks$ ]] || false
+    [[ "${lines[2]}" =~ ^[0-9a-f]{32},update\ no_pks$ ]] || false
+
+    run dolt sql -r csv -q "select message from dolt_blame_no_pks as of 'HEAD~1';"
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 3 ]
+    [[ "${lines[1]}" = "add no_pks" ]] || false
+    [[ "${lines[2]}" = "add no_pks" ]] || false
 }
 
 @test "blame-system-view: view can be described" {
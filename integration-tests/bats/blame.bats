@@ -183,3 +183,56 @@ SQL
     [[ "${lines[9]}" =~ "| sub  | 2   |" ]] || false
     [[ "${lines[10]}" =~ "| zzz  | 4   |" ]] || false
 }
+
+@test "blame: --columns annotates each column of each row" {
+    set_dolt_user "Penny Pincher", "bats-5@email.fake"
+    dolt sql -q "alter table blame_test add column price decimal(10,2)"
+    dolt sql -q "update blame_test set price = 1.50 where pk in (1, 2)"
+    dolt commit -am "add prices"
+    dolt sql -q "update blame_test set price = 2.25 where pk = 2"
+    dolt commit -am "raise price of 2"
+    restore_stashed_dolt_user
+
+    run dolt blame --columns blame_test
+    [ "$status" -eq 0 ]
+    [[ "${lines[1]}" =~ "pk".*"column_name".*"commit".*"commit_date".*"committer".*"email".*"message" ]] || false
+    [[ "$output" =~ "| 1  | name        |".+"| Thomas Foolery, | bats-1@email.fake | create blame_test table       |" ]] || false
+    [[ "$output" =~ "| 1  | price       |".+"| Penny Pincher,  | bats-5@email.fake | add prices                    |" ]] || false
+    [[ "$output" =~ "| 2  | name        |".+"| Harry Wombat,   | bats-3@email.fake | replace richard with harry    |" ]] || false
+    [[ "$output" =~ "| 2  | price       |".+"| Penny Pincher,  | bats-5@email.fake | raise price of 2              |" ]] || false
+    [[ "$output" =~ "| 3  | pk          |".+"| Johnny Moolah,  | bats-4@email.fake | add more people to blame_test |" ]] || false
+
+    run dolt blame --columns HEAD~1 blame_test
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "| 2  | price       |".+"| add prices " ]] || false
+    [[ ! "$output" =~ "raise price of 2" ]] || false
+
+    run dolt blame --columns HEAD~6 blame_test
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "table not found: blame_test" ]] || false
+}
+
+@test "blame: keyless tables are blamed by row hash" {
+    dolt sql -q "create table keyless (a int, b varchar(10))"
+    dolt sql -q "insert into keyless values (1, 'one'), (2, 'two')"
+    dolt commit -Am "add keyless"
+    dolt sql -q "update keyless set b = 'TWO' where a = 2"
+    dolt commit -am "update keyless"
+
+    run dolt blame keyless
+    [ "$status" -eq 0 ]
+    [[ "${lines[1]}" =~ "row_hash".*"commit".*"commit_date".*"committer".*"email".*"message" ]] || false
+    [[ "$output" =~ "| add keyless " ]] || false
+    [[ "$output" =~ "| update keyless " ]] || false
+
+    run dolt blame HEAD~1 keyless
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "| add keyless " ]] || false
+    [[ ! "$output" =~ "update keyless" ]] || false
+
+    run dolt blame --columns keyless
+    [ "$status" -eq 0 ]
+    [[ "${lines[1]}" =~ "row_hash".*"column_name" ]] || false
+    [ "$(echo "$output" | grep -c '| update keyless ')" -eq 2 ]
+    [ "$(echo "$output" | grep -c '| add keyless ')" -eq 2 ]
+}
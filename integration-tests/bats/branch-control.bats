@@ -233,6 +233,36 @@ branch', 'test', '%', 'admin')"
     [[ $output =~ "cannot create a branch" ]] || false   
 }
 
+@test "branch-control: test table control" {
+    setup_test_user
+
+    dolt sql -q "create table owned (pk int primary key, owner varchar(20)); create table other (pk int primary key)"
+    dolt sql -q "insert into dolt_branch_control values ('dolt-repo-$$', 'main', 'test', '%', 'write')"
+    dolt sql -q "insert into dolt_table_control values ('dolt-repo-$$', 'main', 'test', '%', 'owned', 'owner = ''test''', 'write')"
+
+    run dolt sql -r csv -q "select * from dolt_table_control"
+    [ $status -eq 0 ]
+    [ "${lines[0]}" = "database,branch,user,host,table,predicate,permissions" ]
+    [ "${lines[1]}" = "dolt-repo-$$,main,test,%,owned,owner = 'test',write" ]
+
+    start_sql_server
+
+    dolt -u test -p '' sql -q "insert into owned values (1, 'test')"
+
+    run dolt -u test -p '' sql -q "insert into owned values (2, 'other')"
+    [ $status -ne 0 ]
+    [[ $output =~ "does not have permission to modify the row" ]] || false
+
+    run dolt -u test -p '' sql -q "insert into other values (1)"
+    [ $status -ne 0 ]
+    [[ $output =~ "does not have permission to modify the table" ]] || false
+
+    # test has no entries on other branches, so it is unrestricted there
+    dolt sql -q "insert into dolt_branch_control values ('dolt-repo-$$', 'test-branch', 'test', '%', 'write')"
+    dolt sql -q "call dolt_branch('test-branch')"
+    dolt -u test -p '' sql -q "call dolt_checkout('test-branch'); insert into other values (1)"
+}
+
 @test "branch-control: test longest match in branch access control" {
   setup_test_user
   dolt sql -q "create user admin identified by ''"
@@ -297,6 +327,65 @@ SQL
   [[ $output =~ "0 rows affected" ]] || false
 }
 
+@test "branch-control: protected branches" {
+  dolt sql -q "CREATE TABLE t (pk INT PRIMARY KEY)"
+  dolt commit -Am "init"
+  dolt branch feature
+  dolt checkout feature
+  dolt sql -q "INSERT INTO t VALUES (1)"
+  dolt commit -Am "feature"
+  dolt checkout main
+  dolt sql -q "INSERT INTO dolt_branch_protection (\`database\`, branch, fast_forward_only, required_approvals) VALUES ('%', 'main', true, 1)"
+
+  run dolt sql -q "SELECT * FROM dolt_branch_protection" -r=csv
+  [ $status -eq 0 ]
+  [ ${lines[0]} = "database,branch,fast_forward_only,deny_force_push,required_approvals,required_workflow" ]
+  [ ${lines[1]} = "%,main,1,1,1," ]
+
+  run dolt merge feature
+  [ $status -ne 0 ]
+  [[ $output =~ "requires 1 approval(s)" ]] || false
+
+  run dolt merge --no-ff feature -m "merge"
+  [ $status -ne 0 ]
+  [[ $output =~ "only allows fast-forward merges" ]] || false
+
+  run dolt branch -f main feature
+  [ $status -ne 0 ]
+  [[ $output =~ "does not allow its history to be overwritten" ]] || false
+
+  run dolt commit --allow-empty -m "direct commit"
+  [ $status -ne 0 ]
+  [[ $output =~ "only accepts changes through merges" ]] || false
+
+  run dolt branch -m main renamed
+  [ $status -ne 0 ]
+  [[ $output =~ "cannot be deleted or renamed" ]] || false
+
+  mkdir ../remote
+  dolt remote add origin file://../remote
+  dolt push origin main
+  run dolt push --force origin main
+  [ $status -ne 0 ]
+  [[ $output =~ "does not allow its history to be overwritten" ]] || false
+
+  dolt sql -q "INSERT INTO dolt_merge_approvals VALUES (database(), 'main', HASHOF('feature'), 'reviewer')"
+  run dolt merge feature
+  [ $status -eq 0 ]
+  [[ $output =~ "Fast-forward" ]] || false
+
+  run dolt reset --hard HEAD~1
+  [ $status -ne 0 ]
+  [[ $output =~ "does not allow its history to be overwritten" ]] || false
+
+  # The rules are persisted alongside the other branch control tables
+  run dolt sql -q "SELECT user FROM dolt_merge_approvals" -r=csv
+  [ $status -eq 0 ]
+  [ ${lines[1]} = "reviewer" ]
+  dolt sql -q "DELETE FROM dolt_branch_protection"
+  dolt reset --hard HEAD~1
+}
+
 @test "branch-control: Issue #8622 ttask" {
   # https://github.com/dolthub/dolt/issues/8622
   dolt sql <<SQL
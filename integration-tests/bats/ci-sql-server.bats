@@ -0,0 +1,181 @@
+#!/usr/bin/env bats
+load $BATS_TEST_DIRNAME/helper/common.bash
+load $BATS_TEST_DIRNAME/helper/query-server-common.bash
+
+setup() {
+    skiponwindows "tests are flaky on Windows"
+    if [ "$SQL_ENGINE" = "remote-engine" ]; then
+      skip "This test tests remote connections directly, SQL_ENGINE is not needed."
+    fi
+    setup_common
+
+    dolt sql -q "create table t1 (pk int primary key);"
+    dolt sql -q "insert into t1 values (1), (2);"
+    dolt sql --save "count t1" -q "select * from t1;"
+    cat > workflow.yaml <<EOF
+name: workflow_1
+on:
+  push:
+    branches:
+      - main
+  pull_request:
+    branches:
+      - release
+jobs:
+  - name: validate t1
+    steps:
+      - name: t1 has two rows
+        saved_query_name: count t1
+        expected_rows: "== 2"
+EOF
+    dolt ci init
+    dolt ci import ./workflow.yaml
+    dolt add .
+    dolt commit -m "add t1 and saved query"
+    dolt branch release
+}
+
+teardown() {
+    stop_sql_server 1 && sleep 0.5
+    teardown_common
+}
+
+# start_ci_sql_server starts a sql-server which runs dolt_ci workflows when branch heads are updated
+start_ci_sql_server() {
+    PORT=$( definePORT )
+    cat > ci-server.yaml <<EOF
+listener:
+  host: 0.0.0.0
+  port: $PORT
+behavior:
+  run_ci_workflows: true
+EOF
+    start_sql_server_with_args_no_port --config ci-server.yaml
+}
+
+# wait_for_ci_runs polls dolt_ci_runs until it has at least $1 rows
+wait_for_ci_runs() {
+    for i in $(seq 1 50); do
+        run dolt sql -r csv -q "select count(*) from dolt_ci_runs"
+        if [ "$status" -eq 0 ] && [ "${lines[1]}" -ge "$1" ]; then
+            return 0
+        fi
+        sleep 0.2
+    done
+    echo "timed out waiting for $1 dolt_ci_runs rows"
+    return 1
+}
+
+@test "ci-sql-server: commit to a push branch runs the workflow" {
+    start_ci_sql_server
+
+    dolt sql -q "call dolt_commit('--allow-empty', '-m', 'empty commit');"
+    wait_for_ci_runs 1
+
+    run dolt sql -r csv -q "select workflow, branch, status, failing_step from dolt_ci_runs"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "workflow_1,main,passed," ]] || false
+}
+
+@test "ci-sql-server: failing workflow run records the failing step" {
+    start_ci_sql_server
+
+    dolt sql -q "insert into t1 values (3); call dolt_commit('-am', 'add a row');"
+    wait_for_ci_runs 1
+
+    run dolt sql -r csv -q "select status, failing_step from dolt_ci_runs"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "failed,t1 has two rows" ]] || false
+
+    run dolt sql -r csv -q "select output from dolt_ci_runs"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "expected row count == 2, got 3" ]] || false
+}
+
+@test "ci-sql-server: commit to an untriggered branch does not run the workflow" {
+    start_ci_sql_server
+
+    dolt sql -q "call dolt_checkout('-b', 'other'); call dolt_commit('--allow-empty', '-m', 'empty commit');"
+    dolt sql -q "call dolt_commit('--allow-empty', '-m', 'empty commit');"
+    wait_for_ci_runs 1
+
+    run dolt sql -r csv -q "select branch from dolt_ci_runs"
+    [ "$status" -eq 0 ]
+    [[ ! "$output" =~ "other" ]] || false
+}
+
+@test "ci-sql-server: merge into a pull request branch runs the workflow" {
+    start_ci_sql_server
+
+    dolt sql -q "call dolt_checkout('-b', 'feature'); call dolt_commit('--allow-empty', '-m', 'feature commit'); call dolt_checkout('release'); call dolt_commit('--allow-empty', '-m', 'release commit'); call dolt_merge('feature');"
+    wait_for_ci_runs 1
+
+    run dolt sql -r csv -q "select workflow, branch, status from dolt_ci_runs"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "workflow_1,release,passed" ]] || false
+}
+
+@test "ci-sql-server: workflow runs are kept after the server stops" {
+    start_ci_sql_server
+
+    dolt sql -q "call dolt_commit('--allow-empty', '-m', 'empty commit');"
+    wait_for_ci_runs 1
+    stop_sql_server 1
+
+    run dolt sql -r csv -q "select workflow, branch, status from dolt_ci_runs"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "workflow_1,main,passed" ]] || false
+
+    start_ci_sql_server
+    run dolt sql -r csv -q "select count(*) from dolt_ci_runs"
+    [ "$status" -eq 0 ]
+    [ "${lines[1]}" -eq 1 ]
+}
+
+@test "ci-sql-server: workflows are not run unless run_ci_workflows is set" {
+    start_sql_server
+
+    dolt sql -q "call dolt_commit('--allow-empty', '-m', 'empty commit');"
+    sleep 2
+
+    run dolt sql -r csv -q "select count(*) from dolt_ci_runs"
+    [ "$status" -eq 0 ]
+    [ "${lines[1]}" -eq 0 ]
+}
+
+@test "ci-sql-server: workflows may only read their database" {
+    dolt sql -q "insert into dolt_query_catalog values ('escalate', 2, 'escalate', 'create database evil', '');"
+    cat > workflow.yaml <<EOF
+name: workflow_1
+on:
+  push:
+    branches:
+      - main
+jobs:
+  - name: validate t1
+    steps:
+      - name: t1 constraints
+        verify_constraints:
+          tables:
+            - t1
+      - name: escalate
+        saved_query_name: escalate
+EOF
+    dolt ci import ./workflow.yaml
+    dolt add .
+    dolt commit -m "add a workflow which creates a database"
+    start_ci_sql_server
+
+    dolt sql -q "call dolt_commit('--allow-empty', '-m', 'empty commit');"
+    wait_for_ci_runs 1
+
+    run dolt sql -r csv -q "select status, failing_step, output from dolt_ci_runs"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "failed,escalate" ]] || false
+    [[ "$output" =~ "PASS  t1 constraints" ]] || false
+    [[ "$output" =~ "command denied" ]] || false
+
+    run dolt sql -q "show databases"
+    [ "$status" -eq 0 ]
+    [[ ! "$output" =~ "evil" ]] || false
+}
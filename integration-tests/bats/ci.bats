@@ -373,3 +373,284 @@ EOF
     [ "$status" -eq 0 ]
     [[ "$output" =~ "workflow_2" ]] || false
 }
+
+@test "ci: run passes when saved query results match expectations" {
+    skip_remote_engine
+    dolt sql -q "create table t1 (pk int primary key);"
+    dolt sql -q "insert into t1 values (1), (2);"
+    dolt sql --save "count t1" -q "select * from t1;"
+    cat > workflow.yaml <<EOF
+name: workflow_1
+on:
+  push:
+    branches:
+      - master
+jobs:
+  - name: validate t1
+    steps:
+      - name: t1 has two rows
+        saved_query_name: count t1
+        expected_rows: "== 2"
+        expected_columns: "1"
+EOF
+    dolt ci init
+    dolt ci import ./workflow.yaml
+    run dolt ci run workflow_1
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "PASS" ]] || false
+    [[ "$output" =~ "t1 has two rows" ]] || false
+    [[ "$output" =~ "Workflow 'workflow_1' passed" ]] || false
+}
+
+@test "ci: run fails with a non-zero exit code when an expectation is not met" {
+    skip_remote_engine
+    dolt sql -q "create table t1 (pk int primary key);"
+    dolt sql -q "insert into t1 values (1), (2);"
+    dolt sql --save "count t1" -q "select * from t1;"
+    cat > workflow.yaml <<EOF
+name: workflow_1
+on:
+  push:
+    branches:
+      - master
+jobs:
+  - name: validate t1
+    steps:
+      - name: t1 has three rows
+        saved_query_name: count t1
+        expected_rows: ">= 3"
+EOF
+    dolt ci init
+    dolt ci import ./workflow.yaml
+    run dolt ci run workflow_1
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "FAIL" ]] || false
+    [[ "$output" =~ "expected row count >= 3, got 2" ]] || false
+    [[ "$output" =~ "Workflow 'workflow_1' failed" ]] || false
+}
+
+@test "ci: run fails when the saved query does not exist" {
+    skip_remote_engine
+    cat > workflow.yaml <<EOF
+name: workflow_1
+on:
+  push:
+    branches:
+      - master
+jobs:
+  - name: validate t1
+    steps:
+      - name: missing query
+        saved_query_name: does not exist
+EOF
+    dolt ci init
+    dolt ci import ./workflow.yaml
+    run dolt ci run workflow_1
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "saved query not found: does not exist" ]] || false
+}
+
+@test "ci: run can be run against a ref" {
+    skip_remote_engine
+    dolt sql -q "create table t1 (pk int primary key);"
+    dolt sql --save "select t1" -q "select * from t1;"
+    cat > workflow.yaml <<EOF
+name: workflow_1
+on:
+  push:
+    branches:
+      - master
+jobs:
+  - name: validate t1
+    steps:
+      - name: t1 is empty
+        saved_query_name: select t1
+        expected_rows: "== 0"
+EOF
+    dolt ci init
+    dolt ci import ./workflow.yaml
+    dolt add .
+    dolt commit -m "add t1"
+    dolt tag empty_t1
+    dolt sql -q "insert into t1 values (1);"
+
+    run dolt ci run workflow_1
+    [ "$status" -eq 1 ]
+
+    run dolt ci run --ref empty_t1 workflow_1
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Workflow 'workflow_1' passed" ]] || false
+}
+
+@test "ci: run errors on unknown workflow" {
+    skip_remote_engine
+    dolt ci init
+    run dolt ci run workflow_1
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "workflow not found" ]] || false
+}
+
+@test "ci: schema assertion, diff budget and verify constraints steps round trip through export" {
+    skip_remote_engine
+    cat > workflow.yaml <<EOF
+name: workflow_1
+on:
+  push:
+    branches:
+      - master
+jobs:
+  - name: validate
+    steps:
+      - name: child has parent id
+        schema_assertion:
+          table: child
+          column: parent_id
+          column_type: int
+      - name: small change
+        diff_budget:
+          table: parent
+          base: HEAD
+          max_rows_changed: 2
+      - name: no violations
+        verify_constraints:
+          tables:
+            - child
+EOF
+    dolt ci init
+    dolt ci import ./workflow.yaml
+    run dolt ci export workflow_1
+    [ "$status" -eq 0 ]
+    run cat workflow_1.yaml
+    [ "$status" -eq 0 ]
+    [[ ${output} == *"schema_assertion:"* ]] || false
+    [[ ${output} == *"column_type: \"int\""* ]] || false
+    [[ ${output} == *"diff_budget:"* ]] || false
+    [[ ${output} == *"max_rows_changed: \"2\""* ]] || false
+    [[ ${output} == *"verify_constraints:"* ]] || false
+
+    run dolt ci import ./workflow_1.yaml
+    [ "$status" -eq 0 ]
+    [[ ${output} == *"up to date"* ]] || false
+}
+
+@test "ci: run executes schema assertion steps" {
+    skip_remote_engine
+    dolt sql -q "create table t1 (pk int primary key, c1 varchar(20));"
+    cat > workflow.yaml <<EOF
+name: workflow_1
+on:
+  push:
+    branches:
+      - master
+jobs:
+  - name: validate
+    steps:
+      - name: c1 is a varchar
+        schema_assertion:
+          table: t1
+          column: c1
+          column_type: VARCHAR(20)
+EOF
+    dolt ci init
+    dolt ci import ./workflow.yaml
+    run dolt ci run workflow_1
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "PASS  c1 is a varchar" ]] || false
+
+    dolt sql -q "alter table t1 modify column c1 text;"
+    run dolt ci run workflow_1
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "expected column t1.c1 to have type VARCHAR(20), got text" ]] || false
+
+    dolt sql -q "alter table t1 drop column c1;"
+    run dolt ci run workflow_1
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "column not found: t1.c1" ]] || false
+}
+
+@test "ci: run executes diff budget steps" {
+    skip_remote_engine
+    dolt sql -q "create table t1 (pk int primary key);"
+    dolt commit -Am "create t1"
+    cat > workflow.yaml <<EOF
+name: workflow_1
+on:
+  push:
+    branches:
+      - master
+jobs:
+  - name: validate
+    steps:
+      - name: small change
+        diff_budget:
+          table: t1
+          base: HEAD
+          max_rows_changed: 2
+EOF
+    dolt ci init
+    dolt ci import ./workflow.yaml
+    dolt sql -q "insert into t1 values (1), (2);"
+    run dolt ci run workflow_1
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "PASS  small change" ]] || false
+
+    dolt sql -q "insert into t1 values (3);"
+    run dolt ci run workflow_1
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "expected at most 2 rows changed in t1 since HEAD, got 3" ]] || false
+}
+
+@test "ci: run executes verify constraints steps" {
+    skip_remote_engine
+    dolt sql -q "create table parent (id int primary key);"
+    dolt sql -q "create table child (id int primary key, parent_id int, foreign key (parent_id) references parent(id));"
+    cat > workflow.yaml <<EOF
+name: workflow_1
+on:
+  push:
+    branches:
+      - master
+jobs:
+  - name: validate
+    steps:
+      - name: no violations
+        verify_constraints:
+          tables:
+            - child
+EOF
+    dolt ci init
+    dolt ci import ./workflow.yaml
+    run dolt ci run workflow_1
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "PASS  no violations" ]] || false
+
+    dolt sql -q "set foreign_key_checks = 0; insert into child values (1, 42);"
+    run dolt ci run workflow_1
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "constraint violations found" ]] || false
+
+    # verifying constraints does not record the violations
+    run dolt sql -q "select count(*) from dolt_constraint_violations" -r csv
+    [[ "$output" =~ "0" ]] || false
+}
+
+@test "ci: import rejects a step with more than one kind" {
+    skip_remote_engine
+    cat > workflow.yaml <<EOF
+name: workflow_1
+on:
+  push:
+    branches:
+      - master
+jobs:
+  - name: validate
+    steps:
+      - name: confused
+        saved_query_name: sq
+        verify_constraints: {}
+EOF
+    dolt ci init
+    run dolt ci import ./workflow.yaml
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "must define only one of" ]] || false
+}
@@ -231,3 +231,51 @@ teardown() {
     [ $status -eq 0 ]
     [[ $output =~ "main" ]] || false
 }
+
+@test "conflicts-resolve: resolve a schema conflict with a merged schema" {
+    dolt sql -q "create table t (pk int primary key, c0 varchar(20), c1 int)"
+    dolt sql -q "insert into t values (1, '1', 1), (2, 'two', 2)"
+    dolt add .
+    dolt commit -am "init commit"
+    dolt checkout -b other
+    dolt sql -q "alter table t modify column c0 varchar(30)"
+    dolt sql -q "insert into t values (3, '3', 3)"
+    dolt commit -am "other commit"
+    dolt checkout main
+    dolt sql -q "alter table t modify column c0 varchar(10)"
+    dolt commit -am "main commit"
+
+    run dolt merge other
+    [ $status -eq 1 ]
+    [[ $output =~ "Automatic merge failed" ]] || false
+
+    run dolt conflicts resolve --ours --schema "create table t (pk int primary key, c0 int, c1 int)"
+    [ $status -eq 1 ]
+    [[ $output =~ "--schema can't be used with --ours or --theirs" ]] || false
+
+    run dolt conflicts resolve --schema "create table t (pk int primary key, c0 int, c1 int)"
+    [ $status -eq 0 ]
+
+    run dolt sql -q "select * from dolt_schema_conflicts" -r csv
+    [ $status -eq 0 ]
+    [ "${#lines[@]}" -eq 1 ]
+
+    run dolt sql -q "select pk, c0, c1 from t order by pk" -r csv
+    [ $status -eq 0 ]
+    [[ "$output" =~ "1,1,1" ]] || false
+    [[ "$output" =~ "2,,2" ]] || false
+    [[ "$output" =~ "3,3,3" ]] || false
+
+    # 'two' can't be converted to an int, so that row is left as a data conflict
+    run dolt sql -q "select our_pk from dolt_conflicts_t" -r csv
+    [ $status -eq 0 ]
+    [[ "$output" =~ "2" ]] || false
+    [ "${#lines[@]}" -eq 2 ]
+
+    dolt sql -q "set @@dolt_allow_commit_conflicts = 1; update t set c0 = 2 where pk = 2; delete from dolt_conflicts_t"
+    dolt commit -am "merged other"
+
+    run dolt sql -q "select pk, c0, c1 from t order by pk" -r csv
+    [ $status -eq 0 ]
+    [[ "$output" =~ "2,2,2" ]] || false
+}
@@ -0,0 +1,143 @@
+#!/usr/bin/env bats
+load $BATS_TEST_DIRNAME/helper/common.bash
+
+setup() {
+    setup_common
+
+    dolt sql <<SQL
+CREATE TABLE test (
+  pk BIGINT NOT NULL,
+  c1 BIGINT,
+  c2 VARCHAR(20),
+  PRIMARY KEY (pk)
+);
+INSERT INTO test VALUES (0, 0, 'zero'), (1, 1, 'one');
+SQL
+    dolt commit -Am "create table test"
+}
+
+teardown() {
+    assert_feature_version
+    teardown_common
+}
+
+@test "csv-parquet-diff: csv output to stdout" {
+    dolt sql -q "insert into test values (2, 2, 'two, too'); update test set c1 = 10 where pk = 1; delete from test where pk = 0;"
+
+    run dolt diff -r csv
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 4 ]
+    [ "${lines[0]}" = "diff_type,from_pk,from_c1,from_c2,to_pk,to_c1,to_c2" ]
+    [ "${lines[1]}" = "removed,0,0,zero,,," ]
+    [ "${lines[2]}" = "modified,1,1,one,1,10,one" ]
+    [ "${lines[3]}" = 'added,,,,2,2,"two, too"' ]
+}
+
+@test "csv-parquet-diff: csv output between commits" {
+    dolt sql -q "insert into test values (2, 2, 'two')"
+    dolt commit -am "add a row"
+
+    run dolt diff -r csv HEAD~1 HEAD
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 2 ]
+    [ "${lines[1]}" = "added,,,,2,2,two" ]
+
+    run dolt diff -r csv HEAD HEAD~1
+    [ "$status" -eq 0 ]
+    [ "${lines[1]}" = "removed,2,2,two,,," ]
+}
+
+@test "csv-parquet-diff: schema changes are reflected in the columns" {
+    dolt sql -q "alter table test add column c3 int; update test set c3 = 3 where pk = 1;"
+
+    run dolt diff -r csv
+    [ "$status" -eq 0 ]
+    [ "${lines[0]}" = "diff_type,from_pk,from_c1,from_c2,from_c3,to_pk,to_c1,to_c2,to_c3" ]
+    [ "${lines[1]}" = "modified,1,1,one,,1,1,one,3" ]
+}
+
+@test "csv-parquet-diff: csv output with --output-dir writes a file per table" {
+    dolt sql -q "create table other (id int primary key); insert into other values (1); insert into test values (2, 2, 'two');"
+    dolt sql -q "create table unchanged (id int primary key)"
+    dolt commit -Am "unchanged"
+    dolt sql -q "insert into other values (2); update test set c2 = 'uno' where pk = 1;"
+
+    run dolt diff -r csv --output-dir diffs
+    [ "$status" -eq 0 ]
+    [ "$output" = "" ]
+    [ -f diffs/test.csv ]
+    [ -f diffs/other.csv ]
+    [ ! -f diffs/unchanged.csv ]
+
+    run cat diffs/test.csv
+    [ "${lines[0]}" = "diff_type,from_pk,from_c1,from_c2,to_pk,to_c1,to_c2" ]
+    [ "${lines[1]}" = "modified,1,1,one,1,1,uno" ]
+
+    run cat diffs/other.csv
+    [ "${lines[0]}" = "diff_type,from_id,to_id" ]
+    [ "${lines[1]}" = "added,,2" ]
+}
+
+@test "csv-parquet-diff: --output-dir writes tables with the same file name to different files" {
+    dolt sql -q 'create table `a/b` (id int primary key); create table a_b (id int primary key); create table `a:b` (id int primary key)'
+    dolt commit -Am "tables"
+    dolt sql -q 'insert into `a/b` values (1); insert into a_b values (2); insert into `a:b` values (3)'
+
+    run dolt diff -r csv --output-dir diffs
+    [ "$status" -eq 0 ]
+    [ -f diffs/a_b.csv ]
+    [ -f diffs/a_b_2.csv ]
+    [ -f diffs/a_b_3.csv ]
+
+    run cat diffs/*.csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "added,,1" ]] || false
+    [[ "$output" =~ "added,,2" ]] || false
+    [[ "$output" =~ "added,,3" ]] || false
+}
+
+@test "csv-parquet-diff: parquet output" {
+    dolt sql -q "insert into test values (2, 2, 'two'); update test set c1 = 10 where pk = 1; delete from test where pk = 0;"
+
+    run dolt diff -r parquet --output-dir diffs
+    [ "$status" -eq 0 ]
+    [ -f diffs/test.parquet ]
+
+    dolt sql -q "create table imported (diff_type text, from_pk bigint, from_c1 bigint, from_c2 varchar(20), to_pk bigint, to_c1 bigint, to_c2 varchar(20))"
+    dolt table import -u imported diffs/test.parquet
+
+    run dolt sql -r csv -q "select * from imported order by diff_type"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "added,,,,2,2,two" ]] || false
+    [[ "$output" =~ "modified,1,1,one,1,10,one" ]] || false
+    [[ "$output" =~ "removed,0,0,zero,,," ]] || false
+}
+
+@test "csv-parquet-diff: parquet output can be read by pandas" {
+    dolt sql -q "insert into test values (2, null, 'two')"
+    dolt diff -r parquet --output-dir diffs
+
+    echo "import pandas as pd
+df = pd.read_parquet('diffs/test.parquet')
+print(df.to_csv(index=False))
+" > pandas_test.py
+    run python3 pandas_test.py
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "diff_type,from_pk,from_c1,from_c2,to_pk,to_c1,to_c2" ]] || false
+    [[ "$output" =~ "added,,,,2,,two" ]] || false
+}
+
+@test "csv-parquet-diff: invalid arguments" {
+    run dolt diff -r parquet
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "parquet output requires --output-dir" ]] || false
+
+    run dolt diff -r json --output-dir diffs
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "--output-dir is only supported for csv and parquet output" ]] || false
+
+    dolt sql -q "insert into test values (2, 2, 'two')"
+    run dolt diff -r csv --stat
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "diff stats are not supported for csv output" ]] || false
+}
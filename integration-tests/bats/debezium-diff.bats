@@ -0,0 +1,141 @@
+#!/usr/bin/env bats
+load $BATS_TEST_DIRNAME/helper/common.bash
+
+setup() {
+    setup_common
+
+    dolt sql <<SQL
+CREATE TABLE test (
+  pk BIGINT NOT NULL,
+  c1 BIGINT,
+  c2 VARCHAR(20),
+  PRIMARY KEY (pk)
+);
+INSERT INTO test VALUES (0, 0, 'zero'), (1, 1, 'one');
+SQL
+    dolt commit -Am "create table test"
+}
+
+teardown() {
+    assert_feature_version
+    teardown_common
+}
+
+@test "debezium-diff: row changes in the working set" {
+    dolt sql -q "insert into test values (2, 2, 'two'); update test set c1 = 10 where pk = 1; delete from test where pk = 0;"
+
+    run dolt diff -r debezium
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 3 ]
+    [[ "${lines[0]}" =~ '{"before":{"c1":0,"c2":"zero","pk":0},"after":null,"op":"d","source":{' ]] || false
+    [[ "${lines[1]}" =~ '{"before":{"c1":1,"c2":"one","pk":1},"after":{"c1":10,"c2":"one","pk":1},"op":"u","source":{' ]] || false
+    [[ "${lines[2]}" =~ '{"before":null,"after":{"c1":2,"c2":"two","pk":2},"op":"c","source":{' ]] || false
+    [[ "${lines[0]}" =~ '"connector":"dolt"' ]] || false
+    [[ "${lines[0]}" =~ '"table":"test","commit":null,"branch":"main"' ]] || false
+}
+
+@test "debezium-diff: source identifies the commit and branch" {
+    dolt sql -q "insert into test values (2, 2, 'two')"
+    dolt commit -am "add a row"
+    head=$(dolt sql -r csv -q "select dolt_hashof('HEAD')" | tail -n 1)
+
+    run dolt diff -r debezium HEAD~1 HEAD
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 1 ]
+    [[ "$output" =~ "\"table\":\"test\",\"commit\":\"$head\",\"branch\":\"main\"" ]] || false
+
+    dolt branch other
+    run dolt diff -r debezium HEAD~1 other
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "\"commit\":\"$head\",\"branch\":\"other\"" ]] || false
+
+    run dolt diff -r debezium HEAD~1 $head
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "\"commit\":\"$head\",\"branch\":null" ]] || false
+}
+
+@test "debezium-diff: every line is a change event" {
+    dolt sql -q "insert into test values (2, 2, 'two'), (3, null, 'three'); update test set c2 = 'uno' where pk = 1;"
+    dolt commit -am "more rows"
+
+    run python3 -c "
+import json, subprocess
+out = subprocess.run(['dolt', 'diff', '-r', 'debezium', 'HEAD~1', 'HEAD'], capture_output=True, check=True, text=True).stdout
+ops = []
+for line in out.splitlines():
+    event = json.loads(line)
+    assert set(event) == {'before', 'after', 'op', 'source', 'ts_ms'}, event
+    assert event['source']['db'].startswith('dolt-repo-'), event['source']
+    ops.append(event['op'])
+print(','.join(ops))
+"
+    [ "$status" -eq 0 ]
+    [ "$output" = "u,c,c" ]
+}
+
+@test "debezium-diff: null values are included" {
+    dolt sql -q "insert into test values (2, null, null)"
+
+    run dolt diff -r debezium
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ '"after":{"c1":null,"c2":null,"pk":2}' ]] || false
+}
+
+@test "debezium-diff: schema changes" {
+    dolt sql -q "alter table test add column c3 int; create table other (id int primary key);"
+
+    run dolt diff -r debezium
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ '"databaseName":"dolt-repo-'[0-9]+'","ddl":"CREATE TABLE `other`' ]] || false
+    [[ "$output" =~ '"tableChanges":[{"type":"CREATE","id":"dolt-repo-'[0-9]+'.other"}]' ]] || false
+    [[ "$output" =~ '"ddl":"ALTER TABLE `test` ADD `c3` int;","tableChanges":[{"type":"ALTER","id":"dolt-repo-'[0-9]+'.test"}]' ]] || false
+
+    run dolt diff -r debezium --data
+    [ "$status" -eq 0 ]
+    [[ ! "$output" =~ '"ddl"' ]] || false
+}
+
+@test "debezium-diff: dropped tables emit no delete events" {
+    dolt sql -q "drop table test"
+
+    run dolt diff -r debezium
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 1 ]
+    [[ "$output" =~ '"ddl":"DROP TABLE `test`;","tableChanges":[{"type":"DROP","id":"dolt-repo-'[0-9]+'.test"}]' ]] || false
+}
+
+@test "debezium-diff: views and triggers" {
+    dolt sql -q "create view v as select * from test; create trigger trg before insert on test for each row set new.c1 = 0;"
+    dolt commit -Am "add view and trigger"
+    dolt sql -q "drop view v"
+
+    run dolt diff -r debezium HEAD~1 HEAD
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ '"ddl":"create trigger trg' ]] || false
+    [[ "$output" =~ '"ddl":"create view v' ]] || false
+
+    run dolt diff -r debezium
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 1 ]
+    [[ "$output" =~ '"ddl":"DROP VIEW `v`;","tableChanges":[]' ]] || false
+}
+
+@test "debezium-diff: keyless tables" {
+    dolt sql -q "create table keyless (c1 int, c2 int); insert into keyless values (1, 1), (1, 1);"
+    dolt commit -Am "keyless"
+    dolt sql -q "delete from keyless"
+
+    run dolt diff -r debezium
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 2 ]
+    [[ "${lines[0]}" =~ '{"before":{"c1":1,"c2":1},"after":null,"op":"d"' ]] || false
+    [[ "${lines[1]}" =~ '{"before":{"c1":1,"c2":1},"after":null,"op":"d"' ]] || false
+}
+
+@test "debezium-diff: stat is not supported" {
+    dolt sql -q "insert into test values (2, 2, 'two')"
+
+    run dolt diff -r debezium --stat
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "diff stats are not supported for debezium output" ]] || false
+}
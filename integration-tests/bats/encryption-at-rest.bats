@@ -0,0 +1,93 @@
+#!/usr/bin/env bats
+load $BATS_TEST_DIRNAME/helper/common.bash
+
+setup() {
+    setup_common
+    head -c 32 /dev/urandom | base64 > "$BATS_TMPDIR/key-$$"
+    head -c 32 /dev/urandom | base64 > "$BATS_TMPDIR/other-key-$$"
+
+    dolt sql <<SQL
+CREATE TABLE pii (
+  pk BIGINT NOT NULL,
+  ssn VARCHAR(20),
+  PRIMARY KEY (pk)
+);
+INSERT INTO pii VALUES (1, 'plaintext-ssn-0001');
+SQL
+    dolt add pii
+    dolt commit -m "add pii"
+    dolt gc
+}
+
+teardown() {
+    rm -f "$BATS_TMPDIR/key-$$" "$BATS_TMPDIR/other-key-$$"
+    assert_feature_version
+    teardown_common
+}
+
+@test "encryption-at-rest: encrypt and decrypt a database" {
+    dolt sql -q "INSERT INTO pii VALUES (2, 'plaintext-ssn-0002')"
+    dolt admin encrypt --key-file "$BATS_TMPDIR/key-$$"
+    [ -f .dolt/noms/encryption ]
+
+    run grep -r "plaintext-ssn" .dolt/noms
+    [ "$status" -eq 1 ]
+
+    export DOLT_ENCRYPTION_KEY_FILE="$BATS_TMPDIR/key-$$"
+    run dolt sql -q "SELECT ssn FROM pii ORDER BY pk" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "plaintext-ssn-0001" ]] || false
+    [[ "$output" =~ "plaintext-ssn-0002" ]] || false
+
+    dolt admin decrypt
+    unset DOLT_ENCRYPTION_KEY_FILE
+    [ ! -f .dolt/noms/encryption ]
+
+    run dolt sql -q "SELECT count(*) FROM pii" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "2" ]] || false
+}
+
+@test "encryption-at-rest: writes and gc keep an encrypted database encrypted" {
+    dolt admin encrypt --key-file "$BATS_TMPDIR/key-$$"
+
+    export DOLT_ENCRYPTION_KEY=$(cat "$BATS_TMPDIR/key-$$")
+    dolt sql -q "INSERT INTO pii VALUES (2, 'plaintext-ssn-0002')"
+    dolt commit -am "add row"
+    run grep -r "plaintext-ssn" .dolt/noms
+    [ "$status" -eq 1 ]
+
+    dolt gc
+    run grep -r "plaintext-ssn" .dolt/noms
+    [ "$status" -eq 1 ]
+
+    run dolt sql -q "SELECT count(*) FROM pii" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "2" ]] || false
+}
+
+@test "encryption-at-rest: an encrypted database can't be opened without its key" {
+    dolt admin encrypt --key-file "$BATS_TMPDIR/key-$$"
+
+    run dolt status
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "the database is encrypted" ]] || false
+
+    DOLT_ENCRYPTION_KEY_FILE="$BATS_TMPDIR/other-key-$$" run dolt status
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "the encryption key does not match" ]] || false
+
+    run dolt admin decrypt --key-file "$BATS_TMPDIR/other-key-$$"
+    [ "$status" -eq 1 ]
+    [ -f .dolt/noms/encryption ]
+
+    DOLT_ENCRYPTION_KEY_FILE="$BATS_TMPDIR/key-$$" run dolt status
+    [ "$status" -eq 0 ]
+}
+
+@test "encryption-at-rest: encrypt requires a key" {
+    run dolt admin encrypt
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "an encryption key is required" ]] || false
+    [ ! -f .dolt/noms/encryption ]
+}
@@ -0,0 +1,155 @@
+#!/usr/bin/env bats
+load $BATS_TEST_DIRNAME/helper/common.bash
+
+setup() {
+    setup_common
+
+    dolt sql <<SQL
+CREATE TABLE test (
+  id BIGINT NOT NULL,
+  name VARCHAR(20) NOT NULL,
+  team VARCHAR(20),
+  d DATE,
+  dt DATETIME(6),
+  amt DECIMAL(10,2),
+  u INT UNSIGNED,
+  f DOUBLE,
+  PRIMARY KEY (id)
+);
+INSERT INTO test VALUES
+  (1, 'one', 'a', '2020-01-02', '2020-01-02 03:04:05.5', 1.25, 4294967295, 1.5),
+  (2, 'two', 'a', NULL, NULL, NULL, NULL, NULL);
+SQL
+    dolt commit -Am "create table test"
+    dolt table export test test.parquet
+}
+
+teardown() {
+    assert_feature_version
+    teardown_common
+}
+
+@test "import-infer-schema: create table from parquet without a schema file" {
+    run dolt table import -c imported test.parquet
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Column id has a unique value in every row; use --pk id to make it the primary key." ]] || false
+    [[ "$output" =~ "Rows Processed: 2, Additions: 2, Modifications: 0, Had No Effect: 0" ]] || false
+
+    run dolt schema show imported
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ '`id` bigint NOT NULL' ]] || false
+    [[ "$output" =~ '`name` longtext NOT NULL' ]] || false
+    [[ "$output" =~ '`team` longtext' ]] || false
+    [[ "$output" =~ '`d` datetime(6)' ]] || false
+    [[ "$output" =~ '`amt` decimal(10,2)' ]] || false
+    [[ "$output" =~ '`u` bigint unsigned' ]] || false
+    [[ "$output" =~ '`f` double' ]] || false
+    [[ ! "$output" =~ "PRIMARY KEY" ]] || false
+
+    run dolt sql -r csv -q "select id, name, team, d, dt, amt, u, f from imported order by id"
+    [ "$status" -eq 0 ]
+    [ "${lines[1]}" = "1,one,a,2020-01-02 00:00:00,2020-01-02 03:04:05.5,1.25,4294967295,1.5" ]
+    [ "${lines[2]}" = "2,two,a,,,,," ]
+}
+
+@test "import-infer-schema: create table from parquet with a text primary key" {
+    run dolt table import -c --pk name imported test.parquet
+    [ "$status" -eq 0 ]
+    [[ ! "$output" =~ "use --pk" ]] || false
+
+    run dolt schema show imported
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ '`name` varchar' ]] || false
+    [[ "$output" =~ 'PRIMARY KEY (`name`)' ]] || false
+
+    run dolt table import -c --pk missing missing test.parquet
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "provided primary key not found" ]] || false
+}
+
+@test "import-infer-schema: update from parquet uses the table schema" {
+    dolt sql -q "delete from test where id = 2; update test set team = 'b' where id = 1"
+
+    run dolt table import -u test test.parquet
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Rows Processed: 2, Additions: 1, Modifications: 1, Had No Effect: 0" ]] || false
+
+    run dolt diff --stat
+    [ "$output" = "" ]
+}
+
+@test "import-infer-schema: create table from xlsx infers dates and suggests a primary key" {
+    run dolt table import -c employees `batshelper employees.xlsx`
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Column id has a unique value in every row; use --pk id to make it the primary key." ]] || false
+
+    run dolt schema show employees
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ '`id` int' ]] || false
+    [[ "$output" =~ '`start date` date' ]] || false
+
+    run dolt sql -r csv -q "select id, \`start date\` from employees order by id"
+    [ "$status" -eq 0 ]
+    [ "${lines[1]}" = "0,2018-08-06" ]
+}
+
+@test "import-infer-schema: schema import from parquet" {
+    run dolt schema import -c --dry-run suggested test.parquet
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "using suggested primary key id" ]] || false
+    [[ "$output" =~ 'PRIMARY KEY (`id`)' ]] || false
+
+    run dolt schema import -c --pks name,team imported test.parquet
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Created table successfully." ]] || false
+
+    run dolt schema show imported
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ '`name` varchar' ]] || false
+    [[ "$output" =~ '`amt` decimal(10,2)' ]] || false
+    [[ "$output" =~ 'PRIMARY KEY (`name`,`team`)' ]] || false
+
+    run dolt table import -u imported test.parquet
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Rows Processed: 2, Additions: 2, Modifications: 0, Had No Effect: 0" ]] || false
+}
+
+@test "import-infer-schema: schema import from xlsx" {
+    run dolt schema import -c employees `batshelper employees.xlsx`
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "using suggested primary key id" ]] || false
+    [[ "$output" =~ '`start date` date' ]] || false
+    [[ "$output" =~ 'PRIMARY KEY (`id`)' ]] || false
+
+    run dolt schema import -c --pks id bad-sheet-name `batshelper employees.xlsx`
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "table name must match excel sheet name" ]] || false
+}
+
+@test "import-infer-schema: schema import without pks and without a unique column" {
+    dolt sql -q "create table dupes (id int, team varchar(10)); insert into dupes values (1, 'a'), (1, 'a')"
+    dolt table export dupes dupes.parquet
+    dolt table export dupes dupes.csv
+
+    run dolt schema import -c nopks dupes.csv
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "missing required parameter pks" ]] || false
+
+    run dolt schema import -c nopks dupes.parquet
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "missing required parameter pks" ]] || false
+    [[ "$output" =~ "No column of 'dupes.parquet' has a unique, non-null value in every row" ]] || false
+}
+
+@test "import-infer-schema: suggested primary keys use mapped column names" {
+    echo '{"id": "test_id"}' > mapping.json
+
+    run dolt schema import -c --map mapping.json mapped test.parquet
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "using suggested primary key test_id" ]] || false
+    [[ "$output" =~ 'PRIMARY KEY (`test_id`)' ]] || false
+
+    run dolt table import -c --map mapping.json mapped_rows test.parquet
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "use --pk test_id" ]] || false
+}
@@ -0,0 +1,71 @@
+#!/usr/bin/env bats
+load $BATS_TEST_DIRNAME/helper/common.bash
+
+setup() {
+    setup_common
+
+    dolt sql <<SQL
+CREATE TABLE counters (
+  name varchar(20) primary key,
+  hits int,
+  note varchar(20),
+  updated_at datetime
+);
+INSERT INTO counters VALUES ('home', 10, 'base', '2020-01-01');
+SQL
+    dolt add .
+    dolt commit -m "base"
+}
+
+teardown() {
+    assert_feature_version
+    teardown_common
+}
+
+@test "merge-rules: conflicting cells are resolved by merge rules" {
+    dolt sql -q "insert into dolt_merge_rules values ('counters', 'hits', 'sum', null), ('counters', 'note', 'latest', 'updated_at'), ('counters', 'updated_at', 'max', null)"
+    dolt commit -Am "add merge rules"
+
+    dolt checkout -b other
+    dolt sql -q "update counters set hits = hits + 5, note = 'other', updated_at = '2022-01-01'"
+    dolt commit -am "other"
+
+    dolt checkout main
+    dolt sql -q "update counters set hits = hits + 1, note = 'main', updated_at = '2021-01-01'"
+    dolt commit -am "main"
+
+    run dolt merge other -m "merge other"
+    [ "$status" -eq 0 ]
+    [[ ! "$output" =~ "CONFLICT" ]] || false
+
+    run dolt sql -q "select hits, note from counters" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "16,other" ]] || false
+}
+
+@test "merge-rules: cells without merge rules still conflict" {
+    dolt sql -q "insert into dolt_merge_rules values ('counters', 'hits', 'max', null)"
+    dolt commit -Am "add merge rules"
+
+    dolt checkout -b other
+    dolt sql -q "update counters set hits = 20, note = 'other'"
+    dolt commit -am "other"
+
+    dolt checkout main
+    dolt sql -q "update counters set hits = 30, note = 'main'"
+    dolt commit -am "main"
+
+    run dolt merge other -m "merge other"
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "CONFLICT (content): Merge conflict in counters" ]] || false
+}
+
+@test "merge-rules: invalid merge rules are rejected" {
+    run dolt sql -q "insert into dolt_merge_rules values ('counters', 'hits', 'average', null)"
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "unknown strategy 'average'" ]] || false
+
+    run dolt sql -q "insert into dolt_merge_rules values ('counters', 'note', 'latest', null)"
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "the latest strategy requires an order_by_column" ]] || false
+}
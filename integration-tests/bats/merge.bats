@@ -1360,3 +1360,76 @@ SQL
     run dolt merge b1
     log_status_eq 0
 }
+
+@test "merge: merging multiple branches creates a single merge commit" {
+    for b in b1 b2 b3; do
+        dolt checkout -b $b main
+        dolt sql -q "insert into test1 values (${b:1}, ${b:1}, ${b:1})"
+        dolt commit -am "$b"
+    done
+    dolt checkout main
+    dolt sql -q "insert into test2 values (0, 0, 0)"
+    dolt commit -am "main"
+
+    run dolt merge b1 b2 b3
+    log_status_eq 0
+    [[ "$output" =~ "Merge branches 'b1', 'b2' and 'b3' into main" ]] || false
+
+    run dolt log -n 1
+    log_status_eq 0
+    [[ "$output" =~ "Merge:" ]] || false
+
+    run dolt sql -q "select count(*) from dolt_commit_ancestors where commit_hash = hashof('HEAD')" -r csv
+    log_status_eq 0
+    [[ "$output" =~ "4" ]] || false
+
+    run dolt sql -q "select count(*) from test1" -r csv
+    log_status_eq 0
+    [[ "$output" =~ "3" ]] || false
+}
+
+@test "merge: merging multiple branches fails on conflicts" {
+    dolt sql -q "insert into test1 values (0, 0, 0)"
+    dolt commit -am "base row"
+    for b in b1 b2; do
+        dolt checkout -b $b main
+        dolt sql -q "update test1 set c1 = ${b:1} where pk = 0"
+        dolt commit -am "$b"
+    done
+    dolt checkout main
+
+    run dolt merge b1 b2
+    log_status_eq 1
+    [[ "$output" =~ "merging b2 produced conflicts or constraint violations" ]] || false
+
+    run dolt log -n 1
+    log_status_eq 0
+    [[ "$output" =~ "base row" ]] || false
+
+    run dolt status
+    log_status_eq 0
+    [[ "$output" =~ "nothing to commit, working tree clean" ]] || false
+}
+
+@test "merge: ours strategy records a merge without taking changes" {
+    dolt checkout -b b1
+    dolt sql -q "insert into test1 values (1, 1, 1)"
+    dolt commit -am "b1"
+    dolt checkout main
+
+    run dolt merge -s ours b1 -m "supersede b1"
+    log_status_eq 0
+    [[ "$output" =~ "supersede b1" ]] || false
+
+    run dolt sql -q "select count(*) from test1" -r csv
+    log_status_eq 0
+    [[ "$output" =~ "0" ]] || false
+
+    run dolt sql -q "select hashof('HEAD^2') = hashof('b1')" -r csv
+    log_status_eq 0
+    [[ "$output" =~ "true" ]] || false
+
+    run dolt merge -s recursive b1
+    log_status_eq 1
+    [[ "$output" =~ "unsupported merge strategy 'recursive'" ]] || false
+}
@@ -0,0 +1,166 @@
+#!/usr/bin/env bats
+load $BATS_TEST_DIRNAME/helper/common.bash
+
+setup() {
+    setup_common
+
+    dolt sql <<SQL
+CREATE TABLE test (
+  pk BIGINT NOT NULL,
+  c VARCHAR(20),
+  f DOUBLE,
+  j JSON,
+  PRIMARY KEY (pk)
+);
+INSERT INTO test VALUES
+  (1, 'one', 1.5, '{"a": [1, 2]}'),
+  (2, NULL, NULL, NULL),
+  (9007199254740993, 'big', 2, '[]');
+SQL
+    dolt commit -Am "create table test"
+}
+
+teardown() {
+    assert_feature_version
+    teardown_common
+}
+
+@test "ndjson-import-export: export writes one object per line" {
+    run dolt table export test test.jsonl
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Successfully exported data." ]] || false
+
+    run cat test.jsonl
+    [ "${#lines[@]}" -eq 3 ]
+    [ "${lines[0]}" = '{"c":"one","f":1.5,"j":{"a":[1,2]},"pk":1}' ]
+    [ "${lines[1]}" = '{"pk":2}' ]
+    [ "${lines[2]}" = '{"c":"big","f":2,"j":[],"pk":9007199254740993}' ]
+}
+
+@test "ndjson-import-export: create table infers a schema without a schema file" {
+    cat <<JSON > people.ndjson
+{"id": 1, "name": "tim", "age": 30, "tags": ["a", "b"]}
+{"id": 2, "name": "brian", "admin": true}
+{"id": 3, "age": null}
+JSON
+
+    run dolt table import -c --pk id people people.ndjson
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Rows Processed: 3, Additions: 3, Modifications: 0, Had No Effect: 0" ]] || false
+
+    run dolt schema show people
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ '`id` int NOT NULL' ]] || false
+    [[ "$output" =~ '`name` varchar' ]] || false
+    [[ "$output" =~ '`age` int' ]] || false
+    [[ "$output" =~ '`tags` json' ]] || false
+    [[ "$output" =~ '`admin` tinyint' ]] || false
+    [[ "$output" =~ 'PRIMARY KEY (`id`)' ]] || false
+
+    run dolt sql -r csv -q "select * from people order by id"
+    [ "$status" -eq 0 ]
+    [ "${lines[1]}" = '1,tim,30,"[""a"",""b""]",' ]
+    [ "${lines[2]}" = "2,brian,,,1" ]
+    [ "${lines[3]}" = "3,,,," ]
+}
+
+@test "ndjson-import-export: round trip through a jsonl file" {
+    dolt table export test test.jsonl
+
+    run dolt table import -c --pk pk imported test.jsonl
+    [ "$status" -eq 0 ]
+
+    run dolt sql -r csv -q "select pk, c, f, j from imported order by pk"
+    [ "$status" -eq 0 ]
+    [ "${lines[1]}" = '1,one,1.5,"{""a"":[1,2]}"' ]
+    [ "${lines[2]}" = "2,,," ]
+    [ "${lines[3]}" = "9007199254740993,big,2,[]" ]
+}
+
+@test "ndjson-import-export: update and replace an existing table" {
+    dolt table export test test.jsonl
+    dolt sql -q "delete from test where pk = 2; update test set c = 'changed' where pk = 1"
+
+    run dolt table import -u test test.jsonl
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Rows Processed: 3, Additions: 1, Modifications: 1, Had No Effect: 1" ]] || false
+
+    run dolt diff --stat
+    [ "$output" = "" ]
+
+    echo '{"pk": 7, "c": "seven"}' > seven.jsonl
+    run dolt table import -r test seven.jsonl
+    [ "$status" -eq 0 ]
+
+    run dolt sql -r csv -q "select pk, c from test"
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 2 ]
+    [ "${lines[1]}" = "7,seven" ]
+}
+
+@test "ndjson-import-export: import with a schema file" {
+    cat <<SQL > schema.sql
+CREATE TABLE typed (
+  id INT NOT NULL,
+  amount DECIMAL(10,2),
+  PRIMARY KEY (id)
+);
+SQL
+    echo '{"id": 1, "amount": 12.345}' > typed.jsonl
+
+    run dolt table import -c -s schema.sql typed typed.jsonl
+    [ "$status" -eq 0 ]
+
+    run dolt sql -r csv -q "select * from typed"
+    [ "$status" -eq 0 ]
+    [ "${lines[1]}" = "1,12.35" ]
+}
+
+@test "ndjson-import-export: stream to stdout and from stdin" {
+    run dolt table export --file-type jsonl test
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ '{"pk":2}' ]] || false
+
+    dolt table export --file-type ndjson test > stdout.jsonl
+    [ "$(wc -l < stdout.jsonl)" -eq 3 ]
+
+    run dolt table import -u --file-type jsonl test <<JSON
+{"pk": 5, "c": "five"}
+{"pk": 6, "f": 3}
+JSON
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Rows Processed: 2, Additions: 2, Modifications: 0, Had No Effect: 0" ]] || false
+
+    run dolt sql -r csv -q "select pk, c, f from test where pk in (5, 6) order by pk"
+    [ "$status" -eq 0 ]
+    [ "${lines[1]}" = "5,five," ]
+    [ "${lines[2]}" = "6,,3" ]
+}
+
+@test "ndjson-import-export: dump to jsonl and ndjson files" {
+    dolt sql -q "create table other (id int primary key, name varchar(10)); insert into other values (1, 'a');"
+    dolt commit -Am "create table other"
+
+    run dolt dump -r jsonl
+    [ "$status" -eq 0 ]
+    [ -f doltdump/test.jsonl ]
+    [ -f doltdump/other.jsonl ]
+
+    run dolt dump -r ndjson -d ndjson
+    [ "$status" -eq 0 ]
+    [ -f ndjson/test.ndjson ]
+    [ -f ndjson/other.ndjson ]
+
+    run dolt table import -r other ndjson/other.ndjson
+    [ "$status" -eq 0 ]
+    run dolt diff --stat
+    [ "$output" = "" ]
+}
+
+@test "ndjson-import-export: bad ndjson file import errors" {
+    printf '{"id": 1}\n[1, 2]\n' > bad.jsonl
+
+    run dolt table import -c bad bad.jsonl
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "row 2: expected a JSON object" ]] || false
+}
@@ -0,0 +1,163 @@
+#!/usr/bin/env bats
+#
+# Tests for partial clones, which only fetch the data of a subset of
+# the tables of a database. Excluded tables are left behind on the
+# remote as ghost chunks.
+
+load $BATS_TEST_DIRNAME/helper/common.bash
+
+setup() {
+    setup_common
+    cd $BATS_TMPDIR
+    cd dolt-repo-$$
+    mkdir remote
+
+    dolt sql <<SQL
+CREATE TABLE small (id int primary key, v varchar(20));
+CREATE TABLE big (id int primary key, v varchar(20));
+INSERT INTO small VALUES (1, 'a'), (2, 'b');
+INSERT INTO big VALUES (1, 'x'), (2, 'y'), (3, 'z');
+CREATE VIEW small_view AS SELECT * FROM small;
+SQL
+    dolt add .
+    dolt commit -m "initial tables"
+    dolt sql -q "INSERT INTO big VALUES (4, 'w')"
+    dolt commit -am "more big rows"
+    dolt remote add origin file://remote
+    dolt push origin main
+}
+
+teardown() {
+    assert_feature_version
+    teardown_common
+}
+
+@test "partial-clone: clone with --tables only fetches the given tables" {
+    dolt clone --tables small file://remote clone
+    cd clone
+
+    run dolt sql -q "SELECT * FROM small" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "1,a" ]] || false
+    [[ "$output" =~ "2,b" ]] || false
+
+    # views are stored in a system table, which is always included
+    run dolt sql -q "SELECT count(*) FROM small_view" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "2" ]] || false
+
+    run dolt sql -q "SELECT * FROM big"
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "table is not present in this partial clone: big" ]] || false
+
+    run dolt ls
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "small" ]] || false
+    [[ "$output" =~ "big" ]] || false
+
+    run dolt status
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "nothing to commit, working tree clean" ]] || false
+
+    run dolt log --oneline
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 3 ]
+}
+
+@test "partial-clone: clone with --tables fails on an unknown table" {
+    run dolt clone --tables small,nope file://remote clone
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "table not found: 'nope' on branch 'main'" ]] || false
+    [ ! -d clone ]
+}
+
+@test "partial-clone: clone with --tables requires a user table" {
+    run dolt clone --tables dolt_schemas file://remote clone
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "a partial clone must include at least one non-system table" ]] || false
+}
+
+@test "partial-clone: dolt_clone with --tables" {
+    mkdir clones
+    cd clones
+    dolt sql -q "call dolt_clone('--tables', 'big', 'file://../remote', 'clone')"
+    cd clone
+
+    run dolt sql -q "SELECT count(*) FROM big" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "4" ]] || false
+
+    run dolt sql -q "SELECT * FROM small"
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "table is not present in this partial clone: small" ]] || false
+}
+
+@test "partial-clone: partial clone with --depth" {
+    dolt clone --depth 1 --tables big file://remote clone
+    cd clone
+
+    run dolt log --oneline
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 1 ]
+
+    run dolt sql -q "SELECT count(*) FROM big" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "4" ]] || false
+
+    run dolt sql -q "SELECT * FROM small"
+    [ "$status" -eq 1 ]
+}
+
+@test "partial-clone: commit, push, and pull from a partial clone" {
+    dolt clone --tables small file://remote clone
+
+    # changes to excluded tables on the remote are fetched as ghosts
+    dolt sql -q "INSERT INTO big VALUES (5, 'v')"
+    dolt commit -am "remote big row"
+    dolt push origin main
+
+    cd clone
+    dolt sql -q "INSERT INTO small VALUES (3, 'c')"
+    dolt commit -am "partial clone small row"
+    dolt pull origin main
+
+    run dolt sql -q "SELECT count(*) FROM small" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "3" ]] || false
+
+    run dolt sql -q "SELECT * FROM big"
+    [ "$status" -eq 1 ]
+
+    dolt push origin main
+
+    cd ..
+    dolt pull origin main
+    run dolt sql -q "SELECT count(*) FROM small" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "3" ]] || false
+    run dolt sql -q "SELECT count(*) FROM big" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "5" ]] || false
+
+    run dolt fsck
+    [ "$status" -eq 0 ]
+}
+
+@test "partial-clone: merge fails when an excluded table changed on both sides" {
+    dolt clone --tables small file://remote clone
+
+    dolt checkout -b other
+    dolt sql -q "INSERT INTO big VALUES (6, 'u')"
+    dolt commit -am "other big row"
+    dolt checkout main
+    dolt sql -q "INSERT INTO big VALUES (7, 't')"
+    dolt commit -am "main big row"
+    dolt push origin main
+    dolt push origin other
+
+    cd clone
+    dolt pull origin main
+    run dolt merge origin/other
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "cannot merge table 'big', which was modified on both sides of the merge" ]] || false
+}
@@ -497,4 +497,146 @@ setupCustomEditorScript() {
     # Make sure the commit that became empty appears in the commit log
     run dolt log
     [[ $output =~ "repeating change from main on b1" ]] || false
+
+    # Reset back to the test start point and repeat the rebase with --empty=stop
+    dolt reset --hard testStartPoint
+    run dolt rebase -i --empty=stop main
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Stopped rebasing at pick" ]] || false
+    [[ "$output" =~ "which became empty" ]] || false
+
+    run dolt rebase --continue
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Successfully rebased and updated refs/heads/b1" ]] || false
+
+    # Make sure the commit that became empty does NOT appear in the commit log
+    run dolt log
+    [[ ! $output =~ "repeating change from main on b1" ]] || false
+}
+
+@test "rebase: edit and break actions stop the rebase" {
+    setupCustomEditorScript "rebasePlan.txt"
+
+    dolt checkout b1
+    run dolt show head
+    [ "$status" -eq 0 ]
+    COMMIT1=${lines[0]:12:32}
+
+    touch rebasePlan.txt
+    echo "edit $COMMIT1 b1 commit 1" >> rebasePlan.txt
+    echo "break" >> rebasePlan.txt
+    echo "exec select * from t2" >> rebasePlan.txt
+
+    run dolt rebase -i main
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Stopped rebasing at edit $COMMIT1 (b1 commit 1)" ]] || false
+
+    # Assert that we are on the rebase working branch, and amend the commit being edited
+    run dolt sql -q "select active_branch();"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ " dolt_rebase_b1 " ]] || false
+    dolt sql -q "INSERT INTO t2 VALUES (5);"
+    dolt add t2
+
+    run dolt rebase --continue
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Stopped rebasing at break" ]] || false
+
+    run dolt rebase --continue
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Successfully rebased and updated refs/heads/b1" ]] || false
+
+    run dolt branch
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "* b1" ]] || false
+    ! [[ "$output" =~ "dolt_rebase_b1" ]] || false
+
+    run dolt log --oneline
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 4 ]
+    [[ "${lines[0]}" =~ "b1 commit 1" ]] || false
+
+    run dolt sql -q "select * from t2;" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "5" ]] || false
+}
+
+@test "rebase: failed exec action stops the rebase" {
+    setupCustomEditorScript "rebasePlan.txt"
+
+    dolt checkout b1
+    run dolt show head
+    [ "$status" -eq 0 ]
+    COMMIT1=${lines[0]:12:32}
+
+    touch rebasePlan.txt
+    echo "pick $COMMIT1 b1 commit 1" >> rebasePlan.txt
+    echo "exec INSERT INTO t1 VALUES (1,1)" >> rebasePlan.txt
+
+    run dolt rebase -i main
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "exec query failed while rebasing: INSERT INTO t1 VALUES (1,1)" ]] || false
+
+    # The rebase is not aborted, so it can be continued after fixing the problem
+    run dolt sql -q "select active_branch();"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ " dolt_rebase_b1 " ]] || false
+
+    run dolt rebase --continue
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Successfully rebased and updated refs/heads/b1" ]] || false
+
+    run dolt log
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "b1 commit 1" ]] || false
+    [[ "$output" =~ "main commit 2" ]] || false
+}
+
+@test "rebase: --onto transplants commits to a new base" {
+    setupCustomEditorScript
+
+    dolt checkout b1
+    dolt checkout -b b2
+    dolt sql -q "INSERT INTO t1 VALUES (2,2);"
+    dolt commit -am "b2 commit 1"
+
+    run dolt rebase -i --onto main b1
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Successfully rebased and updated refs/heads/b2" ]] || false
+
+    run dolt log
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "b2 commit 1" ]] || false
+    [[ "$output" =~ "main commit 2" ]] || false
+    [[ ! "$output" =~ "b1 commit 1" ]] || false
+
+    run dolt sql -q "select * from t1;" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "1,1" ]] || false
+    [[ "$output" =~ "2,2" ]] || false
+}
+
+@test "rebase: --autosquash fixes up commits" {
+    setupCustomEditorScript
+
+    dolt checkout b1
+    dolt sql -q "INSERT INTO t2 VALUES (1);"
+    dolt commit -am "b1 commit 2"
+    dolt sql -q "INSERT INTO t2 VALUES (2);"
+    dolt commit -am "fixup! b1 commit 1"
+
+    run dolt rebase -i --autosquash main
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Successfully rebased and updated refs/heads/b1" ]] || false
+
+    run dolt log --oneline
+    [ "$status" -eq 0 ]
+    [[ ! "$output" =~ "fixup!" ]] || false
+    [[ "${lines[0]}" =~ "b1 commit 2" ]] || false
+    [[ "${lines[1]}" =~ "b1 commit 1" ]] || false
+    [[ "${lines[2]}" =~ "main commit 2" ]] || false
+
+    run dolt sql -q "select count(*) from t2 as of 'HEAD~1';" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "1" ]] || false
 }
@@ -0,0 +1,73 @@
+# Simple smoke tests verifying azure:// remotes work as advertised. They can be run against Azure Blob Storage, or the
+# Azurite emulator by setting AZURE_STORAGE_CONNECTION_STRING to its connection string.
+
+load $BATS_TEST_DIRNAME/helper/common.bash
+
+setup() {
+    setup_common
+}
+
+teardown() {
+    teardown_common
+}
+
+skip_if_no_azure_tests() {
+    if [ -z "$DOLT_BATS_AZURE_ACCOUNT" -o -z "$DOLT_BATS_AZURE_CONTAINER" ]; then
+      skip "skipping azure tests; set DOLT_BATS_AZURE_ACCOUNT and DOLT_BATS_AZURE_CONTAINER, and the credentials of the account, to run"
+    fi
+}
+
+@test "remotes-azure: can add remote with azure url" {
+    if [ "$SQL_ENGINE" = "remote-engine" ]; then
+        skip "remote add requires the server to be stopped"
+    fi
+    dolt remote add origin azure://account/container/repo_name
+    run dolt remote -v
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "origin azure://account/container/repo_name" ]] || false
+
+    run dolt remote add --aws-region us-east-1 other azure://account/container/repo_name
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "only valid for aws remotes" ]] || false
+}
+
+@test "remotes-azure: fetch without credentials fails" {
+    if [ "$SQL_ENGINE" = "remote-engine" ]; then
+        skip "remote add requires the server to be stopped"
+    fi
+    dolt remote add origin azure://account/container/repo_name
+    run env -u AZURE_STORAGE_CONNECTION_STRING -u AZURE_STORAGE_KEY -u AZURE_STORAGE_SAS_TOKEN dolt fetch origin
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "failed to find azure credentials" ]] || false
+}
+
+# bats test_tags=no_lambda
+@test "remotes-azure: can push, clone and pull a new remote" {
+    skip_if_no_azure_tests
+    random_repo=`openssl rand -hex 32`
+    remote_url="azure://$DOLT_BATS_AZURE_ACCOUNT/$DOLT_BATS_AZURE_CONTAINER/$random_repo"
+    dolt remote add origin "$remote_url"
+    dolt sql -q 'create table a_test_table (id int primary key)'
+    dolt sql -q 'insert into a_test_table values (1), (2), (47)'
+    dolt add .
+    dolt commit -m 'creating a test table'
+    dolt push origin main:main
+    dolt push origin main:another-branch
+    dolt fetch origin
+    dolt push origin :another-branch
+
+    cd "$BATS_TMPDIR"
+    rm -rf "azure-clone-$$"
+    dolt clone "$remote_url" "azure-clone-$$"
+    cd "azure-clone-$$"
+    dolt sql -q 'insert into a_test_table values (48)'
+    dolt commit -am 'add a row'
+    dolt push origin main
+
+    cd "$BATS_TMPDIR/dolt-repo-$$"
+    dolt pull origin main
+    run dolt sql -q 'select count(*) from a_test_table' -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "4" ]] || false
+    rm -rf "$BATS_TMPDIR/azure-clone-$$"
+}
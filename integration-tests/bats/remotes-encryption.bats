@@ -0,0 +1,162 @@
+#!/usr/bin/env bats
+
+# Encrypted remotes are exercised with localbs remotes, which share the blobstore code path used by cloud remotes
+
+load $BATS_TEST_DIRNAME/helper/common.bash
+
+setup() {
+    setup_common
+    cd $BATS_TMPDIR
+    cd dolt-repo-$$
+    mkdir "dolt-repo-clones"
+    head -c 32 /dev/urandom | base64 > key1
+    head -c 32 /dev/urandom | base64 > key2
+
+    dolt sql <<SQL
+CREATE TABLE pii (
+  pk BIGINT NOT NULL,
+  ssn VARCHAR(20),
+  PRIMARY KEY (pk)
+);
+INSERT INTO pii VALUES (1, 'plaintext-ssn-0001');
+SQL
+    dolt add pii
+    dolt commit -m "add pii"
+}
+
+teardown() {
+    assert_feature_version
+    teardown_common
+}
+
+@test "remotes-encryption: push, clone and pull an encrypted remote" {
+    mkdir remotedir
+    dolt remote add origin "localbs://remotedir?encrypt=key1"
+    dolt push --set-upstream origin main
+
+    # key file paths are made absolute when the remote is added
+    run dolt remote -v
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "?encrypt=$(pwd)/key1" ]] || false
+
+    run grep -r "plaintext-ssn-0001" remotedir
+    [ "$status" -eq 1 ]
+
+    cd dolt-repo-clones
+    dolt clone "localbs://../remotedir?encrypt=../key1" test-repo
+    cd test-repo
+    run dolt sql -q "select ssn from pii" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "plaintext-ssn-0001" ]] || false
+
+    dolt sql -q "insert into pii values (2, 'plaintext-ssn-0002')"
+    dolt commit -am "add row"
+    dolt push origin main
+
+    cd ../..
+    dolt pull
+    run dolt sql -q "select count(*) from pii" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "2" ]] || false
+}
+
+@test "remotes-encryption: an encrypted remote can't be read without its key" {
+    mkdir remotedir
+    dolt remote add origin "localbs://remotedir?encrypt=key1"
+    dolt push origin main
+
+    cd dolt-repo-clones
+    run dolt clone "localbs://../remotedir?encrypt=../key2" test-repo
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "the encryption key does not match" ]] || false
+    [ ! -d test-repo ]
+
+    run dolt clone "localbs://../remotedir" test-repo
+    [ "$status" -eq 1 ]
+    [ ! -d test-repo ]
+
+    run dolt clone "localbs://../remotedir?encrypt=../missing" test-repo
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "unable to read encryption key file" ]] || false
+}
+
+@test "remotes-encryption: rotate-key for a remote" {
+    mkdir remotedir
+    dolt remote add origin "localbs://remotedir?encrypt=key1"
+    dolt push origin main
+
+    # rotating only the data key keeps the same key file
+    dolt remote rotate-key origin
+    run dolt remote -v
+    [[ "$output" =~ "?encrypt=$(pwd)/key1" ]] || false
+
+    dolt remote rotate-key --key-file key2 origin
+    run dolt remote -v
+    [[ "$output" =~ "?encrypt=$(pwd)/key2" ]] || false
+
+    dolt sql -q "insert into pii values (2, 'plaintext-ssn-0002')"
+    dolt commit -am "add row"
+    dolt push origin main
+
+    cd dolt-repo-clones
+    run dolt clone "localbs://../remotedir?encrypt=../key1" old-key
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "the encryption key does not match" ]] || false
+
+    # data pushed before and after the rotation is readable with the new key
+    dolt clone "localbs://../remotedir?encrypt=../key2" test-repo
+    cd test-repo
+    run dolt sql -q "select count(*) from pii" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "2" ]] || false
+}
+
+@test "remotes-encryption: rotate-key for an unencrypted remote fails" {
+    mkdir remotedir
+    dolt remote add origin localbs://remotedir
+
+    run dolt remote rotate-key origin
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "is not encrypted" ]] || false
+
+    run dolt remote rotate-key nope
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "unknown remote" ]] || false
+}
+
+@test "remotes-encryption: sync, rotate-key and restore an encrypted backup" {
+    mkdir backupdir
+    dolt backup add bak "localbs://backupdir?encrypt=key1"
+    dolt backup sync bak
+
+    run grep -r "plaintext-ssn-0001" backupdir
+    [ "$status" -eq 1 ]
+
+    dolt backup rotate-key --key-file key2 bak
+    run dolt backup -v
+    [[ "$output" =~ "?encrypt=$(pwd)/key2" ]] || false
+
+    dolt sql -q "insert into pii values (2, 'plaintext-ssn-0002')"
+    dolt commit -am "add row"
+    dolt backup sync bak
+    dolt backup verify bak
+
+    cd dolt-repo-clones
+    run dolt backup restore "localbs://../backupdir?encrypt=../key1" old-key
+    [ "$status" -eq 1 ]
+
+    dolt backup restore "localbs://../backupdir?encrypt=../key2" restored
+    cd restored
+    run dolt sql -q "select count(*) from pii" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "2" ]] || false
+}
+
+@test "remotes-encryption: file remotes can't be encrypted" {
+    mkdir remotedir
+    dolt remote add origin "file://remotedir?encrypt=key1"
+
+    run dolt push origin main
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "client-side encryption is not supported for file:// urls" ]] || false
+}
@@ -0,0 +1,84 @@
+# Simple smoke tests verifying s3:// remotes work as advertised. They can be run against AWS, or any S3 compatible
+# object store which supports conditional writes, e.g. MinIO, by setting AWS_ENDPOINT_URL_S3.
+
+load $BATS_TEST_DIRNAME/helper/common.bash
+
+setup() {
+    setup_common
+}
+
+teardown() {
+    teardown_common
+}
+
+skip_if_no_s3_tests() {
+    if [ -z "$DOLT_BATS_S3_BUCKET" ]; then
+      skip "skipping s3 tests; set DOLT_BATS_S3_BUCKET, and AWS_ENDPOINT_URL_S3 for S3 compatible object stores, to run"
+    fi
+}
+
+@test "remotes-s3: can add remote with s3 url and aws params" {
+    if [ "$SQL_ENGINE" = "remote-engine" ]; then
+        skip "remote add requires the server to be stopped"
+    fi
+    dolt remote add --aws-region us-east-1 origin s3://s3_bucket/repo_name
+    run dolt remote -v
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "origin s3://s3_bucket/repo_name {\"aws-region\": \"us-east-1\"}" ]] || false
+}
+
+# bats test_tags=no_lambda
+@test "remotes-s3: can push, clone and pull a new remote" {
+    skip_if_no_s3_tests
+    random_repo=`openssl rand -hex 32`
+    dolt remote add origin "s3://$DOLT_BATS_S3_BUCKET/$random_repo"
+    dolt sql -q 'create table a_test_table (id int primary key)'
+    dolt sql -q 'insert into a_test_table values (1), (2), (47)'
+    dolt add .
+    dolt commit -m 'creating a test table'
+    dolt push origin main:main
+    dolt push origin main:another-branch
+    dolt fetch origin
+    dolt push origin :another-branch
+
+    cd "$BATS_TMPDIR"
+    rm -rf "s3-clone-$$"
+    dolt clone "s3://$DOLT_BATS_S3_BUCKET/$random_repo" "s3-clone-$$"
+    cd "s3-clone-$$"
+    dolt sql -q 'insert into a_test_table values (48)'
+    dolt commit -am 'add a row'
+    dolt push origin main
+
+    cd "$BATS_TMPDIR/dolt-repo-$$"
+    dolt pull origin main
+    run dolt sql -q 'select count(*) from a_test_table' -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "4" ]] || false
+    rm -rf "$BATS_TMPDIR/s3-clone-$$"
+}
+
+# bats test_tags=no_lambda
+@test "remotes-s3: concurrent pushes of the same branch are rejected" {
+    skip_if_no_s3_tests
+    random_repo=`openssl rand -hex 32`
+    dolt remote add origin "s3://$DOLT_BATS_S3_BUCKET/$random_repo"
+    dolt sql -q 'create table a_test_table (id int primary key)'
+    dolt add .
+    dolt commit -m 'creating a test table'
+    dolt push origin main
+
+    cd "$BATS_TMPDIR"
+    rm -rf "s3-clone-$$"
+    dolt clone "s3://$DOLT_BATS_S3_BUCKET/$random_repo" "s3-clone-$$"
+    cd "s3-clone-$$"
+    dolt sql -q 'insert into a_test_table values (1)'
+    dolt commit -am 'add a row in the clone'
+    dolt push origin main
+
+    cd "$BATS_TMPDIR/dolt-repo-$$"
+    dolt sql -q 'insert into a_test_table values (2)'
+    dolt commit -am 'add a row'
+    run dolt push origin main
+    [ "$status" -eq 1 ]
+    rm -rf "$BATS_TMPDIR/s3-clone-$$"
+}
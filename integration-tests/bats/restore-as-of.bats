@@ -0,0 +1,103 @@
+#!/usr/bin/env bats
+#
+# Tests for point-in-time restores, which recreate a database as it was
+# at a wall-clock time from the root updates in its chunk journal.
+
+load $BATS_TEST_DIRNAME/helper/common.bash
+
+setup() {
+    setup_common
+
+    dolt sql <<SQL
+CREATE TABLE t (id int primary key, v varchar(20));
+INSERT INTO t VALUES (1, 'a');
+SQL
+    dolt add .
+    dolt commit -m "initial table"
+    dolt branch feature
+    dolt sql -q "INSERT INTO t VALUES (2, 'uncommitted')"
+
+    # journal timestamps have a resolution of one second
+    sleep 2
+    AS_OF=$(date -u '+%Y-%m-%d %H:%M:%S')
+    sleep 2
+
+    dolt sql -q "DROP TABLE t"
+    dolt commit -am "dropped table"
+    dolt branch -D feature
+}
+
+teardown() {
+    assert_feature_version
+    teardown_common
+}
+
+@test "restore-as-of: admin restore recreates branches and working sets in a new database" {
+    run dolt admin restore --as-of "$AS_OF" restored
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Restored database as of" ]] || false
+
+    # the original database is not modified
+    run dolt sql -q "SHOW TABLES" -r csv
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 1 ]
+
+    cd restored
+    run dolt branch
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "feature" ]] || false
+    [[ "$output" =~ "main" ]] || false
+
+    # the uncommitted row is restored with the working set
+    run dolt sql -q "SELECT v FROM t ORDER BY id" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "uncommitted" ]] || false
+    run dolt status
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "modified" ]] || false
+
+    run dolt log --oneline
+    [ "$status" -eq 0 ]
+    [[ ! "$output" =~ "dropped table" ]] || false
+}
+
+@test "restore-as-of: dolt_restore_as_of creates a new database" {
+    run dolt sql <<SQL
+CALL dolt_restore_as_of('$AS_OF', 'restored');
+USE restored;
+SELECT COUNT(*) AS cnt FROM t;
+SELECT name FROM dolt_branches ORDER BY name;
+SQL
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Restored database as of" ]] || false
+    [[ "$output" =~ "| 2   |" ]] || false
+    [[ "$output" =~ "feature" ]] || false
+
+    run dolt sql -q "SHOW DATABASES" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "restored" ]] || false
+}
+
+@test "restore-as-of: errors" {
+    run dolt admin restore --as-of "2000-01-01" restored
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "no database state was recorded in the chunk journal at or before" ]] || false
+    [ ! -d restored ]
+
+    run dolt admin restore --as-of "not a date" restored
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "is not in a supported format" ]] || false
+
+    run dolt admin restore restored
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "--as-of and a database name are required" ]] || false
+
+    mkdir -p existing/.dolt
+    run dolt sql -q "CALL dolt_restore_as_of('$AS_OF', 'existing')"
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "exists" ]] || false
+
+    run dolt sql -q "CALL dolt_restore_as_of('$AS_OF')"
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "usage: dolt_restore_as_of('time', 'database_name')" ]] || false
+}
@@ -0,0 +1,171 @@
+#!/usr/bin/env bats
+#
+# Tests for sparse checkouts, which limit the tables of a branch's
+# working set that are shown and merged row by row to those matching a
+# set of patterns.
+
+load $BATS_TEST_DIRNAME/helper/common.bash
+
+setup() {
+    setup_common
+
+    dolt sql <<SQL
+CREATE TABLE sales_2024 (id int primary key, amount int);
+CREATE TABLE sales_2025 (id int primary key, amount int);
+CREATE TABLE inventory (id int primary key, qty int);
+INSERT INTO sales_2024 VALUES (1, 10);
+INSERT INTO inventory VALUES (1, 100);
+SQL
+    dolt add .
+    dolt commit -m "initial tables"
+}
+
+teardown() {
+    assert_feature_version
+    teardown_common
+}
+
+@test "sparse-checkout: --sparse limits SHOW TABLES to matching tables" {
+    run dolt checkout --sparse 'sales_*'
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Sparse checkout of tables matching: sales_*" ]] || false
+
+    run dolt sql -q "SHOW TABLES" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "sales_2024" ]] || false
+    [[ "$output" =~ "sales_2025" ]] || false
+    [[ ! "$output" =~ "inventory" ]] || false
+
+    run dolt sql -q "SELECT * FROM dolt_sparse" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "sales_*" ]] || false
+
+    # excluded tables can still be queried by name
+    run dolt sql -q "SELECT qty FROM inventory" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "100" ]] || false
+}
+
+@test "sparse-checkout: status and diff only show tables in the sparse checkout" {
+    dolt checkout --sparse sales_2024
+    dolt sql -q "INSERT INTO sales_2024 VALUES (2, 20); INSERT INTO inventory VALUES (2, 200)"
+
+    run dolt status
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "sales_2024" ]] || false
+    [[ ! "$output" =~ "inventory" ]] || false
+
+    run dolt diff --summary
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "sales_2024" ]] || false
+    [[ ! "$output" =~ "inventory" ]] || false
+
+    run dolt sql -q "SELECT table_name FROM dolt_status" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "sales_2024" ]] || false
+    [[ ! "$output" =~ "inventory" ]] || false
+
+    # naming the table explicitly still shows its diff
+    run dolt sql -q "SELECT * FROM dolt_diff_summary('HEAD', 'WORKING', 'inventory')" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "inventory" ]] || false
+
+    dolt checkout --no-sparse
+    run dolt status
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "inventory" ]] || false
+}
+
+@test "sparse-checkout: dolt_sparse system table edits the patterns" {
+    dolt sql -q "INSERT INTO dolt_sparse VALUES ('inventory'), ('sales_2025')"
+
+    run dolt sql -q "SHOW TABLES" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "inventory" ]] || false
+    [[ "$output" =~ "sales_2025" ]] || false
+    [[ ! "$output" =~ "sales_2024" ]] || false
+
+    run dolt sql -q "INSERT INTO dolt_sparse VALUES ('INVENTORY')"
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "duplicate" ]] || false
+
+    dolt sql -q "DELETE FROM dolt_sparse WHERE pattern = 'sales_2025'"
+    run dolt sql -q "SELECT * FROM dolt_sparse" -r csv
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 2 ]
+    [[ "$output" =~ "inventory" ]] || false
+
+    dolt sql -q "DELETE FROM dolt_sparse"
+    run dolt sql -q "SHOW TABLES" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "sales_2024" ]] || false
+}
+
+@test "sparse-checkout: patterns belong to the working set of a branch" {
+    dolt checkout -b feature --sparse inventory
+
+    run dolt sql -q "SELECT * FROM dolt_sparse" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "inventory" ]] || false
+
+    dolt checkout main
+    run dolt sql -q "SELECT * FROM dolt_sparse" -r csv
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 1 ]
+
+    # the patterns are never committed
+    dolt checkout feature
+    run dolt status
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "nothing to commit" ]] || false
+}
+
+@test "sparse-checkout: merge carries over tables outside of the sparse checkout" {
+    dolt branch other
+    dolt checkout other
+    dolt sql -q "INSERT INTO inventory VALUES (2, 200); INSERT INTO sales_2024 VALUES (2, 20)"
+    dolt commit -am "changes on other"
+    dolt checkout main --sparse sales_2024
+    dolt sql -q "INSERT INTO sales_2024 VALUES (3, 30)"
+    dolt commit -am "changes on main"
+
+    run dolt merge other
+    [ "$status" -eq 0 ]
+
+    run dolt sql -q "SELECT COUNT(*) FROM inventory" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "2" ]] || false
+    run dolt sql -q "SELECT COUNT(*) FROM sales_2024" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "3" ]] || false
+}
+
+@test "sparse-checkout: merge merges rows of a table outside of the sparse checkout modified on both sides" {
+    dolt branch other
+    dolt checkout other
+    dolt sql -q "INSERT INTO inventory VALUES (2, 200)"
+    dolt commit -am "changes on other"
+    dolt checkout main --sparse sales_2024
+    dolt sql -q "INSERT INTO inventory VALUES (3, 300)"
+    dolt commit -am "changes on main"
+
+    run dolt merge other
+    [ "$status" -eq 0 ]
+    run dolt sql -q "SELECT COUNT(*) FROM inventory" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "3" ]] || false
+}
+
+@test "sparse-checkout: invalid flag combinations" {
+    run dolt checkout --sparse inventory --no-sparse
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "Cannot use both --sparse and --no-sparse" ]] || false
+
+    run dolt checkout --sparse inventory main sales_2024
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "can only be used when checking out a branch" ]] || false
+
+    run dolt checkout --sparse ","
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "requires at least one table pattern" ]] || false
+}
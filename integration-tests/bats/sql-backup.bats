@@ -201,6 +201,29 @@ teardown() {
     dolt sql -q "CALL dolt_backup('sync', 'hostedapidb-0')"
 }
 
+@test "sql-backup: dolt_backup verify and prune" {
+    mkdir the_backup
+    dolt backup add hostedapidb-0 file://./the_backup
+    dolt sql -q "call dolt_backup('sync', 'hostedapidb-0')"
+    dolt commit --allow-empty -m "another commit"
+    dolt sql -q "call dolt_backup('sync', 'hostedapidb-0')"
+
+    run dolt sql -q "call dolt_backup('verify', 'hostedapidb-0')"
+    [ "$status" -eq 0 ]
+
+    run dolt sql -q "call dolt_backup('prune', '--keep-last', '1', 'hostedapidb-0')"
+    [ "$status" -eq 0 ]
+
+    run dolt backup verify hostedapidb-0
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 2 ]
+    [[ "${lines[0]}" =~ ^2 ]] || false
+
+    run dolt sql -q "call dolt_backup('prune', 'hostedapidb-0')"
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "usage: dolt_backup('prune', '--keep-last', N, BACKUP_NAME)" ]] || false
+}
+
 @test "sql-backup: dolt_backup sync-url" {
     mkdir the_backup
     dolt sql -q "call dolt_backup('sync-url', 'file://./the_backup')"
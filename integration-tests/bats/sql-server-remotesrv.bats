@@ -504,6 +504,57 @@ call dolt_commit('-am', 'add one val');"
     ! [[ "$output" =~ "zeek" ]] || false
 }
 
+@test "sql-server-remotesrv: force push to remotesapi port respects the server's protected branches" {
+    mkdir remote
+    cd remote
+    dolt init
+    dolt sql -q 'create table names (name varchar(10) primary key);'
+    dolt sql -q 'insert into names (name) values ("abe"), ("betsy"), ("calvin");'
+    dolt add names
+    dolt commit -m 'initial names.'
+    dolt branch other
+
+    APIPORT=$( definePORT )
+    dolt sql -q "CREATE USER root@'%' identified by 'rootpass'; GRANT ALL ON *.* to root@'%';"
+    export DOLT_REMOTE_PASSWORD="rootpass"
+    export SQL_USER="root"
+    start_sql_server_with_args --remotesapi-port $APIPORT
+
+    cd ../
+    dolt clone http://localhost:$APIPORT/remote cloned_db -u root
+
+    cd remote
+    dolt sql -q "INSERT INTO dolt_branch_protection (\`database\`, branch) VALUES ('%', 'main'), ('%', 'other')"
+    dolt sql -q 'insert into names (name) values ("zeek");'
+    dolt commit -a -m 'add Zeek.'
+
+    # The pushing client has no protection rules of its own
+    cd ../cloned_db
+    dolt sql -q 'insert into names values ("dave");'
+    dolt commit -am 'add dave'
+    run dolt push origin --force --user $SQL_USER main:main
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "does not allow its history to be overwritten" ]] || false
+
+    run dolt push origin --force --user $SQL_USER :other
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "does not allow its history to be overwritten" ]] || false
+
+    # Fast-forward pushes are still allowed
+    dolt fetch --user $SQL_USER origin
+    dolt reset --hard origin/main
+    dolt sql -q 'insert into names values ("dave");'
+    dolt commit -am 'add dave'
+    dolt push origin --user $SQL_USER main:main
+
+    cd ../remote
+    run dolt sql -q 'select * from names;'
+    [[ "$output" =~ "dave" ]] || false
+    [[ "$output" =~ "zeek" ]] || false
+    run dolt branch
+    [[ "$output" =~ "other" ]] || false
+}
+
 @test "sql-server-remotesrv: push to remoteapi port as non-super user rejected" {
     mkdir remote
     cd remote
@@ -0,0 +1,177 @@
+#!/usr/bin/env bats
+load $BATS_TEST_DIRNAME/helper/common.bash
+load $BATS_TEST_DIRNAME/helper/query-server-common.bash
+
+setup() {
+    skiponwindows "tests are flaky on Windows"
+    if [ "$SQL_ENGINE" = "remote-engine" ]; then
+      skip "This test tests remote connections directly, SQL_ENGINE is not needed."
+    fi
+    setup_common
+
+    dolt sql -q "create table t1 (pk int primary key);"
+    dolt commit -Am "add t1"
+    dolt branch release
+}
+
+teardown() {
+    stop_sql_server 1 && sleep 0.5
+    if [ -n "$LISTENER_PID" ]; then
+        kill $LISTENER_PID || true
+    fi
+    teardown_common
+}
+
+# start_webhook_listener starts a local HTTP endpoint which appends each request it receives to requests.log, as
+# a JSON line holding the signature header and the payload.
+start_webhook_listener() {
+    LISTENER_PORT=$( definePORT )
+    cat > listener.py <<EOF
+import http.server, json
+class Handler(http.server.BaseHTTPRequestHandler):
+    def do_POST(self):
+        body = self.rfile.read(int(self.headers['Content-Length']))
+        with open('requests.log', 'a') as f:
+            f.write(json.dumps({'signature': self.headers.get('X-Dolt-Signature-256'), 'body': body.decode()}) + '\n')
+        self.send_response(200)
+        self.end_headers()
+    def log_message(self, *args):
+        pass
+http.server.HTTPServer(('127.0.0.1', $LISTENER_PORT), Handler).serve_forever()
+EOF
+    python3 listener.py &
+    LISTENER_PID=$!
+    sleep 1
+}
+
+# wait_for_deliveries polls dolt_webhook_deliveries until it has at least $1 rows which are no longer pending
+wait_for_deliveries() {
+    for i in $(seq 1 50); do
+        run dolt sql -r csv -q "select count(*) from dolt_webhook_deliveries where status != 'pending'"
+        if [ "$status" -eq 0 ] && [ "${lines[1]}" -ge "$1" ]; then
+            return 0
+        fi
+        sleep 0.2
+    done
+    echo "timed out waiting for $1 dolt_webhook_deliveries rows"
+    return 1
+}
+
+@test "webhooks-sql-server: branch updates are delivered with a signed payload" {
+    start_webhook_listener
+    cat > server.yaml <<EOF
+webhooks:
+- name: local
+  url: http://127.0.0.1:$LISTENER_PORT/hook
+  secret: s3cr3t
+EOF
+    start_sql_server_with_config "" server.yaml
+
+    dolt sql -q "insert into t1 values (1), (2); call dolt_commit('-am', 'add two rows');"
+    wait_for_deliveries 1
+
+    run dolt sql -r csv -q "select webhook, branch, status, attempts, response_code from dolt_webhook_deliveries"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "local,main,delivered,1,200" ]] || false
+
+    run cat requests.log
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ '\"branch\":\"main\"' ]] || false
+    [[ "$output" =~ '\"message\":\"add two rows\"' ]] || false
+    [[ "$output" =~ '\"table_name\":\"t1\",\"rows_added\":2' ]] || false
+
+    run python3 -c "
+import hashlib, hmac, json
+for line in open('requests.log'):
+    req = json.loads(line)
+    expected = 'sha256=' + hmac.new(b's3cr3t', req['body'].encode(), hashlib.sha256).hexdigest()
+    assert req['signature'] == expected, req['signature']
+print('signatures verified')
+"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "signatures verified" ]] || false
+}
+
+@test "webhooks-sql-server: deliveries are kept after the server stops" {
+    start_webhook_listener
+    cat > server.yaml <<EOF
+webhooks:
+- name: local
+  url: http://127.0.0.1:$LISTENER_PORT/hook
+EOF
+    start_sql_server_with_config "" server.yaml
+
+    dolt sql -q "call dolt_commit('--allow-empty', '-m', 'empty commit');"
+    wait_for_deliveries 1
+    stop_sql_server 1
+
+    run dolt sql -r csv -q "select webhook, branch, status from dolt_webhook_deliveries"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "local,main,delivered" ]] || false
+}
+
+@test "webhooks-sql-server: branch creation and deletion are delivered" {
+    start_webhook_listener
+    cat > server.yaml <<EOF
+webhooks:
+- url: http://127.0.0.1:$LISTENER_PORT/hook
+EOF
+    start_sql_server_with_config "" server.yaml
+
+    dolt sql -q "call dolt_branch('feature'); call dolt_branch('-d', 'feature');"
+    wait_for_deliveries 2
+
+    run dolt sql -r csv -q "select branch, old_commit is null, new_commit is null, status from dolt_webhook_deliveries"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "feature,1,0,delivered" ]] || false
+    [[ "$output" =~ "feature,0,1,delivered" ]] || false
+}
+
+@test "webhooks-sql-server: only matching branches are delivered" {
+    start_webhook_listener
+    cat > server.yaml <<EOF
+webhooks:
+- url: http://127.0.0.1:$LISTENER_PORT/hook
+  branches: [release]
+EOF
+    start_sql_server_with_config "" server.yaml
+
+    dolt sql -q "call dolt_commit('--allow-empty', '-m', 'main commit');"
+    dolt sql -q "call dolt_checkout('release'); call dolt_commit('--allow-empty', '-m', 'release commit');"
+    wait_for_deliveries 1
+
+    run dolt sql -r csv -q "select branch from dolt_webhook_deliveries"
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 2 ]
+    [ "${lines[1]}" = "release" ]
+}
+
+@test "webhooks-sql-server: failed deliveries are retried and recorded" {
+    HOOK_PORT=$( definePORT )
+    cat > server.yaml <<EOF
+webhooks:
+- name: unreachable
+  url: http://127.0.0.1:$HOOK_PORT/hook
+  max_retries: 1
+  timeout_millis: 500
+EOF
+    start_sql_server_with_config "" server.yaml
+
+    dolt sql -q "call dolt_commit('--allow-empty', '-m', 'empty commit');"
+    wait_for_deliveries 1
+
+    run dolt sql -r csv -q "select webhook, status, attempts, error is not null from dolt_webhook_deliveries"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "unreachable,failed,2,1" ]] || false
+}
+
+@test "webhooks-sql-server: invalid webhook config is rejected" {
+    cat > server.yaml <<EOF
+webhooks:
+- url: ftp://127.0.0.1/hook
+EOF
+    PORT=$( definePORT )
+    run dolt sql-server --port $PORT --config server.yaml
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "webhooks[0]: url" ]] || false
+}